@@ -0,0 +1,153 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ABI is the in-memory form of a MultiversX contract ABI JSON file, as produced by the Rust framework.
+type ABI struct {
+	Name               string                      `json:"name"`
+	Constructor        *Endpoint                   `json:"constructor,omitempty"`
+	UpgradeConstructor *Endpoint                   `json:"upgradeConstructor,omitempty"`
+	Endpoints          []*Endpoint                 `json:"endpoints"`
+	Events             []*Event                    `json:"events,omitempty"`
+	HasCallback        bool                        `json:"hasCallback,omitempty"`
+	Types              map[string]*TypeDescription `json:"types,omitempty"`
+}
+
+// Endpoint describes a contract endpoint, the constructor or the upgrade constructor.
+type Endpoint struct {
+	Name            string    `json:"name"`
+	Mutability      string    `json:"mutability,omitempty"`
+	PayableInTokens []string  `json:"payableInTokens,omitempty"`
+	Inputs          []*Input  `json:"inputs"`
+	Outputs         []*Output `json:"outputs"`
+}
+
+// Input describes a single endpoint input.
+type Input struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	MultiArg bool   `json:"multi_arg,omitempty"`
+}
+
+// Output describes a single endpoint output.
+type Output struct {
+	Name        string `json:"name,omitempty"`
+	Type        string `json:"type"`
+	MultiResult bool   `json:"multi_result,omitempty"`
+}
+
+// Event describes an event the contract can emit.
+type Event struct {
+	Identifier string        `json:"identifier"`
+	Inputs     []*EventInput `json:"inputs"`
+}
+
+// EventInput describes a field of an event. Indexed fields are written as topics, the others as data.
+type EventInput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed,omitempty"`
+}
+
+// TypeDescription describes a custom struct or enum type declared in the ABI.
+type TypeDescription struct {
+	Type     string     `json:"type"`
+	Fields   []*Field   `json:"fields,omitempty"`
+	Variants []*Variant `json:"variants,omitempty"`
+}
+
+// Field describes a named field of a struct or of an enum variant.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Variant describes a single variant of an enum.
+type Variant struct {
+	Name         string   `json:"name"`
+	Discriminant uint8    `json:"discriminant"`
+	Fields       []*Field `json:"fields,omitempty"`
+}
+
+const (
+	typeKindStruct       = "struct"
+	typeKindEnum         = "enum"
+	typeKindExplicitEnum = "explicit-enum"
+)
+
+// LoadABI reads and parses an ABI JSON file.
+func LoadABI(path string) (*ABI, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseABI(content)
+}
+
+// ParseABI parses the contents of an ABI JSON file.
+func ParseABI(content []byte) (*ABI, error) {
+	contractABI := &ABI{}
+	err := json.Unmarshal(content, contractABI)
+	if err != nil {
+		return nil, err
+	}
+
+	err = contractABI.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return contractABI, nil
+}
+
+// GetEndpoint returns the endpoint with the given name. The constructor is
+// returned for "init", the upgrade constructor for "upgrade".
+func (contractABI *ABI) GetEndpoint(name string) (*Endpoint, error) {
+	for _, endpoint := range contractABI.Endpoints {
+		if endpoint.Name == name {
+			return endpoint, nil
+		}
+	}
+
+	if name == initFunctionName && contractABI.Constructor != nil {
+		return contractABI.Constructor, nil
+	}
+	if name == upgradeFunctionName && contractABI.UpgradeConstructor != nil {
+		return contractABI.UpgradeConstructor, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrEndpointNotFound, name)
+}
+
+// GetEvent returns the event with the given identifier.
+func (contractABI *ABI) GetEvent(identifier string) (*Event, error) {
+	for _, event := range contractABI.Events {
+		if event.Identifier == identifier {
+			return event, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrEventNotFound, identifier)
+}
+
+func (contractABI *ABI) validate() error {
+	for name, description := range contractABI.Types {
+		switch description.Type {
+		case typeKindStruct, typeKindEnum, typeKindExplicitEnum:
+		default:
+			return fmt.Errorf("%w: %s has kind %s", ErrUnsupportedType, name, description.Type)
+		}
+	}
+
+	return nil
+}
+
+const (
+	initFunctionName    = "init"
+	upgradeFunctionName = "upgrade"
+)
@@ -0,0 +1,239 @@
+package abi
+
+import (
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+var testABIJSON = []byte(`{
+	"name": "Tester",
+	"constructor": {
+		"inputs": [{"name": "initial", "type": "BigUint"}],
+		"outputs": []
+	},
+	"endpoints": [
+		{
+			"name": "add",
+			"mutability": "mutable",
+			"inputs": [
+				{"name": "value", "type": "u64"},
+				{"name": "note", "type": "optional<bytes>", "multi_arg": true}
+			],
+			"outputs": []
+		},
+		{
+			"name": "getPayment",
+			"mutability": "readonly",
+			"inputs": [],
+			"outputs": [{"type": "Payment"}, {"type": "Status"}]
+		},
+		{
+			"name": "getValues",
+			"mutability": "readonly",
+			"inputs": [{"name": "pairs", "type": "variadic<multi<u32,BigInt>>", "multi_arg": true}],
+			"outputs": [{"type": "List<i16>"}, {"type": "Option<Address>"}]
+		}
+	],
+	"events": [
+		{
+			"identifier": "deposit",
+			"inputs": [
+				{"name": "caller", "type": "Address", "indexed": true},
+				{"name": "amount", "type": "BigUint", "indexed": true},
+				{"name": "payment", "type": "Payment"}
+			]
+		}
+	],
+	"types": {
+		"Payment": {
+			"type": "struct",
+			"fields": [
+				{"name": "token", "type": "TokenIdentifier"},
+				{"name": "nonce", "type": "u64"},
+				{"name": "amount", "type": "BigUint"}
+			]
+		},
+		"Status": {
+			"type": "enum",
+			"variants": [
+				{"name": "Inactive", "discriminant": 0},
+				{"name": "Active", "discriminant": 1, "fields": [{"name": "0", "type": "u8"}]}
+			]
+		}
+	}
+}`)
+
+func newTestCodec(t *testing.T) *Codec {
+	contractABI, err := ParseABI(testABIJSON)
+	require.Nil(t, err)
+	return NewCodec(contractABI)
+}
+
+func TestParseTypeExpression(t *testing.T) {
+	expression, err := parseTypeExpression("variadic<multi<u32, List<Option<utf-8 string>>>>")
+	require.Nil(t, err)
+	require.Equal(t, "variadic<multi<u32,List<Option<utf-8 string>>>>", expression.String())
+
+	_, err = parseTypeExpression("List<u8")
+	require.ErrorIs(t, err, ErrInvalidTypeExpression)
+
+	_, err = parseTypeExpression("List<u8>>")
+	require.ErrorIs(t, err, ErrInvalidTypeExpression)
+}
+
+func TestCodec_EncodeArguments(t *testing.T) {
+	abiCodec := newTestCodec(t)
+
+	arguments, err := abiCodec.EncodeArguments("add", uint64(258))
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{{1, 2}}, arguments)
+
+	arguments, err = abiCodec.EncodeArguments("add", 0, "0x0102")
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{{}, {1, 2}}, arguments)
+
+	arguments, err = abiCodec.EncodeArguments("getValues", []interface{}{
+		[]interface{}{1, big.NewInt(-1)},
+		[]interface{}{uint32(2), "128"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{{1}, {0xff}, {2}, {0, 0x80}}, arguments)
+
+	arguments, err = abiCodec.EncodeArguments("init", 1000)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{{0x03, 0xe8}}, arguments)
+
+	_, err = abiCodec.EncodeArguments("add")
+	require.ErrorIs(t, err, ErrWrongNumberOfArguments)
+
+	_, err = abiCodec.EncodeArguments("add", -1)
+	require.ErrorIs(t, err, ErrValueOutOfRange)
+
+	_, err = abiCodec.EncodeArguments("missing")
+	require.ErrorIs(t, err, ErrEndpointNotFound)
+}
+
+func TestCodec_DecodeResults(t *testing.T) {
+	abiCodec := newTestCodec(t)
+
+	payment := []byte{0, 0, 0, 3, 'A', 'B', 'C', 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 1, 100}
+	results, err := abiCodec.DecodeResults("getPayment", [][]byte{payment, {1, 5}})
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{
+		"token":  "ABC",
+		"nonce":  uint64(7),
+		"amount": big.NewInt(100),
+	}, results[0])
+	require.Equal(t, &EnumValue{
+		Variant:      "Active",
+		Discriminant: 1,
+		Fields:       map[string]interface{}{"0": uint64(5)},
+	}, results[1])
+
+	results, err = abiCodec.DecodeResults("getPayment", [][]byte{payment, {}})
+	require.Nil(t, err)
+	require.Equal(t, &EnumValue{Variant: "Inactive"}, results[1])
+
+	results, err = abiCodec.DecodeResults("getValues", [][]byte{{0xff, 0xfe, 0, 3}, {}})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{int64(-2), int64(3)}, results[0])
+	require.Nil(t, results[1])
+
+	_, err = abiCodec.DecodeResults("getValues", [][]byte{{0xff}, {}})
+	require.ErrorIs(t, err, ErrUnexpectedEndOfData)
+
+	_, err = abiCodec.DecodeResults("getValues", [][]byte{{}, {}, {1}})
+	require.ErrorIs(t, err, ErrTrailingData)
+}
+
+func TestCodec_DecodeNestedListWithHugeLength(t *testing.T) {
+	abiCodec := newTestCodec(t)
+	expression, err := parseTypeExpression("List<List<u32>>")
+	require.Nil(t, err)
+
+	// the length prefix announces 2^32-1 items, which are not in the data
+	_, _, err = abiCodec.codec.decodeNested(expression, []byte{0xff, 0xff, 0xff, 0xff})
+	require.ErrorIs(t, err, ErrUnexpectedEndOfData)
+
+	_, _, err = abiCodec.codec.decodeNested(expression, []byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0})
+	require.ErrorIs(t, err, ErrUnexpectedEndOfData)
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	abiCodec := newTestCodec(t)
+
+	payment := map[string]interface{}{
+		"token":  "TOKEN-abcdef",
+		"nonce":  uint64(1),
+		"amount": big.NewInt(1234567890),
+	}
+	encoded, err := abiCodec.EncodeValue("List<Payment>", []interface{}{payment, payment})
+	require.Nil(t, err)
+
+	decoded, err := abiCodec.DecodeStorageValue("List<Payment>", encoded)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{payment, payment}, decoded)
+
+	encoded, err = abiCodec.EncodeValue("Option<tuple<i8,bool>>", []interface{}{-3, true})
+	require.Nil(t, err)
+	require.Equal(t, []byte{1, 0xfd, 1}, encoded)
+
+	decoded, err = abiCodec.DecodeValue("Option<tuple<i8,bool>>", encoded)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{int64(-3), true}, decoded)
+
+	encoded, err = abiCodec.EncodeValue("Status", "Inactive")
+	require.Nil(t, err)
+	require.Equal(t, []byte{}, encoded)
+}
+
+func TestCodec_DecodeEvent(t *testing.T) {
+	abiCodec := newTestCodec(t)
+
+	caller := make([]byte, 32)
+	caller[31] = 1
+	payment := map[string]interface{}{
+		"token":  "EGLD",
+		"nonce":  uint64(0),
+		"amount": big.NewInt(5),
+	}
+	paymentBytes, err := abiCodec.EncodeValue("Payment", payment)
+	require.Nil(t, err)
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte("deposit"),
+		Address:    []byte("contract"),
+		Topics:     [][]byte{[]byte("deposit"), caller, {5}},
+		Data:       [][]byte{paymentBytes},
+	}
+
+	decoded, err := abiCodec.DecodeEvent(logEntry)
+	require.Nil(t, err)
+	require.Equal(t, "deposit", decoded.Identifier)
+	require.Equal(t, caller, decoded.Fields["caller"])
+	require.Equal(t, big.NewInt(5), decoded.Fields["amount"])
+	require.Equal(t, payment, decoded.Fields["payment"])
+
+	events, err := abiCodec.DecodeEvents([]*vmcommon.LogEntry{
+		{Topics: [][]byte{[]byte("unknown")}},
+		logEntry,
+	})
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+
+	_, err = abiCodec.DecodeEventAs("deposit", &vmcommon.LogEntry{Topics: [][]byte{[]byte("other")}})
+	require.ErrorIs(t, err, ErrEventIdentifierMismatch)
+}
+
+func TestCodec_SetCallArguments(t *testing.T) {
+	abiCodec := newTestCodec(t)
+
+	input := &vmcommon.ContractCallInput{}
+	err := abiCodec.SetCallArguments(input, "add", 5, nil)
+	require.Nil(t, err)
+	require.Equal(t, "add", input.Function)
+	require.Equal(t, [][]byte{{5}}, input.Arguments)
+}
@@ -0,0 +1,258 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Codec encodes call arguments and decodes results, events and storage values using an ABI.
+type Codec struct {
+	contractABI *ABI
+	codec       *codec
+}
+
+// NewCodec creates a Codec for the given ABI.
+func NewCodec(contractABI *ABI) *Codec {
+	return &Codec{
+		contractABI: contractABI,
+		codec:       newCodec(contractABI),
+	}
+}
+
+// ABI returns the ABI the codec was created with.
+func (abiCodec *Codec) ABI() *ABI {
+	return abiCodec.contractABI
+}
+
+// EncodeArguments encodes the values as the arguments of the given endpoint.
+// Multi-value inputs (variadic, optional, multi, counted-variadic) expect a slice of values.
+func (abiCodec *Codec) EncodeArguments(endpointName string, values ...interface{}) ([][]byte, error) {
+	endpoint, err := abiCodec.contractABI.GetEndpoint(endpointName)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > len(endpoint.Inputs) || len(values) < countMandatoryInputs(endpoint.Inputs) {
+		return nil, fmt.Errorf("%w: %s expects %d, got %d", ErrWrongNumberOfArguments, endpointName, len(endpoint.Inputs), len(values))
+	}
+
+	arguments := make([][]byte, 0, len(values))
+	for i, value := range values {
+		input := endpoint.Inputs[i]
+		expression, errParse := parseTypeExpression(input.Type)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		encoded, errEncode := abiCodec.encodeMultiValue(expression, value)
+		if errEncode != nil {
+			return nil, fmt.Errorf("argument %s: %w", input.Name, errEncode)
+		}
+		arguments = append(arguments, encoded...)
+	}
+
+	return arguments, nil
+}
+
+// DecodeResults decodes the return data of a call to the given endpoint.
+func (abiCodec *Codec) DecodeResults(endpointName string, returnData [][]byte) ([]interface{}, error) {
+	endpoint, err := abiCodec.contractABI.GetEndpoint(endpointName)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, 0, len(endpoint.Outputs))
+	for _, output := range endpoint.Outputs {
+		expression, errParse := parseTypeExpression(output.Type)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		var result interface{}
+		result, returnData, err = abiCodec.decodeMultiValue(expression, returnData)
+		if err != nil {
+			return nil, fmt.Errorf("result %s: %w", output.Type, err)
+		}
+		results = append(results, result)
+	}
+	if len(returnData) > 0 {
+		return nil, fmt.Errorf("%w: %d unexpected results", ErrTrailingData, len(returnData))
+	}
+
+	return results, nil
+}
+
+// EncodeValue encodes a single value of the given type in its top-level form, as stored or passed as argument.
+func (abiCodec *Codec) EncodeValue(typeName string, value interface{}) ([]byte, error) {
+	expression, err := parseTypeExpression(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return abiCodec.codec.encodeTopLevel(expression, value)
+}
+
+// DecodeValue decodes a single top-level encoded value of the given type, such as a storage value.
+func (abiCodec *Codec) DecodeValue(typeName string, data []byte) (interface{}, error) {
+	expression, err := parseTypeExpression(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return abiCodec.codec.decodeTopLevel(expression, data)
+}
+
+// DecodeStorageValue decodes a raw storage value of the given type. Storage values use the top-level encoding.
+func (abiCodec *Codec) DecodeStorageValue(typeName string, value []byte) (interface{}, error) {
+	return abiCodec.DecodeValue(typeName, value)
+}
+
+func (abiCodec *Codec) encodeMultiValue(expression *typeExpression, value interface{}) ([][]byte, error) {
+	if !expression.isMultiValue() {
+		encoded, err := abiCodec.codec.encodeTopLevel(expression, value)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{encoded}, nil
+	}
+
+	switch expression.name {
+	case typeOptional:
+		if value == nil {
+			return [][]byte{}, nil
+		}
+		innerType, err := expression.singleArg()
+		if err != nil {
+			return nil, err
+		}
+		return abiCodec.encodeMultiValue(innerType, value)
+	case typeMulti:
+		items, err := toSlice(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != len(expression.args) {
+			return nil, fmt.Errorf("%w: %s expects %d values, got %d", ErrInvalidValue, expression, len(expression.args), len(items))
+		}
+		return abiCodec.encodeMultiValueSequence(expression.args, items)
+	default:
+		innerType, err := expression.singleArg()
+		if err != nil {
+			return nil, err
+		}
+		items, err := toSlice(value)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([][]byte, 0, len(items)+1)
+		if expression.name == typeCountedVariadic {
+			result = append(result, big.NewInt(int64(len(items))).Bytes())
+		}
+		for _, item := range items {
+			encoded, errEncode := abiCodec.encodeMultiValue(innerType, item)
+			if errEncode != nil {
+				return nil, errEncode
+			}
+			result = append(result, encoded...)
+		}
+		return result, nil
+	}
+}
+
+func (abiCodec *Codec) encodeMultiValueSequence(types []*typeExpression, values []interface{}) ([][]byte, error) {
+	result := make([][]byte, 0, len(values))
+	for i, value := range values {
+		encoded, err := abiCodec.encodeMultiValue(types[i], value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, encoded...)
+	}
+
+	return result, nil
+}
+
+func (abiCodec *Codec) decodeMultiValue(expression *typeExpression, data [][]byte) (interface{}, [][]byte, error) {
+	if !expression.isMultiValue() {
+		if len(data) == 0 {
+			return nil, nil, ErrUnexpectedEndOfData
+		}
+		value, err := abiCodec.codec.decodeTopLevel(expression, data[0])
+		return value, data[1:], err
+	}
+
+	switch expression.name {
+	case typeOptional:
+		if len(data) == 0 {
+			return nil, data, nil
+		}
+		innerType, err := expression.singleArg()
+		if err != nil {
+			return nil, nil, err
+		}
+		return abiCodec.decodeMultiValue(innerType, data)
+	case typeMulti:
+		values := make([]interface{}, 0, len(expression.args))
+		for _, itemType := range expression.args {
+			var value interface{}
+			var err error
+			value, data, err = abiCodec.decodeMultiValue(itemType, data)
+			if err != nil {
+				return nil, nil, err
+			}
+			values = append(values, value)
+		}
+		return values, data, nil
+	case typeCountedVariadic:
+		innerType, err := expression.singleArg()
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(data) == 0 {
+			return nil, nil, ErrUnexpectedEndOfData
+		}
+		count := big.NewInt(0).SetBytes(data[0])
+		if !count.IsInt64() || count.Int64() > int64(len(data)) {
+			return nil, nil, fmt.Errorf("%w: counted-variadic count %s", ErrValueOutOfRange, count)
+		}
+		data = data[1:]
+		values := make([]interface{}, 0, count.Int64())
+		for i := int64(0); i < count.Int64(); i++ {
+			var value interface{}
+			value, data, err = abiCodec.decodeMultiValue(innerType, data)
+			if err != nil {
+				return nil, nil, err
+			}
+			values = append(values, value)
+		}
+		return values, data, nil
+	default:
+		innerType, err := expression.singleArg()
+		if err != nil {
+			return nil, nil, err
+		}
+		values := make([]interface{}, 0)
+		for len(data) > 0 {
+			var value interface{}
+			value, data, err = abiCodec.decodeMultiValue(innerType, data)
+			if err != nil {
+				return nil, nil, err
+			}
+			values = append(values, value)
+		}
+		return values, data, nil
+	}
+}
+
+func countMandatoryInputs(inputs []*Input) int {
+	mandatory := len(inputs)
+	for i := len(inputs) - 1; i >= 0; i-- {
+		expression, err := parseTypeExpression(inputs[i].Type)
+		if err != nil || !expression.isMultiValue() || expression.name == typeMulti {
+			break
+		}
+		mandatory = i
+	}
+
+	return mandatory
+}
@@ -0,0 +1,34 @@
+package abi
+
+import (
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// SetCallArguments encodes the values as arguments of the given endpoint and sets both the
+// function name and the arguments on the call input.
+func (abiCodec *Codec) SetCallArguments(input *vmcommon.ContractCallInput, endpointName string, values ...interface{}) error {
+	arguments, err := abiCodec.EncodeArguments(endpointName, values...)
+	if err != nil {
+		return err
+	}
+
+	input.Function = endpointName
+	input.Arguments = arguments
+	return nil
+}
+
+// SetDeployArguments encodes the values as constructor arguments and sets them on the create input.
+func (abiCodec *Codec) SetDeployArguments(input *vmcommon.ContractCreateInput, values ...interface{}) error {
+	arguments, err := abiCodec.EncodeArguments(initFunctionName, values...)
+	if err != nil {
+		return err
+	}
+
+	input.Arguments = arguments
+	return nil
+}
+
+// DecodeOutput decodes the return data of a VMOutput produced by a call to the given endpoint.
+func (abiCodec *Codec) DecodeOutput(endpointName string, vmOutput *vmcommon.VMOutput) ([]interface{}, error) {
+	return abiCodec.DecodeResults(endpointName, vmOutput.ReturnData)
+}
@@ -0,0 +1,802 @@
+package abi
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	twos "github.com/multiversx/mx-components-big-int/twos-complement"
+)
+
+const lengthPrefixSize = 4
+
+const (
+	addressLength      = 32
+	h256Length         = 32
+	codeMetadataLength = 2
+)
+
+// EnumValue is the Go representation of an enum value. Fields is nil for variants without fields.
+type EnumValue struct {
+	Variant      string
+	Discriminant uint8
+	Fields       map[string]interface{}
+}
+
+type integerType struct {
+	size   int
+	signed bool
+}
+
+var integerTypes = map[string]integerType{
+	"u8":    {size: 1},
+	"u16":   {size: 2},
+	"u32":   {size: 4},
+	"u64":   {size: 8},
+	"usize": {size: 4},
+	"i8":    {size: 1, signed: true},
+	"i16":   {size: 2, signed: true},
+	"i32":   {size: 4, signed: true},
+	"i64":   {size: 8, signed: true},
+	"isize": {size: 4, signed: true},
+}
+
+var bytesTypes = map[string]struct{}{
+	"bytes":                     {},
+	"BoxedBytes":                {},
+	"ManagedBuffer":             {},
+	"EgldOrEsdtTokenIdentifier": {},
+}
+
+var stringTypes = map[string]struct{}{
+	"utf-8 string":    {},
+	"TokenIdentifier": {},
+}
+
+var fixedBytesTypes = map[string]int{
+	"Address":        addressLength,
+	"ManagedAddress": addressLength,
+	"H256":           h256Length,
+	"CodeMetadata":   codeMetadataLength,
+}
+
+// codec encodes and decodes single values with the MultiversX serialization format,
+// both in nested form (self-delimiting, as inside lists and structs) and in top-level
+// form (as a whole argument or return value).
+type codec struct {
+	types map[string]*TypeDescription
+}
+
+func newCodec(contractABI *ABI) *codec {
+	types := make(map[string]*TypeDescription)
+	if contractABI != nil && contractABI.Types != nil {
+		types = contractABI.Types
+	}
+
+	return &codec{types: types}
+}
+
+func (c *codec) encodeNested(expression *typeExpression, value interface{}) ([]byte, error) {
+	if intType, ok := integerTypes[expression.name]; ok {
+		number, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		return encodeFixedInteger(number, intType)
+	}
+
+	switch expression.name {
+	case "bool":
+		return encodeBool(value, true)
+	case "BigUint", "BigInt":
+		encoded, err := c.encodeTopLevel(expression, value)
+		if err != nil {
+			return nil, err
+		}
+		return withLengthPrefix(encoded), nil
+	case typeList, typeVec, typeManagedVec:
+		return c.encodeNestedList(expression, value)
+	case typeOption:
+		return c.encodeNestedOption(expression, value)
+	case typeTuple:
+		return c.encodeNestedTuple(expression, value)
+	}
+
+	if _, ok := bytesTypes[expression.name]; ok {
+		return encodeBytesNested(value)
+	}
+	if _, ok := stringTypes[expression.name]; ok {
+		return encodeBytesNested(value)
+	}
+	if length, ok := fixedBytesTypes[expression.name]; ok {
+		return encodeFixedBytes(value, length)
+	}
+	if length, ok := expression.arrayLength(); ok {
+		return c.encodeArray(expression.args[0], length, value)
+	}
+	if description, ok := c.types[expression.name]; ok {
+		return c.encodeCustomNested(expression.name, description, value)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, expression)
+}
+
+func (c *codec) encodeTopLevel(expression *typeExpression, value interface{}) ([]byte, error) {
+	if _, ok := integerTypes[expression.name]; ok {
+		number, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		intType := integerTypes[expression.name]
+		_, err = encodeFixedInteger(number, intType)
+		if err != nil {
+			return nil, err
+		}
+		return encodeMinimalInteger(number, intType.signed), nil
+	}
+
+	switch expression.name {
+	case "bool":
+		return encodeBool(value, false)
+	case "BigUint":
+		number, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		if number.Sign() < 0 {
+			return nil, fmt.Errorf("%w: negative BigUint", ErrValueOutOfRange)
+		}
+		return number.Bytes(), nil
+	case "BigInt":
+		number, err := toBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		return twos.ToBytes(number), nil
+	case typeList, typeVec, typeManagedVec:
+		return c.encodeTopLevelList(expression, value)
+	case typeOption:
+		if value == nil {
+			return []byte{}, nil
+		}
+		return c.encodeNestedOption(expression, value)
+	}
+
+	if _, ok := bytesTypes[expression.name]; ok {
+		return toBytes(value)
+	}
+	if _, ok := stringTypes[expression.name]; ok {
+		return toBytes(value)
+	}
+	if description, ok := c.types[expression.name]; ok && isEnum(description) {
+		encoded, err := c.encodeCustomNested(expression.name, description, value)
+		if err != nil {
+			return nil, err
+		}
+		if len(encoded) == 1 && encoded[0] == 0 {
+			return []byte{}, nil
+		}
+		return encoded, nil
+	}
+
+	return c.encodeNested(expression, value)
+}
+
+func (c *codec) encodeNestedList(expression *typeExpression, value interface{}) ([]byte, error) {
+	items, err := toSlice(value)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := c.encodeTopLevelList(expression, items)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, lengthPrefixSize, lengthPrefixSize+len(encoded))
+	binary.BigEndian.PutUint32(result, uint32(len(items)))
+	return append(result, encoded...), nil
+}
+
+func (c *codec) encodeTopLevelList(expression *typeExpression, value interface{}) ([]byte, error) {
+	itemType, err := expression.singleArg()
+	if err != nil {
+		return nil, err
+	}
+	if itemType.name == "u8" {
+		if raw, ok := value.([]byte); ok {
+			return raw, nil
+		}
+	}
+
+	items, err := toSlice(value)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, 0)
+	for _, item := range items {
+		encodedItem, errEncode := c.encodeNested(itemType, item)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		result = append(result, encodedItem...)
+	}
+
+	return result, nil
+}
+
+func (c *codec) encodeNestedOption(expression *typeExpression, value interface{}) ([]byte, error) {
+	if value == nil {
+		return []byte{0}, nil
+	}
+
+	innerType, err := expression.singleArg()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := c.encodeNested(innerType, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{1}, encoded...), nil
+}
+
+func (c *codec) encodeNestedTuple(expression *typeExpression, value interface{}) ([]byte, error) {
+	items, err := toSlice(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) != len(expression.args) {
+		return nil, fmt.Errorf("%w: %s expects %d items, got %d", ErrInvalidValue, expression, len(expression.args), len(items))
+	}
+
+	result := make([]byte, 0)
+	for i, item := range items {
+		encodedItem, errEncode := c.encodeNested(expression.args[i], item)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		result = append(result, encodedItem...)
+	}
+
+	return result, nil
+}
+
+func (c *codec) encodeArray(itemType *typeExpression, length int, value interface{}) ([]byte, error) {
+	if itemType.name == "u8" {
+		if raw, ok := value.([]byte); ok {
+			if len(raw) != length {
+				return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidValue, length, len(raw))
+			}
+			return raw, nil
+		}
+	}
+
+	items, err := toSlice(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) != length {
+		return nil, fmt.Errorf("%w: expected %d items, got %d", ErrInvalidValue, length, len(items))
+	}
+
+	result := make([]byte, 0)
+	for _, item := range items {
+		encodedItem, errEncode := c.encodeNested(itemType, item)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		result = append(result, encodedItem...)
+	}
+
+	return result, nil
+}
+
+func (c *codec) encodeCustomNested(name string, description *TypeDescription, value interface{}) ([]byte, error) {
+	if !isEnum(description) {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: struct %s expects map[string]interface{}, got %T", ErrInvalidValue, name, value)
+		}
+		return c.encodeFields(description.Fields, fields)
+	}
+
+	variant, fields, err := findVariant(name, description, value)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedFields, err := c.encodeFields(variant.Fields, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{variant.Discriminant}, encodedFields...), nil
+}
+
+func (c *codec) encodeFields(fields []*Field, values map[string]interface{}) ([]byte, error) {
+	result := make([]byte, 0)
+	for _, field := range fields {
+		fieldValue, ok := values[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing field %s", ErrInvalidValue, field.Name)
+		}
+
+		fieldType, err := parseTypeExpression(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		encodedField, err := c.encodeNested(fieldType, fieldValue)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		result = append(result, encodedField...)
+	}
+
+	return result, nil
+}
+
+func (c *codec) decodeNested(expression *typeExpression, data []byte) (interface{}, []byte, error) {
+	if intType, ok := integerTypes[expression.name]; ok {
+		if len(data) < intType.size {
+			return nil, nil, ErrUnexpectedEndOfData
+		}
+		return decodeInteger(data[:intType.size], intType.signed), data[intType.size:], nil
+	}
+
+	switch expression.name {
+	case "bool":
+		if len(data) < 1 {
+			return nil, nil, ErrUnexpectedEndOfData
+		}
+		return data[0] == 1, data[1:], nil
+	case "BigUint", "BigInt":
+		raw, rest, err := readLengthPrefixed(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := c.decodeTopLevel(expression, raw)
+		return value, rest, err
+	case typeList, typeVec, typeManagedVec:
+		return c.decodeNestedList(expression, data)
+	case typeOption:
+		return c.decodeNestedOption(expression, data)
+	case typeTuple:
+		return c.decodeSequence(expression.args, data)
+	}
+
+	if _, ok := bytesTypes[expression.name]; ok {
+		raw, rest, err := readLengthPrefixed(data)
+		return raw, rest, err
+	}
+	if _, ok := stringTypes[expression.name]; ok {
+		raw, rest, err := readLengthPrefixed(data)
+		return string(raw), rest, err
+	}
+	if length, ok := fixedBytesTypes[expression.name]; ok {
+		if len(data) < length {
+			return nil, nil, ErrUnexpectedEndOfData
+		}
+		return cloneBytes(data[:length]), data[length:], nil
+	}
+	if length, ok := expression.arrayLength(); ok {
+		if expression.args[0].name == "u8" {
+			if len(data) < length {
+				return nil, nil, ErrUnexpectedEndOfData
+			}
+			return cloneBytes(data[:length]), data[length:], nil
+		}
+		itemTypes := make([]*typeExpression, length)
+		for i := range itemTypes {
+			itemTypes[i] = expression.args[0]
+		}
+		return c.decodeSequence(itemTypes, data)
+	}
+	if description, ok := c.types[expression.name]; ok {
+		return c.decodeCustomNested(expression.name, description, data)
+	}
+
+	return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedType, expression)
+}
+
+func (c *codec) decodeTopLevel(expression *typeExpression, data []byte) (interface{}, error) {
+	if intType, ok := integerTypes[expression.name]; ok {
+		if len(data) > intType.size {
+			return nil, fmt.Errorf("%w: %d bytes for %s", ErrValueOutOfRange, len(data), expression.name)
+		}
+		return decodeInteger(data, intType.signed), nil
+	}
+
+	switch expression.name {
+	case "bool":
+		if len(data) > 1 || (len(data) == 1 && data[0] > 1) {
+			return nil, fmt.Errorf("%w: invalid bool", ErrInvalidValue)
+		}
+		return len(data) == 1, nil
+	case "BigUint":
+		return big.NewInt(0).SetBytes(data), nil
+	case "BigInt":
+		return twos.FromBytes(data), nil
+	case typeList, typeVec, typeManagedVec:
+		return c.decodeTopLevelList(expression, data)
+	case typeOption:
+		if len(data) == 0 {
+			return nil, nil
+		}
+	}
+
+	if _, ok := bytesTypes[expression.name]; ok {
+		return cloneBytes(data), nil
+	}
+	if _, ok := stringTypes[expression.name]; ok {
+		return string(data), nil
+	}
+	if description, ok := c.types[expression.name]; ok && isEnum(description) && len(data) == 0 {
+		data = []byte{0}
+	}
+
+	value, rest, err := c.decodeNested(expression, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("%w: %d bytes after %s", ErrTrailingData, len(rest), expression)
+	}
+
+	return value, nil
+}
+
+func (c *codec) decodeNestedList(expression *typeExpression, data []byte) (interface{}, []byte, error) {
+	itemType, err := expression.singleArg()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) < lengthPrefixSize {
+		return nil, nil, ErrUnexpectedEndOfData
+	}
+
+	count := int(binary.BigEndian.Uint32(data))
+	data = data[lengthPrefixSize:]
+	if itemType.name == "u8" {
+		if len(data) < count {
+			return nil, nil, ErrUnexpectedEndOfData
+		}
+		return cloneBytes(data[:count]), data[count:], nil
+	}
+
+	// the count comes from the data, so it only bounds the allocation as far as the data can back it
+	items := make([]interface{}, 0, min(count, len(data)))
+	for i := 0; i < count; i++ {
+		var item interface{}
+		item, data, err = c.decodeNested(itemType, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, data, nil
+}
+
+func (c *codec) decodeTopLevelList(expression *typeExpression, data []byte) (interface{}, error) {
+	itemType, err := expression.singleArg()
+	if err != nil {
+		return nil, err
+	}
+	if itemType.name == "u8" {
+		return cloneBytes(data), nil
+	}
+
+	items := make([]interface{}, 0)
+	for len(data) > 0 {
+		var item interface{}
+		item, data, err = c.decodeNested(itemType, data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (c *codec) decodeNestedOption(expression *typeExpression, data []byte) (interface{}, []byte, error) {
+	innerType, err := expression.singleArg()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) < 1 {
+		return nil, nil, ErrUnexpectedEndOfData
+	}
+
+	switch data[0] {
+	case 0:
+		return nil, data[1:], nil
+	case 1:
+		return c.decodeNested(innerType, data[1:])
+	default:
+		return nil, nil, fmt.Errorf("%w: invalid Option marker %d", ErrInvalidValue, data[0])
+	}
+}
+
+func (c *codec) decodeSequence(itemTypes []*typeExpression, data []byte) (interface{}, []byte, error) {
+	items := make([]interface{}, 0, len(itemTypes))
+	for _, itemType := range itemTypes {
+		var item interface{}
+		var err error
+		item, data, err = c.decodeNested(itemType, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, data, nil
+}
+
+func (c *codec) decodeCustomNested(name string, description *TypeDescription, data []byte) (interface{}, []byte, error) {
+	if !isEnum(description) {
+		return c.decodeFields(description.Fields, data)
+	}
+
+	if len(data) < 1 {
+		return nil, nil, ErrUnexpectedEndOfData
+	}
+
+	for _, variant := range description.Variants {
+		if variant.Discriminant != data[0] {
+			continue
+		}
+
+		enumValue := &EnumValue{
+			Variant:      variant.Name,
+			Discriminant: variant.Discriminant,
+		}
+		rest := data[1:]
+		if len(variant.Fields) > 0 {
+			fields, restAfterFields, err := c.decodeFields(variant.Fields, rest)
+			if err != nil {
+				return nil, nil, err
+			}
+			enumValue.Fields = fields.(map[string]interface{})
+			rest = restAfterFields
+		}
+		return enumValue, rest, nil
+	}
+
+	return nil, nil, fmt.Errorf("%w: %s discriminant %d", ErrUnknownEnumVariant, name, data[0])
+}
+
+func (c *codec) decodeFields(fields []*Field, data []byte) (interface{}, []byte, error) {
+	values := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		fieldType, err := parseTypeExpression(field.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var value interface{}
+		value, data, err = c.decodeNested(fieldType, data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		values[field.Name] = value
+	}
+
+	return values, data, nil
+}
+
+func isEnum(description *TypeDescription) bool {
+	return description.Type == typeKindEnum || description.Type == typeKindExplicitEnum
+}
+
+func findVariant(name string, description *TypeDescription, value interface{}) (*Variant, map[string]interface{}, error) {
+	var variantName string
+	var fields map[string]interface{}
+	switch typedValue := value.(type) {
+	case *EnumValue:
+		variantName = typedValue.Variant
+		fields = typedValue.Fields
+	case EnumValue:
+		variantName = typedValue.Variant
+		fields = typedValue.Fields
+	case string:
+		variantName = typedValue
+	default:
+		return nil, nil, fmt.Errorf("%w: enum %s expects EnumValue or variant name, got %T", ErrInvalidValue, name, value)
+	}
+
+	for _, variant := range description.Variants {
+		if variant.Name == variantName {
+			return variant, fields, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w: %s::%s", ErrUnknownEnumVariant, name, variantName)
+}
+
+func encodeFixedInteger(number *big.Int, intType integerType) ([]byte, error) {
+	if !intType.signed {
+		if number.Sign() < 0 || number.BitLen() > intType.size*8 {
+			return nil, fmt.Errorf("%w: %s does not fit in %d unsigned bytes", ErrValueOutOfRange, number, intType.size)
+		}
+		result := make([]byte, intType.size)
+		return number.FillBytes(result), nil
+	}
+
+	result, err := twos.ToBytesOfLength(number, intType.size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrValueOutOfRange, err.Error())
+	}
+
+	return result, nil
+}
+
+func encodeMinimalInteger(number *big.Int, signed bool) []byte {
+	if signed {
+		return twos.ToBytes(number)
+	}
+	return number.Bytes()
+}
+
+func decodeInteger(data []byte, signed bool) interface{} {
+	if signed {
+		return twos.FromBytes(data).Int64()
+	}
+	return big.NewInt(0).SetBytes(data).Uint64()
+}
+
+func encodeBool(value interface{}, nested bool) ([]byte, error) {
+	boolValue, ok := value.(bool)
+	if !ok {
+		return nil, fmt.Errorf("%w: bool expected, got %T", ErrInvalidValue, value)
+	}
+
+	switch {
+	case boolValue:
+		return []byte{1}, nil
+	case nested:
+		return []byte{0}, nil
+	default:
+		return []byte{}, nil
+	}
+}
+
+func encodeBytesNested(value interface{}) ([]byte, error) {
+	raw, err := toBytes(value)
+	if err != nil {
+		return nil, err
+	}
+	return withLengthPrefix(raw), nil
+}
+
+func encodeFixedBytes(value interface{}, length int) ([]byte, error) {
+	raw, err := toBytes(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != length {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidValue, length, len(raw))
+	}
+	return raw, nil
+}
+
+func withLengthPrefix(raw []byte) []byte {
+	result := make([]byte, lengthPrefixSize, lengthPrefixSize+len(raw))
+	binary.BigEndian.PutUint32(result, uint32(len(raw)))
+	return append(result, raw...)
+}
+
+func readLengthPrefixed(data []byte) ([]byte, []byte, error) {
+	if len(data) < lengthPrefixSize {
+		return nil, nil, ErrUnexpectedEndOfData
+	}
+
+	length := int(binary.BigEndian.Uint32(data))
+	data = data[lengthPrefixSize:]
+	if len(data) < length {
+		return nil, nil, ErrUnexpectedEndOfData
+	}
+
+	return cloneBytes(data[:length]), data[length:], nil
+}
+
+func cloneBytes(data []byte) []byte {
+	result := make([]byte, len(data))
+	copy(result, data)
+	return result
+}
+
+func toBigInt(value interface{}) (*big.Int, error) {
+	switch number := value.(type) {
+	case *big.Int:
+		if number == nil {
+			return nil, fmt.Errorf("%w: nil *big.Int", ErrInvalidValue)
+		}
+		return big.NewInt(0).Set(number), nil
+	case big.Int:
+		return big.NewInt(0).Set(&number), nil
+	case int:
+		return big.NewInt(int64(number)), nil
+	case int8:
+		return big.NewInt(int64(number)), nil
+	case int16:
+		return big.NewInt(int64(number)), nil
+	case int32:
+		return big.NewInt(int64(number)), nil
+	case int64:
+		return big.NewInt(number), nil
+	case uint:
+		return big.NewInt(0).SetUint64(uint64(number)), nil
+	case uint8:
+		return big.NewInt(0).SetUint64(uint64(number)), nil
+	case uint16:
+		return big.NewInt(0).SetUint64(uint64(number)), nil
+	case uint32:
+		return big.NewInt(0).SetUint64(uint64(number)), nil
+	case uint64:
+		return big.NewInt(0).SetUint64(number), nil
+	case float64:
+		result, accuracy := big.NewFloat(number).Int(nil)
+		if accuracy != big.Exact {
+			return nil, fmt.Errorf("%w: %v is not an integer", ErrInvalidValue, number)
+		}
+		return result, nil
+	case string:
+		result, ok := big.NewInt(0).SetString(strings.ReplaceAll(number, "_", ""), 0)
+		if !ok {
+			return nil, fmt.Errorf("%w: cannot parse %q as integer", ErrInvalidValue, number)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("%w: integer expected, got %T", ErrInvalidValue, value)
+	}
+}
+
+// toBytes accepts raw bytes, plain strings, and hex strings prefixed with "0x".
+func toBytes(value interface{}) ([]byte, error) {
+	switch raw := value.(type) {
+	case []byte:
+		return raw, nil
+	case string:
+		if strings.HasPrefix(raw, "0x") {
+			return hex.DecodeString(raw[2:])
+		}
+		return []byte(raw), nil
+	default:
+		return nil, fmt.Errorf("%w: bytes expected, got %T", ErrInvalidValue, value)
+	}
+}
+
+func toSlice(value interface{}) ([]interface{}, error) {
+	switch items := value.(type) {
+	case []interface{}:
+		return items, nil
+	case [][]byte:
+		result := make([]interface{}, len(items))
+		for i, item := range items {
+			result[i] = item
+		}
+		return result, nil
+	case []string:
+		result := make([]interface{}, len(items))
+		for i, item := range items {
+			result[i] = item
+		}
+		return result, nil
+	case []byte:
+		result := make([]interface{}, len(items))
+		for i, item := range items {
+			result[i] = item
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("%w: list expected, got %T", ErrInvalidValue, value)
+	}
+}
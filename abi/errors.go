@@ -0,0 +1,36 @@
+package abi
+
+import "errors"
+
+// ErrEndpointNotFound signals that the ABI does not declare the requested endpoint
+var ErrEndpointNotFound = errors.New("endpoint not found in ABI")
+
+// ErrEventNotFound signals that the ABI does not declare the requested event
+var ErrEventNotFound = errors.New("event not found in ABI")
+
+// ErrUnsupportedType signals that a type cannot be encoded or decoded
+var ErrUnsupportedType = errors.New("unsupported ABI type")
+
+// ErrInvalidTypeExpression signals that a type name could not be parsed
+var ErrInvalidTypeExpression = errors.New("invalid ABI type expression")
+
+// ErrInvalidValue signals that a Go value does not match the ABI type it is encoded as
+var ErrInvalidValue = errors.New("value does not match ABI type")
+
+// ErrWrongNumberOfArguments signals that the number of values does not match the ABI inputs
+var ErrWrongNumberOfArguments = errors.New("wrong number of arguments")
+
+// ErrUnexpectedEndOfData signals that there were not enough bytes to decode a value
+var ErrUnexpectedEndOfData = errors.New("unexpected end of data")
+
+// ErrTrailingData signals that bytes were left over after decoding a value
+var ErrTrailingData = errors.New("trailing data after decoding")
+
+// ErrValueOutOfRange signals that a number does not fit in the ABI integer type
+var ErrValueOutOfRange = errors.New("value out of range for ABI type")
+
+// ErrUnknownEnumVariant signals that an enum discriminant or name is not declared in the ABI
+var ErrUnknownEnumVariant = errors.New("unknown enum variant")
+
+// ErrEventIdentifierMismatch signals that a log entry does not belong to the requested event
+var ErrEventIdentifierMismatch = errors.New("log entry identifier does not match event")
@@ -0,0 +1,97 @@
+package abi
+
+import (
+	"bytes"
+	"fmt"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// DecodedEvent is a log entry decoded against the event declaration in the ABI.
+type DecodedEvent struct {
+	Identifier string
+	Address    []byte
+	Fields     map[string]interface{}
+}
+
+// DecodeEvent decodes a log entry emitted with WriteEventLog or ManagedWriteLog. The first
+// topic is the event identifier, indexed fields follow as topics and the remaining fields
+// are read from the data entries, in declaration order.
+func (abiCodec *Codec) DecodeEvent(logEntry *vmcommon.LogEntry) (*DecodedEvent, error) {
+	if logEntry == nil || len(logEntry.Topics) == 0 {
+		return nil, fmt.Errorf("%w: log entry has no identifier topic", ErrEventNotFound)
+	}
+
+	event, err := abiCodec.contractABI.GetEvent(string(logEntry.Topics[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	return abiCodec.decodeEventFields(event, logEntry)
+}
+
+// DecodeEventAs decodes a log entry as the named event, failing if the identifier differs.
+func (abiCodec *Codec) DecodeEventAs(identifier string, logEntry *vmcommon.LogEntry) (*DecodedEvent, error) {
+	event, err := abiCodec.contractABI.GetEvent(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if logEntry == nil || len(logEntry.Topics) == 0 || !bytes.Equal(logEntry.Topics[0], []byte(identifier)) {
+		return nil, fmt.Errorf("%w: %s", ErrEventIdentifierMismatch, identifier)
+	}
+
+	return abiCodec.decodeEventFields(event, logEntry)
+}
+
+// DecodeEvents decodes all the log entries that match an event declared in the ABI,
+// skipping the others (for example logs emitted by other contracts).
+func (abiCodec *Codec) DecodeEvents(logs []*vmcommon.LogEntry) ([]*DecodedEvent, error) {
+	decoded := make([]*DecodedEvent, 0, len(logs))
+	for _, logEntry := range logs {
+		if logEntry == nil || len(logEntry.Topics) == 0 {
+			continue
+		}
+		event, err := abiCodec.contractABI.GetEvent(string(logEntry.Topics[0]))
+		if err != nil {
+			continue
+		}
+
+		decodedEvent, err := abiCodec.decodeEventFields(event, logEntry)
+		if err != nil {
+			return nil, err
+		}
+		decoded = append(decoded, decodedEvent)
+	}
+
+	return decoded, nil
+}
+
+func (abiCodec *Codec) decodeEventFields(event *Event, logEntry *vmcommon.LogEntry) (*DecodedEvent, error) {
+	decoded := &DecodedEvent{
+		Identifier: event.Identifier,
+		Address:    logEntry.Address,
+		Fields:     make(map[string]interface{}, len(event.Inputs)),
+	}
+
+	topics := logEntry.Topics[1:]
+	data := logEntry.Data
+	for _, input := range event.Inputs {
+		expression, err := parseTypeExpression(input.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		var value interface{}
+		if input.Indexed {
+			value, topics, err = abiCodec.decodeMultiValue(expression, topics)
+		} else {
+			value, data, err = abiCodec.decodeMultiValue(expression, data)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("event %s field %s: %w", event.Identifier, input.Name, err)
+		}
+		decoded.Fields[input.Name] = value
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,121 @@
+package abi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// typeExpression is a parsed ABI type name, e.g. "List<Option<u32>>".
+type typeExpression struct {
+	name string
+	args []*typeExpression
+}
+
+const (
+	typeList            = "List"
+	typeVec             = "vec"
+	typeManagedVec      = "ManagedVec"
+	typeOption          = "Option"
+	typeTuple           = "tuple"
+	typeVariadic        = "variadic"
+	typeOptional        = "optional"
+	typeMulti           = "multi"
+	typeCountedVariadic = "counted-variadic"
+	typeArrayPrefix     = "array"
+)
+
+func parseTypeExpression(typeName string) (*typeExpression, error) {
+	expression, rest, err := parseTypeExpressionPrefix(typeName)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(rest)) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTypeExpression, typeName)
+	}
+
+	return expression, nil
+}
+
+func parseTypeExpressionPrefix(input string) (*typeExpression, string, error) {
+	end := strings.IndexAny(input, "<>,")
+	if end < 0 {
+		end = len(input)
+	}
+
+	expression := &typeExpression{
+		name: strings.TrimSpace(input[:end]),
+	}
+	if len(expression.name) == 0 {
+		return nil, "", fmt.Errorf("%w: %s", ErrInvalidTypeExpression, input)
+	}
+
+	rest := input[end:]
+	if !strings.HasPrefix(rest, "<") {
+		return expression, rest, nil
+	}
+
+	rest = rest[1:]
+	for {
+		var arg *typeExpression
+		var err error
+		arg, rest, err = parseTypeExpressionPrefix(rest)
+		if err != nil {
+			return nil, "", err
+		}
+		expression.args = append(expression.args, arg)
+
+		rest = strings.TrimLeft(rest, " ")
+		switch {
+		case strings.HasPrefix(rest, ","):
+			rest = rest[1:]
+		case strings.HasPrefix(rest, ">"):
+			return expression, rest[1:], nil
+		default:
+			return nil, "", fmt.Errorf("%w: %s", ErrInvalidTypeExpression, input)
+		}
+	}
+}
+
+// String renders the expression back into ABI notation.
+func (expression *typeExpression) String() string {
+	if len(expression.args) == 0 {
+		return expression.name
+	}
+
+	args := make([]string, len(expression.args))
+	for i, arg := range expression.args {
+		args[i] = arg.String()
+	}
+
+	return expression.name + "<" + strings.Join(args, ",") + ">"
+}
+
+func (expression *typeExpression) isMultiValue() bool {
+	switch expression.name {
+	case typeVariadic, typeOptional, typeMulti, typeCountedVariadic:
+		return true
+	}
+	return false
+}
+
+// arrayLength returns the length of a fixed-size array type such as "array32", or false otherwise.
+func (expression *typeExpression) arrayLength() (int, bool) {
+	if !strings.HasPrefix(expression.name, typeArrayPrefix) || len(expression.args) != 1 {
+		return 0, false
+	}
+
+	length, err := strconv.Atoi(strings.TrimPrefix(expression.name, typeArrayPrefix))
+	if err != nil {
+		return 0, false
+	}
+
+	return length, true
+}
+
+func (expression *typeExpression) singleArg() (*typeExpression, error) {
+	if len(expression.args) != 1 {
+		return nil, fmt.Errorf("%w: %s expects one type argument", ErrInvalidTypeExpression, expression)
+	}
+	return expression.args[0], nil
+}
@@ -0,0 +1,24 @@
+package batch
+
+import "errors"
+
+// ErrNilOverlay signals that a nil overlay was provided
+var ErrNilOverlay = errors.New("nil overlay")
+
+// ErrAccountNotFound signals that the account does not exist in the overlay
+var ErrAccountNotFound = errors.New("account not found")
+
+// ErrInsufficientFunds signals that the account does not hold enough funds for a transfer
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrInvalidTransferValue signals that a transfer has a nil or negative value
+var ErrInvalidTransferValue = errors.New("invalid transfer value")
+
+// ErrOperationNotPermitted signals that the sender is not allowed to change the account
+var ErrOperationNotPermitted = errors.New("operation not permitted")
+
+// ErrInvalidSnapshot signals that the snapshot is not known by the overlay
+var ErrInvalidSnapshot = errors.New("invalid snapshot")
+
+// ErrBuiltInFunctionNotSupported signals that the overlay cannot apply the built-in function
+var ErrBuiltInFunctionNotSupported = errors.New("built-in function not supported by the overlay")
@@ -0,0 +1,165 @@
+package batch
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// Transaction is a transaction executed by the Executor. An empty Receiver deploys Code.
+type Transaction struct {
+	Sender        []byte
+	Receiver      []byte
+	Value         *big.Int
+	ESDTTransfers []*vmcommon.ESDTTransfer
+	Function      string
+	Arguments     [][]byte
+	Code          []byte
+	CodeMetadata  []byte
+	GasLimit      uint64
+	GasPrice      uint64
+}
+
+// TransactionResult holds the outcome of a transaction. Err is set when the VM host returned an error.
+type TransactionResult struct {
+	VMOutput *vmcommon.VMOutput
+	Err      error
+}
+
+// Result holds the outcome of a batch.
+type Result struct {
+	Outputs   []*TransactionResult
+	StateDiff *StateDiff
+}
+
+// Executor runs an ordered list of transactions against an Overlay, so that each transaction
+// sees the changes of the previous ones. The VM host must use the Overlay as its BlockchainHook.
+//
+// The Executor does not charge fees and does not move value across shards. It is not safe for concurrent use.
+type Executor struct {
+	host    vmhost.VMHost
+	overlay *Overlay
+}
+
+// NewExecutor creates a new Executor
+func NewExecutor(host vmhost.VMHost, overlay *Overlay) (*Executor, error) {
+	if check.IfNil(host) {
+		return nil, vmhost.ErrNilVMHost
+	}
+	if overlay == nil {
+		return nil, ErrNilOverlay
+	}
+
+	return &Executor{
+		host:    host,
+		overlay: overlay,
+	}, nil
+}
+
+// Execute runs the transactions in order and returns their outputs, together with the state changed by the whole batch.
+// The changes of a failed transaction are reverted, except for the nonce of its sender.
+func (executor *Executor) Execute(txs []*Transaction) *Result {
+	result := &Result{
+		Outputs: make([]*TransactionResult, 0, len(txs)),
+	}
+
+	for _, tx := range txs {
+		vmOutput, err := executor.executeTransaction(tx)
+		result.Outputs = append(result.Outputs, &TransactionResult{
+			VMOutput: vmOutput,
+			Err:      err,
+		})
+	}
+
+	result.StateDiff = executor.overlay.StateDiff()
+	return result
+}
+
+func (executor *Executor) executeTransaction(tx *Transaction) (*vmcommon.VMOutput, error) {
+	overlay := executor.overlay
+	sender := overlay.loadAccount(tx.Sender)
+	if !sender.exists {
+		return nil, ErrAccountNotFound
+	}
+	overlay.setNonce(sender, sender.nonce+1)
+
+	snapshot := overlay.GetSnapshot()
+	vmOutput, err := executor.runTransaction(tx, sender)
+	if err != nil || vmOutput.ReturnCode != vmcommon.Ok {
+		_ = overlay.RevertToSnapshot(snapshot)
+		return vmOutput, err
+	}
+
+	overlay.ApplyVMOutput(vmOutput)
+	return vmOutput, nil
+}
+
+func (executor *Executor) runTransaction(tx *Transaction, sender *overlayAccount) (*vmcommon.VMOutput, error) {
+	overlay := executor.overlay
+	value := big.NewInt(0)
+	if tx.Value != nil {
+		value.Set(tx.Value)
+	}
+	if value.Sign() < 0 {
+		return nil, ErrInvalidTransferValue
+	}
+	if sender.balance.Cmp(value) < 0 {
+		return nil, ErrInsufficientFunds
+	}
+	overlay.addBalance(sender, big.NewInt(0).Neg(value))
+
+	if len(tx.ESDTTransfers) > 0 {
+		err := overlay.transferESDT(tx.Sender, tx.Receiver, tx.ESDTTransfers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmInput := vmcommon.VMInput{
+		CallerAddr:         tx.Sender,
+		OriginalCallerAddr: tx.Sender,
+		CallValue:          value,
+		CallType:           vm.DirectCall,
+		GasPrice:           tx.GasPrice,
+		GasProvided:        tx.GasLimit,
+		Arguments:          tx.Arguments,
+		ESDTTransfers:      tx.ESDTTransfers,
+	}
+
+	if len(tx.Receiver) == 0 {
+		return executor.host.RunSmartContractCreate(&vmcommon.ContractCreateInput{
+			VMInput:              vmInput,
+			ContractCode:         tx.Code,
+			ContractCodeMetadata: tx.CodeMetadata,
+		})
+	}
+
+	receiver := overlay.loadAccount(tx.Receiver)
+	if len(receiver.code) == 0 {
+		return plainTransferOutput(tx.Receiver, value, tx.GasLimit), nil
+	}
+
+	return executor.host.RunSmartContractCall(&vmcommon.ContractCallInput{
+		VMInput:       vmInput,
+		RecipientAddr: tx.Receiver,
+		Function:      tx.Function,
+	})
+}
+
+// plainTransferOutput is the output of a transaction to an account without code, which only receives the value.
+func plainTransferOutput(receiver []byte, value *big.Int, gasLimit uint64) *vmcommon.VMOutput {
+	return &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: gasLimit,
+		GasRefund:    big.NewInt(0),
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(receiver): {
+				Address:      receiver,
+				BalanceDelta: value,
+			},
+		},
+	}
+}
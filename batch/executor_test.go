@@ -0,0 +1,117 @@
+package batch
+
+import (
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+// counterHost increments the counter of the contract, reading it through the overlay. The "fail" function fails after writing.
+type counterHost struct {
+	vmhost.VMHost
+	hook vmcommon.BlockchainHook
+}
+
+func (host *counterHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	if input.Function == "fail" {
+		return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "fail"}, nil
+	}
+
+	value, _, err := host.hook.GetStorageData(input.RecipientAddr, counterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	counter := big.NewInt(0).SetBytes(value)
+	counter.Add(counter, big.NewInt(1))
+
+	return &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		ReturnData: [][]byte{counter.Bytes()},
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(input.RecipientAddr): {
+				Address:      input.RecipientAddr,
+				BalanceDelta: input.CallValue,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					string(counterKey): {Offset: counterKey, Data: counter.Bytes(), Written: true},
+				},
+			},
+		},
+	}, nil
+}
+
+func (host *counterHost) IsInterfaceNil() bool {
+	return host == nil
+}
+
+func TestNewExecutor(t *testing.T) {
+	t.Parallel()
+
+	executor, err := NewExecutor(nil, nil)
+	require.Nil(t, executor)
+	require.Equal(t, vmhost.ErrNilVMHost, err)
+
+	executor, err = NewExecutor(&counterHost{}, nil)
+	require.Nil(t, executor)
+	require.Equal(t, ErrNilOverlay, err)
+}
+
+func TestExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	world := newTestWorld(t)
+	overlay := newTestOverlay(t, world)
+	executor, err := NewExecutor(&counterHost{hook: overlay}, overlay)
+	require.Nil(t, err)
+
+	result := executor.Execute([]*Transaction{
+		{Sender: userAddress, Receiver: contractAddress, Value: big.NewInt(100), Function: "increment", GasLimit: 1000},
+		{Sender: userAddress, Receiver: contractAddress, Value: big.NewInt(100), Function: "fail", GasLimit: 1000},
+		{Sender: userAddress, Receiver: contractAddress, Function: "increment", GasLimit: 1000},
+		{Sender: userAddress, Receiver: otherUserAddress, Value: big.NewInt(5000)},
+		{Sender: userAddress, Receiver: otherUserAddress, Value: big.NewInt(200)},
+		{Sender: otherUserAddress, Receiver: userAddress, Value: big.NewInt(1)},
+		{Sender: contractAddress[:10], Receiver: userAddress},
+	})
+
+	require.Len(t, result.Outputs, 7)
+	require.Equal(t, [][]byte{{6}}, result.Outputs[0].VMOutput.ReturnData)
+	require.Equal(t, vmcommon.UserError, result.Outputs[1].VMOutput.ReturnCode)
+	require.Equal(t, [][]byte{{7}}, result.Outputs[2].VMOutput.ReturnData)
+	require.Equal(t, ErrInsufficientFunds, result.Outputs[3].Err)
+	require.Nil(t, result.Outputs[4].Err)
+	require.Nil(t, result.Outputs[5].Err)
+	require.Equal(t, ErrAccountNotFound, result.Outputs[6].Err)
+
+	require.Equal(t, []*AccountDiff{
+		{
+			Address: contractAddress,
+			Exists:  true,
+			Balance: big.NewInt(150),
+			Storage: map[string][]byte{string(counterKey): {7}},
+			ESDT:    []*ESDTDiff{},
+		},
+		{
+			Address: otherUserAddress,
+			Exists:  true,
+			Nonce:   1,
+			Balance: big.NewInt(199),
+			Storage: map[string][]byte{},
+			ESDT:    []*ESDTDiff{},
+		},
+		{
+			Address: userAddress,
+			Exists:  true,
+			Nonce:   12,
+			Balance: big.NewInt(701),
+			Storage: map[string][]byte{},
+			ESDT:    []*ESDTDiff{},
+		},
+	}, result.StateDiff.Accounts)
+
+	require.Equal(t, uint64(7), world.AcctMap.GetAccount(userAddress).Nonce)
+	require.Nil(t, world.AcctMap.GetAccount(otherUserAddress))
+}
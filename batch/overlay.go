@@ -0,0 +1,483 @@
+package batch
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var log = logger.GetOrCreate("vm/batch")
+
+var _ vmcommon.BlockchainHook = (*Overlay)(nil)
+
+// egldTokenName is the token name of EGLD when it is transferred as an ESDT
+const egldTokenName = "EGLD-000000"
+
+// ArgsNewOverlay holds the arguments needed to create an Overlay
+type ArgsNewOverlay struct {
+	BlockchainHook     vmcommon.BlockchainHook
+	ESDTTransferParser vmcommon.ESDTTransferParser
+	Hasher             vmhost.HashComputer
+}
+
+// Overlay is a copy-on-write BlockchainHook: the accounts are read from the wrapped hook the first time they
+// are needed, then all the changes stay in memory. The wrapped hook is never changed.
+//
+// The ESDT transfers requested by the VM are applied by the Overlay itself, and they are only credited when
+// both accounts are in the same shard. The other built-in functions are not supported. Like the VM host it
+// serves, the Overlay is not safe for concurrent use.
+type Overlay struct {
+	vmcommon.BlockchainHook
+	esdtTransferParser vmcommon.ESDTTransferParser
+	hasher             vmhost.HashComputer
+
+	accounts map[string]*overlayAccount
+	journal  []func()
+}
+
+// NewOverlay creates a new Overlay over the given BlockchainHook
+func NewOverlay(args ArgsNewOverlay) (*Overlay, error) {
+	if check.IfNil(args.BlockchainHook) {
+		return nil, vmhost.ErrNilBlockChainHook
+	}
+	if check.IfNil(args.ESDTTransferParser) {
+		return nil, vmhost.ErrNilESDTTransferParser
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, vmhost.ErrNilHasher
+	}
+
+	return &Overlay{
+		BlockchainHook:     args.BlockchainHook,
+		esdtTransferParser: args.ESDTTransferParser,
+		hasher:             args.Hasher,
+		accounts:           make(map[string]*overlayAccount),
+	}, nil
+}
+
+// GetUserAccount returns a view of the account, as changed by the overlay
+func (overlay *Overlay) GetUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	account := overlay.loadAccount(address)
+	if !account.exists {
+		return nil, ErrAccountNotFound
+	}
+
+	return account.userAccount(), nil
+}
+
+// GetCode returns the code of the account, as changed by the overlay
+func (overlay *Overlay) GetCode(account vmcommon.UserAccountHandler) []byte {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	return overlay.loadAccount(account.AddressBytes()).code
+}
+
+// GetStorageData returns the storage value of the account, as changed by the overlay
+func (overlay *Overlay) GetStorageData(accountAddress []byte, index []byte) ([]byte, uint32, error) {
+	value, err := overlay.storageValue(overlay.loadAccount(accountAddress), index)
+	return value, 0, err
+}
+
+// GetAllState returns the storage of the account, as changed by the overlay
+func (overlay *Overlay) GetAllState(address []byte) (map[string][]byte, error) {
+	account := overlay.loadAccount(address)
+	result := make(map[string][]byte)
+	if account.hasUnderlyingState {
+		state, err := overlay.BlockchainHook.GetAllState(address)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range state {
+			result[key] = value
+		}
+	}
+
+	for key := range account.writtenStorage {
+		value := account.storage[key]
+		if len(value) == 0 {
+			delete(result, key)
+			continue
+		}
+		result[key] = value
+	}
+
+	return result, nil
+}
+
+// GetESDTToken returns the token held by the account, as changed by the overlay
+func (overlay *Overlay) GetESDTToken(address []byte, tokenID []byte, nonce uint64) (*esdt.ESDigitalToken, error) {
+	token, err := overlay.esdtToken(overlay.loadAccount(address), tokenID, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyToken(token), nil
+}
+
+// IsPayable checks the code metadata of the receiver, as changed by the overlay
+func (overlay *Overlay) IsPayable(sndAddress []byte, recvAddress []byte) (bool, error) {
+	if !core.IsSmartContractAddress(recvAddress) {
+		return true, nil
+	}
+
+	receiver := overlay.loadAccount(recvAddress)
+	if !receiver.exists {
+		return false, nil
+	}
+
+	metadata := vmcommon.CodeMetadataFromBytes(receiver.codeMetadata)
+	if metadata.Payable {
+		return true, nil
+	}
+
+	return metadata.PayableBySC && core.IsSmartContractAddress(sndAddress), nil
+}
+
+// ProcessBuiltInFunction applies the ESDT transfers in the overlay. The other built-in functions are not supported.
+func (overlay *Overlay) ProcessBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	parsedTransfers, err := overlay.esdtTransferParser.ParseESDTTransfers(
+		input.CallerAddr,
+		input.RecipientAddr,
+		input.Function,
+		input.Arguments)
+	if err != nil {
+		log.Trace("built-in function not supported by the overlay", "function", input.Function, "error", err)
+		return nil, ErrBuiltInFunctionNotSupported
+	}
+
+	err = overlay.transferESDT(input.CallerAddr, parsedTransfers.RcvAddr, parsedTransfers.ESDTTransfers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{
+		ReturnCode:     vmcommon.Ok,
+		GasRemaining:   input.GasProvided,
+		GasRefund:      big.NewInt(0),
+		OutputAccounts: make(map[string]*vmcommon.OutputAccount),
+	}, nil
+}
+
+// GetSnapshot returns the number of changes made in the overlay
+func (overlay *Overlay) GetSnapshot() int {
+	return len(overlay.journal)
+}
+
+// RevertToSnapshot undoes the changes made in the overlay after the given snapshot
+func (overlay *Overlay) RevertToSnapshot(snapshot int) error {
+	if snapshot < 0 || snapshot > len(overlay.journal) {
+		return ErrInvalidSnapshot
+	}
+
+	for i := len(overlay.journal) - 1; i >= snapshot; i-- {
+		overlay.journal[i]()
+	}
+	overlay.journal = overlay.journal[:snapshot]
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (overlay *Overlay) IsInterfaceNil() bool {
+	return overlay == nil
+}
+
+// ApplyVMOutput applies the changes of a successful execution: storage updates, balance deltas, nonces,
+// deployed code and deleted accounts. The ESDT transfers were already applied during the execution.
+func (overlay *Overlay) ApplyVMOutput(vmOutput *vmcommon.VMOutput) {
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		account := overlay.loadAccount(outputAccount.Address)
+
+		if len(outputAccount.Code) > 0 {
+			owner := account.owner
+			if len(account.code) == 0 {
+				owner = outputAccount.CodeDeployerAddress
+			}
+			overlay.setCode(account, outputAccount.Code, outputAccount.CodeMetadata, owner)
+		}
+		if outputAccount.Nonce > account.nonce {
+			overlay.setNonce(account, outputAccount.Nonce)
+		}
+		if outputAccount.BalanceDelta != nil && outputAccount.BalanceDelta.Sign() != 0 {
+			overlay.addBalance(account, outputAccount.BalanceDelta)
+		}
+		for _, storageUpdate := range outputAccount.StorageUpdates {
+			if storageUpdate.Written {
+				overlay.setStorage(account, storageUpdate.Offset, storageUpdate.Data)
+			}
+		}
+	}
+
+	for _, address := range vmOutput.DeletedAccounts {
+		overlay.deleteAccount(overlay.loadAccount(address))
+	}
+}
+
+// loadAccount returns the overlay copy of an account, copying it from the wrapped hook the first time.
+func (overlay *Overlay) loadAccount(address []byte) *overlayAccount {
+	account, ok := overlay.accounts[string(address)]
+	if ok {
+		return account
+	}
+
+	account = newOverlayAccount(address)
+	overlay.accounts[string(address)] = account
+
+	underlyingAccount, err := overlay.BlockchainHook.GetUserAccount(address)
+	if err != nil || check.IfNil(underlyingAccount) {
+		return account
+	}
+
+	account.exists = true
+	account.hasUnderlyingState = true
+	account.nonce = underlyingAccount.GetNonce()
+	if underlyingAccount.GetBalance() != nil {
+		account.balance.Set(underlyingAccount.GetBalance())
+	}
+	if underlyingAccount.GetDeveloperReward() != nil {
+		account.developerReward.Set(underlyingAccount.GetDeveloperReward())
+	}
+	account.codeHash = underlyingAccount.GetCodeHash()
+	account.codeMetadata = underlyingAccount.GetCodeMetadata()
+	account.owner = underlyingAccount.GetOwnerAddress()
+	account.username = underlyingAccount.GetUserName()
+	account.rootHash = underlyingAccount.GetRootHash()
+	account.code = overlay.BlockchainHook.GetCode(underlyingAccount)
+
+	return account
+}
+
+func (overlay *Overlay) storageValue(account *overlayAccount, key []byte) ([]byte, error) {
+	value, ok := account.storage[string(key)]
+	if ok {
+		return value, nil
+	}
+	if !account.hasUnderlyingState {
+		return make([]byte, 0), nil
+	}
+
+	value, _, err := overlay.BlockchainHook.GetStorageData(account.address, key)
+	if err != nil {
+		return nil, err
+	}
+
+	account.storage[string(key)] = value
+	return value, nil
+}
+
+func (overlay *Overlay) esdtToken(account *overlayAccount, tokenID []byte, nonce uint64) (*esdt.ESDigitalToken, error) {
+	key := esdtKey{tokenID: string(tokenID), nonce: nonce}
+	token, ok := account.esdt[key]
+	if ok {
+		return token, nil
+	}
+
+	token = &esdt.ESDigitalToken{Value: big.NewInt(0)}
+	if account.hasUnderlyingState {
+		underlyingToken, err := overlay.BlockchainHook.GetESDTToken(account.address, tokenID, nonce)
+		if err != nil {
+			return nil, err
+		}
+		if underlyingToken != nil {
+			token = copyToken(underlyingToken)
+		}
+	}
+
+	account.esdt[key] = token
+	return token, nil
+}
+
+func (overlay *Overlay) transferESDT(sender []byte, receiver []byte, transfers []*vmcommon.ESDTTransfer) error {
+	snapshot := overlay.GetSnapshot()
+	err := overlay.doTransferESDT(sender, receiver, transfers)
+	if err != nil {
+		_ = overlay.RevertToSnapshot(snapshot)
+	}
+
+	return err
+}
+
+func (overlay *Overlay) doTransferESDT(sender []byte, receiver []byte, transfers []*vmcommon.ESDTTransfer) error {
+	senderAccount := overlay.loadAccount(sender)
+	receiverAccount := overlay.loadAccount(receiver)
+	isSameShard := overlay.GetShardOfAddress(sender) == overlay.GetShardOfAddress(receiver)
+
+	for _, transfer := range transfers {
+		value := transfer.ESDTValue
+		if value == nil || value.Sign() < 0 {
+			return ErrInvalidTransferValue
+		}
+
+		if string(transfer.ESDTTokenName) == egldTokenName {
+			if senderAccount.balance.Cmp(value) < 0 {
+				return ErrInsufficientFunds
+			}
+			overlay.addBalance(senderAccount, big.NewInt(0).Neg(value))
+			if isSameShard {
+				overlay.addBalance(receiverAccount, value)
+			}
+			continue
+		}
+
+		senderToken, err := overlay.esdtToken(senderAccount, transfer.ESDTTokenName, transfer.ESDTTokenNonce)
+		if err != nil {
+			return err
+		}
+		if senderToken.Value.Cmp(value) < 0 {
+			return ErrInsufficientFunds
+		}
+
+		newSenderToken := copyToken(senderToken)
+		newSenderToken.Value.Sub(newSenderToken.Value, value)
+		overlay.setESDT(senderAccount, transfer.ESDTTokenName, transfer.ESDTTokenNonce, newSenderToken)
+
+		if !isSameShard {
+			continue
+		}
+
+		receiverToken, err := overlay.esdtToken(receiverAccount, transfer.ESDTTokenName, transfer.ESDTTokenNonce)
+		if err != nil {
+			return err
+		}
+
+		newReceiverToken := copyToken(receiverToken)
+		if newReceiverToken.TokenMetaData == nil {
+			newReceiverToken.Type = senderToken.Type
+			newReceiverToken.TokenMetaData = senderToken.TokenMetaData
+		}
+		newReceiverToken.Value.Add(newReceiverToken.Value, value)
+		overlay.setESDT(receiverAccount, transfer.ESDTTokenName, transfer.ESDTTokenNonce, newReceiverToken)
+	}
+
+	return nil
+}
+
+// journalAccount records the scalar fields of the account, so that the next change can be undone.
+func (overlay *Overlay) journalAccount(account *overlayAccount) {
+	previous := *account
+	previous.balance = big.NewInt(0).Set(account.balance)
+	overlay.journal = append(overlay.journal, func() {
+		account.exists = previous.exists
+		account.nonce = previous.nonce
+		account.balance = previous.balance
+		account.code = previous.code
+		account.codeHash = previous.codeHash
+		account.codeMetadata = previous.codeMetadata
+		account.owner = previous.owner
+		account.hasUnderlyingState = previous.hasUnderlyingState
+		account.storage = previous.storage
+		account.esdt = previous.esdt
+		account.modified = previous.modified
+		account.codeModified = previous.codeModified
+		account.writtenStorage = previous.writtenStorage
+		account.writtenESDT = previous.writtenESDT
+	})
+}
+
+func (overlay *Overlay) setNonce(account *overlayAccount, nonce uint64) {
+	overlay.journalAccount(account)
+	account.nonce = nonce
+	account.modified = true
+}
+
+func (overlay *Overlay) addBalance(account *overlayAccount, delta *big.Int) {
+	overlay.journalAccount(account)
+	account.balance = big.NewInt(0).Add(account.balance, delta)
+	account.exists = true
+	account.modified = true
+}
+
+func (overlay *Overlay) setCode(account *overlayAccount, code []byte, codeMetadata []byte, owner []byte) {
+	overlay.journalAccount(account)
+	account.code = code
+	account.codeHash = overlay.hasher.Compute(string(code))
+	account.codeMetadata = codeMetadata
+	account.owner = owner
+	account.exists = true
+	account.modified = true
+	account.codeModified = true
+}
+
+func (overlay *Overlay) setStorage(account *overlayAccount, key []byte, value []byte) {
+	previousValue, hadValue := account.storage[string(key)]
+	_, wasWritten := account.writtenStorage[string(key)]
+	overlay.journal = append(overlay.journal, func() {
+		if hadValue {
+			account.storage[string(key)] = previousValue
+		} else {
+			delete(account.storage, string(key))
+		}
+		if !wasWritten {
+			delete(account.writtenStorage, string(key))
+		}
+	})
+	overlay.journalAccount(account)
+
+	account.storage[string(key)] = value
+	account.writtenStorage[string(key)] = struct{}{}
+	account.modified = true
+}
+
+func (overlay *Overlay) setESDT(account *overlayAccount, tokenID []byte, nonce uint64, token *esdt.ESDigitalToken) {
+	key := esdtKey{tokenID: string(tokenID), nonce: nonce}
+	previousToken, hadToken := account.esdt[key]
+	_, wasWritten := account.writtenESDT[key]
+	overlay.journal = append(overlay.journal, func() {
+		if hadToken {
+			account.esdt[key] = previousToken
+		} else {
+			delete(account.esdt, key)
+		}
+		if !wasWritten {
+			delete(account.writtenESDT, key)
+		}
+	})
+	overlay.journalAccount(account)
+
+	account.esdt[key] = token
+	account.writtenESDT[key] = struct{}{}
+	account.exists = true
+	account.modified = true
+}
+
+// deleteAccount replaces the maps of the account, so that the journal keeps the previous ones untouched.
+func (overlay *Overlay) deleteAccount(account *overlayAccount) {
+	overlay.journalAccount(account)
+	account.exists = false
+	account.hasUnderlyingState = false
+	account.nonce = 0
+	account.balance = big.NewInt(0)
+	account.code = nil
+	account.codeHash = nil
+	account.codeMetadata = nil
+	account.owner = nil
+	account.storage = make(map[string][]byte)
+	account.esdt = make(map[esdtKey]*esdt.ESDigitalToken)
+	account.writtenStorage = make(map[string]struct{})
+	account.writtenESDT = make(map[esdtKey]struct{})
+	account.modified = true
+	account.codeModified = true
+}
+
+func copyToken(token *esdt.ESDigitalToken) *esdt.ESDigitalToken {
+	tokenCopy := &esdt.ESDigitalToken{
+		Type:          token.Type,
+		Value:         big.NewInt(0),
+		Properties:    bytes.Clone(token.Properties),
+		TokenMetaData: token.TokenMetaData,
+		Reserved:      bytes.Clone(token.Reserved),
+	}
+	if token.Value != nil {
+		tokenCopy.Value.Set(token.Value)
+	}
+
+	return tokenCopy
+}
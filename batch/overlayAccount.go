@@ -0,0 +1,191 @@
+package batch
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var _ vmcommon.UserAccountHandler = (*userAccount)(nil)
+
+type esdtKey struct {
+	tokenID string
+	nonce   uint64
+}
+
+// overlayAccount is the copy of an account held by the Overlay. Storage keys and tokens are copied lazily.
+type overlayAccount struct {
+	address         []byte
+	exists          bool
+	nonce           uint64
+	balance         *big.Int
+	code            []byte
+	codeHash        []byte
+	codeMetadata    []byte
+	owner           []byte
+	username        []byte
+	developerReward *big.Int
+	rootHash        []byte
+	storage         map[string][]byte
+	esdt            map[esdtKey]*esdt.ESDigitalToken
+
+	// hasUnderlyingState is false for the accounts missing from the wrapped hook, and for the deleted ones,
+	// so that the storage and tokens are not read from the wrapped hook.
+	hasUnderlyingState bool
+	modified           bool
+	codeModified       bool
+	writtenStorage     map[string]struct{}
+	writtenESDT        map[esdtKey]struct{}
+}
+
+func newOverlayAccount(address []byte) *overlayAccount {
+	return &overlayAccount{
+		address:         address,
+		balance:         big.NewInt(0),
+		developerReward: big.NewInt(0),
+		storage:         make(map[string][]byte),
+		esdt:            make(map[esdtKey]*esdt.ESDigitalToken),
+		writtenStorage:  make(map[string]struct{}),
+		writtenESDT:     make(map[esdtKey]struct{}),
+	}
+}
+
+// userAccount is the view of an overlayAccount handed to the VM. Changing it does not change the overlay,
+// the VM host reports its changes in the VMOutput, which the Executor applies.
+type userAccount struct {
+	address         []byte
+	nonce           uint64
+	balance         *big.Int
+	codeHash        []byte
+	codeMetadata    []byte
+	owner           []byte
+	username        []byte
+	developerReward *big.Int
+	rootHash        []byte
+}
+
+func (account *overlayAccount) userAccount() *userAccount {
+	return &userAccount{
+		address:         account.address,
+		nonce:           account.nonce,
+		balance:         big.NewInt(0).Set(account.balance),
+		codeHash:        account.codeHash,
+		codeMetadata:    account.codeMetadata,
+		owner:           account.owner,
+		username:        account.username,
+		developerReward: big.NewInt(0).Set(account.developerReward),
+		rootHash:        account.rootHash,
+	}
+}
+
+// GetCodeMetadata returns the code metadata of the account
+func (account *userAccount) GetCodeMetadata() []byte {
+	return account.codeMetadata
+}
+
+// SetCodeMetadata sets the code metadata of the account view
+func (account *userAccount) SetCodeMetadata(codeMetadata []byte) {
+	account.codeMetadata = codeMetadata
+}
+
+// GetCodeHash returns the code hash of the account
+func (account *userAccount) GetCodeHash() []byte {
+	return account.codeHash
+}
+
+// GetRootHash returns the root hash of the account, as it was before the batch
+func (account *userAccount) GetRootHash() []byte {
+	return account.rootHash
+}
+
+// AccountDataHandler returns nil, the storage is read through the Overlay
+func (account *userAccount) AccountDataHandler() vmcommon.AccountDataHandler {
+	return nil
+}
+
+// AddToBalance adds the value to the balance of the account view
+func (account *userAccount) AddToBalance(value *big.Int) error {
+	account.balance.Add(account.balance, value)
+	return nil
+}
+
+// SubFromBalance subtracts the value from the balance of the account view
+func (account *userAccount) SubFromBalance(value *big.Int) error {
+	if account.balance.Cmp(value) < 0 {
+		return ErrInsufficientFunds
+	}
+	account.balance.Sub(account.balance, value)
+	return nil
+}
+
+// GetBalance returns the balance of the account
+func (account *userAccount) GetBalance() *big.Int {
+	return account.balance
+}
+
+// ClaimDeveloperRewards returns the developer rewards of the account view and resets them
+func (account *userAccount) ClaimDeveloperRewards(sender []byte) (*big.Int, error) {
+	if string(sender) != string(account.owner) {
+		return nil, ErrOperationNotPermitted
+	}
+
+	rewards := account.developerReward
+	account.developerReward = big.NewInt(0)
+	return rewards, nil
+}
+
+// GetDeveloperReward returns the developer rewards of the account
+func (account *userAccount) GetDeveloperReward() *big.Int {
+	return account.developerReward
+}
+
+// ChangeOwnerAddress changes the owner of the account view
+func (account *userAccount) ChangeOwnerAddress(sender []byte, newAddress []byte) error {
+	if string(sender) != string(account.owner) {
+		return ErrOperationNotPermitted
+	}
+
+	account.owner = newAddress
+	return nil
+}
+
+// SetOwnerAddress sets the owner of the account view
+func (account *userAccount) SetOwnerAddress(address []byte) {
+	account.owner = address
+}
+
+// GetOwnerAddress returns the owner of the account
+func (account *userAccount) GetOwnerAddress() []byte {
+	return account.owner
+}
+
+// SetUserName sets the username of the account view
+func (account *userAccount) SetUserName(userName []byte) {
+	account.username = userName
+}
+
+// GetUserName returns the username of the account
+func (account *userAccount) GetUserName() []byte {
+	return account.username
+}
+
+// AddressBytes returns the address of the account
+func (account *userAccount) AddressBytes() []byte {
+	return account.address
+}
+
+// IncreaseNonce increases the nonce of the account view
+func (account *userAccount) IncreaseNonce(nonce uint64) {
+	account.nonce += nonce
+}
+
+// GetNonce returns the nonce of the account
+func (account *userAccount) GetNonce() uint64 {
+	return account.nonce
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (account *userAccount) IsInterfaceNil() bool {
+	return account == nil
+}
@@ -0,0 +1,198 @@
+package batch
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	worldmock "github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var userAddress = bytes.Repeat([]byte{'u'}, 32)
+var otherUserAddress = bytes.Repeat([]byte{'o'}, 32)
+var contractAddress = append(make([]byte, 8), bytes.Repeat([]byte{'c'}, 24)...)
+
+var counterKey = []byte("counter")
+var tokenID = []byte("TOKEN-abcdef")
+
+func newTestWorld(t *testing.T) *worldmock.MockWorld {
+	world := worldmock.NewMockWorld()
+	require.Nil(t, world.InitBuiltinFunctions(config.MakeGasMapForTests()))
+
+	user := &worldmock.Account{
+		Address: userAddress,
+		Nonce:   7,
+		Balance: big.NewInt(1000),
+		Storage: make(map[string][]byte),
+	}
+	require.Nil(t, user.SetTokenBalanceUint64(tokenID, 0, 100))
+	world.AcctMap.PutAccount(user)
+	world.AcctMap.PutAccount(&worldmock.Account{
+		Address:         contractAddress,
+		Balance:         big.NewInt(50),
+		Code:            []byte("counter code"),
+		OwnerAddress:    userAddress,
+		IsSmartContract: true,
+		Storage: map[string][]byte{
+			string(counterKey): {5},
+			"other":            {1},
+		},
+	})
+
+	return world
+}
+
+func newTestOverlay(t *testing.T, world *worldmock.MockWorld) *Overlay {
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
+	overlay, err := NewOverlay(ArgsNewOverlay{
+		BlockchainHook:     world,
+		ESDTTransferParser: esdtTransferParser,
+		Hasher:             blake2b.NewBlake2b(),
+	})
+	require.Nil(t, err)
+
+	return overlay
+}
+
+func TestNewOverlay(t *testing.T) {
+	t.Parallel()
+
+	overlay, err := NewOverlay(ArgsNewOverlay{})
+	require.Nil(t, overlay)
+	require.Equal(t, vmhost.ErrNilBlockChainHook, err)
+
+	overlay, err = NewOverlay(ArgsNewOverlay{BlockchainHook: worldmock.NewMockWorld()})
+	require.Nil(t, overlay)
+	require.Equal(t, vmhost.ErrNilESDTTransferParser, err)
+
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
+	overlay, err = NewOverlay(ArgsNewOverlay{
+		BlockchainHook:     worldmock.NewMockWorld(),
+		ESDTTransferParser: esdtTransferParser,
+	})
+	require.Nil(t, overlay)
+	require.Equal(t, vmhost.ErrNilHasher, err)
+}
+
+func TestOverlay_ApplyVMOutputAndRevert(t *testing.T) {
+	t.Parallel()
+
+	world := newTestWorld(t)
+	overlay := newTestOverlay(t, world)
+
+	snapshot := overlay.GetSnapshot()
+	overlay.ApplyVMOutput(&vmcommon.VMOutput{
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contractAddress): {
+				Address:      contractAddress,
+				BalanceDelta: big.NewInt(10),
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					string(counterKey): {Offset: counterKey, Data: []byte{6}, Written: true},
+					"other":            {Offset: []byte("other"), Data: []byte{}, Written: true},
+				},
+			},
+		},
+	})
+
+	value, _, err := overlay.GetStorageData(contractAddress, counterKey)
+	require.Nil(t, err)
+	require.Equal(t, []byte{6}, value)
+	state, err := overlay.GetAllState(contractAddress)
+	require.Nil(t, err)
+	require.Equal(t, map[string][]byte{string(counterKey): {6}}, state)
+	account, err := overlay.GetUserAccount(contractAddress)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(60), account.GetBalance())
+
+	require.Equal(t, []byte{5}, world.AcctMap.GetAccount(contractAddress).Storage[string(counterKey)])
+	require.Equal(t, big.NewInt(50), world.AcctMap.GetAccount(contractAddress).Balance)
+
+	require.Nil(t, overlay.RevertToSnapshot(snapshot))
+	value, _, err = overlay.GetStorageData(contractAddress, counterKey)
+	require.Nil(t, err)
+	require.Equal(t, []byte{5}, value)
+	account, err = overlay.GetUserAccount(contractAddress)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(50), account.GetBalance())
+	require.Empty(t, overlay.StateDiff().Accounts)
+
+	require.Equal(t, ErrInvalidSnapshot, overlay.RevertToSnapshot(snapshot+1))
+}
+
+func TestOverlay_DeletedAccounts(t *testing.T) {
+	t.Parallel()
+
+	overlay := newTestOverlay(t, newTestWorld(t))
+	overlay.ApplyVMOutput(&vmcommon.VMOutput{
+		DeletedAccounts: [][]byte{contractAddress},
+	})
+
+	_, err := overlay.GetUserAccount(contractAddress)
+	require.Equal(t, ErrAccountNotFound, err)
+	value, _, err := overlay.GetStorageData(contractAddress, counterKey)
+	require.Nil(t, err)
+	require.Empty(t, value)
+
+	stateDiff := overlay.StateDiff()
+	require.Len(t, stateDiff.Accounts, 1)
+	require.False(t, stateDiff.Accounts[0].Exists)
+}
+
+func TestOverlay_ProcessBuiltInFunction(t *testing.T) {
+	t.Parallel()
+
+	world := newTestWorld(t)
+	overlay := newTestOverlay(t, world)
+
+	transferInput := func(value int64) *vmcommon.ContractCallInput {
+		return &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  userAddress,
+				CallValue:   big.NewInt(0),
+				GasProvided: 1000,
+				Arguments:   [][]byte{tokenID, big.NewInt(value).Bytes()},
+			},
+			RecipientAddr: otherUserAddress,
+			Function:      core.BuiltInFunctionESDTTransfer,
+		}
+	}
+
+	vmOutput, err := overlay.ProcessBuiltInFunction(transferInput(30))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.Equal(t, uint64(1000), vmOutput.GasRemaining)
+
+	_, err = overlay.ProcessBuiltInFunction(transferInput(80))
+	require.Equal(t, ErrInsufficientFunds, err)
+
+	token, err := overlay.GetESDTToken(userAddress, tokenID, 0)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(70), token.Value)
+	token, err = overlay.GetESDTToken(otherUserAddress, tokenID, 0)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(30), token.Value)
+
+	_, err = overlay.ProcessBuiltInFunction(&vmcommon.ContractCallInput{
+		VMInput:       vmcommon.VMInput{CallerAddr: userAddress, CallValue: big.NewInt(0)},
+		RecipientAddr: userAddress,
+		Function:      core.BuiltInFunctionSetGuardian,
+	})
+	require.Equal(t, ErrBuiltInFunctionNotSupported, err)
+
+	balance, _ := world.AcctMap.GetAccount(userAddress).GetTokenBalanceUint64(tokenID, 0)
+	require.Equal(t, uint64(100), balance)
+
+	stateDiff := overlay.StateDiff()
+	require.Len(t, stateDiff.Accounts, 2)
+	require.Equal(t, otherUserAddress, stateDiff.Accounts[0].Address)
+	require.True(t, stateDiff.Accounts[0].Exists)
+	require.Equal(t, []*ESDTDiff{{TokenID: tokenID, Balance: big.NewInt(30)}}, stateDiff.Accounts[0].ESDT)
+	require.Equal(t, []*ESDTDiff{{TokenID: tokenID, Balance: big.NewInt(70)}}, stateDiff.Accounts[1].ESDT)
+}
@@ -0,0 +1,90 @@
+package batch
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+)
+
+// StateDiff holds the accounts changed by a batch, sorted by address.
+type StateDiff struct {
+	Accounts []*AccountDiff
+}
+
+// AccountDiff holds the final state of an account changed by a batch. Code, CodeMetadata and OwnerAddress are
+// only set when the code was deployed or upgraded. Storage and ESDT only hold the written keys and tokens.
+type AccountDiff struct {
+	Address      []byte
+	Exists       bool
+	Nonce        uint64
+	Balance      *big.Int
+	Code         []byte
+	CodeMetadata []byte
+	OwnerAddress []byte
+	Storage      map[string][]byte
+	ESDT         []*ESDTDiff
+}
+
+// ESDTDiff holds the final balance of a token written by a batch.
+type ESDTDiff struct {
+	TokenID []byte
+	Nonce   uint64
+	Balance *big.Int
+}
+
+// StateDiff returns the accounts changed in the overlay.
+func (overlay *Overlay) StateDiff() *StateDiff {
+	stateDiff := &StateDiff{
+		Accounts: make([]*AccountDiff, 0),
+	}
+
+	for _, account := range overlay.accounts {
+		if !account.modified {
+			continue
+		}
+		stateDiff.Accounts = append(stateDiff.Accounts, account.diff())
+	}
+
+	sort.Slice(stateDiff.Accounts, func(i, j int) bool {
+		return bytes.Compare(stateDiff.Accounts[i].Address, stateDiff.Accounts[j].Address) < 0
+	})
+
+	return stateDiff
+}
+
+func (account *overlayAccount) diff() *AccountDiff {
+	accountDiff := &AccountDiff{
+		Address: account.address,
+		Exists:  account.exists,
+		Nonce:   account.nonce,
+		Balance: big.NewInt(0).Set(account.balance),
+		Storage: make(map[string][]byte),
+		ESDT:    make([]*ESDTDiff, 0),
+	}
+	if account.codeModified {
+		accountDiff.Code = account.code
+		accountDiff.CodeMetadata = account.codeMetadata
+		accountDiff.OwnerAddress = account.owner
+	}
+
+	for key := range account.writtenStorage {
+		accountDiff.Storage[key] = account.storage[key]
+	}
+
+	for key := range account.writtenESDT {
+		accountDiff.ESDT = append(accountDiff.ESDT, &ESDTDiff{
+			TokenID: []byte(key.tokenID),
+			Nonce:   key.nonce,
+			Balance: big.NewInt(0).Set(account.esdt[key].Value),
+		})
+	}
+	sort.Slice(accountDiff.ESDT, func(i, j int) bool {
+		left, right := accountDiff.ESDT[i], accountDiff.ESDT[j]
+		if cmp := bytes.Compare(left.TokenID, right.TokenID); cmp != 0 {
+			return cmp < 0
+		}
+		return left.Nonce < right.Nonce
+	})
+
+	return accountDiff
+}
@@ -0,0 +1,21 @@
+package callpolicy
+
+import "errors"
+
+// ErrNilPolicySource signals that a nil policy source was provided
+var ErrNilPolicySource = errors.New("nil policy source")
+
+// ErrNilPolicy signals that the policy source returned no policy
+var ErrNilPolicy = errors.New("nil policy")
+
+// ErrInvalidEffect signals that a rule or the default effect is neither allow nor deny
+var ErrInvalidEffect = errors.New("invalid effect")
+
+// ErrInvalidKind signals that a rule refers to an unknown kind of call
+var ErrInvalidKind = errors.New("invalid call kind")
+
+// ErrInvalidAddress signals that a rule contains an address which is not hex encoded
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrInvalidValue signals that a rule contains a value bound which is not a non-negative decimal number
+var ErrInvalidValue = errors.New("invalid value")
@@ -0,0 +1,116 @@
+package callpolicy
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var log = logger.GetOrCreate("vm/callpolicy")
+
+var _ vmhost.CallPolicy = (*Firewall)(nil)
+
+// Firewall is a vmhost.CallPolicy enforcing the policy provided by a PolicySource. The policy can be replaced
+// while the VM is running, a failed reload keeps the previous policy in place.
+type Firewall struct {
+	source PolicySource
+	policy atomic.Pointer[compiledPolicy]
+}
+
+// NewFirewall creates a firewall and loads its initial policy from the given source
+func NewFirewall(source PolicySource) (*Firewall, error) {
+	if source == nil {
+		return nil, ErrNilPolicySource
+	}
+
+	firewall := &Firewall{source: source}
+	err := firewall.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return firewall, nil
+}
+
+// Reload loads the policy from the source again and replaces the current one only if it is valid
+func (firewall *Firewall) Reload() error {
+	policy, err := firewall.source.LoadPolicy()
+	if err != nil {
+		return err
+	}
+
+	return firewall.SetPolicy(policy)
+}
+
+// SetPolicy replaces the current policy, the previous one is kept if the new policy is invalid
+func (firewall *Firewall) SetPolicy(policy *Policy) error {
+	compiled, err := compilePolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	firewall.policy.Store(compiled)
+	log.Debug("call policy loaded", "rules", len(compiled.rules), "default", compiled.defaultEffect)
+	return nil
+}
+
+// ReloadPeriodically reloads the policy at the given interval until the context is done
+func (firewall *Firewall) ReloadPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := firewall.Reload()
+			if err != nil {
+				log.Warn("call policy reload failed, keeping the previous policy", "error", err)
+			}
+		}
+	}
+}
+
+// CheckCall returns an error describing the rule which denies the call, or nil if the call is allowed
+func (firewall *Firewall) CheckCall(call *vmhost.PolicyCall) error {
+	rule, effect := firewall.policy.Load().evaluate(call)
+	if effect == Allow {
+		return nil
+	}
+
+	if rule == nil {
+		return fmt.Errorf("default policy denies %s", describeCall(call))
+	}
+	return fmt.Errorf("rule %q denies %s", rule.name, describeCall(call))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (firewall *Firewall) IsInterfaceNil() bool {
+	return firewall == nil
+}
+
+func describeCall(call *vmhost.PolicyCall) string {
+	builder := &strings.Builder{}
+	fmt.Fprintf(builder, "%s from %s to %s",
+		call.Kind,
+		hex.EncodeToString(call.Caller),
+		hex.EncodeToString(call.Destination))
+	if len(call.Function) > 0 {
+		fmt.Fprintf(builder, " function %s", call.Function)
+	}
+	if call.Value != nil && call.Value.Sign() > 0 {
+		fmt.Fprintf(builder, " value %s", call.Value)
+	}
+	for _, transfer := range call.ESDTTransfers {
+		fmt.Fprintf(builder, " token %s", transfer.ESDTTokenName)
+	}
+
+	return builder.String()
+}
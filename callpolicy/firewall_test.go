@@ -0,0 +1,143 @@
+package callpolicy
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice    = []byte("alice___________________________")
+	contract = []byte("contract________________________")
+)
+
+const (
+	aliceHex    = "616c6963655f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f"
+	contractHex = "636f6e74726163745f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f5f"
+)
+
+func newTestFirewall(t *testing.T, policy *Policy) *Firewall {
+	firewall, err := NewFirewall(&StaticSource{Policy: policy})
+	require.Nil(t, err)
+	return firewall
+}
+
+func TestNewFirewall_InvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	firewall, err := NewFirewall(nil)
+	require.Nil(t, firewall)
+	require.Equal(t, ErrNilPolicySource, err)
+
+	firewall, err = NewFirewall(&StaticSource{})
+	require.Nil(t, firewall)
+	require.Equal(t, ErrNilPolicy, err)
+
+	_, err = NewFirewall(&StaticSource{Policy: &Policy{DefaultEffect: "maybe"}})
+	require.True(t, errors.Is(err, ErrInvalidEffect))
+
+	_, err = NewFirewall(&StaticSource{Policy: &Policy{Rules: []*Rule{{Effect: Deny, Kinds: []string{"Teleport"}}}}})
+	require.True(t, errors.Is(err, ErrInvalidKind))
+
+	_, err = NewFirewall(&StaticSource{Policy: &Policy{Rules: []*Rule{{Effect: Deny, Callers: []string{"xyz"}}}}})
+	require.True(t, errors.Is(err, ErrInvalidAddress))
+
+	_, err = NewFirewall(&StaticSource{Policy: &Policy{Rules: []*Rule{{Effect: Deny, MaxValue: "-1"}}}})
+	require.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestFirewall_FirstMatchingRuleDecides(t *testing.T) {
+	t.Parallel()
+
+	firewall := newTestFirewall(t, &Policy{
+		DefaultEffect: Deny,
+		Rules: []*Rule{
+			{Name: "no-upgrade", Effect: Deny, Functions: []string{"upgradeContract"}},
+			{Name: "alice-to-contract", Effect: Allow, Callers: []string{aliceHex}, Destinations: []string{contractHex}},
+		},
+	})
+
+	err := firewall.CheckCall(&vmhost.PolicyCall{
+		Kind:        vmhost.PolicyDirectCall,
+		Caller:      alice,
+		Destination: contract,
+		Function:    "increment",
+	})
+	require.Nil(t, err)
+
+	err = firewall.CheckCall(&vmhost.PolicyCall{
+		Kind:        vmhost.PolicyExecuteOnDestContext,
+		Caller:      alice,
+		Destination: contract,
+		Function:    "upgradeContract",
+	})
+	require.EqualError(t, err, `rule "no-upgrade" denies ExecuteOnDestContext from `+aliceHex+` to `+contractHex+` function upgradeContract`)
+
+	err = firewall.CheckCall(&vmhost.PolicyCall{
+		Kind:        vmhost.PolicyTransfer,
+		Caller:      contract,
+		Destination: alice,
+		Value:       big.NewInt(5),
+	})
+	require.EqualError(t, err, `default policy denies Transfer from `+contractHex+` to `+aliceHex+` value 5`)
+}
+
+func TestFirewall_KindTokenAndValueConditions(t *testing.T) {
+	t.Parallel()
+
+	firewall := newTestFirewall(t, &Policy{
+		Rules: []*Rule{
+			{Name: "frozen-token", Effect: Deny, Tokens: []string{"FROZEN-abcdef"}},
+			{Name: "large-transfers", Effect: Deny, Kinds: []string{"Transfer", "AsyncCall"}, MinValue: "1000"},
+		},
+	})
+
+	call := &vmhost.PolicyCall{
+		Kind:        vmhost.PolicyTransfer,
+		Caller:      alice,
+		Destination: contract,
+		Value:       big.NewInt(999),
+	}
+	require.Nil(t, firewall.CheckCall(call))
+
+	call.Value = big.NewInt(1000)
+	require.NotNil(t, firewall.CheckCall(call))
+
+	call.Kind = vmhost.PolicyDeploy
+	require.Nil(t, firewall.CheckCall(call))
+
+	call.ESDTTransfers = []*vmcommon.ESDTTransfer{
+		{ESDTTokenName: []byte("OTHER-123456"), ESDTValue: big.NewInt(1)},
+		{ESDTTokenName: []byte("FROZEN-abcdef"), ESDTValue: big.NewInt(1)},
+	}
+	err := firewall.CheckCall(call)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), `rule "frozen-token"`)
+}
+
+func TestFirewall_ReloadKeepsPreviousPolicyOnError(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "policy.json")
+	require.Nil(t, os.WriteFile(path, []byte(`{"rules":[{"name":"deny-all","effect":"deny"}]}`), 0o600))
+
+	firewall, err := NewFirewall(NewFileSource(path))
+	require.Nil(t, err)
+
+	call := &vmhost.PolicyCall{Kind: vmhost.PolicyDirectCall, Caller: alice, Destination: contract}
+	require.NotNil(t, firewall.CheckCall(call))
+
+	require.Nil(t, os.WriteFile(path, []byte(`{"rules":[{"name":"broken","effect":"perhaps"}]}`), 0o600))
+	require.True(t, errors.Is(firewall.Reload(), ErrInvalidEffect))
+	require.NotNil(t, firewall.CheckCall(call))
+
+	require.Nil(t, os.WriteFile(path, []byte(`{"defaultEffect":"allow","rules":[]}`), 0o600))
+	require.Nil(t, firewall.Reload())
+	require.Nil(t, firewall.CheckCall(call))
+}
@@ -0,0 +1,230 @@
+package callpolicy
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// Effect is the decision taken by a rule
+type Effect string
+
+const (
+	// Allow lets the call happen
+	Allow Effect = "allow"
+
+	// Deny rejects the call
+	Deny Effect = "deny"
+)
+
+var kindsByName = map[string]vmhost.CallPolicyKind{
+	vmhost.PolicyDirectCall.String():           vmhost.PolicyDirectCall,
+	vmhost.PolicyExecuteOnDestContext.String(): vmhost.PolicyExecuteOnDestContext,
+	vmhost.PolicyExecuteOnSameContext.String(): vmhost.PolicyExecuteOnSameContext,
+	vmhost.PolicyAsyncCall.String():            vmhost.PolicyAsyncCall,
+	vmhost.PolicyDeploy.String():               vmhost.PolicyDeploy,
+	vmhost.PolicyTransfer.String():             vmhost.PolicyTransfer,
+}
+
+// Rule matches the calls satisfying all its non-empty conditions. Addresses are hex encoded, values are decimal.
+type Rule struct {
+	Name         string   `json:"name"`
+	Effect       Effect   `json:"effect"`
+	Kinds        []string `json:"kinds,omitempty"`
+	Callers      []string `json:"callers,omitempty"`
+	Destinations []string `json:"destinations,omitempty"`
+	Functions    []string `json:"functions,omitempty"`
+	Tokens       []string `json:"tokens,omitempty"`
+	MinValue     string   `json:"minValue,omitempty"`
+	MaxValue     string   `json:"maxValue,omitempty"`
+}
+
+// Policy is an ordered list of rules, the first rule matching a call decides. The calls matched by no rule
+// get the default effect, which is Allow when not set.
+type Policy struct {
+	DefaultEffect Effect  `json:"defaultEffect,omitempty"`
+	Rules         []*Rule `json:"rules"`
+}
+
+type compiledRule struct {
+	name         string
+	effect       Effect
+	kinds        map[vmhost.CallPolicyKind]struct{}
+	callers      map[string]struct{}
+	destinations map[string]struct{}
+	functions    map[string]struct{}
+	tokens       map[string]struct{}
+	minValue     *big.Int
+	maxValue     *big.Int
+}
+
+type compiledPolicy struct {
+	defaultEffect Effect
+	rules         []*compiledRule
+}
+
+func compilePolicy(policy *Policy) (*compiledPolicy, error) {
+	if policy == nil {
+		return nil, ErrNilPolicy
+	}
+
+	compiled := &compiledPolicy{
+		defaultEffect: policy.DefaultEffect,
+		rules:         make([]*compiledRule, 0, len(policy.Rules)),
+	}
+	if len(compiled.defaultEffect) == 0 {
+		compiled.defaultEffect = Allow
+	}
+	if !isValidEffect(compiled.defaultEffect) {
+		return nil, fmt.Errorf("%w: default effect %q", ErrInvalidEffect, policy.DefaultEffect)
+	}
+
+	for index, rule := range policy.Rules {
+		compiledRule, err := compileRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", index, err)
+		}
+		compiled.rules = append(compiled.rules, compiledRule)
+	}
+
+	return compiled, nil
+}
+
+func compileRule(rule *Rule) (*compiledRule, error) {
+	if !isValidEffect(rule.Effect) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidEffect, rule.Effect)
+	}
+
+	compiled := &compiledRule{
+		name:      rule.Name,
+		effect:    rule.Effect,
+		kinds:     make(map[vmhost.CallPolicyKind]struct{}),
+		functions: stringSet(rule.Functions),
+		tokens:    stringSet(rule.Tokens),
+	}
+	for _, kindName := range rule.Kinds {
+		kind, ok := kindsByName[kindName]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidKind, kindName)
+		}
+		compiled.kinds[kind] = struct{}{}
+	}
+
+	var err error
+	compiled.callers, err = addressSet(rule.Callers)
+	if err != nil {
+		return nil, err
+	}
+	compiled.destinations, err = addressSet(rule.Destinations)
+	if err != nil {
+		return nil, err
+	}
+	compiled.minValue, err = parseValue(rule.MinValue)
+	if err != nil {
+		return nil, err
+	}
+	compiled.maxValue, err = parseValue(rule.MaxValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return compiled, nil
+}
+
+func (rule *compiledRule) matches(call *vmhost.PolicyCall) bool {
+	if len(rule.kinds) > 0 && !contains(rule.kinds, call.Kind) {
+		return false
+	}
+	if len(rule.callers) > 0 && !contains(rule.callers, string(call.Caller)) {
+		return false
+	}
+	if len(rule.destinations) > 0 && !contains(rule.destinations, string(call.Destination)) {
+		return false
+	}
+	if len(rule.functions) > 0 && !contains(rule.functions, call.Function) {
+		return false
+	}
+	if len(rule.tokens) > 0 && !rule.matchesAnyToken(call) {
+		return false
+	}
+
+	value := call.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	if rule.minValue != nil && value.Cmp(rule.minValue) < 0 {
+		return false
+	}
+	if rule.maxValue != nil && value.Cmp(rule.maxValue) > 0 {
+		return false
+	}
+
+	return true
+}
+
+func (rule *compiledRule) matchesAnyToken(call *vmhost.PolicyCall) bool {
+	for _, transfer := range call.ESDTTransfers {
+		if contains(rule.tokens, string(transfer.ESDTTokenName)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// evaluate returns the rule deciding the call, or nil when the default effect applies
+func (policy *compiledPolicy) evaluate(call *vmhost.PolicyCall) (*compiledRule, Effect) {
+	for _, rule := range policy.rules {
+		if rule.matches(call) {
+			return rule, rule.effect
+		}
+	}
+
+	return nil, policy.defaultEffect
+}
+
+func isValidEffect(effect Effect) bool {
+	return effect == Allow || effect == Deny
+}
+
+func contains[K comparable](set map[K]struct{}, key K) bool {
+	_, ok := set[key]
+	return ok
+}
+
+func stringSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+func addressSet(hexAddresses []string) (map[string]struct{}, error) {
+	set := make(map[string]struct{}, len(hexAddresses))
+	for _, hexAddress := range hexAddresses {
+		address, err := hex.DecodeString(hexAddress)
+		if err != nil || len(address) == 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidAddress, hexAddress)
+		}
+		set[string(address)] = struct{}{}
+	}
+
+	return set, nil
+}
+
+func parseValue(decimal string) (*big.Int, error) {
+	if len(decimal) == 0 {
+		return nil, nil
+	}
+
+	value, ok := big.NewInt(0).SetString(decimal, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidValue, decimal)
+	}
+
+	return value, nil
+}
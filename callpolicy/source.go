@@ -0,0 +1,47 @@
+package callpolicy
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// PolicySource provides the policy enforced by a Firewall, it is consulted again on every reload
+type PolicySource interface {
+	LoadPolicy() (*Policy, error)
+}
+
+// FileSource loads the policy from a JSON file
+type FileSource struct {
+	path string
+}
+
+// NewFileSource creates a policy source reading the JSON file at the given path
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+// LoadPolicy reads and decodes the policy file
+func (source *FileSource) LoadPolicy() (*Policy, error) {
+	data, err := os.ReadFile(source.path)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{}
+	err = json.Unmarshal(data, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// StaticSource always provides the same policy
+type StaticSource struct {
+	Policy *Policy
+}
+
+// LoadPolicy returns the wrapped policy
+func (source *StaticSource) LoadPolicy() (*Policy, error) {
+	return source.Policy, nil
+}
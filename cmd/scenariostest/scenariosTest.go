@@ -1,8 +1,14 @@
 package main
 
 import (
+	"log"
+
 	scenclibase "github.com/multiversx/mx-chain-scenario-go/clibase"
 	scenio "github.com/multiversx/mx-chain-scenario-go/scenario/io"
+	"github.com/multiversx/mx-chain-vm-go/abi"
+	"github.com/multiversx/mx-chain-vm-go/debugger"
+	"github.com/multiversx/mx-chain-vm-go/forensics"
+	"github.com/multiversx/mx-chain-vm-go/profiler"
 	vmscenario "github.com/multiversx/mx-chain-vm-go/scenario"
 	"github.com/multiversx/mx-chain-vm-go/wasmer2"
 	cli "github.com/urfave/cli/v2"
@@ -11,10 +17,21 @@ import (
 var _ scenclibase.CLIRunConfig = (*vm15Flags)(nil)
 
 func main() {
-	scenclibase.ScenariosCLI("VM 1.5 internal", &vm15Flags{})
+	flags := &vm15Flags{}
+	scenclibase.ScenariosCLI("VM 1.5 internal", flags)
+
+	if flags.footprintProfiler != nil {
+		err := flags.footprintProfiler.FootprintReport().MergeIntoFile(flags.footprintReportPath)
+		if err != nil {
+			log.Fatalf("cannot write the footprint report %s: %s", flags.footprintReportPath, err.Error())
+		}
+	}
 }
 
-type vm15Flags struct{}
+type vm15Flags struct {
+	footprintReportPath string
+	footprintProfiler   *profiler.Profiler
+}
 
 func (*vm15Flags) GetFlags() []cli.Flag {
 	return []cli.Flag{
@@ -31,10 +48,30 @@ func (*vm15Flags) GetFlags() []cli.Flag {
 			Name:  "wasmer2",
 			Usage: "use the wasmer2 executor`",
 		},
+		&cli.StringSliceFlag{
+			Name:  "abi",
+			Usage: "decode and log call results and events using the given ABI JSON files`",
+		},
+		&cli.StringFlag{
+			Name:  "debug-rpc",
+			Usage: "serves the step debugger over JSON-RPC on the given local address, e.g. 127.0.0.1:9230`",
+		},
+		&cli.BoolFlag{
+			Name:  "debug-pause-on-start",
+			Usage: "pauses at the first VM hook, so that a debugger client can attach and set breakpoints`",
+		},
+		&cli.StringFlag{
+			Name:  "forensics-dir",
+			Usage: "writes a forensics archive in the given directory whenever an execution panics or fails unexpectedly`",
+		},
+		&cli.StringFlag{
+			Name:  "footprint-report",
+			Usage: "merges the code size and memory footprint of every contract endpoint into the given JSON report`",
+		},
 	}
 }
 
-func (*vm15Flags) ParseFlags(cCtx *cli.Context) scenclibase.CLIRunOptions {
+func (flags *vm15Flags) ParseFlags(cCtx *cli.Context) scenclibase.CLIRunOptions {
 	runOptions := &scenio.RunScenarioOptions{
 		ForceTraceGas: cCtx.Bool("force-trace-gas"),
 	}
@@ -43,6 +80,34 @@ func (*vm15Flags) ParseFlags(cCtx *cli.Context) scenclibase.CLIRunOptions {
 	if cCtx.Bool("wasmer2") {
 		vmBuilder.OverrideVMExecutor = wasmer2.ExecutorFactory()
 	}
+	for _, abiPath := range cCtx.StringSlice("abi") {
+		contractABI, err := abi.LoadABI(abiPath)
+		if err != nil {
+			log.Fatalf("cannot load ABI %s: %s", abiPath, err.Error())
+		}
+		vmBuilder.ABICodecs = append(vmBuilder.ABICodecs, abi.NewCodec(contractABI))
+	}
+	if forensicsDir := cCtx.String("forensics-dir"); len(forensicsDir) > 0 {
+		forensicsWriter, err := forensics.NewWriter(forensicsDir, forensics.DefaultMaxVMHookCalls)
+		if err != nil {
+			log.Fatalf("cannot create the forensics directory %s: %s", forensicsDir, err.Error())
+		}
+		vmBuilder.ForensicsWriter = forensicsWriter
+	}
+	if reportPath := cCtx.String("footprint-report"); len(reportPath) > 0 {
+		flags.footprintReportPath = reportPath
+		flags.footprintProfiler = profiler.NewProfiler(1)
+		vmBuilder.ExecutionProfiler = flags.footprintProfiler
+	}
+	if debugAddress := cCtx.String("debug-rpc"); len(debugAddress) > 0 {
+		vmDebugger := debugger.NewDebugger(cCtx.Bool("debug-pause-on-start"))
+		listener, err := debugger.StartJSONRPCServer(vmDebugger, debugAddress)
+		if err != nil {
+			log.Fatalf("cannot start the debugger on %s: %s", debugAddress, err.Error())
+		}
+		log.Printf("debugger listening on %s", listener.Addr().String())
+		vmBuilder.Debugger = vmDebugger
+	}
 
 	return scenclibase.CLIRunOptions{
 		RunOptions: runOptions,
@@ -0,0 +1,112 @@
+// Compares two versions of the code of a contract and reports the changes which break its upgrade.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-vm-go/abi"
+	"github.com/multiversx/mx-chain-vm-go/upgradecheck"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+	cli "github.com/urfave/cli/v2"
+)
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "upgradecheck"
+	app.Usage = "reports the changes between two versions of a contract which break its upgrade"
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{
+			Name:     "old",
+			Usage:    "the .wasm file of the deployed code`",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "new",
+			Usage:    "the .wasm file of the code to upgrade to`",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "old-abi",
+			Usage: "the ABI JSON file of the deployed code`",
+		},
+		&cli.StringFlag{
+			Name:  "new-abi",
+			Usage: "the ABI JSON file of the code to upgrade to`",
+		},
+		&cli.StringFlag{
+			Name:  "storage",
+			Usage: "a JSON object holding the hex encoded storage of the contract, whose pending async contexts are checked`",
+		},
+		&cli.StringFlag{
+			Name:  "protected-key-prefix",
+			Usage: "the protected key prefix of the VM host`",
+			Value: core.ProtectedKeyPrefix,
+		},
+	}
+	app.Action = runCheck
+
+	if err := app.Run(os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func runCheck(cCtx *cli.Context) error {
+	oldVersion, err := loadCodeVersion(cCtx.String("old"), cCtx.String("old-abi"))
+	if err != nil {
+		return err
+	}
+	newVersion, err := loadCodeVersion(cCtx.String("new"), cCtx.String("new-abi"))
+	if err != nil {
+		return err
+	}
+
+	var pendingContexts []*contexts.SerializableAsyncContext
+	if storagePath := cCtx.String("storage"); len(storagePath) > 0 {
+		content, errRead := os.ReadFile(storagePath)
+		if errRead != nil {
+			return errRead
+		}
+		storage, errParse := upgradecheck.ParseStorageDump(content)
+		if errParse != nil {
+			return fmt.Errorf("cannot parse the storage %s: %w", storagePath, errParse)
+		}
+		pendingContexts, err = upgradecheck.ReadPersistedAsyncContexts(storage, []byte(cCtx.String("protected-key-prefix")))
+		if err != nil {
+			return err
+		}
+	}
+
+	report, err := upgradecheck.Check(oldVersion, newVersion, pendingContexts)
+	if err != nil {
+		return err
+	}
+
+	for _, finding := range report.Findings {
+		fmt.Println(finding.String())
+	}
+	if report.HasBreakingChanges() {
+		return cli.Exit("the upgrade has breaking changes", 1)
+	}
+
+	return nil
+}
+
+func loadCodeVersion(codePath string, abiPath string) (*upgradecheck.CodeVersion, error) {
+	code, err := os.ReadFile(codePath)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &upgradecheck.CodeVersion{Code: code}
+	if len(abiPath) > 0 {
+		version.ABI, err = abi.LoadABI(abiPath)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load ABI %s: %w", abiPath, err)
+		}
+	}
+
+	return version, nil
+}
@@ -0,0 +1,15 @@
+package config
+
+import "errors"
+
+// ErrMissingGasScheduleSection signals that a section of the gas schedule read by the VM is missing
+var ErrMissingGasScheduleSection = errors.New("missing gas schedule section")
+
+// ErrMissingGasScheduleKey signals that a cost expected by the VM is missing from the gas schedule
+var ErrMissingGasScheduleKey = errors.New("missing gas schedule key")
+
+// ErrUnknownGasScheduleKey signals that the gas schedule contains a cost which the VM does not know
+var ErrUnknownGasScheduleKey = errors.New("unknown gas schedule key")
+
+// ErrZeroGasCost signals that a gas cost has been set to 0
+var ErrZeroGasCost = errors.New("gas cost set to 0")
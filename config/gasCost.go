@@ -7,15 +7,18 @@ import (
 
 // GasCost defines the gas cost config structure
 type GasCost struct {
-	BaseOperationCost    BaseOperationCost
-	BigIntAPICost        BigIntAPICost
-	BigFloatAPICost      BigFloatAPICost
-	BaseOpsAPICost       BaseOpsAPICost
-	ManagedBufferAPICost ManagedBufferAPICost
-	ManagedMapAPICost    ManagedMapAPICost
-	CryptoAPICost        CryptoAPICost
-	WASMOpcodeCost       *executor.WASMOpcodeCost
-	DynamicStorageLoad   DynamicStorageLoadCostCoefficients
+	BaseOperationCost       BaseOperationCost
+	BigIntAPICost           BigIntAPICost
+	BigFloatAPICost         BigFloatAPICost
+	BaseOpsAPICost          BaseOpsAPICost
+	ManagedBufferAPICost    ManagedBufferAPICost
+	ManagedMapAPICost       ManagedMapAPICost
+	ManagedDecimalAPICost   ManagedDecimalAPICost
+	TransientStorageAPICost TransientStorageAPICost
+	EthAPICost              EthAPICost
+	CryptoAPICost           CryptoAPICost
+	WASMOpcodeCost          *executor.WASMOpcodeCost
+	DynamicStorageLoad      DynamicStorageLoadCostCoefficients
 }
 
 // BaseOperationCost defines the base operations gas cost config structure
@@ -88,6 +91,7 @@ type BaseOpsAPICost struct {
 	EpochStartBlockTimeStamp uint64
 	EpochStartBlockNonce     uint64
 	EpochStartBlockRound     uint64
+	Int64GetRandomInRange    uint64
 }
 
 // DynamicStorageLoadCostCoefficients holds the signed coefficients of the func that will compute the gas cost
@@ -153,6 +157,7 @@ type BigIntAPICost struct {
 	BigIntGetCallValue         uint64
 	BigIntGetExternalBalance   uint64
 	CopyPerByteForTooBig       uint64
+	BigIntSetRandomInRange     uint64
 }
 
 // BigFloatAPICost defines the big float operations gas cost config structure
@@ -204,30 +209,32 @@ type CryptoAPICost struct {
 
 // ManagedBufferAPICost defines the managed buffer operations gas cost config structure
 type ManagedBufferAPICost struct {
-	MBufferNew                  uint64
-	MBufferNewFromBytes         uint64
-	MBufferGetLength            uint64
-	MBufferGetBytes             uint64
-	MBufferGetByteSlice         uint64
-	MBufferCopyByteSlice        uint64
-	MBufferSetBytes             uint64
-	MBufferAppend               uint64
-	MBufferAppendBytes          uint64
-	MBufferToBigIntUnsigned     uint64
-	MBufferToBigIntSigned       uint64
-	MBufferFromBigIntUnsigned   uint64
-	MBufferFromBigIntSigned     uint64
-	MBufferToSmallIntUnsigned   uint64
-	MBufferToSmallIntSigned     uint64
-	MBufferFromSmallIntUnsigned uint64
-	MBufferFromSmallIntSigned   uint64
-	MBufferToBigFloat           uint64
-	MBufferFromBigFloat         uint64
-	MBufferStorageStore         uint64
-	MBufferStorageLoad          uint64
-	MBufferGetArgument          uint64
-	MBufferFinish               uint64
-	MBufferSetRandom            uint64
+	MBufferNew                    uint64
+	MBufferNewFromBytes           uint64
+	MBufferGetLength              uint64
+	MBufferGetBytes               uint64
+	MBufferGetByteSlice           uint64
+	MBufferCopyByteSlice          uint64
+	MBufferSetBytes               uint64
+	MBufferAppend                 uint64
+	MBufferAppendBytes            uint64
+	MBufferToBigIntUnsigned       uint64
+	MBufferToBigIntSigned         uint64
+	MBufferFromBigIntUnsigned     uint64
+	MBufferFromBigIntSigned       uint64
+	MBufferToSmallIntUnsigned     uint64
+	MBufferToSmallIntSigned       uint64
+	MBufferFromSmallIntUnsigned   uint64
+	MBufferFromSmallIntSigned     uint64
+	MBufferToBigFloat             uint64
+	MBufferFromBigFloat           uint64
+	MBufferStorageStore           uint64
+	MBufferStorageLoad            uint64
+	MBufferStorageLoadBatch       uint64
+	MBufferStorageLoadBatchPerKey uint64
+	MBufferGetArgument            uint64
+	MBufferFinish                 uint64
+	MBufferSetRandom              uint64
 }
 
 // ManagedMapAPICost defines the managed map operations gas cost config structure
@@ -238,3 +245,65 @@ type ManagedMapAPICost struct {
 	ManagedMapRemove   uint64
 	ManagedMapContains uint64
 }
+
+// ManagedDecimalAPICost defines the managed decimal operations gas cost config structure
+type ManagedDecimalAPICost struct {
+	MDecimalFromBigInt            uint64
+	MDecimalToBigInt              uint64
+	MDecimalGetMantissa           uint64
+	MDecimalGetScale              uint64
+	MDecimalFromManagedBuffer     uint64
+	MDecimalToManagedBuffer       uint64
+	MDecimalAdd                   uint64
+	MDecimalSub                   uint64
+	MDecimalMul                   uint64
+	MDecimalDiv                   uint64
+	MDecimalRescale               uint64
+	MDecimalCmp                   uint64
+	MDecimalLn                    uint64
+	MDecimalExp                   uint64
+	MDecimalApproximationPerDigit uint64
+}
+
+// TransientStorageAPICost defines the transient storage operations gas cost config structure
+type TransientStorageAPICost struct {
+	TransientStorageStore uint64
+	TransientStorageLoad  uint64
+}
+
+// EthAPICost defines the Ethereum compatibility layer gas cost config structure
+type EthAPICost struct {
+	UseGas              uint64
+	GetAddress          uint64
+	GetExternalBalance  uint64
+	GetBlockHash        uint64
+	Call                uint64
+	CallDataCopy        uint64
+	GetCallDataSize     uint64
+	CallCode            uint64
+	CallDelegate        uint64
+	CallStatic          uint64
+	StorageStore        uint64
+	StorageLoad         uint64
+	GetCaller           uint64
+	GetCallValue        uint64
+	CodeCopy            uint64
+	GetCodeSize         uint64
+	GetBlockCoinbase    uint64
+	Create              uint64
+	GetBlockDifficulty  uint64
+	ExternalCodeCopy    uint64
+	GetExternalCodeSize uint64
+	GetGasLeft          uint64
+	GetBlockGasLimit    uint64
+	GetTxGasPrice       uint64
+	Log                 uint64
+	GetBlockNumber      uint64
+	GetTxOrigin         uint64
+	Finish              uint64
+	Revert              uint64
+	GetReturnDataSize   uint64
+	ReturnDataCopy      uint64
+	SelfDestruct        uint64
+	GetBlockTimeStamp   uint64
+}
@@ -120,16 +120,37 @@ func CreateGasConfig(gasMap GasScheduleMap) (*GasCost, error) {
 		return nil, err
 	}
 
+	managedDecimalOps := &ManagedDecimalAPICost{}
+	err = mapstructure.Decode(gasMap["ManagedDecimalAPICost"], managedDecimalOps)
+	if err != nil {
+		return nil, err
+	}
+
+	transientStorageOps := &TransientStorageAPICost{}
+	err = mapstructure.Decode(gasMap["TransientStorageAPICost"], transientStorageOps)
+	if err != nil {
+		return nil, err
+	}
+
+	ethOps := &EthAPICost{}
+	err = mapstructure.Decode(gasMap["EthAPICost"], ethOps)
+	if err != nil {
+		return nil, err
+	}
+
 	gasCost := &GasCost{
-		BaseOperationCost:    *baseOps,
-		BigIntAPICost:        *bigIntOps,
-		BigFloatAPICost:      *bigFloatOps,
-		BaseOpsAPICost:       *baseOpsAPI,
-		CryptoAPICost:        *cryptOps,
-		ManagedBufferAPICost: *MBufferOps,
-		WASMOpcodeCost:       wasmOps,
-		DynamicStorageLoad:   *dynamicStorageLoadParams,
-		ManagedMapAPICost:    *managedMapOps,
+		BaseOperationCost:       *baseOps,
+		BigIntAPICost:           *bigIntOps,
+		BigFloatAPICost:         *bigFloatOps,
+		BaseOpsAPICost:          *baseOpsAPI,
+		CryptoAPICost:           *cryptOps,
+		ManagedBufferAPICost:    *MBufferOps,
+		WASMOpcodeCost:          wasmOps,
+		DynamicStorageLoad:      *dynamicStorageLoadParams,
+		ManagedMapAPICost:       *managedMapOps,
+		ManagedDecimalAPICost:   *managedDecimalOps,
+		TransientStorageAPICost: *transientStorageOps,
+		EthAPICost:              *ethOps,
 	}
 
 	return gasCost, nil
@@ -177,6 +198,8 @@ func getSignedCoefficient(coefficient uint64, sign uint64) int64 {
 	return int64(coefficient)
 }
 
+// checkForZeroUint64Fields rejects the costs set to 0, except for the optional ones, which are missing from the gas
+// schedules defined before the activation of their features. The sections are named after their cost types.
 func checkForZeroUint64Fields(arg interface{}) error {
 	v := reflect.ValueOf(arg)
 	for i := 0; i < v.NumField(); i++ {
@@ -184,8 +207,8 @@ func checkForZeroUint64Fields(arg interface{}) error {
 		if field.Kind() != reflect.Uint64 && field.Kind() != reflect.Uint32 {
 			continue
 		}
-		if field.Uint() == 0 {
-			name := v.Type().Field(i).Name
+		name := v.Type().Field(i).Name
+		if field.Uint() == 0 && !isOptionalGasScheduleKey(v.Type().Name(), name) {
 			return fmt.Errorf("gas cost for operation %s has been set to 0 or is not set", name)
 		}
 	}
@@ -205,12 +228,14 @@ func FillGasMap(gasMap GasScheduleMap, value, asyncCallbackGasLock uint64) GasSc
 	gasMap["BuiltInCost"] = FillGasMapBuiltInCosts(value)
 	gasMap["BaseOperationCost"] = FillGasMapBaseOperationCosts(value)
 	gasMap["BaseOpsAPICost"] = FillGasMapBaseOpsAPICosts(value, asyncCallbackGasLock)
-	// EthAPICost needed on node for VM tests < 1.5
 	gasMap["EthAPICost"] = FillGasMapEthereumAPICosts(value)
 	gasMap["BigIntAPICost"] = FillGasMapBigIntAPICosts(value)
 	gasMap["BigFloatAPICost"] = FillGasMapBigFloatAPICosts(value)
 	gasMap["CryptoAPICost"] = FillGasMapCryptoAPICosts(value)
 	gasMap["ManagedBufferAPICost"] = FillGasMapManagedBufferAPICosts(value)
+	gasMap["ManagedMapAPICost"] = FillGasMapManagedMapAPICosts(value)
+	gasMap["ManagedDecimalAPICost"] = FillGasMapManagedDecimalAPICosts(value)
+	gasMap["TransientStorageAPICost"] = FillGasMapTransientStorageAPICosts(value)
 	gasMap["WASMOpcodeCost"] = FillGasMapWASMOpcodeValues(value)
 	gasMap["DynamicStorageLoad"] = FillGasMapDynamicStorageLoad()
 
@@ -326,11 +351,12 @@ func FillGasMapBaseOpsAPICosts(value, asyncCallbackGasLock uint64) map[string]ui
 	gasMap["EpochStartBlockTimeStamp"] = value
 	gasMap["EpochStartBlockNonce"] = value
 	gasMap["EpochStartBlockRound"] = value
+	gasMap["Int64GetRandomInRange"] = value
 
 	return gasMap
 }
 
-// FillGasMapEthereumAPICosts is needed for EthAPICost, which is needed on node for VM tests < 1.5
+// FillGasMapEthereumAPICosts fills the costs of the Ethereum compatibility layer
 func FillGasMapEthereumAPICosts(value uint64) map[string]uint64 {
 	gasMap := make(map[string]uint64)
 	gasMap["UseGas"] = value
@@ -412,6 +438,7 @@ func FillGasMapBigIntAPICosts(value uint64) map[string]uint64 {
 	gasMap["BigIntGetCallValue"] = value
 	gasMap["BigIntGetExternalBalance"] = value
 	gasMap["CopyPerByteForTooBig"] = value
+	gasMap["BigIntSetRandomInRange"] = value
 
 	return gasMap
 }
@@ -493,6 +520,8 @@ func FillGasMapManagedBufferAPICosts(value uint64) map[string]uint64 {
 	gasMap["MBufferFromBigFloat"] = value
 	gasMap["MBufferStorageStore"] = value
 	gasMap["MBufferStorageLoad"] = value
+	gasMap["MBufferStorageLoadBatch"] = value
+	gasMap["MBufferStorageLoadBatchPerKey"] = value
 	gasMap["MBufferGetArgument"] = value
 	gasMap["MBufferFinish"] = value
 	gasMap["MBufferSetRandom"] = value
@@ -500,6 +529,49 @@ func FillGasMapManagedBufferAPICosts(value uint64) map[string]uint64 {
 	return gasMap
 }
 
+// FillGasMapManagedMapAPICosts fills the managed map API costs
+func FillGasMapManagedMapAPICosts(value uint64) map[string]uint64 {
+	gasMap := make(map[string]uint64)
+	gasMap["ManagedMapNew"] = value
+	gasMap["ManagedMapPut"] = value
+	gasMap["ManagedMapGet"] = value
+	gasMap["ManagedMapRemove"] = value
+	gasMap["ManagedMapContains"] = value
+
+	return gasMap
+}
+
+// FillGasMapManagedDecimalAPICosts fills the managed decimal operations costs
+func FillGasMapManagedDecimalAPICosts(value uint64) map[string]uint64 {
+	gasMap := make(map[string]uint64)
+	gasMap["MDecimalFromBigInt"] = value
+	gasMap["MDecimalToBigInt"] = value
+	gasMap["MDecimalGetMantissa"] = value
+	gasMap["MDecimalGetScale"] = value
+	gasMap["MDecimalFromManagedBuffer"] = value
+	gasMap["MDecimalToManagedBuffer"] = value
+	gasMap["MDecimalAdd"] = value
+	gasMap["MDecimalSub"] = value
+	gasMap["MDecimalMul"] = value
+	gasMap["MDecimalDiv"] = value
+	gasMap["MDecimalRescale"] = value
+	gasMap["MDecimalCmp"] = value
+	gasMap["MDecimalLn"] = value
+	gasMap["MDecimalExp"] = value
+	gasMap["MDecimalApproximationPerDigit"] = value
+
+	return gasMap
+}
+
+// FillGasMapTransientStorageAPICosts fills the transient storage operations costs
+func FillGasMapTransientStorageAPICosts(value uint64) map[string]uint64 {
+	gasMap := make(map[string]uint64)
+	gasMap["TransientStorageStore"] = value
+	gasMap["TransientStorageLoad"] = value
+
+	return gasMap
+}
+
 // FillGasMapWASMOpcodeValues fills the wasm opcodes costs
 func FillGasMapWASMOpcodeValues(value uint64) map[string]uint64 {
 	gasMap := make(map[string]uint64)
@@ -1075,6 +1147,7 @@ func FillGasMapDynamicStorageLoad() map[string]uint64 {
 	gasMap["SignOfLinear"] = 0
 	gasMap["ConstantCoefficient"] = 15287
 	gasMap["SignOfConstant"] = 0
+	gasMap["MinimumGasCost"] = 10000
 
 	return gasMap
 }
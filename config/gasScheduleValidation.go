@@ -0,0 +1,225 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// EnableEpochsHandler tells which of the epoch flags activating the features with their own costs are enabled
+type EnableEpochsHandler interface {
+	IsFlagEnabled(flag core.EnableEpochFlag) bool
+}
+
+// gasScheduleSection describes a section of the gas schedule read by the VM. A section with an activation flag holds
+// the costs of a feature activated by that epoch flag, which the gas schedules of the node only define from its
+// activation.
+type gasScheduleSection struct {
+	name           string
+	costs          interface{}
+	allowZeros     bool
+	activationFlag core.EnableEpochFlag
+}
+
+// optionalGasScheduleKey is a cost added to an existing section by a feature activated by an epoch flag
+type optionalGasScheduleKey struct {
+	key            string
+	activationFlag core.EnableEpochFlag
+}
+
+// gasScheduleSections lists the sections of the gas schedule read by the VM; any other section belongs to the node
+// and is not validated here
+var gasScheduleSections = []gasScheduleSection{
+	{name: "BaseOperationCost", costs: BaseOperationCost{}},
+	{name: "BaseOpsAPICost", costs: BaseOpsAPICost{}},
+	{name: "BigIntAPICost", costs: BigIntAPICost{}},
+	{name: "BigFloatAPICost", costs: BigFloatAPICost{}},
+	{name: "CryptoAPICost", costs: CryptoAPICost{}},
+	{name: "ManagedBufferAPICost", costs: ManagedBufferAPICost{}},
+	{name: "ManagedMapAPICost", costs: ManagedMapAPICost{}},
+	{name: "ManagedDecimalAPICost", costs: ManagedDecimalAPICost{}, activationFlag: "ManagedDecimalOpcodesFlag"},
+	{name: "TransientStorageAPICost", costs: TransientStorageAPICost{}, activationFlag: "TransientStorageFlag"},
+	{name: "EthAPICost", costs: EthAPICost{}, activationFlag: "EthereumAPIFlag"},
+	{name: "WASMOpcodeCost", costs: executor.WASMOpcodeCost{}},
+	// the signs and the coefficients may be 0, the coefficients being checked as a whole
+	{name: "DynamicStorageLoad", costs: DynamicStorageLoadUnsigned{}, allowZeros: true},
+}
+
+// optionalGasScheduleKeys lists the costs added to the existing sections by the features activated by an epoch flag,
+// which the gas schedules of the node only define from their activation
+var optionalGasScheduleKeys = map[string][]optionalGasScheduleKey{
+	"BaseOpsAPICost": {{key: "Int64GetRandomInRange", activationFlag: "PerCallRandomnessFlag"}},
+	"BigIntAPICost":  {{key: "BigIntSetRandomInRange", activationFlag: "PerCallRandomnessFlag"}},
+	"ManagedBufferAPICost": {
+		{key: "MBufferStorageLoadBatch", activationFlag: "BatchedStorageLoadFlag"},
+		{key: "MBufferStorageLoadBatchPerKey", activationFlag: "BatchedStorageLoadFlag"},
+	},
+}
+
+// isMissingCostAllowed returns true if the cost belongs to a feature which is not active yet
+func isMissingCostAllowed(activationFlag core.EnableEpochFlag, enableEpochsHandler EnableEpochsHandler) bool {
+	return activationFlag != "" && !enableEpochsHandler.IsFlagEnabled(activationFlag)
+}
+
+func isOptionalGasScheduleKey(section string, key string) bool {
+	return optionalKeyActivationFlag(section, key) != ""
+}
+
+func optionalKeyActivationFlag(section string, key string) core.EnableEpochFlag {
+	for _, optionalKey := range optionalGasScheduleKeys[section] {
+		if optionalKey.key == key {
+			return optionalKey.activationFlag
+		}
+	}
+
+	return ""
+}
+
+// deprecatedGasScheduleKeys lists the costs still present in the gas schedules of the node, but no longer read by the VM
+var deprecatedGasScheduleKeys = map[string][]string{
+	"BigIntAPICost": {"BigIntByteLength", "BigIntGetBytes", "BigIntSetBytes", "BigIntGetArgument"},
+}
+
+// ValidateGasSchedule checks that every section of the gas schedule read by the VM contains exactly the expected
+// costs, none of them set to 0, and that the dynamic storage load coefficients define a valid function. The costs of
+// a feature activated by an epoch flag may only be missing while its flag is not enabled, and are checked the same
+// way when present.
+func ValidateGasSchedule(gasMap GasScheduleMap, enableEpochsHandler EnableEpochsHandler) error {
+	for _, section := range gasScheduleSections {
+		err := validateGasScheduleSection(section, gasMap[section.name], enableEpochsHandler)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := CreateGasConfig(gasMap)
+	return err
+}
+
+func validateGasScheduleSection(section gasScheduleSection, costs map[string]uint64, enableEpochsHandler EnableEpochsHandler) error {
+	if costs == nil {
+		if isMissingCostAllowed(section.activationFlag, enableEpochsHandler) {
+			return nil
+		}
+		return fmt.Errorf("%w: section %s", ErrMissingGasScheduleSection, section.name)
+	}
+
+	expectedKeys := make(map[string]struct{})
+	for _, key := range deprecatedGasScheduleKeys[section.name] {
+		expectedKeys[key] = struct{}{}
+	}
+
+	costsType := reflect.TypeOf(section.costs)
+	for i := 0; i < costsType.NumField(); i++ {
+		key := costsType.Field(i).Name
+		expectedKeys[key] = struct{}{}
+
+		value, ok := costs[key]
+		if !ok {
+			if isMissingCostAllowed(optionalKeyActivationFlag(section.name, key), enableEpochsHandler) {
+				continue
+			}
+			return fmt.Errorf("%w: %s.%s", ErrMissingGasScheduleKey, section.name, key)
+		}
+		if value == 0 && !section.allowZeros {
+			return fmt.Errorf("%w: %s.%s", ErrZeroGasCost, section.name, key)
+		}
+	}
+
+	for _, key := range sortedKeys(costs) {
+		_, ok := expectedKeys[key]
+		if !ok {
+			return fmt.Errorf("%w: %s.%s", ErrUnknownGasScheduleKey, section.name, key)
+		}
+	}
+
+	return nil
+}
+
+// GasCostChange describes a single cost which differs between two gas schedules
+type GasCostChange struct {
+	Section  string
+	Key      string
+	OldValue uint64
+	NewValue uint64
+}
+
+// String returns a readable description of the change
+func (change GasCostChange) String() string {
+	return fmt.Sprintf("%s.%s: %d -> %d", change.Section, change.Key, change.OldValue, change.NewValue)
+}
+
+// GasScheduleDiff holds the costs which differ between two gas schedules, sorted by section and key
+type GasScheduleDiff []GasCostChange
+
+// DiffGasSchedules returns the costs which were added, removed or changed from the old gas schedule to the new one.
+// A cost missing from a gas schedule is reported with the value 0.
+func DiffGasSchedules(oldGasMap GasScheduleMap, newGasMap GasScheduleMap) GasScheduleDiff {
+	sections := make(map[string]struct{})
+	for section := range oldGasMap {
+		sections[section] = struct{}{}
+	}
+	for section := range newGasMap {
+		sections[section] = struct{}{}
+	}
+
+	diff := make(GasScheduleDiff, 0)
+	for _, section := range sortedKeys(sections) {
+		oldCosts := oldGasMap[section]
+		newCosts := newGasMap[section]
+
+		keys := make(map[string]struct{})
+		for key := range oldCosts {
+			keys[key] = struct{}{}
+		}
+		for key := range newCosts {
+			keys[key] = struct{}{}
+		}
+
+		for _, key := range sortedKeys(keys) {
+			oldValue, oldOk := oldCosts[key]
+			newValue, newOk := newCosts[key]
+			if oldOk == newOk && oldValue == newValue {
+				continue
+			}
+			diff = append(diff, GasCostChange{
+				Section:  section,
+				Key:      key,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+		}
+	}
+
+	return diff
+}
+
+// CopyGasSchedule returns a deep copy of the gas schedule
+func CopyGasSchedule(gasMap GasScheduleMap) GasScheduleMap {
+	if gasMap == nil {
+		return nil
+	}
+
+	gasMapCopy := make(GasScheduleMap, len(gasMap))
+	for section, costs := range gasMap {
+		costsCopy := make(map[string]uint64, len(costs))
+		for key, value := range costs {
+			costsCopy[key] = value
+		}
+		gasMapCopy[section] = costsCopy
+	}
+
+	return gasMapCopy
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,214 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+)
+
+// enabledFlags is an EnableEpochsHandler with only the listed flags enabled
+type enabledFlags []core.EnableEpochFlag
+
+func (flags enabledFlags) IsFlagEnabled(flag core.EnableEpochFlag) bool {
+	for _, enabledFlag := range flags {
+		if enabledFlag == flag {
+			return true
+		}
+	}
+
+	return false
+}
+
+var allFeatureFlags = enabledFlags{
+	"ManagedDecimalOpcodesFlag",
+	"PerCallRandomnessFlag",
+	"TransientStorageFlag",
+	"BatchedStorageLoadFlag",
+	"EthereumAPIFlag",
+}
+
+// loadGasScheduleFile reads a gas schedule the way the node does, flattening its sections
+func loadGasScheduleFile(t *testing.T, path string) GasScheduleMap {
+	contents, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	tree, err := toml.LoadBytes(contents)
+	require.Nil(t, err)
+
+	gasMap := make(GasScheduleMap)
+	for section, costs := range tree.ToMap() {
+		gasMap[section] = make(map[string]uint64)
+		for key, cost := range costs.(map[string]interface{}) {
+			gasMap[section][key] = uint64(cost.(int64))
+		}
+	}
+
+	return gasMap
+}
+
+func TestValidateGasSchedule_ValidSchedule(t *testing.T) {
+	err := ValidateGasSchedule(MakeGasMapForTests(), allFeatureFlags)
+	require.Nil(t, err)
+}
+
+func TestValidateGasSchedule_IgnoresNodeSectionsAndDeprecatedKeys(t *testing.T) {
+	gasMap := MakeGasMapForTests()
+	gasMap["MetaChainSystemSCsCost"] = map[string]uint64{"Stake": 5000000}
+	gasMap["BigIntAPICost"]["BigIntByteLength"] = 2000
+
+	err := ValidateGasSchedule(gasMap, allFeatureFlags)
+	require.Nil(t, err)
+}
+
+// removeFeatureCosts removes the costs of the features activated by an epoch flag
+func removeFeatureCosts(gasMap GasScheduleMap) {
+	delete(gasMap, "ManagedDecimalAPICost")
+	delete(gasMap, "TransientStorageAPICost")
+	delete(gasMap, "EthAPICost")
+	delete(gasMap["BaseOpsAPICost"], "Int64GetRandomInRange")
+	delete(gasMap["BigIntAPICost"], "BigIntSetRandomInRange")
+	delete(gasMap["ManagedBufferAPICost"], "MBufferStorageLoadBatch")
+	delete(gasMap["ManagedBufferAPICost"], "MBufferStorageLoadBatchPerKey")
+}
+
+func TestValidateGasSchedule_SchedulesWithoutTheNewFeatures(t *testing.T) {
+	for _, path := range []string{"../scenario/gasSchedules/gasScheduleV3.toml", "../scenario/gasSchedules/gasScheduleV4.toml"} {
+		gasMap := loadGasScheduleFile(t, path)
+		err := ValidateGasSchedule(gasMap, allFeatureFlags)
+		require.Nil(t, err, path)
+
+		// as the gas schedules of the node were before the activation of the features
+		removeFeatureCosts(gasMap)
+		err = ValidateGasSchedule(gasMap, enabledFlags{})
+		require.Nil(t, err, path)
+	}
+}
+
+func TestValidateGasSchedule_OptionalSectionsAndKeys(t *testing.T) {
+	gasMap := MakeGasMapForTests()
+	removeFeatureCosts(gasMap)
+
+	err := ValidateGasSchedule(gasMap, enabledFlags{})
+	require.Nil(t, err)
+
+	gasMap["ManagedBufferAPICost"]["MBufferStorageLoadBatch"] = 0
+	err = ValidateGasSchedule(gasMap, enabledFlags{})
+	require.True(t, errors.Is(err, ErrZeroGasCost), err)
+}
+
+func TestValidateGasSchedule_MissingCostsOfActiveFeatures(t *testing.T) {
+	expectedErrors := map[core.EnableEpochFlag]error{
+		"ManagedDecimalOpcodesFlag": ErrMissingGasScheduleSection,
+		"PerCallRandomnessFlag":     ErrMissingGasScheduleKey,
+		"TransientStorageFlag":      ErrMissingGasScheduleSection,
+		"BatchedStorageLoadFlag":    ErrMissingGasScheduleKey,
+		"EthereumAPIFlag":           ErrMissingGasScheduleSection,
+	}
+	require.Len(t, expectedErrors, len(allFeatureFlags))
+
+	for flag, expectedErr := range expectedErrors {
+		gasMap := MakeGasMapForTests()
+		removeFeatureCosts(gasMap)
+
+		err := ValidateGasSchedule(gasMap, enabledFlags{flag})
+		require.True(t, errors.Is(err, expectedErr), "%s: %v", flag, err)
+	}
+}
+
+func TestValidateGasSchedule_InvalidSchedules(t *testing.T) {
+	testCases := []struct {
+		name        string
+		change      func(gasMap GasScheduleMap)
+		expectedErr error
+	}{
+		{
+			name:        "missing section",
+			change:      func(gasMap GasScheduleMap) { delete(gasMap, "ManagedMapAPICost") },
+			expectedErr: ErrMissingGasScheduleSection,
+		},
+		{
+			name:        "missing key",
+			change:      func(gasMap GasScheduleMap) { delete(gasMap["BaseOpsAPICost"], "Finish") },
+			expectedErr: ErrMissingGasScheduleKey,
+		},
+		{
+			name:        "missing ethereum cost",
+			change:      func(gasMap GasScheduleMap) { delete(gasMap["EthAPICost"], "Revert") },
+			expectedErr: ErrMissingGasScheduleKey,
+		},
+		{
+			name:        "incomplete optional section",
+			change:      func(gasMap GasScheduleMap) { delete(gasMap["TransientStorageAPICost"], "TransientStorageLoad") },
+			expectedErr: ErrMissingGasScheduleKey,
+		},
+		{
+			name:        "unknown key",
+			change:      func(gasMap GasScheduleMap) { gasMap["WASMOpcodeCost"]["NotAnOpcode"] = 1 },
+			expectedErr: ErrUnknownGasScheduleKey,
+		},
+		{
+			name:        "zero cost",
+			change:      func(gasMap GasScheduleMap) { gasMap["BigIntAPICost"]["BigIntAdd"] = 0 },
+			expectedErr: ErrZeroGasCost,
+		},
+		{
+			name:        "missing dynamic storage load coefficient",
+			change:      func(gasMap GasScheduleMap) { delete(gasMap["DynamicStorageLoad"], "MinimumGasCost") },
+			expectedErr: ErrMissingGasScheduleKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gasMap := MakeGasMapForTests()
+			testCase.change(gasMap)
+
+			err := ValidateGasSchedule(gasMap, allFeatureFlags)
+			require.True(t, errors.Is(err, testCase.expectedErr), err)
+		})
+	}
+}
+
+func TestValidateGasSchedule_InvalidDynamicStorageLoad(t *testing.T) {
+	gasMap := MakeGasMapForTests()
+	gasMap["DynamicStorageLoad"]["QuadraticCoefficient"] = 0
+
+	err := ValidateGasSchedule(gasMap, allFeatureFlags)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "dynamic gas computation func incorrectly defined")
+}
+
+func TestDiffGasSchedules(t *testing.T) {
+	oldGasMap := MakeGasMapForTests()
+	newGasMap := CopyGasSchedule(oldGasMap)
+	require.Empty(t, DiffGasSchedules(oldGasMap, newGasMap))
+
+	newGasMap["WASMOpcodeCost"]["BrIf"] = 21
+	newGasMap["BaseOpsAPICost"]["Finish"] = 3
+	delete(newGasMap["BuiltInCost"], "ESDTBurn")
+	newGasMap["NewSection"] = map[string]uint64{"NewCost": 7}
+
+	diff := DiffGasSchedules(oldGasMap, newGasMap)
+	expectedDiff := GasScheduleDiff{
+		{Section: "BaseOpsAPICost", Key: "Finish", OldValue: 1, NewValue: 3},
+		{Section: "BuiltInCost", Key: "ESDTBurn", OldValue: 1, NewValue: 0},
+		{Section: "NewSection", Key: "NewCost", OldValue: 0, NewValue: 7},
+		{Section: "WASMOpcodeCost", Key: "BrIf", OldValue: 1, NewValue: 21},
+	}
+	require.Equal(t, expectedDiff, diff)
+	require.Equal(t, "WASMOpcodeCost.BrIf: 1 -> 21", diff[3].String())
+}
+
+func TestCopyGasSchedule(t *testing.T) {
+	gasMap := MakeGasMapForTests()
+	gasMapCopy := CopyGasSchedule(gasMap)
+	require.Equal(t, gasMap, gasMapCopy)
+
+	gasMapCopy["WASMOpcodeCost"]["BrIf"] = 100
+	require.Equal(t, uint64(1), gasMap["WASMOpcodeCost"]["BrIf"])
+	require.Nil(t, CopyGasSchedule(nil))
+}
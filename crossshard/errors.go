@@ -0,0 +1,27 @@
+package crossshard
+
+import "errors"
+
+// ErrNilShardCoordinator signals that a nil shard coordinator was provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilShard signals that a nil shard was provided
+var ErrNilShard = errors.New("nil shard")
+
+// ErrNilOverlay signals that a shard was provided without an overlay
+var ErrNilOverlay = errors.New("nil overlay")
+
+// ErrDuplicateShard signals that two shards were provided with the same ID
+var ErrDuplicateShard = errors.New("duplicate shard")
+
+// ErrShardNotFound signals that an address belongs to a shard the simulator does not run
+var ErrShardNotFound = errors.New("shard not found")
+
+// ErrInsufficientFunds signals that the sender of the initial call cannot pay its value
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrInvalidSelection signals that the selector returned an index outside the pending messages
+var ErrInvalidSelection = errors.New("invalid message selection")
+
+// ErrTooManySteps signals that the messages were not all delivered within the maximum number of steps
+var ErrTooManySteps = errors.New("too many steps")
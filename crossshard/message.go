@@ -0,0 +1,171 @@
+package crossshard
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/txDataBuilder"
+	"github.com/multiversx/mx-chain-vm-go/crypto"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// Message is a call or a transfer waiting to be delivered to the shard of its recipient.
+// Data and AsyncData are encoded the same way as in the OutputTransfers of a VMOutput.
+type Message struct {
+	SenderAddress    []byte
+	RecipientAddress []byte
+	Value            *big.Int
+	Data             []byte
+	CallType         vm.CallType
+	GasLimit         uint64
+	GasLocked        uint64
+	AsyncData        []byte
+
+	// TxHash identifies the message, PrevTxHash identifies the message that produced it.
+	TxHash     []byte
+	PrevTxHash []byte
+}
+
+// Selector chooses the next message to deliver, by returning its index among the pending ones.
+type Selector func(pending []*Message) int
+
+// FIFOSelector delivers the messages in the order they were produced.
+func FIFOSelector(_ []*Message) int {
+	return 0
+}
+
+// NewRandomSelector creates a Selector delivering a random pending message. The same seed gives the same order.
+func NewRandomSelector(seed int64) Selector {
+	random := rand.New(rand.NewSource(seed))
+	return func(pending []*Message) int {
+		return random.Intn(len(pending))
+	}
+}
+
+func messageFromOutputTransfer(recipient []byte, transfer *vmcommon.OutputTransfer) *Message {
+	value := big.NewInt(0)
+	if transfer.Value != nil {
+		value.Set(transfer.Value)
+	}
+
+	return &Message{
+		SenderAddress:    transfer.SenderAddress,
+		RecipientAddress: recipient,
+		Value:            value,
+		Data:             transfer.Data,
+		CallType:         transfer.CallType,
+		GasLimit:         transfer.GasLimit,
+		GasLocked:        transfer.GasLocked,
+		AsyncData:        transfer.AsyncData,
+	}
+}
+
+// callbackMessage builds the callback of a cross-shard async call, as the protocol does when the VM does not
+// return one: the return code and data as arguments, the remaining and the locked gas as gas limit, and the
+// value sent back to the caller, or the value of the call when it failed.
+func callbackMessage(
+	hasher crypto.Hasher,
+	call *Message,
+	asyncArguments *vmcommon.AsyncArguments,
+	vmOutput *vmcommon.VMOutput,
+	returnedValue *big.Int,
+) *Message {
+	callData := txDataBuilder.NewBuilder()
+	callData.Func(vmhost.CallbackFunctionName)
+
+	gasLimit := call.GasLocked
+	value := big.NewInt(0).Set(call.Value)
+	if vmOutput != nil && vmOutput.ReturnCode == vmcommon.Ok {
+		callData.Bytes(returnCodeToBytes(vmcommon.Ok))
+		for _, data := range vmOutput.ReturnData {
+			callData.Bytes(data)
+		}
+		gasLimit += vmOutput.GasRemaining
+		value.Set(returnedValue)
+	} else {
+		returnCode, returnMessage := vmcommon.ExecutionFailed, vmhost.ErrExecutionFailed.Error()
+		if vmOutput != nil {
+			returnCode, returnMessage = vmOutput.ReturnCode, vmOutput.ReturnMessage
+		}
+		callData.Bytes(returnCodeToBytes(returnCode))
+		callData.Str(returnMessage)
+	}
+
+	asyncData := txDataBuilder.NewBuilder()
+	asyncData.Bytes(generateNewCallID(hasher, asyncArguments.CallID, []byte{0}))
+	asyncData.Bytes(asyncArguments.CallID)
+	asyncData.Bytes(asyncArguments.CallerCallID)
+	asyncData.Bytes(big.NewInt(0).Bytes())
+
+	return &Message{
+		SenderAddress:    call.RecipientAddress,
+		RecipientAddress: call.SenderAddress,
+		Value:            value,
+		Data:             callData.ToBytes(),
+		CallType:         vm.AsynchronousCallBack,
+		GasLimit:         gasLimit,
+		AsyncData:        asyncData.ToBytes(),
+	}
+}
+
+// refundMessage sends back the value of a failed call.
+func refundMessage(call *Message) *Message {
+	return &Message{
+		SenderAddress:    call.RecipientAddress,
+		RecipientAddress: call.SenderAddress,
+		Value:            big.NewInt(0).Set(call.Value),
+		CallType:         vm.DirectCall,
+	}
+}
+
+// parseAsyncArguments decodes the AsyncData of a message, laid out as "@callID@callerCallID" for the async
+// calls, followed by "@callbackAsyncInitiatorCallID@gasAccumulated" for the callbacks.
+func parseAsyncArguments(message *Message, parseArguments func(data string) ([][]byte, error)) (*vmcommon.AsyncArguments, error) {
+	if message.CallType != vm.AsynchronousCall && message.CallType != vm.AsynchronousCallBack {
+		return nil, nil
+	}
+
+	// the AsyncData starts with "@", so the first argument is always empty
+	parsed, err := parseArguments(string(message.AsyncData))
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) < 3 {
+		return nil, vmcommon.ErrAsyncParams
+	}
+
+	asyncArguments := &vmcommon.AsyncArguments{
+		CallID:       parsed[1],
+		CallerCallID: parsed[2],
+	}
+	if message.CallType == vm.AsynchronousCallBack {
+		if len(parsed) < 5 {
+			return nil, vmcommon.ErrAsyncParams
+		}
+		asyncArguments.CallbackAsyncInitiatorCallID = parsed[3]
+		asyncArguments.GasAccumulated = big.NewInt(0).SetBytes(parsed[4]).Uint64()
+	}
+
+	return asyncArguments, nil
+}
+
+// generateNewCallID derives a call ID the same way the async context of the VM does.
+func generateNewCallID(hasher crypto.Hasher, parentCallID []byte, suffix []byte) []byte {
+	data := append(append(make([]byte, 0, len(parentCallID)+len(suffix)), parentCallID...), suffix...)
+	newCallID, err := hasher.Sha256(data)
+	if err != nil {
+		return []byte{}
+	}
+
+	return newCallID
+}
+
+func returnCodeToBytes(returnCode vmcommon.ReturnCode) []byte {
+	if returnCode == vmcommon.Ok {
+		return []byte{0}
+	}
+
+	return big.NewInt(int64(returnCode)).Bytes()
+}
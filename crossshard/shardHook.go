@@ -0,0 +1,37 @@
+package crossshard
+
+import (
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// ShardCoordinator computes the shard of an address
+type ShardCoordinator interface {
+	GetShardOfAddress(address []byte) uint32
+	IsInterfaceNil() bool
+}
+
+// shardHook answers GetShardOfAddress with the shard coordinator, so that every shard of the simulation
+// agrees on where an address lives. Everything else is delegated to the wrapped hook.
+type shardHook struct {
+	vmcommon.BlockchainHook
+	shardCoordinator ShardCoordinator
+}
+
+// NewShardHook wraps the hook of a shard, so that the shard of any address is computed by the given coordinator.
+// The VM host of the shard decides whether an async call is local or cross-shard by asking its hook.
+func NewShardHook(hook vmcommon.BlockchainHook, shardCoordinator ShardCoordinator) vmcommon.BlockchainHook {
+	return &shardHook{
+		BlockchainHook:   hook,
+		shardCoordinator: shardCoordinator,
+	}
+}
+
+// GetShardOfAddress returns the shard of the address, as computed by the shard coordinator
+func (hook *shardHook) GetShardOfAddress(address []byte) uint32 {
+	return hook.shardCoordinator.GetShardOfAddress(address)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hook *shardHook) IsInterfaceNil() bool {
+	return hook == nil
+}
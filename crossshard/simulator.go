@@ -0,0 +1,416 @@
+package crossshard
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-common-go/txDataBuilder"
+	"github.com/multiversx/mx-chain-vm-go/batch"
+	"github.com/multiversx/mx-chain-vm-go/crypto"
+	"github.com/multiversx/mx-chain-vm-go/crypto/hashing"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var log = logger.GetOrCreate("vm/crossshard")
+
+const defaultMaxSteps = 1000
+
+// Shard is a shard of the simulation. Its VM host must read the state through the overlay, and the overlay must
+// wrap a hook created with NewShardHook, so that the VM host sees the shards computed by the ShardCoordinator.
+type Shard struct {
+	ID      uint32
+	Host    vmhost.VMHost
+	Overlay *batch.Overlay
+}
+
+// ArgsNewSimulator holds the arguments needed to create a Simulator
+type ArgsNewSimulator struct {
+	Shards           []*Shard
+	ShardCoordinator ShardCoordinator
+
+	// Selector chooses the next message to deliver. The messages are delivered in the order they were
+	// produced when it is nil.
+	Selector Selector
+
+	// MaxSteps bounds the number of delivered messages of a run. A default is used when it is 0.
+	MaxSteps int
+}
+
+// Step is the delivery of a message to the shard of its recipient. Input is nil for the plain transfers.
+type Step struct {
+	ShardID  uint32
+	Message  *Message
+	Input    *vmcommon.ContractCallInput
+	VMOutput *vmcommon.VMOutput
+	Err      error
+}
+
+// Report holds the steps of a run, in the order they were executed, and the state changed in every shard.
+type Report struct {
+	Steps  []*Step
+	States map[uint32]*batch.StateDiff
+}
+
+// Simulator runs one VM host per shard and routes the calls between them, the way the protocol does with the
+// smart contract results: the OutputTransfers towards other shards become messages, which are delivered to
+// the shard of their recipient and executed there. A cross-shard async call is followed by its callback.
+//
+// Transfers within the executing shard are handled by the VM host itself. ESDT transfers between shards fail
+// at the destination, since the overlay cannot credit tokens sent from another shard. Fees are not charged.
+// The Simulator is not safe for concurrent use.
+type Simulator struct {
+	shards           map[uint32]*Shard
+	shardCoordinator ShardCoordinator
+	selector         Selector
+	maxSteps         int
+	hasher           crypto.Hasher
+	argsParser       vmcommon.CallArgsParser
+	txCounter        uint64
+}
+
+// NewSimulator creates a new Simulator
+func NewSimulator(args ArgsNewSimulator) (*Simulator, error) {
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	shards := make(map[uint32]*Shard, len(args.Shards))
+	for _, shard := range args.Shards {
+		if shard == nil {
+			return nil, ErrNilShard
+		}
+		if check.IfNil(shard.Host) {
+			return nil, vmhost.ErrNilVMHost
+		}
+		if shard.Overlay == nil {
+			return nil, ErrNilOverlay
+		}
+		if _, exists := shards[shard.ID]; exists {
+			return nil, ErrDuplicateShard
+		}
+		shards[shard.ID] = shard
+	}
+
+	selector := args.Selector
+	if selector == nil {
+		selector = FIFOSelector
+	}
+	maxSteps := args.MaxSteps
+	if maxSteps <= 0 {
+		maxSteps = defaultMaxSteps
+	}
+
+	return &Simulator{
+		shards:           shards,
+		shardCoordinator: args.ShardCoordinator,
+		selector:         selector,
+		maxSteps:         maxSteps,
+		hasher:           hashing.NewHasher(),
+		argsParser:       parsers.NewCallArgsParser(),
+	}, nil
+}
+
+// run holds the state of a single Run
+type run struct {
+	originalCaller []byte
+	originalTxHash []byte
+	pending        []*Message
+	report         *Report
+}
+
+// Run executes the call sent by a user, then delivers the messages it produced until none is left. The sender
+// pays the value and increments its nonce in its own shard, then the call is delivered to the shard of the
+// recipient. The returned Report is incomplete when the error is ErrTooManySteps or ErrInvalidSelection.
+func (simulator *Simulator) Run(input *vmcommon.ContractCallInput) (*Report, error) {
+	senderShard, ok := simulator.shardOf(input.CallerAddr)
+	if !ok {
+		return nil, ErrShardNotFound
+	}
+
+	sender, err := senderShard.Overlay.GetUserAccount(input.CallerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0)
+	if input.CallValue != nil {
+		value.Set(input.CallValue)
+	}
+	if value.Sign() < 0 || sender.GetBalance().Cmp(value) < 0 {
+		return nil, ErrInsufficientFunds
+	}
+
+	senderShard.Overlay.ApplyVMOutput(&vmcommon.VMOutput{
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(input.CallerAddr): {
+				Address:      input.CallerAddr,
+				Nonce:        sender.GetNonce() + 1,
+				BalanceDelta: big.NewInt(0).Neg(value),
+			},
+		},
+	})
+
+	callData := txDataBuilder.NewBuilder()
+	callData.Func(input.Function)
+	for _, argument := range input.Arguments {
+		callData.Bytes(argument)
+	}
+
+	initialMessage := &Message{
+		SenderAddress:    input.CallerAddr,
+		RecipientAddress: input.RecipientAddr,
+		Value:            value,
+		Data:             callData.ToBytes(),
+		CallType:         vm.DirectCall,
+		GasLimit:         input.GasProvided,
+		TxHash:           simulator.nextTxHash(),
+	}
+
+	currentRun := &run{
+		originalCaller: input.CallerAddr,
+		originalTxHash: initialMessage.TxHash,
+		pending:        []*Message{initialMessage},
+		report:         &Report{Steps: make([]*Step, 0)},
+	}
+	err = simulator.deliverAll(currentRun)
+	currentRun.report.States = simulator.states()
+
+	return currentRun.report, err
+}
+
+func (simulator *Simulator) deliverAll(currentRun *run) error {
+	for len(currentRun.pending) > 0 {
+		if len(currentRun.report.Steps) >= simulator.maxSteps {
+			return ErrTooManySteps
+		}
+
+		index := simulator.selector(currentRun.pending)
+		if index < 0 || index >= len(currentRun.pending) {
+			return ErrInvalidSelection
+		}
+
+		message := currentRun.pending[index]
+		currentRun.pending = append(currentRun.pending[:index], currentRun.pending[index+1:]...)
+
+		step, produced := simulator.deliver(currentRun, message)
+		currentRun.report.Steps = append(currentRun.report.Steps, step)
+		for _, producedMessage := range produced {
+			producedMessage.TxHash = simulator.nextTxHash()
+			producedMessage.PrevTxHash = message.TxHash
+		}
+		currentRun.pending = append(currentRun.pending, produced...)
+	}
+
+	return nil
+}
+
+// deliver executes the message in the shard of its recipient and returns the messages it produced.
+func (simulator *Simulator) deliver(currentRun *run, message *Message) (*Step, []*Message) {
+	step := &Step{Message: message}
+	shard, ok := simulator.shardOf(message.RecipientAddress)
+	if !ok {
+		step.Err = ErrShardNotFound
+		return step, nil
+	}
+	step.ShardID = shard.ID
+
+	snapshot := shard.Overlay.GetSnapshot()
+	step.Input, step.VMOutput, step.Err = simulator.execute(currentRun, shard, message)
+	failed := step.Err != nil || step.VMOutput == nil || step.VMOutput.ReturnCode != vmcommon.Ok
+	log.Trace("delivered message",
+		"shard", shard.ID,
+		"callType", message.CallType,
+		"sender", message.SenderAddress,
+		"recipient", message.RecipientAddress,
+		"failed", failed)
+
+	if failed {
+		_ = shard.Overlay.RevertToSnapshot(snapshot)
+		return step, simulator.messagesAfterFailure(shard, message, step)
+	}
+
+	shard.Overlay.ApplyVMOutput(simulator.selfShardOutput(shard, step.VMOutput))
+	return step, simulator.messagesAfterSuccess(shard, message, step)
+}
+
+func (simulator *Simulator) execute(currentRun *run, shard *Shard, message *Message) (*vmcommon.ContractCallInput, *vmcommon.VMOutput, error) {
+	if len(message.Data) == 0 {
+		return nil, plainTransferOutput(message.RecipientAddress, message.Value, message.GasLimit), nil
+	}
+
+	function, arguments, err := simulator.argsParser.ParseData(string(message.Data))
+	if err != nil {
+		return nil, nil, err
+	}
+	asyncArguments, err := parseAsyncArguments(message, simulator.argsParser.ParseArguments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:         message.SenderAddress,
+			OriginalCallerAddr: currentRun.originalCaller,
+			Arguments:          arguments,
+			CallValue:          big.NewInt(0).Set(message.Value),
+			CallType:           message.CallType,
+			GasProvided:        message.GasLimit,
+			GasLocked:          message.GasLocked,
+			CurrentTxHash:      message.TxHash,
+			PrevTxHash:         message.PrevTxHash,
+			OriginalTxHash:     currentRun.originalTxHash,
+			AsyncArguments:     asyncArguments,
+		},
+		RecipientAddr: message.RecipientAddress,
+		Function:      function,
+	}
+
+	vmOutput, err := shard.Host.RunSmartContractCall(input)
+	return input, vmOutput, err
+}
+
+// messagesAfterFailure returns the value of a failed call: a failed async call returns it through its callback,
+// a failed direct call through a refund. The value of a failed callback stays with its recipient.
+func (simulator *Simulator) messagesAfterFailure(shard *Shard, message *Message, step *Step) []*Message {
+	switch message.CallType {
+	case vm.AsynchronousCall:
+		if step.Input == nil {
+			return []*Message{refundMessage(message)}
+		}
+		return []*Message{callbackMessage(simulator.hasher, message, step.Input.AsyncArguments, step.VMOutput, nil)}
+	case vm.AsynchronousCallBack:
+		if message.Value.Sign() > 0 {
+			shard.Overlay.ApplyVMOutput(plainTransferOutput(message.RecipientAddress, message.Value, 0))
+		}
+		return nil
+	default:
+		if message.Value.Sign() > 0 {
+			return []*Message{refundMessage(message)}
+		}
+		return nil
+	}
+}
+
+// messagesAfterSuccess turns the OutputTransfers towards other shards into messages. After an async call, the
+// value sent back to the caller is carried by the callback, unless the VM host returned the callback itself.
+func (simulator *Simulator) messagesAfterSuccess(shard *Shard, message *Message, step *Step) []*Message {
+	isAsyncCall := message.CallType == vm.AsynchronousCall
+	returnedValue := big.NewInt(0)
+	hasCallback := false
+
+	produced := make([]*Message, 0)
+	for _, outputAccount := range sortedOutputAccounts(step.VMOutput) {
+		if simulator.shardCoordinator.GetShardOfAddress(outputAccount.Address) == shard.ID {
+			continue
+		}
+
+		isToCaller := bytes.Equal(outputAccount.Address, message.SenderAddress)
+		for i := range outputAccount.OutputTransfers {
+			transfer := &outputAccount.OutputTransfers[i]
+			if isAsyncCall && isToCaller && transfer.CallType == vm.AsynchronousCallBack {
+				hasCallback = true
+			}
+			isReturnedValue := isAsyncCall && isToCaller &&
+				transfer.CallType == vm.DirectCall &&
+				len(transfer.Data) == 0 &&
+				bytes.Equal(transfer.SenderAddress, message.RecipientAddress)
+			if isReturnedValue && transfer.Value != nil {
+				returnedValue.Add(returnedValue, transfer.Value)
+				continue
+			}
+
+			produced = append(produced, messageFromOutputTransfer(outputAccount.Address, transfer))
+		}
+	}
+
+	if isAsyncCall && !hasCallback {
+		produced = append(produced, callbackMessage(simulator.hasher, message, step.Input.AsyncArguments, step.VMOutput, returnedValue))
+	} else if returnedValue.Sign() > 0 {
+		produced = append(produced, &Message{
+			SenderAddress:    message.RecipientAddress,
+			RecipientAddress: message.SenderAddress,
+			Value:            returnedValue,
+			CallType:         vm.DirectCall,
+		})
+	}
+
+	return produced
+}
+
+// selfShardOutput keeps the accounts of the executing shard, the others receive their changes through messages.
+func (simulator *Simulator) selfShardOutput(shard *Shard, vmOutput *vmcommon.VMOutput) *vmcommon.VMOutput {
+	selfShardOutput := &vmcommon.VMOutput{
+		OutputAccounts:  make(map[string]*vmcommon.OutputAccount),
+		DeletedAccounts: make([][]byte, 0),
+	}
+	for key, outputAccount := range vmOutput.OutputAccounts {
+		if simulator.shardCoordinator.GetShardOfAddress(outputAccount.Address) == shard.ID {
+			selfShardOutput.OutputAccounts[key] = outputAccount
+		}
+	}
+	for _, address := range vmOutput.DeletedAccounts {
+		if simulator.shardCoordinator.GetShardOfAddress(address) == shard.ID {
+			selfShardOutput.DeletedAccounts = append(selfShardOutput.DeletedAccounts, address)
+		}
+	}
+
+	return selfShardOutput
+}
+
+func (simulator *Simulator) shardOf(address []byte) (*Shard, bool) {
+	shard, ok := simulator.shards[simulator.shardCoordinator.GetShardOfAddress(address)]
+	return shard, ok
+}
+
+func (simulator *Simulator) states() map[uint32]*batch.StateDiff {
+	states := make(map[uint32]*batch.StateDiff, len(simulator.shards))
+	for shardID, shard := range simulator.shards {
+		states[shardID] = shard.Overlay.StateDiff()
+	}
+
+	return states
+}
+
+func (simulator *Simulator) nextTxHash() []byte {
+	simulator.txCounter++
+	txHash, _ := simulator.hasher.Sha256(big.NewInt(0).SetUint64(simulator.txCounter).Bytes())
+	return txHash
+}
+
+func sortedOutputAccounts(vmOutput *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(vmOutput.OutputAccounts))
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		sortedAccount := *outputAccount
+		sortedAccount.OutputTransfers = append([]vmcommon.OutputTransfer(nil), outputAccount.OutputTransfers...)
+		sort.SliceStable(sortedAccount.OutputTransfers, func(i, j int) bool {
+			return sortedAccount.OutputTransfers[i].Index < sortedAccount.OutputTransfers[j].Index
+		})
+		outputAccounts = append(outputAccounts, &sortedAccount)
+	}
+	sort.Slice(outputAccounts, func(i, j int) bool {
+		return bytes.Compare(outputAccounts[i].Address, outputAccounts[j].Address) < 0
+	})
+
+	return outputAccounts
+}
+
+// plainTransferOutput is the output of a transfer without data, which only credits the recipient.
+func plainTransferOutput(recipient []byte, value *big.Int, gasLimit uint64) *vmcommon.VMOutput {
+	return &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: gasLimit,
+		GasRefund:    big.NewInt(0),
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(recipient): {
+				Address:      recipient,
+				BalanceDelta: big.NewInt(0).Set(value),
+			},
+		},
+	}
+}
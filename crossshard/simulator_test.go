@@ -0,0 +1,287 @@
+package crossshard
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	worldmock "github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-common-go/txDataBuilder"
+	"github.com/multiversx/mx-chain-vm-go/batch"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+// the last byte of an address is its shard
+var userAddress = append(bytes.Repeat([]byte{'u'}, 31), 0)
+var callerAddress = append(bytes.Repeat([]byte{'a'}, 31), 0)
+var calleeAddress = append(bytes.Repeat([]byte{'b'}, 31), 1)
+
+type lastByteShardCoordinator struct{}
+
+func (coordinator *lastByteShardCoordinator) GetShardOfAddress(address []byte) uint32 {
+	return uint32(address[len(address)-1])
+}
+
+func (coordinator *lastByteShardCoordinator) IsInterfaceNil() bool {
+	return coordinator == nil
+}
+
+// asyncHost mimics a contract calling "work" asynchronously on the callee, which fails when asked to
+type asyncHost struct {
+	vmhost.VMHost
+}
+
+func (host *asyncHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:     vmcommon.Ok,
+		GasRemaining:   200,
+		OutputAccounts: make(map[string]*vmcommon.OutputAccount),
+	}
+	recipient := &vmcommon.OutputAccount{
+		Address:        input.RecipientAddr,
+		BalanceDelta:   big.NewInt(0).Set(input.CallValue),
+		StorageUpdates: make(map[string]*vmcommon.StorageUpdate),
+	}
+	vmOutput.OutputAccounts[string(input.RecipientAddr)] = recipient
+
+	switch input.Function {
+	case "start":
+		valuePerCall := big.NewInt(0)
+		if len(input.Arguments) > 0 {
+			valuePerCall.Div(input.CallValue, big.NewInt(int64(len(input.Arguments))))
+		}
+		callee := &vmcommon.OutputAccount{Address: calleeAddress, BalanceDelta: big.NewInt(0)}
+		for i, argument := range input.Arguments {
+			asyncData := txDataBuilder.NewBuilder()
+			asyncData.Bytes([]byte{byte(i + 1)})
+			asyncData.Bytes(input.CurrentTxHash)
+			callData := txDataBuilder.NewBuilder()
+			callData.Func("work")
+			callData.Bytes(argument)
+
+			callee.BalanceDelta.Add(callee.BalanceDelta, valuePerCall)
+			recipient.BalanceDelta.Sub(recipient.BalanceDelta, valuePerCall)
+			callee.OutputTransfers = append(callee.OutputTransfers, vmcommon.OutputTransfer{
+				Index:         uint32(i),
+				Value:         valuePerCall,
+				GasLimit:      500,
+				GasLocked:     100,
+				AsyncData:     asyncData.ToBytes(),
+				Data:          callData.ToBytes(),
+				CallType:      vm.AsynchronousCall,
+				SenderAddress: input.RecipientAddr,
+			})
+		}
+		vmOutput.OutputAccounts[string(calleeAddress)] = callee
+	case "work":
+		if input.AsyncArguments == nil {
+			return nil, vmcommon.ErrAsyncParams
+		}
+		if string(input.Arguments[0]) == "fail" {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "work failed"}, nil
+		}
+		recipient.StorageUpdates["work"+string(input.Arguments[0])] = &vmcommon.StorageUpdate{
+			Offset:  []byte("work" + string(input.Arguments[0])),
+			Data:    input.Arguments[0],
+			Written: true,
+		}
+		vmOutput.ReturnData = [][]byte{append(input.Arguments[0], '!')}
+	case vmhost.CallbackFunctionName:
+		key := []byte("callback" + string(input.AsyncArguments.CallerCallID))
+		recipient.StorageUpdates[string(key)] = &vmcommon.StorageUpdate{
+			Offset:  key,
+			Data:    bytes.Join(input.Arguments, []byte{'|'}),
+			Written: true,
+		}
+	}
+
+	return vmOutput, nil
+}
+
+func (host *asyncHost) IsInterfaceNil() bool {
+	return host == nil
+}
+
+func newTestSimulator(t *testing.T, selector Selector) *Simulator {
+	coordinator := &lastByteShardCoordinator{}
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
+
+	newShard := func(id uint32, accounts ...*worldmock.Account) *Shard {
+		world := worldmock.NewMockWorld()
+		for _, account := range accounts {
+			world.AcctMap.PutAccount(account)
+		}
+		overlay, err := batch.NewOverlay(batch.ArgsNewOverlay{
+			BlockchainHook:     NewShardHook(world, coordinator),
+			ESDTTransferParser: esdtTransferParser,
+			Hasher:             blake2b.NewBlake2b(),
+		})
+		require.Nil(t, err)
+
+		return &Shard{ID: id, Host: &asyncHost{}, Overlay: overlay}
+	}
+
+	simulator, err := NewSimulator(ArgsNewSimulator{
+		Shards: []*Shard{
+			newShard(0,
+				&worldmock.Account{Address: userAddress, Balance: big.NewInt(1000)},
+				&worldmock.Account{Address: callerAddress, Balance: big.NewInt(0), Code: []byte("caller"), IsSmartContract: true},
+			),
+			newShard(1,
+				&worldmock.Account{Address: calleeAddress, Balance: big.NewInt(0), Code: []byte("callee"), IsSmartContract: true},
+			),
+		},
+		ShardCoordinator: coordinator,
+		Selector:         selector,
+	})
+	require.Nil(t, err)
+
+	return simulator
+}
+
+func startInput(value int64, arguments ...[]byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  userAddress,
+			CallValue:   big.NewInt(value),
+			GasProvided: 1000,
+			Arguments:   arguments,
+		},
+		RecipientAddr: callerAddress,
+		Function:      "start",
+	}
+}
+
+func findAccount(stateDiff *batch.StateDiff, address []byte) *batch.AccountDiff {
+	for _, account := range stateDiff.Accounts {
+		if bytes.Equal(account.Address, address) {
+			return account
+		}
+	}
+	return nil
+}
+
+func TestNewSimulator(t *testing.T) {
+	t.Parallel()
+
+	simulator, err := NewSimulator(ArgsNewSimulator{})
+	require.Nil(t, simulator)
+	require.Equal(t, ErrNilShardCoordinator, err)
+
+	args := ArgsNewSimulator{ShardCoordinator: &lastByteShardCoordinator{}}
+	args.Shards = []*Shard{nil}
+	_, err = NewSimulator(args)
+	require.Equal(t, ErrNilShard, err)
+
+	args.Shards = []*Shard{{ID: 0}}
+	_, err = NewSimulator(args)
+	require.Equal(t, vmhost.ErrNilVMHost, err)
+
+	args.Shards = []*Shard{{ID: 0, Host: &asyncHost{}}}
+	_, err = NewSimulator(args)
+	require.Equal(t, ErrNilOverlay, err)
+
+	args.Shards = []*Shard{{ID: 0, Host: &asyncHost{}, Overlay: &batch.Overlay{}}, {ID: 0, Host: &asyncHost{}, Overlay: &batch.Overlay{}}}
+	_, err = NewSimulator(args)
+	require.Equal(t, ErrDuplicateShard, err)
+}
+
+func TestSimulator_AsyncCallAndCallback(t *testing.T) {
+	t.Parallel()
+
+	simulator := newTestSimulator(t, nil)
+	report, err := simulator.Run(startInput(10, []byte("x")))
+	require.Nil(t, err)
+	require.Len(t, report.Steps, 3)
+
+	start, work, callback := report.Steps[0], report.Steps[1], report.Steps[2]
+	require.Equal(t, uint32(0), start.ShardID)
+	require.Equal(t, uint32(1), work.ShardID)
+	require.Equal(t, vm.AsynchronousCall, work.Input.CallType)
+	require.Equal(t, []byte{1}, work.Input.AsyncArguments.CallID)
+	require.Equal(t, start.Message.TxHash, work.Input.AsyncArguments.CallerCallID)
+	require.Equal(t, start.Message.TxHash, work.Input.PrevTxHash)
+
+	require.Equal(t, uint32(0), callback.ShardID)
+	require.Equal(t, vm.AsynchronousCallBack, callback.Input.CallType)
+	require.Equal(t, calleeAddress, callback.Input.CallerAddr)
+	require.Equal(t, [][]byte{{0}, []byte("x!")}, callback.Input.Arguments)
+	require.Equal(t, uint64(300), callback.Input.GasProvided)
+	require.Equal(t, []byte{1}, callback.Input.AsyncArguments.CallerCallID)
+	require.Equal(t, start.Message.TxHash, callback.Input.AsyncArguments.CallbackAsyncInitiatorCallID)
+	require.Equal(t, big.NewInt(0), callback.Input.CallValue)
+
+	user := findAccount(report.States[0], userAddress)
+	require.Equal(t, uint64(1), user.Nonce)
+	require.Equal(t, big.NewInt(990), user.Balance)
+	require.Equal(t, big.NewInt(0), findAccount(report.States[0], callerAddress).Balance)
+	require.Equal(t, []byte{0, '|', 'x', '!'}, findAccount(report.States[0], callerAddress).Storage["callback\x01"])
+
+	callee := findAccount(report.States[1], calleeAddress)
+	require.Equal(t, big.NewInt(10), callee.Balance)
+	require.Equal(t, []byte("x"), callee.Storage["workx"])
+	require.Len(t, report.States[1].Accounts, 1)
+}
+
+func TestSimulator_FailedAsyncCallReturnsTheValue(t *testing.T) {
+	t.Parallel()
+
+	simulator := newTestSimulator(t, nil)
+	report, err := simulator.Run(startInput(10, []byte("fail")))
+	require.Nil(t, err)
+	require.Len(t, report.Steps, 3)
+
+	callback := report.Steps[2]
+	require.Equal(t, [][]byte{{byte(vmcommon.UserError)}, []byte("work failed")}, callback.Input.Arguments)
+	require.Equal(t, uint64(100), callback.Input.GasProvided)
+	require.Equal(t, big.NewInt(10), callback.Input.CallValue)
+
+	require.Equal(t, big.NewInt(10), findAccount(report.States[0], callerAddress).Balance)
+	require.Empty(t, report.States[1].Accounts)
+}
+
+func TestSimulator_SelectorChoosesTheOrder(t *testing.T) {
+	t.Parallel()
+
+	lastSelector := func(pending []*Message) int {
+		return len(pending) - 1
+	}
+
+	for _, selector := range []Selector{nil, lastSelector, NewRandomSelector(7)} {
+		simulator := newTestSimulator(t, selector)
+		report, err := simulator.Run(startInput(2, []byte("x"), []byte("y")))
+		require.Nil(t, err)
+		require.Len(t, report.Steps, 5)
+
+		require.Equal(t, big.NewInt(2), findAccount(report.States[1], calleeAddress).Balance)
+		caller := findAccount(report.States[0], callerAddress)
+		require.Equal(t, []byte{0, '|', 'x', '!'}, caller.Storage["callback\x01"])
+		require.Equal(t, []byte{0, '|', 'y', '!'}, caller.Storage["callback\x02"])
+	}
+
+	lastFirst := newTestSimulator(t, lastSelector)
+	report, _ := lastFirst.Run(startInput(2, []byte("x"), []byte("y")))
+	require.Equal(t, []byte{2}, report.Steps[1].Input.AsyncArguments.CallID)
+
+	_, err := newTestSimulator(t, func(_ []*Message) int { return 5 }).Run(startInput(1))
+	require.Equal(t, ErrInvalidSelection, err)
+}
+
+func TestSimulator_RunErrors(t *testing.T) {
+	t.Parallel()
+
+	simulator := newTestSimulator(t, nil)
+
+	input := startInput(1)
+	input.CallerAddr = append(bytes.Repeat([]byte{'u'}, 31), 2)
+	_, err := simulator.Run(input)
+	require.Equal(t, ErrShardNotFound, err)
+
+	_, err = simulator.Run(startInput(5000))
+	require.Equal(t, ErrInsufficientFunds, err)
+}
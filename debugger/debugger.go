@@ -0,0 +1,332 @@
+package debugger
+
+import (
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var log = logger.GetOrCreate("vm/debugger")
+
+var _ executorwrapper.ExecutorLogger = (*Debugger)(nil)
+
+// Moment tells whether the execution is paused before or after a VM hook.
+type Moment string
+
+const (
+	// BeforeVMHook designates the moment just before a VM hook is processed.
+	BeforeVMHook Moment = "before"
+
+	// AfterVMHook designates the moment just after a VM hook was processed.
+	AfterVMHook Moment = "after"
+)
+
+// Breakpoint describes when the execution must pause. All the fields that are set must match.
+type Breakpoint struct {
+	ID int `json:"id"`
+
+	// VMHook is the name of the VM hook, as in the executor.VMHooks interface (e.g. "MBufferStorageStore").
+	VMHook string `json:"vmHook,omitempty"`
+
+	// Moment restricts the breakpoint to before or after the VM hook. Empty matches both.
+	Moment Moment `json:"moment,omitempty"`
+
+	// Contract is the hex-encoded address of the contract executing the VM hook.
+	Contract string `json:"contract,omitempty"`
+
+	// Function is the name of the contract function executing the VM hook.
+	Function string `json:"function,omitempty"`
+
+	// GasLeftBelow makes the breakpoint fire once the gas left drops under the given threshold.
+	GasLeftBelow uint64 `json:"gasLeftBelow,omitempty"`
+}
+
+// PauseInfo describes the point where the execution is paused.
+type PauseInfo struct {
+	BreakpointID int    `json:"breakpointId,omitempty"`
+	Moment       Moment `json:"moment"`
+	VMHook       string `json:"vmHook"`
+	CallInfo     string `json:"callInfo"`
+	Contract     string `json:"contract"`
+	Function     string `json:"function"`
+	GasLeft      uint64 `json:"gasLeft"`
+}
+
+type debuggerCommand struct {
+	run    func()
+	resume bool
+	step   bool
+	done   chan struct{}
+}
+
+// Debugger pauses the contract execution at the configured breakpoints and lets clients inspect the VM state while paused.
+// It receives the VM hook calls as the logger of an executorwrapper.WrapperExecutorFactory.
+// All inspections run on the execution goroutine, which is blocked waiting for commands while paused.
+// Commands from concurrent clients are handled one at a time, a command sent after a resume finding the execution running.
+type Debugger struct {
+	mutex        sync.Mutex
+	commandMutex sync.Mutex
+	host         vmhost.VMHost
+	breakpoints  []*Breakpoint
+	nextID       int
+	stepping     bool
+	lastGasLeft  uint64
+	paused       *PauseInfo
+	pauseWaiters chan struct{}
+	commands     chan *debuggerCommand
+}
+
+// NewDebugger creates a new Debugger. If pauseOnStart is set, the execution pauses at the first VM hook.
+func NewDebugger(pauseOnStart bool) *Debugger {
+	return &Debugger{
+		nextID:       1,
+		stepping:     pauseOnStart,
+		pauseWaiters: make(chan struct{}),
+		commands:     make(chan *debuggerCommand),
+	}
+}
+
+// SetHost sets the VM host whose state is inspected while paused.
+func (d *Debugger) SetHost(host vmhost.VMHost) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.host = host
+}
+
+// AddBreakpoint registers a new breakpoint and returns its id.
+func (d *Debugger) AddBreakpoint(breakpoint Breakpoint) int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	breakpoint.ID = d.nextID
+	breakpoint.Contract = strings.ToLower(strings.TrimPrefix(breakpoint.Contract, "0x"))
+	d.nextID++
+	d.breakpoints = append(d.breakpoints, &breakpoint)
+
+	return breakpoint.ID
+}
+
+// RemoveBreakpoint removes the breakpoint with the given id. It returns false if there is no such breakpoint.
+func (d *Debugger) RemoveBreakpoint(id int) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for i, breakpoint := range d.breakpoints {
+		if breakpoint.ID == id {
+			d.breakpoints = append(d.breakpoints[:i], d.breakpoints[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Breakpoints returns a copy of the registered breakpoints.
+func (d *Debugger) Breakpoints() []Breakpoint {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	result := make([]Breakpoint, 0, len(d.breakpoints))
+	for _, breakpoint := range d.breakpoints {
+		result = append(result, *breakpoint)
+	}
+
+	return result
+}
+
+// Paused returns the current pause point, or nil if the execution is running.
+func (d *Debugger) Paused() *PauseInfo {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.paused == nil {
+		return nil
+	}
+	pauseInfo := *d.paused
+	return &pauseInfo
+}
+
+// WaitPause returns a channel that is closed the next time the execution pauses,
+// or an already closed channel if the execution is paused.
+func (d *Debugger) WaitPause() <-chan struct{} {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.paused != nil {
+		closed := make(chan struct{})
+		close(closed)
+		return closed
+	}
+	return d.pauseWaiters
+}
+
+// Continue resumes the paused execution, until the next breakpoint.
+func (d *Debugger) Continue() error {
+	return d.sendCommand(&debuggerCommand{resume: true})
+}
+
+// Step resumes the paused execution, pausing again at the next VM hook event.
+func (d *Debugger) Step() error {
+	return d.sendCommand(&debuggerCommand{resume: true, step: true})
+}
+
+// Inspect runs the given function on the execution goroutine, while paused.
+func (d *Debugger) Inspect(inspection func(host vmhost.VMHost)) error {
+	d.mutex.Lock()
+	host := d.host
+	d.mutex.Unlock()
+
+	return d.sendCommand(&debuggerCommand{
+		run: func() {
+			inspection(host)
+		},
+	})
+}
+
+func (d *Debugger) sendCommand(command *debuggerCommand) error {
+	d.commandMutex.Lock()
+	defer d.commandMutex.Unlock()
+
+	if d.Paused() == nil {
+		return ErrNotPaused
+	}
+
+	command.done = make(chan struct{})
+	d.commands <- command
+	<-command.done
+
+	return nil
+}
+
+// LogExecutorEvent ignores the executor events, the debugger only pauses at VM hooks.
+func (d *Debugger) LogExecutorEvent(_ string) {
+}
+
+// LogVMHookCallBefore is called before processing a wrapped VM hook.
+func (d *Debugger) LogVMHookCallBefore(callInfo string) {
+	d.checkpoint(BeforeVMHook, callInfo)
+}
+
+// LogVMHookCallAfter is called after processing a wrapped VM hook.
+func (d *Debugger) LogVMHookCallAfter(callInfo string) {
+	d.checkpoint(AfterVMHook, callInfo)
+}
+
+func (d *Debugger) checkpoint(moment Moment, callInfo string) {
+	pauseInfo, shouldPause := d.matchCheckpoint(moment, callInfo)
+	if !shouldPause {
+		return
+	}
+
+	log.Debug("execution paused",
+		"breakpoint", pauseInfo.BreakpointID,
+		"moment", pauseInfo.Moment,
+		"hook", pauseInfo.VMHook,
+		"contract", pauseInfo.Contract,
+		"function", pauseInfo.Function)
+
+	d.mutex.Lock()
+	d.paused = pauseInfo
+	close(d.pauseWaiters)
+	d.pauseWaiters = make(chan struct{})
+	d.mutex.Unlock()
+
+	d.serveCommandsWhilePaused()
+}
+
+func (d *Debugger) serveCommandsWhilePaused() {
+	for command := range d.commands {
+		if command.run != nil {
+			command.run()
+		}
+
+		if command.resume {
+			d.mutex.Lock()
+			d.paused = nil
+			d.stepping = command.step
+			d.mutex.Unlock()
+
+			close(command.done)
+			return
+		}
+
+		close(command.done)
+	}
+}
+
+func (d *Debugger) matchCheckpoint(moment Moment, callInfo string) (*PauseInfo, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if check.IfNil(d.host) {
+		return nil, false
+	}
+	if !d.stepping && len(d.breakpoints) == 0 {
+		return nil, false
+	}
+
+	runtime := d.host.Runtime()
+	gasLeft := d.host.Metering().GasLeft()
+	lastGasLeft := d.lastGasLeft
+	d.lastGasLeft = gasLeft
+
+	pauseInfo := &PauseInfo{
+		Moment:   moment,
+		VMHook:   vmHookName(callInfo),
+		CallInfo: callInfo,
+		Contract: hex.EncodeToString(runtime.GetContextAddress()),
+		Function: runtime.FunctionName(),
+		GasLeft:  gasLeft,
+	}
+
+	if d.stepping {
+		return pauseInfo, true
+	}
+
+	for _, breakpoint := range d.breakpoints {
+		if breakpoint.matches(pauseInfo, lastGasLeft) {
+			pauseInfo.BreakpointID = breakpoint.ID
+			return pauseInfo, true
+		}
+	}
+
+	return nil, false
+}
+
+func (breakpoint *Breakpoint) matches(pauseInfo *PauseInfo, lastGasLeft uint64) bool {
+	if len(breakpoint.VMHook) > 0 && breakpoint.VMHook != pauseInfo.VMHook {
+		return false
+	}
+	if len(breakpoint.Moment) > 0 && breakpoint.Moment != pauseInfo.Moment {
+		return false
+	}
+	if len(breakpoint.Contract) > 0 && breakpoint.Contract != pauseInfo.Contract {
+		return false
+	}
+	if len(breakpoint.Function) > 0 && breakpoint.Function != pauseInfo.Function {
+		return false
+	}
+	if breakpoint.GasLeftBelow > 0 {
+		crossedThreshold := pauseInfo.GasLeft < breakpoint.GasLeftBelow && lastGasLeft >= breakpoint.GasLeftBelow
+		if !crossedThreshold {
+			return false
+		}
+	}
+
+	return true
+}
+
+// vmHookName extracts the name of the VM hook from the call info produced by the WrapperVMHooks, e.g. "GetGasLeft()".
+func vmHookName(callInfo string) string {
+	index := strings.IndexByte(callInfo, '(')
+	if index < 0 {
+		return callInfo
+	}
+	return callInfo[:index]
+}
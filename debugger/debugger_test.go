@@ -0,0 +1,303 @@
+package debugger
+
+import (
+	"encoding/hex"
+	"math/big"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"testing"
+	"time"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	vmMath "github.com/multiversx/mx-chain-vm-go/math"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRuntime struct {
+	vmhost.RuntimeContext
+	address  []byte
+	function string
+}
+
+func (runtime *fakeRuntime) GetContextAddress() []byte {
+	return runtime.address
+}
+
+func (runtime *fakeRuntime) FunctionName() string {
+	return runtime.function
+}
+
+func (runtime *fakeRuntime) GetCallStack() []vmhost.CallFrame {
+	return []vmhost.CallFrame{{ContractAddress: runtime.address, CodeAddress: runtime.address, Function: runtime.function}}
+}
+
+type fakeMetering struct {
+	vmhost.MeteringContext
+	gasLeft uint64
+}
+
+func (metering *fakeMetering) GasLeft() uint64 {
+	return metering.gasLeft
+}
+
+type fakeManagedTypes struct {
+	vmhost.ManagedTypesContext
+}
+
+func (managedTypes *fakeManagedTypes) GetBigInt(handle int32) (*big.Int, error) {
+	if handle != 7 {
+		return nil, vmhost.ErrNoBigIntUnderThisHandle
+	}
+	return big.NewInt(1234), nil
+}
+
+type fakeOutput struct {
+	vmhost.OutputContext
+}
+
+func (output *fakeOutput) ReturnData() [][]byte {
+	return [][]byte{{0x01, 0x02}}
+}
+
+func (output *fakeOutput) GetOutputAccounts() map[string]*vmcommon.OutputAccount {
+	return map[string]*vmcommon.OutputAccount{
+		"contract": {
+			Address: []byte("contract"),
+			StorageUpdates: map[string]*vmcommon.StorageUpdate{
+				"key": {Offset: []byte("key"), Data: []byte("value"), Written: true},
+			},
+		},
+	}
+}
+
+type fakeHost struct {
+	vmhost.VMHost
+	runtime      *fakeRuntime
+	metering     *fakeMetering
+	managedTypes *fakeManagedTypes
+	output       *fakeOutput
+}
+
+func newFakeHost() *fakeHost {
+	return &fakeHost{
+		runtime:      &fakeRuntime{address: []byte("contract"), function: "doSomething"},
+		metering:     &fakeMetering{gasLeft: 1000},
+		managedTypes: &fakeManagedTypes{},
+		output:       &fakeOutput{},
+	}
+}
+
+func (host *fakeHost) Runtime() vmhost.RuntimeContext {
+	return host.runtime
+}
+
+func (host *fakeHost) Metering() vmhost.MeteringContext {
+	return host.metering
+}
+
+func (host *fakeHost) ManagedTypes() vmhost.ManagedTypesContext {
+	return host.managedTypes
+}
+
+func (host *fakeHost) Output() vmhost.OutputContext {
+	return host.output
+}
+
+func (host *fakeHost) IsInterfaceNil() bool {
+	return host == nil
+}
+
+func newTestClient(t *testing.T, debugger *Debugger) *rpc.Client {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName(RPCServiceName, NewRPCService(debugger)))
+
+	serverConn, clientConn := net.Pipe()
+	go server.ServeCodec(jsonrpc.NewServerCodec(serverConn))
+
+	client := jsonrpc.NewClient(clientConn)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client
+}
+
+// runHooks simulates an execution calling the given VM hooks, in the background
+func runHooks(debugger *Debugger, callInfos ...string) chan struct{} {
+	finished := make(chan struct{})
+	go func() {
+		for _, callInfo := range callInfos {
+			debugger.LogVMHookCallBefore(callInfo)
+			debugger.LogVMHookCallAfter(callInfo)
+		}
+		close(finished)
+	}()
+	return finished
+}
+
+func requireFinished(t *testing.T, finished chan struct{}) {
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "execution did not finish")
+	}
+}
+
+func TestDebugger_NoHostOrBreakpointsDoesNotPause(t *testing.T) {
+	t.Parallel()
+
+	debugger := NewDebugger(true)
+	requireFinished(t, runHooks(debugger, "GetGasLeft()"))
+
+	debugger = NewDebugger(false)
+	debugger.SetHost(newFakeHost())
+	requireFinished(t, runHooks(debugger, "GetGasLeft()"))
+}
+
+func TestDebugger_BreakpointOnVMHookAndInspect(t *testing.T) {
+	t.Parallel()
+
+	debugger := NewDebugger(false)
+	debugger.SetHost(newFakeHost())
+	client := newTestClient(t, debugger)
+
+	var id int
+	err := client.Call("Debugger.AddBreakpoint", Breakpoint{
+		VMHook:   "BigIntAdd",
+		Moment:   AfterVMHook,
+		Contract: "0x" + hex.EncodeToString([]byte("contract")),
+		Function: "doSomething",
+	}, &id)
+	require.Nil(t, err)
+	require.Equal(t, 1, id)
+
+	err = client.Call("Debugger.Continue", Empty{}, &Empty{})
+	require.Equal(t, ErrNotPaused.Error(), err.Error())
+
+	finished := runHooks(debugger, "GetGasLeft()", "BigIntAdd(7, 7, 7)", "GetGasLeft()")
+
+	var pauseInfo PauseInfo
+	require.Nil(t, client.Call("Debugger.Wait", WaitArgs{TimeoutMs: 5000}, &pauseInfo))
+	require.Equal(t, PauseInfo{
+		BreakpointID: 1,
+		Moment:       AfterVMHook,
+		VMHook:       "BigIntAdd",
+		CallInfo:     "BigIntAdd(7, 7, 7)",
+		Contract:     hex.EncodeToString([]byte("contract")),
+		Function:     "doSomething",
+		GasLeft:      1000,
+	}, pauseInfo)
+
+	var value ManagedValue
+	require.Nil(t, client.Call("Debugger.ManagedValue", InspectManagedValueArgs{Kind: ManagedBigInt, Handle: 7}, &value))
+	require.Equal(t, "1234", value.Value)
+	err = client.Call("Debugger.ManagedValue", InspectManagedValueArgs{Kind: ManagedBigInt, Handle: 8}, &value)
+	require.Equal(t, vmhost.ErrNoBigIntUnderThisHandle.Error(), err.Error())
+
+	var returnData []string
+	require.Nil(t, client.Call("Debugger.ReturnData", Empty{}, &returnData))
+	require.Equal(t, []string{"0102"}, returnData)
+
+	var storageUpdates []StorageUpdate
+	require.Nil(t, client.Call("Debugger.StorageUpdates", Empty{}, &storageUpdates))
+	require.Equal(t, []StorageUpdate{{
+		Contract: hex.EncodeToString([]byte("contract")),
+		Key:      hex.EncodeToString([]byte("key")),
+		Value:    hex.EncodeToString([]byte("value")),
+		Written:  true,
+	}}, storageUpdates)
+
+	var callStack []StackFrame
+	require.Nil(t, client.Call("Debugger.CallStack", Empty{}, &callStack))
+	require.Len(t, callStack, 1)
+	require.Equal(t, "doSomething", callStack[0].Function)
+
+	require.Nil(t, client.Call("Debugger.Continue", Empty{}, &Empty{}))
+	requireFinished(t, finished)
+}
+
+func TestDebugger_StepAndGasThreshold(t *testing.T) {
+	t.Parallel()
+
+	host := newFakeHost()
+	debugger := NewDebugger(true)
+	debugger.SetHost(host)
+	debugger.AddBreakpoint(Breakpoint{GasLeftBelow: 500})
+
+	finished := make(chan struct{})
+	go func() {
+		debugger.LogVMHookCallBefore("GetGasLeft()")
+		debugger.LogVMHookCallAfter("GetGasLeft()")
+		host.metering.gasLeft = 400
+		debugger.LogVMHookCallBefore("BigIntAdd(1, 2, 3)")
+		debugger.LogVMHookCallBefore("BigIntAdd(1, 2, 3)")
+		close(finished)
+	}()
+
+	<-debugger.WaitPause()
+	pauseInfo := debugger.Paused()
+	require.Equal(t, BeforeVMHook, pauseInfo.Moment)
+	require.Equal(t, "GetGasLeft", pauseInfo.VMHook)
+	require.Equal(t, 0, pauseInfo.BreakpointID)
+
+	require.Nil(t, debugger.Step())
+	<-debugger.WaitPause()
+	pauseInfo = debugger.Paused()
+	require.Equal(t, AfterVMHook, pauseInfo.Moment)
+
+	require.Nil(t, debugger.Continue())
+	<-debugger.WaitPause()
+	pauseInfo = debugger.Paused()
+	require.Equal(t, 1, pauseInfo.BreakpointID)
+	require.Equal(t, uint64(400), pauseInfo.GasLeft)
+
+	// the threshold was already crossed, so the next hook does not pause
+	require.Nil(t, debugger.Continue())
+	requireFinished(t, finished)
+
+	require.True(t, debugger.RemoveBreakpoint(1))
+	require.False(t, debugger.RemoveBreakpoint(1))
+	require.Empty(t, debugger.Breakpoints())
+}
+
+func TestDebugger_ConcurrentCommands(t *testing.T) {
+	host := newFakeHost()
+	debugger := NewDebugger(true)
+	debugger.SetHost(host)
+
+	finished := make(chan struct{})
+	go func() {
+		debugger.LogVMHookCallBefore("GetGasLeft()")
+		close(finished)
+	}()
+	<-debugger.WaitPause()
+
+	numClients := 10
+	results := make(chan error, numClients)
+	for i := 0; i < numClients; i++ {
+		go func() {
+			results <- debugger.Continue()
+		}()
+	}
+
+	// only one client resumes the execution, the others find it running instead of waiting for the next pause
+	numResumed := 0
+	for i := 0; i < numClients; i++ {
+		err := <-results
+		if err == nil {
+			numResumed++
+			continue
+		}
+		require.Equal(t, ErrNotPaused, err)
+	}
+	require.Equal(t, 1, numResumed)
+	requireFinished(t, finished)
+}
+
+func TestFormatDecimal(t *testing.T) {
+	require.Equal(t, "1.50", formatDecimal(&vmMath.Decimal{Mantissa: big.NewInt(150), Scale: 2}))
+	require.Equal(t, "-0.005", formatDecimal(&vmMath.Decimal{Mantissa: big.NewInt(-5), Scale: 3}))
+	require.Equal(t, "0.0", formatDecimal(&vmMath.Decimal{Mantissa: big.NewInt(0), Scale: 1}))
+	require.Equal(t, "42", formatDecimal(&vmMath.Decimal{Mantissa: big.NewInt(42), Scale: 0}))
+}
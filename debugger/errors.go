@@ -0,0 +1,15 @@
+package debugger
+
+import "errors"
+
+// ErrNotPaused signals that the requested operation is only possible while the execution is paused
+var ErrNotPaused = errors.New("execution is not paused")
+
+// ErrUnknownManagedTypeKind signals that the requested kind of managed type cannot be inspected
+var ErrUnknownManagedTypeKind = errors.New("unknown managed type kind")
+
+// ErrUnknownBreakpoint signals that there is no breakpoint with the given id
+var ErrUnknownBreakpoint = errors.New("unknown breakpoint")
+
+// ErrWaitTimeout signals that the execution did not pause within the given time
+var ErrWaitTimeout = errors.New("timeout while waiting for the execution to pause")
@@ -0,0 +1,163 @@
+package debugger
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strings"
+
+	vmMath "github.com/multiversx/mx-chain-vm-go/math"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// ManagedTypeKind selects the kind of managed type to inspect.
+type ManagedTypeKind string
+
+const (
+	// ManagedBuffer designates a managed buffer handle.
+	ManagedBuffer ManagedTypeKind = "buffer"
+
+	// ManagedBigInt designates a big integer handle.
+	ManagedBigInt ManagedTypeKind = "bigInt"
+
+	// ManagedBigFloat designates a big float handle.
+	ManagedBigFloat ManagedTypeKind = "bigFloat"
+
+	// ManagedDecimal designates a managed decimal handle.
+	ManagedDecimal ManagedTypeKind = "decimal"
+)
+
+// ManagedValue is the printable value behind a managed type handle.
+type ManagedValue struct {
+	Kind   ManagedTypeKind `json:"kind"`
+	Handle int32           `json:"handle"`
+	Value  string          `json:"value"`
+}
+
+// StorageUpdate is a pending storage update, with hex-encoded key and value.
+type StorageUpdate struct {
+	Contract string `json:"contract"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Written  bool   `json:"written"`
+}
+
+// StackFrame is a printable vmhost.CallFrame.
+type StackFrame struct {
+	Contract    string `json:"contract"`
+	Code        string `json:"code"`
+	Function    string `json:"function"`
+	CallType    string `json:"callType"`
+	GasProvided uint64 `json:"gasProvided"`
+	ReadOnly    bool   `json:"readOnly"`
+}
+
+// inspectManagedValue reads the value behind a handle. Managed buffers are hex-encoded, numbers are in base 10.
+func inspectManagedValue(host vmhost.VMHost, kind ManagedTypeKind, handle int32) (*ManagedValue, error) {
+	managedTypes := host.ManagedTypes()
+	result := &ManagedValue{
+		Kind:   kind,
+		Handle: handle,
+	}
+
+	switch kind {
+	case ManagedBuffer:
+		value, err := managedTypes.GetBytes(handle)
+		if err != nil {
+			return nil, err
+		}
+		result.Value = hex.EncodeToString(value)
+	case ManagedBigInt:
+		value, err := managedTypes.GetBigInt(handle)
+		if err != nil {
+			return nil, err
+		}
+		result.Value = value.String()
+	case ManagedBigFloat:
+		value, err := managedTypes.GetBigFloat(handle)
+		if err != nil {
+			return nil, err
+		}
+		result.Value = value.String()
+	case ManagedDecimal:
+		value, err := managedTypes.GetDecimal(handle)
+		if err != nil {
+			return nil, err
+		}
+		result.Value = formatDecimal(value)
+	default:
+		return nil, ErrUnknownManagedTypeKind
+	}
+
+	return result, nil
+}
+
+// formatDecimal formats the decimal in base 10, with exactly Scale fractional digits.
+func formatDecimal(d *vmMath.Decimal) string {
+	digits := big.NewInt(0).Abs(d.Mantissa).String()
+	if len(digits) <= int(d.Scale) {
+		digits = strings.Repeat("0", int(d.Scale)-len(digits)+1) + digits
+	}
+
+	sign := ""
+	if d.Mantissa.Sign() < 0 {
+		sign = "-"
+	}
+	if d.Scale == 0 {
+		return sign + digits
+	}
+
+	integerLength := len(digits) - int(d.Scale)
+	return sign + digits[:integerLength] + "." + digits[integerLength:]
+}
+
+func inspectReturnData(host vmhost.VMHost) []string {
+	returnData := host.Output().ReturnData()
+	result := make([]string, 0, len(returnData))
+	for _, data := range returnData {
+		result = append(result, hex.EncodeToString(data))
+	}
+
+	return result
+}
+
+// inspectStorageUpdates lists the storage updates of all the output accounts, sorted by contract and key.
+func inspectStorageUpdates(host vmhost.VMHost) []StorageUpdate {
+	result := make([]StorageUpdate, 0)
+	for _, account := range host.Output().GetOutputAccounts() {
+		for _, update := range account.StorageUpdates {
+			result = append(result, StorageUpdate{
+				Contract: hex.EncodeToString(account.Address),
+				Key:      hex.EncodeToString(update.Offset),
+				Value:    hex.EncodeToString(update.Data),
+				Written:  update.Written,
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Contract != result[j].Contract {
+			return result[i].Contract < result[j].Contract
+		}
+		return result[i].Key < result[j].Key
+	})
+
+	return result
+}
+
+func inspectCallStack(host vmhost.VMHost) []StackFrame {
+	callStack := host.Runtime().GetCallStack()
+	result := make([]StackFrame, 0, len(callStack))
+	for _, frame := range callStack {
+		result = append(result, StackFrame{
+			Contract:    hex.EncodeToString(frame.ContractAddress),
+			Code:        hex.EncodeToString(frame.CodeAddress),
+			Function:    frame.Function,
+			CallType:    frame.CallType.ToString(),
+			GasProvided: frame.GasProvided,
+			ReadOnly:    frame.ReadOnly,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,190 @@
+package debugger
+
+import (
+	"errors"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"time"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// RPCServiceName is the name under which the debugger methods are exposed, e.g. "Debugger.Continue".
+const RPCServiceName = "Debugger"
+
+// Empty is the argument or reply of the RPC methods that need none.
+type Empty struct{}
+
+// WaitArgs are the arguments of Debugger.Wait.
+type WaitArgs struct {
+	// TimeoutMs bounds the wait. Zero waits until the execution pauses.
+	TimeoutMs uint64 `json:"timeoutMs"`
+}
+
+// InspectManagedValueArgs are the arguments of Debugger.ManagedValue.
+type InspectManagedValueArgs struct {
+	Kind   ManagedTypeKind `json:"kind"`
+	Handle int32           `json:"handle"`
+}
+
+// RPCService exposes a Debugger over JSON-RPC. Every exported method follows the net/rpc conventions.
+type RPCService struct {
+	debugger *Debugger
+}
+
+// NewRPCService creates the JSON-RPC facade of the given debugger.
+func NewRPCService(debugger *Debugger) *RPCService {
+	return &RPCService{
+		debugger: debugger,
+	}
+}
+
+// AddBreakpoint registers a breakpoint and replies with its id.
+func (service *RPCService) AddBreakpoint(args Breakpoint, reply *int) error {
+	*reply = service.debugger.AddBreakpoint(args)
+	return nil
+}
+
+// RemoveBreakpoint removes the breakpoint with the given id.
+func (service *RPCService) RemoveBreakpoint(id int, _ *Empty) error {
+	if !service.debugger.RemoveBreakpoint(id) {
+		return ErrUnknownBreakpoint
+	}
+	return nil
+}
+
+// Breakpoints replies with the registered breakpoints.
+func (service *RPCService) Breakpoints(_ Empty, reply *[]Breakpoint) error {
+	*reply = service.debugger.Breakpoints()
+	return nil
+}
+
+// Wait blocks until the execution is paused and replies with the pause point.
+func (service *RPCService) Wait(args WaitArgs, reply *PauseInfo) error {
+	var timeout <-chan time.Time
+	if args.TimeoutMs > 0 {
+		timeout = time.After(time.Duration(args.TimeoutMs) * time.Millisecond)
+	}
+
+	for {
+		select {
+		case <-service.debugger.WaitPause():
+		case <-timeout:
+			return ErrWaitTimeout
+		}
+
+		// the execution might have been resumed by another client in the meantime
+		pauseInfo := service.debugger.Paused()
+		if pauseInfo != nil {
+			*reply = *pauseInfo
+			return nil
+		}
+	}
+}
+
+// State replies with the current pause point.
+func (service *RPCService) State(_ Empty, reply *PauseInfo) error {
+	pauseInfo := service.debugger.Paused()
+	if pauseInfo == nil {
+		return ErrNotPaused
+	}
+
+	*reply = *pauseInfo
+	return nil
+}
+
+// Continue resumes the execution until the next breakpoint.
+func (service *RPCService) Continue(_ Empty, _ *Empty) error {
+	return service.debugger.Continue()
+}
+
+// Step resumes the execution until the next VM hook event.
+func (service *RPCService) Step(_ Empty, _ *Empty) error {
+	return service.debugger.Step()
+}
+
+// ManagedValue replies with the value behind a managed type handle.
+func (service *RPCService) ManagedValue(args InspectManagedValueArgs, reply *ManagedValue) error {
+	var inspectErr error
+	err := service.debugger.Inspect(func(host vmhost.VMHost) {
+		var value *ManagedValue
+		value, inspectErr = inspectManagedValue(host, args.Kind, args.Handle)
+		if inspectErr == nil {
+			*reply = *value
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return inspectErr
+}
+
+// ReturnData replies with the hex-encoded return data of the current call.
+func (service *RPCService) ReturnData(_ Empty, reply *[]string) error {
+	return service.debugger.Inspect(func(host vmhost.VMHost) {
+		*reply = inspectReturnData(host)
+	})
+}
+
+// StorageUpdates replies with the storage updates not yet committed.
+func (service *RPCService) StorageUpdates(_ Empty, reply *[]StorageUpdate) error {
+	return service.debugger.Inspect(func(host vmhost.VMHost) {
+		*reply = inspectStorageUpdates(host)
+	})
+}
+
+// GasLeft replies with the gas left of the current call.
+func (service *RPCService) GasLeft(_ Empty, reply *uint64) error {
+	return service.debugger.Inspect(func(host vmhost.VMHost) {
+		*reply = host.Metering().GasLeft()
+	})
+}
+
+// CallStack replies with the calls on the instance stack, starting with the outermost one.
+func (service *RPCService) CallStack(_ Empty, reply *[]StackFrame) error {
+	return service.debugger.Inspect(func(host vmhost.VMHost) {
+		*reply = inspectCallStack(host)
+	})
+}
+
+// ServeJSONRPC accepts connections on the given listener and serves the debugger over JSON-RPC on each of them.
+// It returns when the listener is closed.
+func ServeJSONRPC(debugger *Debugger, listener net.Listener) error {
+	server := rpc.NewServer()
+	err := server.RegisterName(RPCServiceName, NewRPCService(debugger))
+	if err != nil {
+		return err
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		log.Debug("debugger client attached", "address", conn.RemoteAddr().String())
+		go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
+
+// StartJSONRPCServer listens on the given local address and serves the debugger in the background.
+func StartJSONRPCServer(debugger *Debugger, address string) (net.Listener, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		errServe := ServeJSONRPC(debugger, listener)
+		if errServe != nil {
+			log.Error("debugger JSON-RPC server stopped", "error", errServe)
+		}
+	}()
+
+	return listener, nil
+}
@@ -0,0 +1,60 @@
+package executor
+
+// VMHookArgKind is the semantic kind of a VM hook argument or result, as inferred by the VM hooks generator.
+type VMHookArgKind uint8
+
+const (
+	// PlainArg is a number without further meaning for the VM hooks
+	PlainArg VMHookArgKind = iota
+	// BigIntHandleArg is a handle to a big integer
+	BigIntHandleArg
+	// BigFloatHandleArg is a handle to a big float
+	BigFloatHandleArg
+	// ManagedBufferHandleArg is a handle to a managed buffer
+	ManagedBufferHandleArg
+	// ManagedMapHandleArg is a handle to a managed map
+	ManagedMapHandleArg
+	// ManagedDecimalHandleArg is a handle to a managed decimal
+	ManagedDecimalHandleArg
+	// EllipticCurveHandleArg is a handle to an elliptic curve
+	EllipticCurveHandleArg
+	// AddressArg is a pointer to an address in WASM memory
+	AddressArg
+	// TokenIDArg is a pointer to a token identifier in WASM memory
+	TokenIDArg
+)
+
+// String returns the name of the kind, as used in the VM hooks manifest.
+func (kind VMHookArgKind) String() string {
+	switch kind {
+	case BigIntHandleArg:
+		return "bigInt"
+	case BigFloatHandleArg:
+		return "bigFloat"
+	case ManagedBufferHandleArg:
+		return "managedBuffer"
+	case ManagedMapHandleArg:
+		return "managedMap"
+	case ManagedDecimalHandleArg:
+		return "managedDecimal"
+	case EllipticCurveHandleArg:
+		return "ellipticCurve"
+	case AddressArg:
+		return "address"
+	case TokenIDArg:
+		return "tokenID"
+	default:
+		return "plain"
+	}
+}
+
+// VMHookValueDecoder is implemented by the VM hooks able to describe the values behind handles and memory pointers.
+// Decoding neither consumes gas nor changes any state, it is meant for logging and debugging tools.
+type VMHookValueDecoder interface {
+	// DecodeHandle describes the value under a handle, the second result is false if the handle does not exist.
+	DecodeHandle(kind VMHookArgKind, handle int32) (string, bool)
+
+	// DecodeMemory describes the data of the given kind found in WASM memory, the second result is false if it
+	// cannot be read. Addresses have a fixed length, the given length is ignored for them.
+	DecodeMemory(kind VMHookArgKind, offset MemPtr, length MemLength) (string, bool)
+}
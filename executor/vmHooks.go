@@ -14,6 +14,10 @@ type VMHooks interface {
 	BigIntVMHooks
 	ManagedBufferVMHooks
 	ManagedMapVMHooks
+	ManagedDecimalVMHooks
+	TransientStorageVMHooks
+	ReentrancyVMHooks
+	EthereumVMHooks
 	SmallIntVMHooks
 	CryptoVMHooks
 }
@@ -143,6 +147,11 @@ type ManagedVMHooks interface {
 	ManagedMultiTransferESDTNFTExecuteWithReturn(dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32
 	ManagedMultiTransferESDTNFTExecuteByUser(userHandle int32, dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32
 	ManagedTransferValueExecute(dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32
+	ManagedCreateContractWithErrorReturn(gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32
+	ManagedDeployFromSourceContractWithErrorReturn(gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32
+	ManagedUpgradeContractWithErrorReturn(destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32
+	ManagedUpgradeFromSourceContractWithErrorReturn(destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32
+	ManagedTransferValueExecuteWithErrorReturn(dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32
 	ManagedIsESDTFrozen(addressHandle int32, tokenIDHandle int32, nonce int64) int32
 	ManagedIsESDTLimitedTransfer(tokenIDHandle int32) int32
 	ManagedIsESDTPaused(tokenIDHandle int32) int32
@@ -220,6 +229,7 @@ type BigIntVMHooks interface {
 	BigIntFinishUnsigned(referenceHandle int32)
 	BigIntFinishSigned(referenceHandle int32)
 	BigIntToString(bigIntHandle int32, destinationHandle int32)
+	BigIntSetRandomInRange(destinationHandle int32, minHandle int32, maxHandle int32) int32
 }
 
 type ManagedBufferVMHooks interface {
@@ -247,6 +257,8 @@ type ManagedBufferVMHooks interface {
 	MBufferStorageStore(keyHandle int32, sourceHandle int32) int32
 	MBufferStorageLoad(keyHandle int32, destinationHandle int32) int32
 	MBufferStorageLoadFromAddress(addressHandle int32, keyHandle int32, destinationHandle int32)
+	MBufferStorageLoadBatch(keysHandle int32, destinationHandle int32) int32
+	MBufferStorageLoadBatchFromAddress(addressHandle int32, keysHandle int32, destinationHandle int32)
 	MBufferGetArgument(id int32, destinationHandle int32) int32
 	MBufferFinish(sourceHandle int32) int32
 	MBufferSetRandom(destinationHandle int32, length int32) int32
@@ -260,6 +272,50 @@ type ManagedMapVMHooks interface {
 	ManagedMapContains(mMapHandle int32, keyHandle int32) int32
 }
 
+type ManagedDecimalVMHooks interface {
+	MDecimalFromBigInt(destinationHandle int32, bigIntHandle int32, scale int32) int32
+	MDecimalToBigInt(destinationHandle int32, decimalHandle int32, roundingMode int32) int32
+	MDecimalGetMantissa(destinationHandle int32, decimalHandle int32) int32
+	MDecimalGetScale(decimalHandle int32) int32
+	MDecimalFromManagedBuffer(destinationHandle int32, mBufferHandle int32) int32
+	MDecimalToManagedBuffer(decimalHandle int32, destinationHandle int32) int32
+	MDecimalAdd(destinationHandle int32, op1Handle int32, op2Handle int32) int32
+	MDecimalSub(destinationHandle int32, op1Handle int32, op2Handle int32) int32
+	MDecimalMul(destinationHandle int32, op1Handle int32, op2Handle int32, roundingMode int32) int32
+	MDecimalDiv(destinationHandle int32, op1Handle int32, op2Handle int32, roundingMode int32) int32
+	MDecimalRescale(destinationHandle int32, opHandle int32, scale int32, roundingMode int32) int32
+	MDecimalCmp(op1Handle int32, op2Handle int32) int32
+	MDecimalLn(destinationHandle int32, opHandle int32) int32
+	MDecimalExp(destinationHandle int32, opHandle int32) int32
+}
+
+type TransientStorageVMHooks interface {
+	MBufferTransientStorageStore(keyHandle int32, sourceHandle int32) int32
+	MBufferTransientStorageLoad(keyHandle int32, destinationHandle int32) int32
+}
+
+type ReentrancyVMHooks interface {
+	ProtectContractAgainstReentrancy() int32
+	ManagedProtectEndpointAgainstReentrancy(endpointHandle int32) int32
+}
+
+type EthereumVMHooks interface {
+	EthGetCallDataSize() int32
+	EthCallDataCopy(resultOffset MemPtr, dataOffset int32, length MemLength)
+	EthStorageStore(pathOffset MemPtr, valueOffset MemPtr)
+	EthStorageLoad(pathOffset MemPtr, resultOffset MemPtr)
+	EthGetCallValue(resultOffset MemPtr)
+	EthGetCaller(resultOffset MemPtr)
+	EthGetAddress(resultOffset MemPtr)
+	EthGetTxOrigin(resultOffset MemPtr)
+	EthGetGasLeft() int64
+	EthGetBlockNumber() int64
+	EthGetBlockTimestamp() int64
+	EthLog(dataOffset MemPtr, dataLength MemLength, numberOfTopics int32, topic1Offset MemPtr, topic2Offset MemPtr, topic3Offset MemPtr, topic4Offset MemPtr)
+	EthFinish(dataOffset MemPtr, dataLength MemLength)
+	EthRevert(dataOffset MemPtr, dataLength MemLength)
+}
+
 type SmallIntVMHooks interface {
 	SmallIntGetUnsignedArgument(id int32) int64
 	SmallIntGetSignedArgument(id int32) int64
@@ -273,6 +329,7 @@ type SmallIntVMHooks interface {
 	Int64finish(value int64)
 	Int64storageStore(keyOffset MemPtr, keyLength MemLength, value int64) int32
 	Int64storageLoad(keyOffset MemPtr, keyLength MemLength) int64
+	SmallIntGetRandomInRange(min int64, max int64) int64
 }
 
 type CryptoVMHooks interface {
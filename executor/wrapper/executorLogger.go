@@ -43,6 +43,11 @@ func (cl *ConsoleLogger) LogVMHookCallAfter(callInfo string) {
 	log.Trace(fmt.Sprintf("VM hook end: %s", callInfo))
 }
 
+// LogInvalidHandle is called when a VM hook receives an input handle which does not exist.
+func (cl *ConsoleLogger) LogInvalidHandle(vmHookName string, argName string, kind executor.VMHookArgKind, handle int32) {
+	log.Trace(fmt.Sprintf("VM hook invalid handle: %s %s=%d is not a %s", vmHookName, argName, handle, kind))
+}
+
 // StringLogger is a simple ExecutorLogger that records data into a string builder.
 type StringLogger struct {
 	sb strings.Builder
@@ -75,6 +80,12 @@ func (sl *StringLogger) LogVMHookCallAfter(callInfo string) {
 	sl.sb.WriteRune('\n')
 }
 
+// LogInvalidHandle is called when a VM hook receives an input handle which does not exist.
+func (sl *StringLogger) LogInvalidHandle(vmHookName string, argName string, kind executor.VMHookArgKind, handle int32) {
+	sl.sb.WriteString(fmt.Sprintf("VM hook invalid handle: %s %s=%d is not a %s", vmHookName, argName, handle, kind))
+	sl.sb.WriteRune('\n')
+}
+
 // String yields the logs accumulated up to this point.
 func (sl *StringLogger) String() string {
 	return sl.sb.String()
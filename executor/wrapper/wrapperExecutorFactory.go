@@ -8,6 +8,7 @@ import (
 type WrapperExecutorFactory struct {
 	logger         ExecutorLogger
 	wrappedFactory executor.ExecutorAbstractFactory
+	decodeValues   bool
 
 	// LastCreatedExecutor gives access to the created Executor
 	LastCreatedExecutor *WrapperExecutor
@@ -23,6 +24,19 @@ func NewWrappedExecutorFactory(
 	}
 }
 
+// NewDecodingWrappedExecutorFactory yields a new WrapperExecutor factory which logs the values behind the handles and
+// memory pointers passed to the VM hooks, and reports the invalid input handles to loggers implementing
+// InvalidHandleLogger. Decoding only happens if the wrapped VM hooks implement executor.VMHookValueDecoder.
+func NewDecodingWrappedExecutorFactory(
+	logger ExecutorLogger,
+	wrappedFactory executor.ExecutorAbstractFactory) *WrapperExecutorFactory {
+	return &WrapperExecutorFactory{
+		logger:         logger,
+		wrappedFactory: wrappedFactory,
+		decodeValues:   true,
+	}
+}
+
 // SimpleWrappedExecutorFactory yields a WrappedExecutor factory without logging.
 func SimpleWrappedExecutorFactory(wrappedFactory executor.ExecutorAbstractFactory) *WrapperExecutorFactory {
 	return NewWrappedExecutorFactory(&NoLogger{}, wrappedFactory)
@@ -30,11 +44,16 @@ func SimpleWrappedExecutorFactory(wrappedFactory executor.ExecutorAbstractFactor
 
 // CreateExecutor creates a new Executor instance.
 func (factory *WrapperExecutorFactory) CreateExecutor(args executor.ExecutorFactoryArgs) (executor.Executor, error) {
+	wrapperVMHooks := &WrapperVMHooks{
+		logger:         factory.logger,
+		wrappedVMHooks: args.VMHooks,
+	}
+	if factory.decodeValues {
+		wrapperVMHooks.decoder, _ = args.VMHooks.(executor.VMHookValueDecoder)
+	}
+
 	wrappedExecutor, err := factory.wrappedFactory.CreateExecutor(executor.ExecutorFactoryArgs{
-		VMHooks: &WrapperVMHooks{
-			logger:         factory.logger,
-			wrappedVMHooks: args.VMHooks,
-		},
+		VMHooks:                  wrapperVMHooks,
 		OpcodeCosts:              args.OpcodeCosts,
 		RkyvSerializationEnabled: args.RkyvSerializationEnabled,
 		WasmerSIGSEGVPassthrough: args.WasmerSIGSEGVPassthrough,
@@ -0,0 +1,61 @@
+package executorwrapper
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// InvalidHandleLogger can be implemented by an ExecutorLogger to be told about the VM hooks called with handles which
+// they read, but which do not exist. It is only used when the VM hook values are decoded.
+type InvalidHandleLogger interface {
+	LogInvalidHandle(vmHookName string, argName string, kind executor.VMHookArgKind, handle int32)
+}
+
+// handleArg formats a handle argument, followed by its value when decoding. Input handles which do not exist are
+// reported to the logger.
+func (w *WrapperVMHooks) handleArg(vmHookName string, argName string, kind executor.VMHookArgKind, handle int32, input bool) string {
+	if w.decoder == nil {
+		return fmt.Sprintf("%d", handle)
+	}
+
+	value, ok := w.decoder.DecodeHandle(kind, handle)
+	if ok {
+		return fmt.Sprintf("%d=%s", handle, value)
+	}
+	if !input {
+		return fmt.Sprintf("%d=<unset>", handle)
+	}
+
+	invalidHandleLogger, canLog := w.logger.(InvalidHandleLogger)
+	if canLog {
+		invalidHandleLogger.LogInvalidHandle(vmHookName, argName, kind, handle)
+	}
+	return fmt.Sprintf("%d=<invalid %s handle>", handle, kind)
+}
+
+// memoryArg formats a memory pointer argument, followed by the data it points to when decoding.
+func (w *WrapperVMHooks) memoryArg(kind executor.VMHookArgKind, offset executor.MemPtr, length executor.MemLength) string {
+	if w.decoder == nil {
+		return fmt.Sprintf("%d", offset)
+	}
+
+	value, ok := w.decoder.DecodeMemory(kind, offset, length)
+	if !ok {
+		return fmt.Sprintf("%d=<invalid %s>", offset, kind)
+	}
+	return fmt.Sprintf("%d=%s", offset, value)
+}
+
+// handleResult formats the handle returned by a VM hook and its value, only when decoding.
+func (w *WrapperVMHooks) handleResult(kind executor.VMHookArgKind, handle int32) string {
+	if w.decoder == nil {
+		return ""
+	}
+
+	value, ok := w.decoder.DecodeHandle(kind, handle)
+	if !ok {
+		return fmt.Sprintf(" -> %d", handle)
+	}
+	return fmt.Sprintf(" -> %d=%s", handle, value)
+}
@@ -13,9 +13,11 @@ import (
 )
 
 // WrapperVMHooks wraps a VMHooks instance and optionally performs some logging.
+// When a decoder is set, handles and memory pointers are logged together with the values they refer to.
 type WrapperVMHooks struct {
 	logger         ExecutorLogger
 	wrappedVMHooks executor.VMHooks
+	decoder        executor.VMHookValueDecoder
 }
 
 // GetGasLeft VM hook wrapper
@@ -45,7 +47,7 @@ func (w *WrapperVMHooks) GetOwnerAddress(resultOffset executor.MemPtr) {
 
 // GetShardOfAddress VM hook wrapper
 func (w *WrapperVMHooks) GetShardOfAddress(addressOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("GetShardOfAddress(%d)", addressOffset)
+	callInfo := fmt.Sprintf("GetShardOfAddress(%s)", w.memoryArg(executor.AddressArg, addressOffset, 0))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetShardOfAddress(addressOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -54,7 +56,7 @@ func (w *WrapperVMHooks) GetShardOfAddress(addressOffset executor.MemPtr) int32
 
 // IsSmartContract VM hook wrapper
 func (w *WrapperVMHooks) IsSmartContract(addressOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("IsSmartContract(%d)", addressOffset)
+	callInfo := fmt.Sprintf("IsSmartContract(%s)", w.memoryArg(executor.AddressArg, addressOffset, 0))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.IsSmartContract(addressOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -71,7 +73,7 @@ func (w *WrapperVMHooks) SignalError(messageOffset executor.MemPtr, messageLengt
 
 // GetExternalBalance VM hook wrapper
 func (w *WrapperVMHooks) GetExternalBalance(addressOffset executor.MemPtr, resultOffset executor.MemPtr) {
-	callInfo := fmt.Sprintf("GetExternalBalance(%d, %d)", addressOffset, resultOffset)
+	callInfo := fmt.Sprintf("GetExternalBalance(%s, %d)", w.memoryArg(executor.AddressArg, addressOffset, 0), resultOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.GetExternalBalance(addressOffset, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -88,7 +90,7 @@ func (w *WrapperVMHooks) GetBlockHash(nonce int64, resultOffset executor.MemPtr)
 
 // GetESDTBalance VM hook wrapper
 func (w *WrapperVMHooks) GetESDTBalance(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64, resultOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("GetESDTBalance(%d, %d, %d, %d, %d)", addressOffset, tokenIDOffset, tokenIDLen, nonce, resultOffset)
+	callInfo := fmt.Sprintf("GetESDTBalance(%s, %s, %d, %d, %d)", w.memoryArg(executor.AddressArg, addressOffset, 0), w.memoryArg(executor.TokenIDArg, tokenIDOffset, tokenIDLen), tokenIDLen, nonce, resultOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -97,7 +99,7 @@ func (w *WrapperVMHooks) GetESDTBalance(addressOffset executor.MemPtr, tokenIDOf
 
 // GetESDTNFTNameLength VM hook wrapper
 func (w *WrapperVMHooks) GetESDTNFTNameLength(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64) int32 {
-	callInfo := fmt.Sprintf("GetESDTNFTNameLength(%d, %d, %d, %d)", addressOffset, tokenIDOffset, tokenIDLen, nonce)
+	callInfo := fmt.Sprintf("GetESDTNFTNameLength(%s, %s, %d, %d)", w.memoryArg(executor.AddressArg, addressOffset, 0), w.memoryArg(executor.TokenIDArg, tokenIDOffset, tokenIDLen), tokenIDLen, nonce)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTNFTNameLength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -106,7 +108,7 @@ func (w *WrapperVMHooks) GetESDTNFTNameLength(addressOffset executor.MemPtr, tok
 
 // GetESDTNFTAttributeLength VM hook wrapper
 func (w *WrapperVMHooks) GetESDTNFTAttributeLength(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64) int32 {
-	callInfo := fmt.Sprintf("GetESDTNFTAttributeLength(%d, %d, %d, %d)", addressOffset, tokenIDOffset, tokenIDLen, nonce)
+	callInfo := fmt.Sprintf("GetESDTNFTAttributeLength(%s, %s, %d, %d)", w.memoryArg(executor.AddressArg, addressOffset, 0), w.memoryArg(executor.TokenIDArg, tokenIDOffset, tokenIDLen), tokenIDLen, nonce)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTNFTAttributeLength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -115,7 +117,7 @@ func (w *WrapperVMHooks) GetESDTNFTAttributeLength(addressOffset executor.MemPtr
 
 // GetESDTNFTURILength VM hook wrapper
 func (w *WrapperVMHooks) GetESDTNFTURILength(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64) int32 {
-	callInfo := fmt.Sprintf("GetESDTNFTURILength(%d, %d, %d, %d)", addressOffset, tokenIDOffset, tokenIDLen, nonce)
+	callInfo := fmt.Sprintf("GetESDTNFTURILength(%s, %s, %d, %d)", w.memoryArg(executor.AddressArg, addressOffset, 0), w.memoryArg(executor.TokenIDArg, tokenIDOffset, tokenIDLen), tokenIDLen, nonce)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTNFTURILength(addressOffset, tokenIDOffset, tokenIDLen, nonce)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -124,7 +126,7 @@ func (w *WrapperVMHooks) GetESDTNFTURILength(addressOffset executor.MemPtr, toke
 
 // GetESDTTokenData VM hook wrapper
 func (w *WrapperVMHooks) GetESDTTokenData(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64, valueHandle int32, propertiesOffset executor.MemPtr, hashOffset executor.MemPtr, nameOffset executor.MemPtr, attributesOffset executor.MemPtr, creatorOffset executor.MemPtr, royaltiesHandle int32, urisOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("GetESDTTokenData(%d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d)", addressOffset, tokenIDOffset, tokenIDLen, nonce, valueHandle, propertiesOffset, hashOffset, nameOffset, attributesOffset, creatorOffset, royaltiesHandle, urisOffset)
+	callInfo := fmt.Sprintf("GetESDTTokenData(%s, %s, %d, %d, %s, %d, %d, %d, %d, %d, %s, %d)", w.memoryArg(executor.AddressArg, addressOffset, 0), w.memoryArg(executor.TokenIDArg, tokenIDOffset, tokenIDLen), tokenIDLen, nonce, w.handleArg("GetESDTTokenData", "valueHandle", executor.BigIntHandleArg, valueHandle, false), propertiesOffset, hashOffset, nameOffset, attributesOffset, creatorOffset, w.handleArg("GetESDTTokenData", "royaltiesHandle", executor.BigIntHandleArg, royaltiesHandle, false), urisOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTTokenData(addressOffset, tokenIDOffset, tokenIDLen, nonce, valueHandle, propertiesOffset, hashOffset, nameOffset, attributesOffset, creatorOffset, royaltiesHandle, urisOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -133,7 +135,7 @@ func (w *WrapperVMHooks) GetESDTTokenData(addressOffset executor.MemPtr, tokenID
 
 // GetESDTLocalRoles VM hook wrapper
 func (w *WrapperVMHooks) GetESDTLocalRoles(tokenIdHandle int32) int64 {
-	callInfo := fmt.Sprintf("GetESDTLocalRoles(%d)", tokenIdHandle)
+	callInfo := fmt.Sprintf("GetESDTLocalRoles(%s)", w.handleArg("GetESDTLocalRoles", "tokenIdHandle", executor.ManagedBufferHandleArg, tokenIdHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetESDTLocalRoles(tokenIdHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -142,7 +144,7 @@ func (w *WrapperVMHooks) GetESDTLocalRoles(tokenIdHandle int32) int64 {
 
 // ValidateTokenIdentifier VM hook wrapper
 func (w *WrapperVMHooks) ValidateTokenIdentifier(tokenIdHandle int32) int32 {
-	callInfo := fmt.Sprintf("ValidateTokenIdentifier(%d)", tokenIdHandle)
+	callInfo := fmt.Sprintf("ValidateTokenIdentifier(%s)", w.handleArg("ValidateTokenIdentifier", "tokenIdHandle", executor.ManagedBufferHandleArg, tokenIdHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ValidateTokenIdentifier(tokenIdHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -151,7 +153,7 @@ func (w *WrapperVMHooks) ValidateTokenIdentifier(tokenIdHandle int32) int32 {
 
 // TransferValue VM hook wrapper
 func (w *WrapperVMHooks) TransferValue(destOffset executor.MemPtr, valueOffset executor.MemPtr, dataOffset executor.MemPtr, length executor.MemLength) int32 {
-	callInfo := fmt.Sprintf("TransferValue(%d, %d, %d, %d)", destOffset, valueOffset, dataOffset, length)
+	callInfo := fmt.Sprintf("TransferValue(%s, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), valueOffset, dataOffset, length)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.TransferValue(destOffset, valueOffset, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -160,7 +162,7 @@ func (w *WrapperVMHooks) TransferValue(destOffset executor.MemPtr, valueOffset e
 
 // TransferValueExecute VM hook wrapper
 func (w *WrapperVMHooks) TransferValueExecute(destOffset executor.MemPtr, valueOffset executor.MemPtr, gasLimit int64, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("TransferValueExecute(%d, %d, %d, %d, %d, %d, %d, %d)", destOffset, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("TransferValueExecute(%s, %d, %d, %d, %d, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.TransferValueExecute(destOffset, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -169,7 +171,7 @@ func (w *WrapperVMHooks) TransferValueExecute(destOffset executor.MemPtr, valueO
 
 // TransferESDTExecute VM hook wrapper
 func (w *WrapperVMHooks) TransferESDTExecute(destOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, valueOffset executor.MemPtr, gasLimit int64, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("TransferESDTExecute(%d, %d, %d, %d, %d, %d, %d, %d, %d, %d)", destOffset, tokenIDOffset, tokenIDLen, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("TransferESDTExecute(%s, %s, %d, %d, %d, %d, %d, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), w.memoryArg(executor.TokenIDArg, tokenIDOffset, tokenIDLen), tokenIDLen, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.TransferESDTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -178,7 +180,7 @@ func (w *WrapperVMHooks) TransferESDTExecute(destOffset executor.MemPtr, tokenID
 
 // TransferESDTNFTExecute VM hook wrapper
 func (w *WrapperVMHooks) TransferESDTNFTExecute(destOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, valueOffset executor.MemPtr, nonce int64, gasLimit int64, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("TransferESDTNFTExecute(%d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d)", destOffset, tokenIDOffset, tokenIDLen, valueOffset, nonce, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("TransferESDTNFTExecute(%s, %s, %d, %d, %d, %d, %d, %d, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), w.memoryArg(executor.TokenIDArg, tokenIDOffset, tokenIDLen), tokenIDLen, valueOffset, nonce, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.TransferESDTNFTExecute(destOffset, tokenIDOffset, tokenIDLen, valueOffset, nonce, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -187,7 +189,7 @@ func (w *WrapperVMHooks) TransferESDTNFTExecute(destOffset executor.MemPtr, toke
 
 // MultiTransferESDTNFTExecute VM hook wrapper
 func (w *WrapperVMHooks) MultiTransferESDTNFTExecute(destOffset executor.MemPtr, numTokenTransfers int32, tokenTransfersArgsLengthOffset executor.MemPtr, tokenTransferDataOffset executor.MemPtr, gasLimit int64, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("MultiTransferESDTNFTExecute(%d, %d, %d, %d, %d, %d, %d, %d, %d, %d)", destOffset, numTokenTransfers, tokenTransfersArgsLengthOffset, tokenTransferDataOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("MultiTransferESDTNFTExecute(%s, %d, %d, %d, %d, %d, %d, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), numTokenTransfers, tokenTransfersArgsLengthOffset, tokenTransferDataOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MultiTransferESDTNFTExecute(destOffset, numTokenTransfers, tokenTransfersArgsLengthOffset, tokenTransferDataOffset, gasLimit, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -196,7 +198,7 @@ func (w *WrapperVMHooks) MultiTransferESDTNFTExecute(destOffset executor.MemPtr,
 
 // CreateAsyncCall VM hook wrapper
 func (w *WrapperVMHooks) CreateAsyncCall(destOffset executor.MemPtr, valueOffset executor.MemPtr, dataOffset executor.MemPtr, dataLength executor.MemLength, successOffset executor.MemPtr, successLength executor.MemLength, errorOffset executor.MemPtr, errorLength executor.MemLength, gas int64, extraGasForCallback int64) int32 {
-	callInfo := fmt.Sprintf("CreateAsyncCall(%d, %d, %d, %d, %d, %d, %d, %d, %d, %d)", destOffset, valueOffset, dataOffset, dataLength, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback)
+	callInfo := fmt.Sprintf("CreateAsyncCall(%s, %d, %d, %d, %d, %d, %d, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), valueOffset, dataOffset, dataLength, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.CreateAsyncCall(destOffset, valueOffset, dataOffset, dataLength, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -214,7 +216,7 @@ func (w *WrapperVMHooks) SetAsyncContextCallback(callback executor.MemPtr, callb
 
 // UpgradeContract VM hook wrapper
 func (w *WrapperVMHooks) UpgradeContract(destOffset executor.MemPtr, gasLimit int64, valueOffset executor.MemPtr, codeOffset executor.MemPtr, codeMetadataOffset executor.MemPtr, length executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) {
-	callInfo := fmt.Sprintf("UpgradeContract(%d, %d, %d, %d, %d, %d, %d, %d, %d)", destOffset, gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("UpgradeContract(%s, %d, %d, %d, %d, %d, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.UpgradeContract(destOffset, gasLimit, valueOffset, codeOffset, codeMetadataOffset, length, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -222,7 +224,7 @@ func (w *WrapperVMHooks) UpgradeContract(destOffset executor.MemPtr, gasLimit in
 
 // UpgradeFromSourceContract VM hook wrapper
 func (w *WrapperVMHooks) UpgradeFromSourceContract(destOffset executor.MemPtr, gasLimit int64, valueOffset executor.MemPtr, sourceContractAddressOffset executor.MemPtr, codeMetadataOffset executor.MemPtr, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) {
-	callInfo := fmt.Sprintf("UpgradeFromSourceContract(%d, %d, %d, %d, %d, %d, %d, %d)", destOffset, gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("UpgradeFromSourceContract(%s, %d, %d, %s, %d, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), gasLimit, valueOffset, w.memoryArg(executor.AddressArg, sourceContractAddressOffset, 0), codeMetadataOffset, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.UpgradeFromSourceContract(destOffset, gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -230,7 +232,7 @@ func (w *WrapperVMHooks) UpgradeFromSourceContract(destOffset executor.MemPtr, g
 
 // DeleteContract VM hook wrapper
 func (w *WrapperVMHooks) DeleteContract(destOffset executor.MemPtr, gasLimit int64, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) {
-	callInfo := fmt.Sprintf("DeleteContract(%d, %d, %d, %d, %d)", destOffset, gasLimit, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("DeleteContract(%s, %d, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), gasLimit, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.DeleteContract(destOffset, gasLimit, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -238,7 +240,7 @@ func (w *WrapperVMHooks) DeleteContract(destOffset executor.MemPtr, gasLimit int
 
 // AsyncCall VM hook wrapper
 func (w *WrapperVMHooks) AsyncCall(destOffset executor.MemPtr, valueOffset executor.MemPtr, dataOffset executor.MemPtr, length executor.MemLength) {
-	callInfo := fmt.Sprintf("AsyncCall(%d, %d, %d, %d)", destOffset, valueOffset, dataOffset, length)
+	callInfo := fmt.Sprintf("AsyncCall(%s, %d, %d, %d)", w.memoryArg(executor.AddressArg, destOffset, 0), valueOffset, dataOffset, length)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.AsyncCall(destOffset, valueOffset, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -300,7 +302,7 @@ func (w *WrapperVMHooks) StorageLoadLength(keyOffset executor.MemPtr, keyLength
 
 // StorageLoadFromAddress VM hook wrapper
 func (w *WrapperVMHooks) StorageLoadFromAddress(addressOffset executor.MemPtr, keyOffset executor.MemPtr, keyLength executor.MemLength, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("StorageLoadFromAddress(%d, %d, %d, %d)", addressOffset, keyOffset, keyLength, dataOffset)
+	callInfo := fmt.Sprintf("StorageLoadFromAddress(%s, %d, %d, %d)", w.memoryArg(executor.AddressArg, addressOffset, 0), keyOffset, keyLength, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.StorageLoadFromAddress(addressOffset, keyOffset, keyLength, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -433,7 +435,7 @@ func (w *WrapperVMHooks) GetESDTTokenNonceByIndex(index int32) int64 {
 
 // GetCurrentESDTNFTNonce VM hook wrapper
 func (w *WrapperVMHooks) GetCurrentESDTNFTNonce(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength) int64 {
-	callInfo := fmt.Sprintf("GetCurrentESDTNFTNonce(%d, %d, %d)", addressOffset, tokenIDOffset, tokenIDLen)
+	callInfo := fmt.Sprintf("GetCurrentESDTNFTNonce(%s, %s, %d)", w.memoryArg(executor.AddressArg, addressOffset, 0), w.memoryArg(executor.TokenIDArg, tokenIDOffset, tokenIDLen), tokenIDLen)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetCurrentESDTNFTNonce(addressOffset, tokenIDOffset, tokenIDLen)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -487,7 +489,7 @@ func (w *WrapperVMHooks) GetCallValueTokenNameByIndex(callValueOffset executor.M
 
 // IsReservedFunctionName VM hook wrapper
 func (w *WrapperVMHooks) IsReservedFunctionName(nameHandle int32) int32 {
-	callInfo := fmt.Sprintf("IsReservedFunctionName(%d)", nameHandle)
+	callInfo := fmt.Sprintf("IsReservedFunctionName(%s)", w.handleArg("IsReservedFunctionName", "nameHandle", executor.ManagedBufferHandleArg, nameHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.IsReservedFunctionName(nameHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -670,7 +672,7 @@ func (w *WrapperVMHooks) Finish(pointer executor.MemPtr, length executor.MemLeng
 
 // ExecuteOnSameContext VM hook wrapper
 func (w *WrapperVMHooks) ExecuteOnSameContext(gasLimit int64, addressOffset executor.MemPtr, valueOffset executor.MemPtr, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("ExecuteOnSameContext(%d, %d, %d, %d, %d, %d, %d, %d)", gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("ExecuteOnSameContext(%d, %s, %d, %d, %d, %d, %d, %d)", gasLimit, w.memoryArg(executor.AddressArg, addressOffset, 0), valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ExecuteOnSameContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -679,7 +681,7 @@ func (w *WrapperVMHooks) ExecuteOnSameContext(gasLimit int64, addressOffset exec
 
 // ExecuteOnDestContext VM hook wrapper
 func (w *WrapperVMHooks) ExecuteOnDestContext(gasLimit int64, addressOffset executor.MemPtr, valueOffset executor.MemPtr, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("ExecuteOnDestContext(%d, %d, %d, %d, %d, %d, %d, %d)", gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("ExecuteOnDestContext(%d, %s, %d, %d, %d, %d, %d, %d)", gasLimit, w.memoryArg(executor.AddressArg, addressOffset, 0), valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ExecuteOnDestContext(gasLimit, addressOffset, valueOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -688,7 +690,7 @@ func (w *WrapperVMHooks) ExecuteOnDestContext(gasLimit int64, addressOffset exec
 
 // ExecuteReadOnly VM hook wrapper
 func (w *WrapperVMHooks) ExecuteReadOnly(gasLimit int64, addressOffset executor.MemPtr, functionOffset executor.MemPtr, functionLength executor.MemLength, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("ExecuteReadOnly(%d, %d, %d, %d, %d, %d, %d)", gasLimit, addressOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("ExecuteReadOnly(%d, %s, %d, %d, %d, %d, %d)", gasLimit, w.memoryArg(executor.AddressArg, addressOffset, 0), functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ExecuteReadOnly(gasLimit, addressOffset, functionOffset, functionLength, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -706,7 +708,7 @@ func (w *WrapperVMHooks) CreateContract(gasLimit int64, valueOffset executor.Mem
 
 // DeployFromSourceContract VM hook wrapper
 func (w *WrapperVMHooks) DeployFromSourceContract(gasLimit int64, valueOffset executor.MemPtr, sourceContractAddressOffset executor.MemPtr, codeMetadataOffset executor.MemPtr, resultAddressOffset executor.MemPtr, numArguments int32, argumentsLengthOffset executor.MemPtr, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("DeployFromSourceContract(%d, %d, %d, %d, %d, %d, %d, %d)", gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, resultAddressOffset, numArguments, argumentsLengthOffset, dataOffset)
+	callInfo := fmt.Sprintf("DeployFromSourceContract(%d, %d, %s, %d, %d, %d, %d, %d)", gasLimit, valueOffset, w.memoryArg(executor.AddressArg, sourceContractAddressOffset, 0), codeMetadataOffset, resultAddressOffset, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.DeployFromSourceContract(gasLimit, valueOffset, sourceContractAddressOffset, codeMetadataOffset, resultAddressOffset, numArguments, argumentsLengthOffset, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -782,7 +784,7 @@ func (w *WrapperVMHooks) GetPrevTxHash(dataOffset executor.MemPtr) {
 
 // ManagedSCAddress VM hook wrapper
 func (w *WrapperVMHooks) ManagedSCAddress(destinationHandle int32) {
-	callInfo := fmt.Sprintf("ManagedSCAddress(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("ManagedSCAddress(%s)", w.handleArg("ManagedSCAddress", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedSCAddress(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -790,7 +792,7 @@ func (w *WrapperVMHooks) ManagedSCAddress(destinationHandle int32) {
 
 // ManagedOwnerAddress VM hook wrapper
 func (w *WrapperVMHooks) ManagedOwnerAddress(destinationHandle int32) {
-	callInfo := fmt.Sprintf("ManagedOwnerAddress(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("ManagedOwnerAddress(%s)", w.handleArg("ManagedOwnerAddress", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedOwnerAddress(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -798,7 +800,7 @@ func (w *WrapperVMHooks) ManagedOwnerAddress(destinationHandle int32) {
 
 // ManagedCaller VM hook wrapper
 func (w *WrapperVMHooks) ManagedCaller(destinationHandle int32) {
-	callInfo := fmt.Sprintf("ManagedCaller(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("ManagedCaller(%s)", w.handleArg("ManagedCaller", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedCaller(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -806,7 +808,7 @@ func (w *WrapperVMHooks) ManagedCaller(destinationHandle int32) {
 
 // ManagedGetOriginalCallerAddr VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetOriginalCallerAddr(destinationHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetOriginalCallerAddr(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("ManagedGetOriginalCallerAddr(%s)", w.handleArg("ManagedGetOriginalCallerAddr", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetOriginalCallerAddr(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -814,7 +816,7 @@ func (w *WrapperVMHooks) ManagedGetOriginalCallerAddr(destinationHandle int32) {
 
 // ManagedGetRelayerAddr VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetRelayerAddr(destinationHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetRelayerAddr(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("ManagedGetRelayerAddr(%s)", w.handleArg("ManagedGetRelayerAddr", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetRelayerAddr(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -822,7 +824,7 @@ func (w *WrapperVMHooks) ManagedGetRelayerAddr(destinationHandle int32) {
 
 // ManagedSignalError VM hook wrapper
 func (w *WrapperVMHooks) ManagedSignalError(errHandle int32) {
-	callInfo := fmt.Sprintf("ManagedSignalError(%d)", errHandle)
+	callInfo := fmt.Sprintf("ManagedSignalError(%s)", w.handleArg("ManagedSignalError", "errHandle", executor.ManagedBufferHandleArg, errHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedSignalError(errHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -830,7 +832,7 @@ func (w *WrapperVMHooks) ManagedSignalError(errHandle int32) {
 
 // ManagedWriteLog VM hook wrapper
 func (w *WrapperVMHooks) ManagedWriteLog(topicsHandle int32, dataHandle int32) {
-	callInfo := fmt.Sprintf("ManagedWriteLog(%d, %d)", topicsHandle, dataHandle)
+	callInfo := fmt.Sprintf("ManagedWriteLog(%s, %s)", w.handleArg("ManagedWriteLog", "topicsHandle", executor.ManagedBufferHandleArg, topicsHandle, true), w.handleArg("ManagedWriteLog", "dataHandle", executor.ManagedBufferHandleArg, dataHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedWriteLog(topicsHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -838,7 +840,7 @@ func (w *WrapperVMHooks) ManagedWriteLog(topicsHandle int32, dataHandle int32) {
 
 // ManagedGetOriginalTxHash VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetOriginalTxHash(resultHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetOriginalTxHash(%d)", resultHandle)
+	callInfo := fmt.Sprintf("ManagedGetOriginalTxHash(%s)", w.handleArg("ManagedGetOriginalTxHash", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetOriginalTxHash(resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -846,7 +848,7 @@ func (w *WrapperVMHooks) ManagedGetOriginalTxHash(resultHandle int32) {
 
 // ManagedGetStateRootHash VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetStateRootHash(resultHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetStateRootHash(%d)", resultHandle)
+	callInfo := fmt.Sprintf("ManagedGetStateRootHash(%s)", w.handleArg("ManagedGetStateRootHash", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetStateRootHash(resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -854,7 +856,7 @@ func (w *WrapperVMHooks) ManagedGetStateRootHash(resultHandle int32) {
 
 // ManagedGetBlockRandomSeed VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetBlockRandomSeed(resultHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetBlockRandomSeed(%d)", resultHandle)
+	callInfo := fmt.Sprintf("ManagedGetBlockRandomSeed(%s)", w.handleArg("ManagedGetBlockRandomSeed", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetBlockRandomSeed(resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -862,7 +864,7 @@ func (w *WrapperVMHooks) ManagedGetBlockRandomSeed(resultHandle int32) {
 
 // ManagedGetPrevBlockRandomSeed VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetPrevBlockRandomSeed(resultHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetPrevBlockRandomSeed(%d)", resultHandle)
+	callInfo := fmt.Sprintf("ManagedGetPrevBlockRandomSeed(%s)", w.handleArg("ManagedGetPrevBlockRandomSeed", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetPrevBlockRandomSeed(resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -870,7 +872,7 @@ func (w *WrapperVMHooks) ManagedGetPrevBlockRandomSeed(resultHandle int32) {
 
 // ManagedGetReturnData VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetReturnData(resultID int32, resultHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetReturnData(%d, %d)", resultID, resultHandle)
+	callInfo := fmt.Sprintf("ManagedGetReturnData(%d, %s)", resultID, w.handleArg("ManagedGetReturnData", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetReturnData(resultID, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -878,7 +880,7 @@ func (w *WrapperVMHooks) ManagedGetReturnData(resultID int32, resultHandle int32
 
 // ManagedGetMultiESDTCallValue VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetMultiESDTCallValue(multiCallValueHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetMultiESDTCallValue(%d)", multiCallValueHandle)
+	callInfo := fmt.Sprintf("ManagedGetMultiESDTCallValue(%s)", w.handleArg("ManagedGetMultiESDTCallValue", "multiCallValueHandle", executor.ManagedBufferHandleArg, multiCallValueHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetMultiESDTCallValue(multiCallValueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -886,7 +888,7 @@ func (w *WrapperVMHooks) ManagedGetMultiESDTCallValue(multiCallValueHandle int32
 
 // ManagedGetAllTransfersCallValue VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetAllTransfersCallValue(transferCallValuesListHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetAllTransfersCallValue(%d)", transferCallValuesListHandle)
+	callInfo := fmt.Sprintf("ManagedGetAllTransfersCallValue(%s)", w.handleArg("ManagedGetAllTransfersCallValue", "transferCallValuesListHandle", executor.ManagedBufferHandleArg, transferCallValuesListHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetAllTransfersCallValue(transferCallValuesListHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -894,7 +896,7 @@ func (w *WrapperVMHooks) ManagedGetAllTransfersCallValue(transferCallValuesListH
 
 // ManagedGetBackTransfers VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetBackTransfers(esdtTransfersValueHandle int32, egldValueHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetBackTransfers(%d, %d)", esdtTransfersValueHandle, egldValueHandle)
+	callInfo := fmt.Sprintf("ManagedGetBackTransfers(%s, %s)", w.handleArg("ManagedGetBackTransfers", "esdtTransfersValueHandle", executor.ManagedBufferHandleArg, esdtTransfersValueHandle, false), w.handleArg("ManagedGetBackTransfers", "egldValueHandle", executor.BigIntHandleArg, egldValueHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetBackTransfers(esdtTransfersValueHandle, egldValueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -902,7 +904,7 @@ func (w *WrapperVMHooks) ManagedGetBackTransfers(esdtTransfersValueHandle int32,
 
 // ManagedGetESDTBalance VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetESDTBalance(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetESDTBalance(%d, %d, %d, %d)", addressHandle, tokenIDHandle, nonce, valueHandle)
+	callInfo := fmt.Sprintf("ManagedGetESDTBalance(%s, %s, %d, %s)", w.handleArg("ManagedGetESDTBalance", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedGetESDTBalance", "tokenIDHandle", executor.ManagedBufferHandleArg, tokenIDHandle, true), nonce, w.handleArg("ManagedGetESDTBalance", "valueHandle", executor.BigIntHandleArg, valueHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetESDTBalance(addressHandle, tokenIDHandle, nonce, valueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -910,7 +912,7 @@ func (w *WrapperVMHooks) ManagedGetESDTBalance(addressHandle int32, tokenIDHandl
 
 // ManagedGetESDTTokenData VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetESDTTokenData(addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32, propertiesHandle int32, hashHandle int32, nameHandle int32, attributesHandle int32, creatorHandle int32, royaltiesHandle int32, urisHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetESDTTokenData(%d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d)", addressHandle, tokenIDHandle, nonce, valueHandle, propertiesHandle, hashHandle, nameHandle, attributesHandle, creatorHandle, royaltiesHandle, urisHandle)
+	callInfo := fmt.Sprintf("ManagedGetESDTTokenData(%s, %s, %d, %s, %s, %s, %s, %s, %s, %s, %s)", w.handleArg("ManagedGetESDTTokenData", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedGetESDTTokenData", "tokenIDHandle", executor.ManagedBufferHandleArg, tokenIDHandle, true), nonce, w.handleArg("ManagedGetESDTTokenData", "valueHandle", executor.BigIntHandleArg, valueHandle, false), w.handleArg("ManagedGetESDTTokenData", "propertiesHandle", executor.ManagedBufferHandleArg, propertiesHandle, false), w.handleArg("ManagedGetESDTTokenData", "hashHandle", executor.ManagedBufferHandleArg, hashHandle, false), w.handleArg("ManagedGetESDTTokenData", "nameHandle", executor.ManagedBufferHandleArg, nameHandle, false), w.handleArg("ManagedGetESDTTokenData", "attributesHandle", executor.ManagedBufferHandleArg, attributesHandle, false), w.handleArg("ManagedGetESDTTokenData", "creatorHandle", executor.ManagedBufferHandleArg, creatorHandle, false), w.handleArg("ManagedGetESDTTokenData", "royaltiesHandle", executor.BigIntHandleArg, royaltiesHandle, false), w.handleArg("ManagedGetESDTTokenData", "urisHandle", executor.ManagedBufferHandleArg, urisHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetESDTTokenData(addressHandle, tokenIDHandle, nonce, valueHandle, propertiesHandle, hashHandle, nameHandle, attributesHandle, creatorHandle, royaltiesHandle, urisHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -918,7 +920,7 @@ func (w *WrapperVMHooks) ManagedGetESDTTokenData(addressHandle int32, tokenIDHan
 
 // ManagedGetESDTTokenType VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetESDTTokenType(addressHandle int32, tokenIDHandle int32, nonce int64, typeHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetESDTTokenType(%d, %d, %d, %d)", addressHandle, tokenIDHandle, nonce, typeHandle)
+	callInfo := fmt.Sprintf("ManagedGetESDTTokenType(%s, %s, %d, %s)", w.handleArg("ManagedGetESDTTokenType", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedGetESDTTokenType", "tokenIDHandle", executor.ManagedBufferHandleArg, tokenIDHandle, true), nonce, w.handleArg("ManagedGetESDTTokenType", "typeHandle", executor.BigIntHandleArg, typeHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetESDTTokenType(addressHandle, tokenIDHandle, nonce, typeHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -926,7 +928,7 @@ func (w *WrapperVMHooks) ManagedGetESDTTokenType(addressHandle int32, tokenIDHan
 
 // ManagedAsyncCall VM hook wrapper
 func (w *WrapperVMHooks) ManagedAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32) {
-	callInfo := fmt.Sprintf("ManagedAsyncCall(%d, %d, %d, %d)", destHandle, valueHandle, functionHandle, argumentsHandle)
+	callInfo := fmt.Sprintf("ManagedAsyncCall(%s, %s, %s, %s)", w.handleArg("ManagedAsyncCall", "destHandle", executor.ManagedBufferHandleArg, destHandle, true), w.handleArg("ManagedAsyncCall", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedAsyncCall", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedAsyncCall", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -934,7 +936,7 @@ func (w *WrapperVMHooks) ManagedAsyncCall(destHandle int32, valueHandle int32, f
 
 // ManagedCreateAsyncCall VM hook wrapper
 func (w *WrapperVMHooks) ManagedCreateAsyncCall(destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, successOffset executor.MemPtr, successLength executor.MemLength, errorOffset executor.MemPtr, errorLength executor.MemLength, gas int64, extraGasForCallback int64, callbackClosureHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedCreateAsyncCall(%d, %d, %d, %d, %d, %d, %d, %d, %d, %d, %d)", destHandle, valueHandle, functionHandle, argumentsHandle, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, callbackClosureHandle)
+	callInfo := fmt.Sprintf("ManagedCreateAsyncCall(%s, %s, %s, %s, %d, %d, %d, %d, %d, %d, %s)", w.handleArg("ManagedCreateAsyncCall", "destHandle", executor.ManagedBufferHandleArg, destHandle, true), w.handleArg("ManagedCreateAsyncCall", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedCreateAsyncCall", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedCreateAsyncCall", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, w.handleArg("ManagedCreateAsyncCall", "callbackClosureHandle", executor.ManagedBufferHandleArg, callbackClosureHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedCreateAsyncCall(destHandle, valueHandle, functionHandle, argumentsHandle, successOffset, successLength, errorOffset, errorLength, gas, extraGasForCallback, callbackClosureHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -943,7 +945,7 @@ func (w *WrapperVMHooks) ManagedCreateAsyncCall(destHandle int32, valueHandle in
 
 // ManagedGetCallbackClosure VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetCallbackClosure(callbackClosureHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetCallbackClosure(%d)", callbackClosureHandle)
+	callInfo := fmt.Sprintf("ManagedGetCallbackClosure(%s)", w.handleArg("ManagedGetCallbackClosure", "callbackClosureHandle", executor.ManagedBufferHandleArg, callbackClosureHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetCallbackClosure(callbackClosureHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -951,7 +953,7 @@ func (w *WrapperVMHooks) ManagedGetCallbackClosure(callbackClosureHandle int32)
 
 // ManagedUpgradeFromSourceContract VM hook wrapper
 func (w *WrapperVMHooks) ManagedUpgradeFromSourceContract(destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
-	callInfo := fmt.Sprintf("ManagedUpgradeFromSourceContract(%d, %d, %d, %d, %d, %d, %d)", destHandle, gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedUpgradeFromSourceContract(%s, %d, %s, %s, %s, %s, %s)", w.handleArg("ManagedUpgradeFromSourceContract", "destHandle", executor.ManagedBufferHandleArg, destHandle, true), gas, w.handleArg("ManagedUpgradeFromSourceContract", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedUpgradeFromSourceContract", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedUpgradeFromSourceContract", "codeMetadataHandle", executor.ManagedBufferHandleArg, codeMetadataHandle, true), w.handleArg("ManagedUpgradeFromSourceContract", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedUpgradeFromSourceContract", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedUpgradeFromSourceContract(destHandle, gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -959,7 +961,7 @@ func (w *WrapperVMHooks) ManagedUpgradeFromSourceContract(destHandle int32, gas
 
 // ManagedUpgradeContract VM hook wrapper
 func (w *WrapperVMHooks) ManagedUpgradeContract(destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
-	callInfo := fmt.Sprintf("ManagedUpgradeContract(%d, %d, %d, %d, %d, %d, %d)", destHandle, gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedUpgradeContract(%s, %d, %s, %s, %s, %s, %s)", w.handleArg("ManagedUpgradeContract", "destHandle", executor.ManagedBufferHandleArg, destHandle, true), gas, w.handleArg("ManagedUpgradeContract", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedUpgradeContract", "codeHandle", executor.ManagedBufferHandleArg, codeHandle, true), w.handleArg("ManagedUpgradeContract", "codeMetadataHandle", executor.ManagedBufferHandleArg, codeMetadataHandle, true), w.handleArg("ManagedUpgradeContract", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedUpgradeContract", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedUpgradeContract(destHandle, gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -967,7 +969,7 @@ func (w *WrapperVMHooks) ManagedUpgradeContract(destHandle int32, gas int64, val
 
 // ManagedDeleteContract VM hook wrapper
 func (w *WrapperVMHooks) ManagedDeleteContract(destHandle int32, gasLimit int64, argumentsHandle int32) {
-	callInfo := fmt.Sprintf("ManagedDeleteContract(%d, %d, %d)", destHandle, gasLimit, argumentsHandle)
+	callInfo := fmt.Sprintf("ManagedDeleteContract(%s, %d, %s)", w.handleArg("ManagedDeleteContract", "destHandle", executor.ManagedBufferHandleArg, destHandle, true), gasLimit, w.handleArg("ManagedDeleteContract", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedDeleteContract(destHandle, gasLimit, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -975,7 +977,7 @@ func (w *WrapperVMHooks) ManagedDeleteContract(destHandle int32, gasLimit int64,
 
 // ManagedDeployFromSourceContract VM hook wrapper
 func (w *WrapperVMHooks) ManagedDeployFromSourceContract(gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedDeployFromSourceContract(%d, %d, %d, %d, %d, %d, %d)", gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedDeployFromSourceContract(%d, %s, %s, %s, %s, %s, %s)", gas, w.handleArg("ManagedDeployFromSourceContract", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedDeployFromSourceContract", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedDeployFromSourceContract", "codeMetadataHandle", executor.ManagedBufferHandleArg, codeMetadataHandle, true), w.handleArg("ManagedDeployFromSourceContract", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedDeployFromSourceContract", "resultAddressHandle", executor.ManagedBufferHandleArg, resultAddressHandle, false), w.handleArg("ManagedDeployFromSourceContract", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedDeployFromSourceContract(gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -984,7 +986,7 @@ func (w *WrapperVMHooks) ManagedDeployFromSourceContract(gas int64, valueHandle
 
 // ManagedCreateContract VM hook wrapper
 func (w *WrapperVMHooks) ManagedCreateContract(gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedCreateContract(%d, %d, %d, %d, %d, %d, %d)", gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedCreateContract(%d, %s, %s, %s, %s, %s, %s)", gas, w.handleArg("ManagedCreateContract", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedCreateContract", "codeHandle", executor.ManagedBufferHandleArg, codeHandle, true), w.handleArg("ManagedCreateContract", "codeMetadataHandle", executor.ManagedBufferHandleArg, codeMetadataHandle, true), w.handleArg("ManagedCreateContract", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedCreateContract", "resultAddressHandle", executor.ManagedBufferHandleArg, resultAddressHandle, false), w.handleArg("ManagedCreateContract", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedCreateContract(gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -993,7 +995,7 @@ func (w *WrapperVMHooks) ManagedCreateContract(gas int64, valueHandle int32, cod
 
 // ManagedExecuteReadOnly VM hook wrapper
 func (w *WrapperVMHooks) ManagedExecuteReadOnly(gas int64, addressHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedExecuteReadOnly(%d, %d, %d, %d, %d)", gas, addressHandle, functionHandle, argumentsHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedExecuteReadOnly(%d, %s, %s, %s, %s)", gas, w.handleArg("ManagedExecuteReadOnly", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedExecuteReadOnly", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedExecuteReadOnly", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedExecuteReadOnly", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedExecuteReadOnly(gas, addressHandle, functionHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1002,7 +1004,7 @@ func (w *WrapperVMHooks) ManagedExecuteReadOnly(gas int64, addressHandle int32,
 
 // ManagedExecuteOnSameContext VM hook wrapper
 func (w *WrapperVMHooks) ManagedExecuteOnSameContext(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedExecuteOnSameContext(%d, %d, %d, %d, %d, %d)", gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedExecuteOnSameContext(%d, %s, %s, %s, %s, %s)", gas, w.handleArg("ManagedExecuteOnSameContext", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedExecuteOnSameContext", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedExecuteOnSameContext", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedExecuteOnSameContext", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedExecuteOnSameContext", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedExecuteOnSameContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1011,7 +1013,7 @@ func (w *WrapperVMHooks) ManagedExecuteOnSameContext(gas int64, addressHandle in
 
 // ManagedExecuteOnDestContext VM hook wrapper
 func (w *WrapperVMHooks) ManagedExecuteOnDestContext(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedExecuteOnDestContext(%d, %d, %d, %d, %d, %d)", gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedExecuteOnDestContext(%d, %s, %s, %s, %s, %s)", gas, w.handleArg("ManagedExecuteOnDestContext", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedExecuteOnDestContext", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedExecuteOnDestContext", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedExecuteOnDestContext", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedExecuteOnDestContext", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedExecuteOnDestContext(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1020,7 +1022,7 @@ func (w *WrapperVMHooks) ManagedExecuteOnDestContext(gas int64, addressHandle in
 
 // ManagedExecuteOnDestContextWithErrorReturn VM hook wrapper
 func (w *WrapperVMHooks) ManagedExecuteOnDestContextWithErrorReturn(gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedExecuteOnDestContextWithErrorReturn(%d, %d, %d, %d, %d, %d)", gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedExecuteOnDestContextWithErrorReturn(%d, %s, %s, %s, %s, %s)", gas, w.handleArg("ManagedExecuteOnDestContextWithErrorReturn", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedExecuteOnDestContextWithErrorReturn", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedExecuteOnDestContextWithErrorReturn", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedExecuteOnDestContextWithErrorReturn", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedExecuteOnDestContextWithErrorReturn", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedExecuteOnDestContextWithErrorReturn(gas, addressHandle, valueHandle, functionHandle, argumentsHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1029,7 +1031,7 @@ func (w *WrapperVMHooks) ManagedExecuteOnDestContextWithErrorReturn(gas int64, a
 
 // ManagedMultiTransferESDTNFTExecute VM hook wrapper
 func (w *WrapperVMHooks) ManagedMultiTransferESDTNFTExecute(dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedMultiTransferESDTNFTExecute(%d, %d, %d, %d, %d)", dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
+	callInfo := fmt.Sprintf("ManagedMultiTransferESDTNFTExecute(%s, %s, %d, %s, %s)", w.handleArg("ManagedMultiTransferESDTNFTExecute", "dstHandle", executor.ManagedBufferHandleArg, dstHandle, true), w.handleArg("ManagedMultiTransferESDTNFTExecute", "tokenTransfersHandle", executor.ManagedBufferHandleArg, tokenTransfersHandle, true), gasLimit, w.handleArg("ManagedMultiTransferESDTNFTExecute", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedMultiTransferESDTNFTExecute", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMultiTransferESDTNFTExecute(dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1038,7 +1040,7 @@ func (w *WrapperVMHooks) ManagedMultiTransferESDTNFTExecute(dstHandle int32, tok
 
 // ManagedMultiTransferESDTNFTExecuteWithReturn VM hook wrapper
 func (w *WrapperVMHooks) ManagedMultiTransferESDTNFTExecuteWithReturn(dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedMultiTransferESDTNFTExecuteWithReturn(%d, %d, %d, %d, %d)", dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
+	callInfo := fmt.Sprintf("ManagedMultiTransferESDTNFTExecuteWithReturn(%s, %s, %d, %s, %s)", w.handleArg("ManagedMultiTransferESDTNFTExecuteWithReturn", "dstHandle", executor.ManagedBufferHandleArg, dstHandle, true), w.handleArg("ManagedMultiTransferESDTNFTExecuteWithReturn", "tokenTransfersHandle", executor.ManagedBufferHandleArg, tokenTransfersHandle, true), gasLimit, w.handleArg("ManagedMultiTransferESDTNFTExecuteWithReturn", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedMultiTransferESDTNFTExecuteWithReturn", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMultiTransferESDTNFTExecuteWithReturn(dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1047,7 +1049,7 @@ func (w *WrapperVMHooks) ManagedMultiTransferESDTNFTExecuteWithReturn(dstHandle
 
 // ManagedMultiTransferESDTNFTExecuteByUser VM hook wrapper
 func (w *WrapperVMHooks) ManagedMultiTransferESDTNFTExecuteByUser(userHandle int32, dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedMultiTransferESDTNFTExecuteByUser(%d, %d, %d, %d, %d, %d)", userHandle, dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
+	callInfo := fmt.Sprintf("ManagedMultiTransferESDTNFTExecuteByUser(%s, %s, %s, %d, %s, %s)", w.handleArg("ManagedMultiTransferESDTNFTExecuteByUser", "userHandle", executor.ManagedBufferHandleArg, userHandle, true), w.handleArg("ManagedMultiTransferESDTNFTExecuteByUser", "dstHandle", executor.ManagedBufferHandleArg, dstHandle, true), w.handleArg("ManagedMultiTransferESDTNFTExecuteByUser", "tokenTransfersHandle", executor.ManagedBufferHandleArg, tokenTransfersHandle, true), gasLimit, w.handleArg("ManagedMultiTransferESDTNFTExecuteByUser", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedMultiTransferESDTNFTExecuteByUser", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMultiTransferESDTNFTExecuteByUser(userHandle, dstHandle, tokenTransfersHandle, gasLimit, functionHandle, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1056,16 +1058,61 @@ func (w *WrapperVMHooks) ManagedMultiTransferESDTNFTExecuteByUser(userHandle int
 
 // ManagedTransferValueExecute VM hook wrapper
 func (w *WrapperVMHooks) ManagedTransferValueExecute(dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedTransferValueExecute(%d, %d, %d, %d, %d)", dstHandle, valueHandle, gasLimit, functionHandle, argumentsHandle)
+	callInfo := fmt.Sprintf("ManagedTransferValueExecute(%s, %s, %d, %s, %s)", w.handleArg("ManagedTransferValueExecute", "dstHandle", executor.ManagedBufferHandleArg, dstHandle, true), w.handleArg("ManagedTransferValueExecute", "valueHandle", executor.BigIntHandleArg, valueHandle, true), gasLimit, w.handleArg("ManagedTransferValueExecute", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedTransferValueExecute", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedTransferValueExecute(dstHandle, valueHandle, gasLimit, functionHandle, argumentsHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
 	return result
 }
 
+// ManagedCreateContractWithErrorReturn VM hook wrapper
+func (w *WrapperVMHooks) ManagedCreateContractWithErrorReturn(gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedCreateContractWithErrorReturn(%d, %s, %s, %s, %s, %s, %s, %s, %s)", gas, w.handleArg("ManagedCreateContractWithErrorReturn", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedCreateContractWithErrorReturn", "codeHandle", executor.ManagedBufferHandleArg, codeHandle, true), w.handleArg("ManagedCreateContractWithErrorReturn", "codeMetadataHandle", executor.ManagedBufferHandleArg, codeMetadataHandle, true), w.handleArg("ManagedCreateContractWithErrorReturn", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedCreateContractWithErrorReturn", "resultAddressHandle", executor.ManagedBufferHandleArg, resultAddressHandle, false), w.handleArg("ManagedCreateContractWithErrorReturn", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false), w.handleArg("ManagedCreateContractWithErrorReturn", "errorCodeHandle", executor.ManagedBufferHandleArg, errorCodeHandle, false), w.handleArg("ManagedCreateContractWithErrorReturn", "errorMessageHandle", executor.ManagedBufferHandleArg, errorMessageHandle, false))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedCreateContractWithErrorReturn(gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedDeployFromSourceContractWithErrorReturn VM hook wrapper
+func (w *WrapperVMHooks) ManagedDeployFromSourceContractWithErrorReturn(gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedDeployFromSourceContractWithErrorReturn(%d, %s, %s, %s, %s, %s, %s, %s, %s)", gas, w.handleArg("ManagedDeployFromSourceContractWithErrorReturn", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedDeployFromSourceContractWithErrorReturn", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedDeployFromSourceContractWithErrorReturn", "codeMetadataHandle", executor.ManagedBufferHandleArg, codeMetadataHandle, true), w.handleArg("ManagedDeployFromSourceContractWithErrorReturn", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedDeployFromSourceContractWithErrorReturn", "resultAddressHandle", executor.ManagedBufferHandleArg, resultAddressHandle, false), w.handleArg("ManagedDeployFromSourceContractWithErrorReturn", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false), w.handleArg("ManagedDeployFromSourceContractWithErrorReturn", "errorCodeHandle", executor.ManagedBufferHandleArg, errorCodeHandle, false), w.handleArg("ManagedDeployFromSourceContractWithErrorReturn", "errorMessageHandle", executor.ManagedBufferHandleArg, errorMessageHandle, false))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedDeployFromSourceContractWithErrorReturn(gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedUpgradeContractWithErrorReturn VM hook wrapper
+func (w *WrapperVMHooks) ManagedUpgradeContractWithErrorReturn(destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedUpgradeContractWithErrorReturn(%s, %d, %s, %s, %s, %s, %s, %s, %s)", w.handleArg("ManagedUpgradeContractWithErrorReturn", "destHandle", executor.ManagedBufferHandleArg, destHandle, true), gas, w.handleArg("ManagedUpgradeContractWithErrorReturn", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedUpgradeContractWithErrorReturn", "codeHandle", executor.ManagedBufferHandleArg, codeHandle, true), w.handleArg("ManagedUpgradeContractWithErrorReturn", "codeMetadataHandle", executor.ManagedBufferHandleArg, codeMetadataHandle, true), w.handleArg("ManagedUpgradeContractWithErrorReturn", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedUpgradeContractWithErrorReturn", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false), w.handleArg("ManagedUpgradeContractWithErrorReturn", "errorCodeHandle", executor.ManagedBufferHandleArg, errorCodeHandle, false), w.handleArg("ManagedUpgradeContractWithErrorReturn", "errorMessageHandle", executor.ManagedBufferHandleArg, errorMessageHandle, false))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedUpgradeContractWithErrorReturn(destHandle, gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedUpgradeFromSourceContractWithErrorReturn VM hook wrapper
+func (w *WrapperVMHooks) ManagedUpgradeFromSourceContractWithErrorReturn(destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedUpgradeFromSourceContractWithErrorReturn(%s, %d, %s, %s, %s, %s, %s, %s, %s)", w.handleArg("ManagedUpgradeFromSourceContractWithErrorReturn", "destHandle", executor.ManagedBufferHandleArg, destHandle, true), gas, w.handleArg("ManagedUpgradeFromSourceContractWithErrorReturn", "valueHandle", executor.BigIntHandleArg, valueHandle, true), w.handleArg("ManagedUpgradeFromSourceContractWithErrorReturn", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedUpgradeFromSourceContractWithErrorReturn", "codeMetadataHandle", executor.ManagedBufferHandleArg, codeMetadataHandle, true), w.handleArg("ManagedUpgradeFromSourceContractWithErrorReturn", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedUpgradeFromSourceContractWithErrorReturn", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false), w.handleArg("ManagedUpgradeFromSourceContractWithErrorReturn", "errorCodeHandle", executor.ManagedBufferHandleArg, errorCodeHandle, false), w.handleArg("ManagedUpgradeFromSourceContractWithErrorReturn", "errorMessageHandle", executor.ManagedBufferHandleArg, errorMessageHandle, false))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedUpgradeFromSourceContractWithErrorReturn(destHandle, gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedTransferValueExecuteWithErrorReturn VM hook wrapper
+func (w *WrapperVMHooks) ManagedTransferValueExecuteWithErrorReturn(dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedTransferValueExecuteWithErrorReturn(%s, %s, %d, %s, %s, %s, %s)", w.handleArg("ManagedTransferValueExecuteWithErrorReturn", "dstHandle", executor.ManagedBufferHandleArg, dstHandle, true), w.handleArg("ManagedTransferValueExecuteWithErrorReturn", "valueHandle", executor.BigIntHandleArg, valueHandle, true), gasLimit, w.handleArg("ManagedTransferValueExecuteWithErrorReturn", "functionHandle", executor.ManagedBufferHandleArg, functionHandle, true), w.handleArg("ManagedTransferValueExecuteWithErrorReturn", "argumentsHandle", executor.ManagedBufferHandleArg, argumentsHandle, true), w.handleArg("ManagedTransferValueExecuteWithErrorReturn", "errorCodeHandle", executor.ManagedBufferHandleArg, errorCodeHandle, false), w.handleArg("ManagedTransferValueExecuteWithErrorReturn", "errorMessageHandle", executor.ManagedBufferHandleArg, errorMessageHandle, false))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedTransferValueExecuteWithErrorReturn(dstHandle, valueHandle, gasLimit, functionHandle, argumentsHandle, errorCodeHandle, errorMessageHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
 // ManagedIsESDTFrozen VM hook wrapper
 func (w *WrapperVMHooks) ManagedIsESDTFrozen(addressHandle int32, tokenIDHandle int32, nonce int64) int32 {
-	callInfo := fmt.Sprintf("ManagedIsESDTFrozen(%d, %d, %d)", addressHandle, tokenIDHandle, nonce)
+	callInfo := fmt.Sprintf("ManagedIsESDTFrozen(%s, %s, %d)", w.handleArg("ManagedIsESDTFrozen", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedIsESDTFrozen", "tokenIDHandle", executor.ManagedBufferHandleArg, tokenIDHandle, true), nonce)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedIsESDTFrozen(addressHandle, tokenIDHandle, nonce)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1074,7 +1121,7 @@ func (w *WrapperVMHooks) ManagedIsESDTFrozen(addressHandle int32, tokenIDHandle
 
 // ManagedIsESDTLimitedTransfer VM hook wrapper
 func (w *WrapperVMHooks) ManagedIsESDTLimitedTransfer(tokenIDHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedIsESDTLimitedTransfer(%d)", tokenIDHandle)
+	callInfo := fmt.Sprintf("ManagedIsESDTLimitedTransfer(%s)", w.handleArg("ManagedIsESDTLimitedTransfer", "tokenIDHandle", executor.ManagedBufferHandleArg, tokenIDHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedIsESDTLimitedTransfer(tokenIDHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1083,7 +1130,7 @@ func (w *WrapperVMHooks) ManagedIsESDTLimitedTransfer(tokenIDHandle int32) int32
 
 // ManagedIsESDTPaused VM hook wrapper
 func (w *WrapperVMHooks) ManagedIsESDTPaused(tokenIDHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedIsESDTPaused(%d)", tokenIDHandle)
+	callInfo := fmt.Sprintf("ManagedIsESDTPaused(%s)", w.handleArg("ManagedIsESDTPaused", "tokenIDHandle", executor.ManagedBufferHandleArg, tokenIDHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedIsESDTPaused(tokenIDHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1092,7 +1139,7 @@ func (w *WrapperVMHooks) ManagedIsESDTPaused(tokenIDHandle int32) int32 {
 
 // ManagedBufferToHex VM hook wrapper
 func (w *WrapperVMHooks) ManagedBufferToHex(sourceHandle int32, destHandle int32) {
-	callInfo := fmt.Sprintf("ManagedBufferToHex(%d, %d)", sourceHandle, destHandle)
+	callInfo := fmt.Sprintf("ManagedBufferToHex(%s, %s)", w.handleArg("ManagedBufferToHex", "sourceHandle", executor.ManagedBufferHandleArg, sourceHandle, true), w.handleArg("ManagedBufferToHex", "destHandle", executor.ManagedBufferHandleArg, destHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedBufferToHex(sourceHandle, destHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1100,7 +1147,7 @@ func (w *WrapperVMHooks) ManagedBufferToHex(sourceHandle int32, destHandle int32
 
 // ManagedGetCodeMetadata VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetCodeMetadata(addressHandle int32, responseHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetCodeMetadata(%d, %d)", addressHandle, responseHandle)
+	callInfo := fmt.Sprintf("ManagedGetCodeMetadata(%s, %s)", w.handleArg("ManagedGetCodeMetadata", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedGetCodeMetadata", "responseHandle", executor.ManagedBufferHandleArg, responseHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetCodeMetadata(addressHandle, responseHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1108,7 +1155,7 @@ func (w *WrapperVMHooks) ManagedGetCodeMetadata(addressHandle int32, responseHan
 
 // ManagedGetCodeHash VM hook wrapper
 func (w *WrapperVMHooks) ManagedGetCodeHash(addressHandle int32, codeHashHandle int32) {
-	callInfo := fmt.Sprintf("ManagedGetCodeHash(%d, %d)", addressHandle, codeHashHandle)
+	callInfo := fmt.Sprintf("ManagedGetCodeHash(%s, %s)", w.handleArg("ManagedGetCodeHash", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("ManagedGetCodeHash", "codeHashHandle", executor.ManagedBufferHandleArg, codeHashHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.ManagedGetCodeHash(addressHandle, codeHashHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1116,7 +1163,7 @@ func (w *WrapperVMHooks) ManagedGetCodeHash(addressHandle int32, codeHashHandle
 
 // ManagedIsBuiltinFunction VM hook wrapper
 func (w *WrapperVMHooks) ManagedIsBuiltinFunction(functionNameHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedIsBuiltinFunction(%d)", functionNameHandle)
+	callInfo := fmt.Sprintf("ManagedIsBuiltinFunction(%s)", w.handleArg("ManagedIsBuiltinFunction", "functionNameHandle", executor.ManagedBufferHandleArg, functionNameHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedIsBuiltinFunction(functionNameHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1128,7 +1175,7 @@ func (w *WrapperVMHooks) BigFloatNewFromParts(integralPart int32, fractionalPart
 	callInfo := fmt.Sprintf("BigFloatNewFromParts(%d, %d, %d)", integralPart, fractionalPart, exponent)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatNewFromParts(integralPart, fractionalPart, exponent)
-	w.logger.LogVMHookCallAfter(callInfo)
+	w.logger.LogVMHookCallAfter(callInfo + w.handleResult(executor.BigFloatHandleArg, result))
 	return result
 }
 
@@ -1137,7 +1184,7 @@ func (w *WrapperVMHooks) BigFloatNewFromFrac(numerator int64, denominator int64)
 	callInfo := fmt.Sprintf("BigFloatNewFromFrac(%d, %d)", numerator, denominator)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatNewFromFrac(numerator, denominator)
-	w.logger.LogVMHookCallAfter(callInfo)
+	w.logger.LogVMHookCallAfter(callInfo + w.handleResult(executor.BigFloatHandleArg, result))
 	return result
 }
 
@@ -1146,13 +1193,13 @@ func (w *WrapperVMHooks) BigFloatNewFromSci(significand int64, exponent int64) i
 	callInfo := fmt.Sprintf("BigFloatNewFromSci(%d, %d)", significand, exponent)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatNewFromSci(significand, exponent)
-	w.logger.LogVMHookCallAfter(callInfo)
+	w.logger.LogVMHookCallAfter(callInfo + w.handleResult(executor.BigFloatHandleArg, result))
 	return result
 }
 
 // BigFloatAdd VM hook wrapper
 func (w *WrapperVMHooks) BigFloatAdd(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigFloatAdd(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigFloatAdd(%s, %s, %s)", w.handleArg("BigFloatAdd", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatAdd", "op1Handle", executor.BigFloatHandleArg, op1Handle, true), w.handleArg("BigFloatAdd", "op2Handle", executor.BigFloatHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatAdd(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1160,7 +1207,7 @@ func (w *WrapperVMHooks) BigFloatAdd(destinationHandle int32, op1Handle int32, o
 
 // BigFloatSub VM hook wrapper
 func (w *WrapperVMHooks) BigFloatSub(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigFloatSub(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigFloatSub(%s, %s, %s)", w.handleArg("BigFloatSub", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatSub", "op1Handle", executor.BigFloatHandleArg, op1Handle, true), w.handleArg("BigFloatSub", "op2Handle", executor.BigFloatHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatSub(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1168,7 +1215,7 @@ func (w *WrapperVMHooks) BigFloatSub(destinationHandle int32, op1Handle int32, o
 
 // BigFloatMul VM hook wrapper
 func (w *WrapperVMHooks) BigFloatMul(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigFloatMul(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigFloatMul(%s, %s, %s)", w.handleArg("BigFloatMul", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatMul", "op1Handle", executor.BigFloatHandleArg, op1Handle, true), w.handleArg("BigFloatMul", "op2Handle", executor.BigFloatHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatMul(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1176,7 +1223,7 @@ func (w *WrapperVMHooks) BigFloatMul(destinationHandle int32, op1Handle int32, o
 
 // BigFloatDiv VM hook wrapper
 func (w *WrapperVMHooks) BigFloatDiv(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigFloatDiv(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigFloatDiv(%s, %s, %s)", w.handleArg("BigFloatDiv", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatDiv", "op1Handle", executor.BigFloatHandleArg, op1Handle, true), w.handleArg("BigFloatDiv", "op2Handle", executor.BigFloatHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatDiv(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1184,7 +1231,7 @@ func (w *WrapperVMHooks) BigFloatDiv(destinationHandle int32, op1Handle int32, o
 
 // BigFloatNeg VM hook wrapper
 func (w *WrapperVMHooks) BigFloatNeg(destinationHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatNeg(%d, %d)", destinationHandle, opHandle)
+	callInfo := fmt.Sprintf("BigFloatNeg(%s, %s)", w.handleArg("BigFloatNeg", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatNeg", "opHandle", executor.BigFloatHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatNeg(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1192,7 +1239,7 @@ func (w *WrapperVMHooks) BigFloatNeg(destinationHandle int32, opHandle int32) {
 
 // BigFloatClone VM hook wrapper
 func (w *WrapperVMHooks) BigFloatClone(destinationHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatClone(%d, %d)", destinationHandle, opHandle)
+	callInfo := fmt.Sprintf("BigFloatClone(%s, %s)", w.handleArg("BigFloatClone", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatClone", "opHandle", executor.BigFloatHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatClone(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1200,7 +1247,7 @@ func (w *WrapperVMHooks) BigFloatClone(destinationHandle int32, opHandle int32)
 
 // BigFloatCmp VM hook wrapper
 func (w *WrapperVMHooks) BigFloatCmp(op1Handle int32, op2Handle int32) int32 {
-	callInfo := fmt.Sprintf("BigFloatCmp(%d, %d)", op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigFloatCmp(%s, %s)", w.handleArg("BigFloatCmp", "op1Handle", executor.BigFloatHandleArg, op1Handle, true), w.handleArg("BigFloatCmp", "op2Handle", executor.BigFloatHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatCmp(op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1209,7 +1256,7 @@ func (w *WrapperVMHooks) BigFloatCmp(op1Handle int32, op2Handle int32) int32 {
 
 // BigFloatAbs VM hook wrapper
 func (w *WrapperVMHooks) BigFloatAbs(destinationHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatAbs(%d, %d)", destinationHandle, opHandle)
+	callInfo := fmt.Sprintf("BigFloatAbs(%s, %s)", w.handleArg("BigFloatAbs", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatAbs", "opHandle", executor.BigFloatHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatAbs(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1217,7 +1264,7 @@ func (w *WrapperVMHooks) BigFloatAbs(destinationHandle int32, opHandle int32) {
 
 // BigFloatSign VM hook wrapper
 func (w *WrapperVMHooks) BigFloatSign(opHandle int32) int32 {
-	callInfo := fmt.Sprintf("BigFloatSign(%d)", opHandle)
+	callInfo := fmt.Sprintf("BigFloatSign(%s)", w.handleArg("BigFloatSign", "opHandle", executor.BigFloatHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatSign(opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1226,7 +1273,7 @@ func (w *WrapperVMHooks) BigFloatSign(opHandle int32) int32 {
 
 // BigFloatSqrt VM hook wrapper
 func (w *WrapperVMHooks) BigFloatSqrt(destinationHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatSqrt(%d, %d)", destinationHandle, opHandle)
+	callInfo := fmt.Sprintf("BigFloatSqrt(%s, %s)", w.handleArg("BigFloatSqrt", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatSqrt", "opHandle", executor.BigFloatHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatSqrt(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1234,7 +1281,7 @@ func (w *WrapperVMHooks) BigFloatSqrt(destinationHandle int32, opHandle int32) {
 
 // BigFloatPow VM hook wrapper
 func (w *WrapperVMHooks) BigFloatPow(destinationHandle int32, opHandle int32, exponent int32) {
-	callInfo := fmt.Sprintf("BigFloatPow(%d, %d, %d)", destinationHandle, opHandle, exponent)
+	callInfo := fmt.Sprintf("BigFloatPow(%s, %s, %d)", w.handleArg("BigFloatPow", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatPow", "opHandle", executor.BigFloatHandleArg, opHandle, true), exponent)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatPow(destinationHandle, opHandle, exponent)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1242,7 +1289,7 @@ func (w *WrapperVMHooks) BigFloatPow(destinationHandle int32, opHandle int32, ex
 
 // BigFloatFloor VM hook wrapper
 func (w *WrapperVMHooks) BigFloatFloor(destBigIntHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatFloor(%d, %d)", destBigIntHandle, opHandle)
+	callInfo := fmt.Sprintf("BigFloatFloor(%s, %s)", w.handleArg("BigFloatFloor", "destBigIntHandle", executor.BigIntHandleArg, destBigIntHandle, false), w.handleArg("BigFloatFloor", "opHandle", executor.BigFloatHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatFloor(destBigIntHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1250,7 +1297,7 @@ func (w *WrapperVMHooks) BigFloatFloor(destBigIntHandle int32, opHandle int32) {
 
 // BigFloatCeil VM hook wrapper
 func (w *WrapperVMHooks) BigFloatCeil(destBigIntHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatCeil(%d, %d)", destBigIntHandle, opHandle)
+	callInfo := fmt.Sprintf("BigFloatCeil(%s, %s)", w.handleArg("BigFloatCeil", "destBigIntHandle", executor.BigIntHandleArg, destBigIntHandle, false), w.handleArg("BigFloatCeil", "opHandle", executor.BigFloatHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatCeil(destBigIntHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1258,7 +1305,7 @@ func (w *WrapperVMHooks) BigFloatCeil(destBigIntHandle int32, opHandle int32) {
 
 // BigFloatTruncate VM hook wrapper
 func (w *WrapperVMHooks) BigFloatTruncate(destBigIntHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatTruncate(%d, %d)", destBigIntHandle, opHandle)
+	callInfo := fmt.Sprintf("BigFloatTruncate(%s, %s)", w.handleArg("BigFloatTruncate", "destBigIntHandle", executor.BigIntHandleArg, destBigIntHandle, false), w.handleArg("BigFloatTruncate", "opHandle", executor.BigFloatHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatTruncate(destBigIntHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1266,7 +1313,7 @@ func (w *WrapperVMHooks) BigFloatTruncate(destBigIntHandle int32, opHandle int32
 
 // BigFloatSetInt64 VM hook wrapper
 func (w *WrapperVMHooks) BigFloatSetInt64(destinationHandle int32, value int64) {
-	callInfo := fmt.Sprintf("BigFloatSetInt64(%d, %d)", destinationHandle, value)
+	callInfo := fmt.Sprintf("BigFloatSetInt64(%s, %d)", w.handleArg("BigFloatSetInt64", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), value)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatSetInt64(destinationHandle, value)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1274,7 +1321,7 @@ func (w *WrapperVMHooks) BigFloatSetInt64(destinationHandle int32, value int64)
 
 // BigFloatIsInt VM hook wrapper
 func (w *WrapperVMHooks) BigFloatIsInt(opHandle int32) int32 {
-	callInfo := fmt.Sprintf("BigFloatIsInt(%d)", opHandle)
+	callInfo := fmt.Sprintf("BigFloatIsInt(%s)", w.handleArg("BigFloatIsInt", "opHandle", executor.BigFloatHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigFloatIsInt(opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1283,7 +1330,7 @@ func (w *WrapperVMHooks) BigFloatIsInt(opHandle int32) int32 {
 
 // BigFloatSetBigInt VM hook wrapper
 func (w *WrapperVMHooks) BigFloatSetBigInt(destinationHandle int32, bigIntHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatSetBigInt(%d, %d)", destinationHandle, bigIntHandle)
+	callInfo := fmt.Sprintf("BigFloatSetBigInt(%s, %s)", w.handleArg("BigFloatSetBigInt", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false), w.handleArg("BigFloatSetBigInt", "bigIntHandle", executor.BigIntHandleArg, bigIntHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatSetBigInt(destinationHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1291,7 +1338,7 @@ func (w *WrapperVMHooks) BigFloatSetBigInt(destinationHandle int32, bigIntHandle
 
 // BigFloatGetConstPi VM hook wrapper
 func (w *WrapperVMHooks) BigFloatGetConstPi(destinationHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatGetConstPi(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("BigFloatGetConstPi(%s)", w.handleArg("BigFloatGetConstPi", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatGetConstPi(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1299,7 +1346,7 @@ func (w *WrapperVMHooks) BigFloatGetConstPi(destinationHandle int32) {
 
 // BigFloatGetConstE VM hook wrapper
 func (w *WrapperVMHooks) BigFloatGetConstE(destinationHandle int32) {
-	callInfo := fmt.Sprintf("BigFloatGetConstE(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("BigFloatGetConstE(%s)", w.handleArg("BigFloatGetConstE", "destinationHandle", executor.BigFloatHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigFloatGetConstE(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1307,7 +1354,7 @@ func (w *WrapperVMHooks) BigFloatGetConstE(destinationHandle int32) {
 
 // BigIntGetUnsignedArgument VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetUnsignedArgument(id int32, destinationHandle int32) {
-	callInfo := fmt.Sprintf("BigIntGetUnsignedArgument(%d, %d)", id, destinationHandle)
+	callInfo := fmt.Sprintf("BigIntGetUnsignedArgument(%d, %s)", id, w.handleArg("BigIntGetUnsignedArgument", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetUnsignedArgument(id, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1315,7 +1362,7 @@ func (w *WrapperVMHooks) BigIntGetUnsignedArgument(id int32, destinationHandle i
 
 // BigIntGetSignedArgument VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetSignedArgument(id int32, destinationHandle int32) {
-	callInfo := fmt.Sprintf("BigIntGetSignedArgument(%d, %d)", id, destinationHandle)
+	callInfo := fmt.Sprintf("BigIntGetSignedArgument(%d, %s)", id, w.handleArg("BigIntGetSignedArgument", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetSignedArgument(id, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1323,7 +1370,7 @@ func (w *WrapperVMHooks) BigIntGetSignedArgument(id int32, destinationHandle int
 
 // BigIntStorageStoreUnsigned VM hook wrapper
 func (w *WrapperVMHooks) BigIntStorageStoreUnsigned(keyOffset executor.MemPtr, keyLength executor.MemLength, sourceHandle int32) int32 {
-	callInfo := fmt.Sprintf("BigIntStorageStoreUnsigned(%d, %d, %d)", keyOffset, keyLength, sourceHandle)
+	callInfo := fmt.Sprintf("BigIntStorageStoreUnsigned(%d, %d, %s)", keyOffset, keyLength, w.handleArg("BigIntStorageStoreUnsigned", "sourceHandle", executor.BigIntHandleArg, sourceHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntStorageStoreUnsigned(keyOffset, keyLength, sourceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1332,7 +1379,7 @@ func (w *WrapperVMHooks) BigIntStorageStoreUnsigned(keyOffset executor.MemPtr, k
 
 // BigIntStorageLoadUnsigned VM hook wrapper
 func (w *WrapperVMHooks) BigIntStorageLoadUnsigned(keyOffset executor.MemPtr, keyLength executor.MemLength, destinationHandle int32) int32 {
-	callInfo := fmt.Sprintf("BigIntStorageLoadUnsigned(%d, %d, %d)", keyOffset, keyLength, destinationHandle)
+	callInfo := fmt.Sprintf("BigIntStorageLoadUnsigned(%d, %d, %s)", keyOffset, keyLength, w.handleArg("BigIntStorageLoadUnsigned", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntStorageLoadUnsigned(keyOffset, keyLength, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1341,7 +1388,7 @@ func (w *WrapperVMHooks) BigIntStorageLoadUnsigned(keyOffset executor.MemPtr, ke
 
 // BigIntGetCallValue VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetCallValue(destinationHandle int32) {
-	callInfo := fmt.Sprintf("BigIntGetCallValue(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("BigIntGetCallValue(%s)", w.handleArg("BigIntGetCallValue", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetCallValue(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1349,7 +1396,7 @@ func (w *WrapperVMHooks) BigIntGetCallValue(destinationHandle int32) {
 
 // BigIntGetESDTCallValue VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetESDTCallValue(destination int32) {
-	callInfo := fmt.Sprintf("BigIntGetESDTCallValue(%d)", destination)
+	callInfo := fmt.Sprintf("BigIntGetESDTCallValue(%s)", w.handleArg("BigIntGetESDTCallValue", "destination", executor.BigIntHandleArg, destination, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetESDTCallValue(destination)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1357,7 +1404,7 @@ func (w *WrapperVMHooks) BigIntGetESDTCallValue(destination int32) {
 
 // BigIntGetESDTCallValueByIndex VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetESDTCallValueByIndex(destinationHandle int32, index int32) {
-	callInfo := fmt.Sprintf("BigIntGetESDTCallValueByIndex(%d, %d)", destinationHandle, index)
+	callInfo := fmt.Sprintf("BigIntGetESDTCallValueByIndex(%s, %d)", w.handleArg("BigIntGetESDTCallValueByIndex", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), index)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetESDTCallValueByIndex(destinationHandle, index)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1365,7 +1412,7 @@ func (w *WrapperVMHooks) BigIntGetESDTCallValueByIndex(destinationHandle int32,
 
 // BigIntGetExternalBalance VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetExternalBalance(addressOffset executor.MemPtr, result int32) {
-	callInfo := fmt.Sprintf("BigIntGetExternalBalance(%d, %d)", addressOffset, result)
+	callInfo := fmt.Sprintf("BigIntGetExternalBalance(%s, %s)", w.memoryArg(executor.AddressArg, addressOffset, 0), w.handleArg("BigIntGetExternalBalance", "result", executor.BigIntHandleArg, result, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetExternalBalance(addressOffset, result)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1373,7 +1420,7 @@ func (w *WrapperVMHooks) BigIntGetExternalBalance(addressOffset executor.MemPtr,
 
 // BigIntGetESDTExternalBalance VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetESDTExternalBalance(addressOffset executor.MemPtr, tokenIDOffset executor.MemPtr, tokenIDLen executor.MemLength, nonce int64, resultHandle int32) {
-	callInfo := fmt.Sprintf("BigIntGetESDTExternalBalance(%d, %d, %d, %d, %d)", addressOffset, tokenIDOffset, tokenIDLen, nonce, resultHandle)
+	callInfo := fmt.Sprintf("BigIntGetESDTExternalBalance(%s, %s, %d, %d, %s)", w.memoryArg(executor.AddressArg, addressOffset, 0), w.memoryArg(executor.TokenIDArg, tokenIDOffset, tokenIDLen), tokenIDLen, nonce, w.handleArg("BigIntGetESDTExternalBalance", "resultHandle", executor.BigIntHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntGetESDTExternalBalance(addressOffset, tokenIDOffset, tokenIDLen, nonce, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1384,13 +1431,13 @@ func (w *WrapperVMHooks) BigIntNew(smallValue int64) int32 {
 	callInfo := fmt.Sprintf("BigIntNew(%d)", smallValue)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntNew(smallValue)
-	w.logger.LogVMHookCallAfter(callInfo)
+	w.logger.LogVMHookCallAfter(callInfo + w.handleResult(executor.BigIntHandleArg, result))
 	return result
 }
 
 // BigIntUnsignedByteLength VM hook wrapper
 func (w *WrapperVMHooks) BigIntUnsignedByteLength(referenceHandle int32) int32 {
-	callInfo := fmt.Sprintf("BigIntUnsignedByteLength(%d)", referenceHandle)
+	callInfo := fmt.Sprintf("BigIntUnsignedByteLength(%s)", w.handleArg("BigIntUnsignedByteLength", "referenceHandle", executor.BigIntHandleArg, referenceHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntUnsignedByteLength(referenceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1399,7 +1446,7 @@ func (w *WrapperVMHooks) BigIntUnsignedByteLength(referenceHandle int32) int32 {
 
 // BigIntSignedByteLength VM hook wrapper
 func (w *WrapperVMHooks) BigIntSignedByteLength(referenceHandle int32) int32 {
-	callInfo := fmt.Sprintf("BigIntSignedByteLength(%d)", referenceHandle)
+	callInfo := fmt.Sprintf("BigIntSignedByteLength(%s)", w.handleArg("BigIntSignedByteLength", "referenceHandle", executor.BigIntHandleArg, referenceHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntSignedByteLength(referenceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1408,7 +1455,7 @@ func (w *WrapperVMHooks) BigIntSignedByteLength(referenceHandle int32) int32 {
 
 // BigIntGetUnsignedBytes VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetUnsignedBytes(referenceHandle int32, byteOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("BigIntGetUnsignedBytes(%d, %d)", referenceHandle, byteOffset)
+	callInfo := fmt.Sprintf("BigIntGetUnsignedBytes(%s, %d)", w.handleArg("BigIntGetUnsignedBytes", "referenceHandle", executor.BigIntHandleArg, referenceHandle, true), byteOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntGetUnsignedBytes(referenceHandle, byteOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1417,7 +1464,7 @@ func (w *WrapperVMHooks) BigIntGetUnsignedBytes(referenceHandle int32, byteOffse
 
 // BigIntGetSignedBytes VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetSignedBytes(referenceHandle int32, byteOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("BigIntGetSignedBytes(%d, %d)", referenceHandle, byteOffset)
+	callInfo := fmt.Sprintf("BigIntGetSignedBytes(%s, %d)", w.handleArg("BigIntGetSignedBytes", "referenceHandle", executor.BigIntHandleArg, referenceHandle, true), byteOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntGetSignedBytes(referenceHandle, byteOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1426,7 +1473,7 @@ func (w *WrapperVMHooks) BigIntGetSignedBytes(referenceHandle int32, byteOffset
 
 // BigIntSetUnsignedBytes VM hook wrapper
 func (w *WrapperVMHooks) BigIntSetUnsignedBytes(destinationHandle int32, byteOffset executor.MemPtr, byteLength executor.MemLength) {
-	callInfo := fmt.Sprintf("BigIntSetUnsignedBytes(%d, %d, %d)", destinationHandle, byteOffset, byteLength)
+	callInfo := fmt.Sprintf("BigIntSetUnsignedBytes(%s, %d, %d)", w.handleArg("BigIntSetUnsignedBytes", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), byteOffset, byteLength)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSetUnsignedBytes(destinationHandle, byteOffset, byteLength)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1434,7 +1481,7 @@ func (w *WrapperVMHooks) BigIntSetUnsignedBytes(destinationHandle int32, byteOff
 
 // BigIntSetSignedBytes VM hook wrapper
 func (w *WrapperVMHooks) BigIntSetSignedBytes(destinationHandle int32, byteOffset executor.MemPtr, byteLength executor.MemLength) {
-	callInfo := fmt.Sprintf("BigIntSetSignedBytes(%d, %d, %d)", destinationHandle, byteOffset, byteLength)
+	callInfo := fmt.Sprintf("BigIntSetSignedBytes(%s, %d, %d)", w.handleArg("BigIntSetSignedBytes", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), byteOffset, byteLength)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSetSignedBytes(destinationHandle, byteOffset, byteLength)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1442,7 +1489,7 @@ func (w *WrapperVMHooks) BigIntSetSignedBytes(destinationHandle int32, byteOffse
 
 // BigIntIsInt64 VM hook wrapper
 func (w *WrapperVMHooks) BigIntIsInt64(destinationHandle int32) int32 {
-	callInfo := fmt.Sprintf("BigIntIsInt64(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("BigIntIsInt64(%s)", w.handleArg("BigIntIsInt64", "destinationHandle", executor.BigIntHandleArg, destinationHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntIsInt64(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1451,7 +1498,7 @@ func (w *WrapperVMHooks) BigIntIsInt64(destinationHandle int32) int32 {
 
 // BigIntGetInt64 VM hook wrapper
 func (w *WrapperVMHooks) BigIntGetInt64(destinationHandle int32) int64 {
-	callInfo := fmt.Sprintf("BigIntGetInt64(%d)", destinationHandle)
+	callInfo := fmt.Sprintf("BigIntGetInt64(%s)", w.handleArg("BigIntGetInt64", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntGetInt64(destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1460,7 +1507,7 @@ func (w *WrapperVMHooks) BigIntGetInt64(destinationHandle int32) int64 {
 
 // BigIntSetInt64 VM hook wrapper
 func (w *WrapperVMHooks) BigIntSetInt64(destinationHandle int32, value int64) {
-	callInfo := fmt.Sprintf("BigIntSetInt64(%d, %d)", destinationHandle, value)
+	callInfo := fmt.Sprintf("BigIntSetInt64(%s, %d)", w.handleArg("BigIntSetInt64", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), value)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSetInt64(destinationHandle, value)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1468,7 +1515,7 @@ func (w *WrapperVMHooks) BigIntSetInt64(destinationHandle int32, value int64) {
 
 // BigIntAdd VM hook wrapper
 func (w *WrapperVMHooks) BigIntAdd(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntAdd(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntAdd(%s, %s, %s)", w.handleArg("BigIntAdd", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntAdd", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntAdd", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntAdd(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1476,7 +1523,7 @@ func (w *WrapperVMHooks) BigIntAdd(destinationHandle int32, op1Handle int32, op2
 
 // BigIntSub VM hook wrapper
 func (w *WrapperVMHooks) BigIntSub(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntSub(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntSub(%s, %s, %s)", w.handleArg("BigIntSub", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntSub", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntSub", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSub(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1484,7 +1531,7 @@ func (w *WrapperVMHooks) BigIntSub(destinationHandle int32, op1Handle int32, op2
 
 // BigIntMul VM hook wrapper
 func (w *WrapperVMHooks) BigIntMul(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntMul(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntMul(%s, %s, %s)", w.handleArg("BigIntMul", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntMul", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntMul", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntMul(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1492,7 +1539,7 @@ func (w *WrapperVMHooks) BigIntMul(destinationHandle int32, op1Handle int32, op2
 
 // BigIntTDiv VM hook wrapper
 func (w *WrapperVMHooks) BigIntTDiv(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntTDiv(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntTDiv(%s, %s, %s)", w.handleArg("BigIntTDiv", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntTDiv", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntTDiv", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntTDiv(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1500,7 +1547,7 @@ func (w *WrapperVMHooks) BigIntTDiv(destinationHandle int32, op1Handle int32, op
 
 // BigIntTMod VM hook wrapper
 func (w *WrapperVMHooks) BigIntTMod(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntTMod(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntTMod(%s, %s, %s)", w.handleArg("BigIntTMod", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntTMod", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntTMod", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntTMod(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1508,7 +1555,7 @@ func (w *WrapperVMHooks) BigIntTMod(destinationHandle int32, op1Handle int32, op
 
 // BigIntEDiv VM hook wrapper
 func (w *WrapperVMHooks) BigIntEDiv(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntEDiv(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntEDiv(%s, %s, %s)", w.handleArg("BigIntEDiv", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntEDiv", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntEDiv", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntEDiv(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1516,7 +1563,7 @@ func (w *WrapperVMHooks) BigIntEDiv(destinationHandle int32, op1Handle int32, op
 
 // BigIntEMod VM hook wrapper
 func (w *WrapperVMHooks) BigIntEMod(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntEMod(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntEMod(%s, %s, %s)", w.handleArg("BigIntEMod", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntEMod", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntEMod", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntEMod(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1524,7 +1571,7 @@ func (w *WrapperVMHooks) BigIntEMod(destinationHandle int32, op1Handle int32, op
 
 // BigIntSqrt VM hook wrapper
 func (w *WrapperVMHooks) BigIntSqrt(destinationHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigIntSqrt(%d, %d)", destinationHandle, opHandle)
+	callInfo := fmt.Sprintf("BigIntSqrt(%s, %s)", w.handleArg("BigIntSqrt", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntSqrt", "opHandle", executor.BigIntHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntSqrt(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1532,7 +1579,7 @@ func (w *WrapperVMHooks) BigIntSqrt(destinationHandle int32, opHandle int32) {
 
 // BigIntPow VM hook wrapper
 func (w *WrapperVMHooks) BigIntPow(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntPow(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntPow(%s, %s, %s)", w.handleArg("BigIntPow", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntPow", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntPow", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntPow(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1540,7 +1587,7 @@ func (w *WrapperVMHooks) BigIntPow(destinationHandle int32, op1Handle int32, op2
 
 // BigIntLog2 VM hook wrapper
 func (w *WrapperVMHooks) BigIntLog2(op1Handle int32) int32 {
-	callInfo := fmt.Sprintf("BigIntLog2(%d)", op1Handle)
+	callInfo := fmt.Sprintf("BigIntLog2(%s)", w.handleArg("BigIntLog2", "op1Handle", executor.BigIntHandleArg, op1Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntLog2(op1Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1549,7 +1596,7 @@ func (w *WrapperVMHooks) BigIntLog2(op1Handle int32) int32 {
 
 // BigIntAbs VM hook wrapper
 func (w *WrapperVMHooks) BigIntAbs(destinationHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigIntAbs(%d, %d)", destinationHandle, opHandle)
+	callInfo := fmt.Sprintf("BigIntAbs(%s, %s)", w.handleArg("BigIntAbs", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntAbs", "opHandle", executor.BigIntHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntAbs(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1557,7 +1604,7 @@ func (w *WrapperVMHooks) BigIntAbs(destinationHandle int32, opHandle int32) {
 
 // BigIntNeg VM hook wrapper
 func (w *WrapperVMHooks) BigIntNeg(destinationHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigIntNeg(%d, %d)", destinationHandle, opHandle)
+	callInfo := fmt.Sprintf("BigIntNeg(%s, %s)", w.handleArg("BigIntNeg", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntNeg", "opHandle", executor.BigIntHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntNeg(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1565,7 +1612,7 @@ func (w *WrapperVMHooks) BigIntNeg(destinationHandle int32, opHandle int32) {
 
 // BigIntSign VM hook wrapper
 func (w *WrapperVMHooks) BigIntSign(opHandle int32) int32 {
-	callInfo := fmt.Sprintf("BigIntSign(%d)", opHandle)
+	callInfo := fmt.Sprintf("BigIntSign(%s)", w.handleArg("BigIntSign", "opHandle", executor.BigIntHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntSign(opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1574,7 +1621,7 @@ func (w *WrapperVMHooks) BigIntSign(opHandle int32) int32 {
 
 // BigIntCmp VM hook wrapper
 func (w *WrapperVMHooks) BigIntCmp(op1Handle int32, op2Handle int32) int32 {
-	callInfo := fmt.Sprintf("BigIntCmp(%d, %d)", op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntCmp(%s, %s)", w.handleArg("BigIntCmp", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntCmp", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.BigIntCmp(op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1583,7 +1630,7 @@ func (w *WrapperVMHooks) BigIntCmp(op1Handle int32, op2Handle int32) int32 {
 
 // BigIntNot VM hook wrapper
 func (w *WrapperVMHooks) BigIntNot(destinationHandle int32, opHandle int32) {
-	callInfo := fmt.Sprintf("BigIntNot(%d, %d)", destinationHandle, opHandle)
+	callInfo := fmt.Sprintf("BigIntNot(%s, %s)", w.handleArg("BigIntNot", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntNot", "opHandle", executor.BigIntHandleArg, opHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntNot(destinationHandle, opHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1591,7 +1638,7 @@ func (w *WrapperVMHooks) BigIntNot(destinationHandle int32, opHandle int32) {
 
 // BigIntAnd VM hook wrapper
 func (w *WrapperVMHooks) BigIntAnd(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntAnd(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntAnd(%s, %s, %s)", w.handleArg("BigIntAnd", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntAnd", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntAnd", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntAnd(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1599,7 +1646,7 @@ func (w *WrapperVMHooks) BigIntAnd(destinationHandle int32, op1Handle int32, op2
 
 // BigIntOr VM hook wrapper
 func (w *WrapperVMHooks) BigIntOr(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntOr(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntOr(%s, %s, %s)", w.handleArg("BigIntOr", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntOr", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntOr", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntOr(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1607,7 +1654,7 @@ func (w *WrapperVMHooks) BigIntOr(destinationHandle int32, op1Handle int32, op2H
 
 // BigIntXor VM hook wrapper
 func (w *WrapperVMHooks) BigIntXor(destinationHandle int32, op1Handle int32, op2Handle int32) {
-	callInfo := fmt.Sprintf("BigIntXor(%d, %d, %d)", destinationHandle, op1Handle, op2Handle)
+	callInfo := fmt.Sprintf("BigIntXor(%s, %s, %s)", w.handleArg("BigIntXor", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntXor", "op1Handle", executor.BigIntHandleArg, op1Handle, true), w.handleArg("BigIntXor", "op2Handle", executor.BigIntHandleArg, op2Handle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntXor(destinationHandle, op1Handle, op2Handle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1615,7 +1662,7 @@ func (w *WrapperVMHooks) BigIntXor(destinationHandle int32, op1Handle int32, op2
 
 // BigIntShr VM hook wrapper
 func (w *WrapperVMHooks) BigIntShr(destinationHandle int32, opHandle int32, bits int32) {
-	callInfo := fmt.Sprintf("BigIntShr(%d, %d, %d)", destinationHandle, opHandle, bits)
+	callInfo := fmt.Sprintf("BigIntShr(%s, %s, %d)", w.handleArg("BigIntShr", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntShr", "opHandle", executor.BigIntHandleArg, opHandle, true), bits)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntShr(destinationHandle, opHandle, bits)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1623,7 +1670,7 @@ func (w *WrapperVMHooks) BigIntShr(destinationHandle int32, opHandle int32, bits
 
 // BigIntShl VM hook wrapper
 func (w *WrapperVMHooks) BigIntShl(destinationHandle int32, opHandle int32, bits int32) {
-	callInfo := fmt.Sprintf("BigIntShl(%d, %d, %d)", destinationHandle, opHandle, bits)
+	callInfo := fmt.Sprintf("BigIntShl(%s, %s, %d)", w.handleArg("BigIntShl", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntShl", "opHandle", executor.BigIntHandleArg, opHandle, true), bits)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntShl(destinationHandle, opHandle, bits)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1631,7 +1678,7 @@ func (w *WrapperVMHooks) BigIntShl(destinationHandle int32, opHandle int32, bits
 
 // BigIntFinishUnsigned VM hook wrapper
 func (w *WrapperVMHooks) BigIntFinishUnsigned(referenceHandle int32) {
-	callInfo := fmt.Sprintf("BigIntFinishUnsigned(%d)", referenceHandle)
+	callInfo := fmt.Sprintf("BigIntFinishUnsigned(%s)", w.handleArg("BigIntFinishUnsigned", "referenceHandle", executor.BigIntHandleArg, referenceHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntFinishUnsigned(referenceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1639,7 +1686,7 @@ func (w *WrapperVMHooks) BigIntFinishUnsigned(referenceHandle int32) {
 
 // BigIntFinishSigned VM hook wrapper
 func (w *WrapperVMHooks) BigIntFinishSigned(referenceHandle int32) {
-	callInfo := fmt.Sprintf("BigIntFinishSigned(%d)", referenceHandle)
+	callInfo := fmt.Sprintf("BigIntFinishSigned(%s)", w.handleArg("BigIntFinishSigned", "referenceHandle", executor.BigIntHandleArg, referenceHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntFinishSigned(referenceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1647,18 +1694,27 @@ func (w *WrapperVMHooks) BigIntFinishSigned(referenceHandle int32) {
 
 // BigIntToString VM hook wrapper
 func (w *WrapperVMHooks) BigIntToString(bigIntHandle int32, destinationHandle int32) {
-	callInfo := fmt.Sprintf("BigIntToString(%d, %d)", bigIntHandle, destinationHandle)
+	callInfo := fmt.Sprintf("BigIntToString(%s, %s)", w.handleArg("BigIntToString", "bigIntHandle", executor.BigIntHandleArg, bigIntHandle, true), w.handleArg("BigIntToString", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.BigIntToString(bigIntHandle, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
 }
 
+// BigIntSetRandomInRange VM hook wrapper
+func (w *WrapperVMHooks) BigIntSetRandomInRange(destinationHandle int32, minHandle int32, maxHandle int32) int32 {
+	callInfo := fmt.Sprintf("BigIntSetRandomInRange(%s, %s, %s)", w.handleArg("BigIntSetRandomInRange", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("BigIntSetRandomInRange", "minHandle", executor.BigIntHandleArg, minHandle, true), w.handleArg("BigIntSetRandomInRange", "maxHandle", executor.BigIntHandleArg, maxHandle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.BigIntSetRandomInRange(destinationHandle, minHandle, maxHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
 // MBufferNew VM hook wrapper
 func (w *WrapperVMHooks) MBufferNew() int32 {
 	callInfo := "MBufferNew()"
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferNew()
-	w.logger.LogVMHookCallAfter(callInfo)
+	w.logger.LogVMHookCallAfter(callInfo + w.handleResult(executor.ManagedBufferHandleArg, result))
 	return result
 }
 
@@ -1667,13 +1723,13 @@ func (w *WrapperVMHooks) MBufferNewFromBytes(dataOffset executor.MemPtr, dataLen
 	callInfo := fmt.Sprintf("MBufferNewFromBytes(%d, %d)", dataOffset, dataLength)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferNewFromBytes(dataOffset, dataLength)
-	w.logger.LogVMHookCallAfter(callInfo)
+	w.logger.LogVMHookCallAfter(callInfo + w.handleResult(executor.ManagedBufferHandleArg, result))
 	return result
 }
 
 // MBufferGetLength VM hook wrapper
 func (w *WrapperVMHooks) MBufferGetLength(mBufferHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferGetLength(%d)", mBufferHandle)
+	callInfo := fmt.Sprintf("MBufferGetLength(%s)", w.handleArg("MBufferGetLength", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferGetLength(mBufferHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1682,7 +1738,7 @@ func (w *WrapperVMHooks) MBufferGetLength(mBufferHandle int32) int32 {
 
 // MBufferGetBytes VM hook wrapper
 func (w *WrapperVMHooks) MBufferGetBytes(mBufferHandle int32, resultOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("MBufferGetBytes(%d, %d)", mBufferHandle, resultOffset)
+	callInfo := fmt.Sprintf("MBufferGetBytes(%s, %d)", w.handleArg("MBufferGetBytes", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, true), resultOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferGetBytes(mBufferHandle, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1691,7 +1747,7 @@ func (w *WrapperVMHooks) MBufferGetBytes(mBufferHandle int32, resultOffset execu
 
 // MBufferGetByteSlice VM hook wrapper
 func (w *WrapperVMHooks) MBufferGetByteSlice(sourceHandle int32, startingPosition int32, sliceLength int32, resultOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("MBufferGetByteSlice(%d, %d, %d, %d)", sourceHandle, startingPosition, sliceLength, resultOffset)
+	callInfo := fmt.Sprintf("MBufferGetByteSlice(%s, %d, %d, %d)", w.handleArg("MBufferGetByteSlice", "sourceHandle", executor.ManagedBufferHandleArg, sourceHandle, true), startingPosition, sliceLength, resultOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferGetByteSlice(sourceHandle, startingPosition, sliceLength, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1700,7 +1756,7 @@ func (w *WrapperVMHooks) MBufferGetByteSlice(sourceHandle int32, startingPositio
 
 // MBufferCopyByteSlice VM hook wrapper
 func (w *WrapperVMHooks) MBufferCopyByteSlice(sourceHandle int32, startingPosition int32, sliceLength int32, destinationHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferCopyByteSlice(%d, %d, %d, %d)", sourceHandle, startingPosition, sliceLength, destinationHandle)
+	callInfo := fmt.Sprintf("MBufferCopyByteSlice(%s, %d, %d, %s)", w.handleArg("MBufferCopyByteSlice", "sourceHandle", executor.ManagedBufferHandleArg, sourceHandle, true), startingPosition, sliceLength, w.handleArg("MBufferCopyByteSlice", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferCopyByteSlice(sourceHandle, startingPosition, sliceLength, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1709,7 +1765,7 @@ func (w *WrapperVMHooks) MBufferCopyByteSlice(sourceHandle int32, startingPositi
 
 // MBufferEq VM hook wrapper
 func (w *WrapperVMHooks) MBufferEq(mBufferHandle1 int32, mBufferHandle2 int32) int32 {
-	callInfo := fmt.Sprintf("MBufferEq(%d, %d)", mBufferHandle1, mBufferHandle2)
+	callInfo := fmt.Sprintf("MBufferEq(%s, %s)", w.handleArg("MBufferEq", "mBufferHandle1", executor.ManagedBufferHandleArg, mBufferHandle1, true), w.handleArg("MBufferEq", "mBufferHandle2", executor.ManagedBufferHandleArg, mBufferHandle2, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferEq(mBufferHandle1, mBufferHandle2)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1718,7 +1774,7 @@ func (w *WrapperVMHooks) MBufferEq(mBufferHandle1 int32, mBufferHandle2 int32) i
 
 // MBufferSetBytes VM hook wrapper
 func (w *WrapperVMHooks) MBufferSetBytes(mBufferHandle int32, dataOffset executor.MemPtr, dataLength executor.MemLength) int32 {
-	callInfo := fmt.Sprintf("MBufferSetBytes(%d, %d, %d)", mBufferHandle, dataOffset, dataLength)
+	callInfo := fmt.Sprintf("MBufferSetBytes(%s, %d, %d)", w.handleArg("MBufferSetBytes", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, false), dataOffset, dataLength)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferSetBytes(mBufferHandle, dataOffset, dataLength)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1727,7 +1783,7 @@ func (w *WrapperVMHooks) MBufferSetBytes(mBufferHandle int32, dataOffset executo
 
 // MBufferSetByteSlice VM hook wrapper
 func (w *WrapperVMHooks) MBufferSetByteSlice(mBufferHandle int32, startingPosition int32, dataLength executor.MemLength, dataOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("MBufferSetByteSlice(%d, %d, %d, %d)", mBufferHandle, startingPosition, dataLength, dataOffset)
+	callInfo := fmt.Sprintf("MBufferSetByteSlice(%s, %d, %d, %d)", w.handleArg("MBufferSetByteSlice", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, true), startingPosition, dataLength, dataOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferSetByteSlice(mBufferHandle, startingPosition, dataLength, dataOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1736,7 +1792,7 @@ func (w *WrapperVMHooks) MBufferSetByteSlice(mBufferHandle int32, startingPositi
 
 // MBufferAppend VM hook wrapper
 func (w *WrapperVMHooks) MBufferAppend(accumulatorHandle int32, dataHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferAppend(%d, %d)", accumulatorHandle, dataHandle)
+	callInfo := fmt.Sprintf("MBufferAppend(%s, %s)", w.handleArg("MBufferAppend", "accumulatorHandle", executor.ManagedBufferHandleArg, accumulatorHandle, true), w.handleArg("MBufferAppend", "dataHandle", executor.ManagedBufferHandleArg, dataHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferAppend(accumulatorHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1745,7 +1801,7 @@ func (w *WrapperVMHooks) MBufferAppend(accumulatorHandle int32, dataHandle int32
 
 // MBufferAppendBytes VM hook wrapper
 func (w *WrapperVMHooks) MBufferAppendBytes(accumulatorHandle int32, dataOffset executor.MemPtr, dataLength executor.MemLength) int32 {
-	callInfo := fmt.Sprintf("MBufferAppendBytes(%d, %d, %d)", accumulatorHandle, dataOffset, dataLength)
+	callInfo := fmt.Sprintf("MBufferAppendBytes(%s, %d, %d)", w.handleArg("MBufferAppendBytes", "accumulatorHandle", executor.ManagedBufferHandleArg, accumulatorHandle, true), dataOffset, dataLength)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferAppendBytes(accumulatorHandle, dataOffset, dataLength)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1754,7 +1810,7 @@ func (w *WrapperVMHooks) MBufferAppendBytes(accumulatorHandle int32, dataOffset
 
 // MBufferToBigIntUnsigned VM hook wrapper
 func (w *WrapperVMHooks) MBufferToBigIntUnsigned(mBufferHandle int32, bigIntHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferToBigIntUnsigned(%d, %d)", mBufferHandle, bigIntHandle)
+	callInfo := fmt.Sprintf("MBufferToBigIntUnsigned(%s, %s)", w.handleArg("MBufferToBigIntUnsigned", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, true), w.handleArg("MBufferToBigIntUnsigned", "bigIntHandle", executor.BigIntHandleArg, bigIntHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferToBigIntUnsigned(mBufferHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1763,7 +1819,7 @@ func (w *WrapperVMHooks) MBufferToBigIntUnsigned(mBufferHandle int32, bigIntHand
 
 // MBufferToBigIntSigned VM hook wrapper
 func (w *WrapperVMHooks) MBufferToBigIntSigned(mBufferHandle int32, bigIntHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferToBigIntSigned(%d, %d)", mBufferHandle, bigIntHandle)
+	callInfo := fmt.Sprintf("MBufferToBigIntSigned(%s, %s)", w.handleArg("MBufferToBigIntSigned", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, true), w.handleArg("MBufferToBigIntSigned", "bigIntHandle", executor.BigIntHandleArg, bigIntHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferToBigIntSigned(mBufferHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1772,7 +1828,7 @@ func (w *WrapperVMHooks) MBufferToBigIntSigned(mBufferHandle int32, bigIntHandle
 
 // MBufferFromBigIntUnsigned VM hook wrapper
 func (w *WrapperVMHooks) MBufferFromBigIntUnsigned(mBufferHandle int32, bigIntHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferFromBigIntUnsigned(%d, %d)", mBufferHandle, bigIntHandle)
+	callInfo := fmt.Sprintf("MBufferFromBigIntUnsigned(%s, %s)", w.handleArg("MBufferFromBigIntUnsigned", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, false), w.handleArg("MBufferFromBigIntUnsigned", "bigIntHandle", executor.BigIntHandleArg, bigIntHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferFromBigIntUnsigned(mBufferHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1781,7 +1837,7 @@ func (w *WrapperVMHooks) MBufferFromBigIntUnsigned(mBufferHandle int32, bigIntHa
 
 // MBufferFromBigIntSigned VM hook wrapper
 func (w *WrapperVMHooks) MBufferFromBigIntSigned(mBufferHandle int32, bigIntHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferFromBigIntSigned(%d, %d)", mBufferHandle, bigIntHandle)
+	callInfo := fmt.Sprintf("MBufferFromBigIntSigned(%s, %s)", w.handleArg("MBufferFromBigIntSigned", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, false), w.handleArg("MBufferFromBigIntSigned", "bigIntHandle", executor.BigIntHandleArg, bigIntHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferFromBigIntSigned(mBufferHandle, bigIntHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1790,7 +1846,7 @@ func (w *WrapperVMHooks) MBufferFromBigIntSigned(mBufferHandle int32, bigIntHand
 
 // MBufferToSmallIntUnsigned VM hook wrapper
 func (w *WrapperVMHooks) MBufferToSmallIntUnsigned(mBufferHandle int32) int64 {
-	callInfo := fmt.Sprintf("MBufferToSmallIntUnsigned(%d)", mBufferHandle)
+	callInfo := fmt.Sprintf("MBufferToSmallIntUnsigned(%s)", w.handleArg("MBufferToSmallIntUnsigned", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferToSmallIntUnsigned(mBufferHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1799,7 +1855,7 @@ func (w *WrapperVMHooks) MBufferToSmallIntUnsigned(mBufferHandle int32) int64 {
 
 // MBufferToSmallIntSigned VM hook wrapper
 func (w *WrapperVMHooks) MBufferToSmallIntSigned(mBufferHandle int32) int64 {
-	callInfo := fmt.Sprintf("MBufferToSmallIntSigned(%d)", mBufferHandle)
+	callInfo := fmt.Sprintf("MBufferToSmallIntSigned(%s)", w.handleArg("MBufferToSmallIntSigned", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferToSmallIntSigned(mBufferHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1808,7 +1864,7 @@ func (w *WrapperVMHooks) MBufferToSmallIntSigned(mBufferHandle int32) int64 {
 
 // MBufferFromSmallIntUnsigned VM hook wrapper
 func (w *WrapperVMHooks) MBufferFromSmallIntUnsigned(mBufferHandle int32, value int64) {
-	callInfo := fmt.Sprintf("MBufferFromSmallIntUnsigned(%d, %d)", mBufferHandle, value)
+	callInfo := fmt.Sprintf("MBufferFromSmallIntUnsigned(%s, %d)", w.handleArg("MBufferFromSmallIntUnsigned", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, false), value)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.MBufferFromSmallIntUnsigned(mBufferHandle, value)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1816,7 +1872,7 @@ func (w *WrapperVMHooks) MBufferFromSmallIntUnsigned(mBufferHandle int32, value
 
 // MBufferFromSmallIntSigned VM hook wrapper
 func (w *WrapperVMHooks) MBufferFromSmallIntSigned(mBufferHandle int32, value int64) {
-	callInfo := fmt.Sprintf("MBufferFromSmallIntSigned(%d, %d)", mBufferHandle, value)
+	callInfo := fmt.Sprintf("MBufferFromSmallIntSigned(%s, %d)", w.handleArg("MBufferFromSmallIntSigned", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, false), value)
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.MBufferFromSmallIntSigned(mBufferHandle, value)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1824,7 +1880,7 @@ func (w *WrapperVMHooks) MBufferFromSmallIntSigned(mBufferHandle int32, value in
 
 // MBufferToBigFloat VM hook wrapper
 func (w *WrapperVMHooks) MBufferToBigFloat(mBufferHandle int32, bigFloatHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferToBigFloat(%d, %d)", mBufferHandle, bigFloatHandle)
+	callInfo := fmt.Sprintf("MBufferToBigFloat(%s, %s)", w.handleArg("MBufferToBigFloat", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, true), w.handleArg("MBufferToBigFloat", "bigFloatHandle", executor.BigFloatHandleArg, bigFloatHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferToBigFloat(mBufferHandle, bigFloatHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1833,7 +1889,7 @@ func (w *WrapperVMHooks) MBufferToBigFloat(mBufferHandle int32, bigFloatHandle i
 
 // MBufferFromBigFloat VM hook wrapper
 func (w *WrapperVMHooks) MBufferFromBigFloat(mBufferHandle int32, bigFloatHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferFromBigFloat(%d, %d)", mBufferHandle, bigFloatHandle)
+	callInfo := fmt.Sprintf("MBufferFromBigFloat(%s, %s)", w.handleArg("MBufferFromBigFloat", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, false), w.handleArg("MBufferFromBigFloat", "bigFloatHandle", executor.BigFloatHandleArg, bigFloatHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferFromBigFloat(mBufferHandle, bigFloatHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1842,7 +1898,7 @@ func (w *WrapperVMHooks) MBufferFromBigFloat(mBufferHandle int32, bigFloatHandle
 
 // MBufferStorageStore VM hook wrapper
 func (w *WrapperVMHooks) MBufferStorageStore(keyHandle int32, sourceHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferStorageStore(%d, %d)", keyHandle, sourceHandle)
+	callInfo := fmt.Sprintf("MBufferStorageStore(%s, %s)", w.handleArg("MBufferStorageStore", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("MBufferStorageStore", "sourceHandle", executor.ManagedBufferHandleArg, sourceHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferStorageStore(keyHandle, sourceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1851,7 +1907,7 @@ func (w *WrapperVMHooks) MBufferStorageStore(keyHandle int32, sourceHandle int32
 
 // MBufferStorageLoad VM hook wrapper
 func (w *WrapperVMHooks) MBufferStorageLoad(keyHandle int32, destinationHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferStorageLoad(%d, %d)", keyHandle, destinationHandle)
+	callInfo := fmt.Sprintf("MBufferStorageLoad(%s, %s)", w.handleArg("MBufferStorageLoad", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("MBufferStorageLoad", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferStorageLoad(keyHandle, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1860,15 +1916,32 @@ func (w *WrapperVMHooks) MBufferStorageLoad(keyHandle int32, destinationHandle i
 
 // MBufferStorageLoadFromAddress VM hook wrapper
 func (w *WrapperVMHooks) MBufferStorageLoadFromAddress(addressHandle int32, keyHandle int32, destinationHandle int32) {
-	callInfo := fmt.Sprintf("MBufferStorageLoadFromAddress(%d, %d, %d)", addressHandle, keyHandle, destinationHandle)
+	callInfo := fmt.Sprintf("MBufferStorageLoadFromAddress(%s, %s, %s)", w.handleArg("MBufferStorageLoadFromAddress", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("MBufferStorageLoadFromAddress", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("MBufferStorageLoadFromAddress", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.MBufferStorageLoadFromAddress(addressHandle, keyHandle, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
 }
 
+// MBufferStorageLoadBatch VM hook wrapper
+func (w *WrapperVMHooks) MBufferStorageLoadBatch(keysHandle int32, destinationHandle int32) int32 {
+	callInfo := fmt.Sprintf("MBufferStorageLoadBatch(%s, %s)", w.handleArg("MBufferStorageLoadBatch", "keysHandle", executor.ManagedBufferHandleArg, keysHandle, true), w.handleArg("MBufferStorageLoadBatch", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MBufferStorageLoadBatch(keysHandle, destinationHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MBufferStorageLoadBatchFromAddress VM hook wrapper
+func (w *WrapperVMHooks) MBufferStorageLoadBatchFromAddress(addressHandle int32, keysHandle int32, destinationHandle int32) {
+	callInfo := fmt.Sprintf("MBufferStorageLoadBatchFromAddress(%s, %s, %s)", w.handleArg("MBufferStorageLoadBatchFromAddress", "addressHandle", executor.ManagedBufferHandleArg, addressHandle, true), w.handleArg("MBufferStorageLoadBatchFromAddress", "keysHandle", executor.ManagedBufferHandleArg, keysHandle, true), w.handleArg("MBufferStorageLoadBatchFromAddress", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.MBufferStorageLoadBatchFromAddress(addressHandle, keysHandle, destinationHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
 // MBufferGetArgument VM hook wrapper
 func (w *WrapperVMHooks) MBufferGetArgument(id int32, destinationHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferGetArgument(%d, %d)", id, destinationHandle)
+	callInfo := fmt.Sprintf("MBufferGetArgument(%d, %s)", id, w.handleArg("MBufferGetArgument", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferGetArgument(id, destinationHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1877,7 +1950,7 @@ func (w *WrapperVMHooks) MBufferGetArgument(id int32, destinationHandle int32) i
 
 // MBufferFinish VM hook wrapper
 func (w *WrapperVMHooks) MBufferFinish(sourceHandle int32) int32 {
-	callInfo := fmt.Sprintf("MBufferFinish(%d)", sourceHandle)
+	callInfo := fmt.Sprintf("MBufferFinish(%s)", w.handleArg("MBufferFinish", "sourceHandle", executor.ManagedBufferHandleArg, sourceHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferFinish(sourceHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1886,7 +1959,7 @@ func (w *WrapperVMHooks) MBufferFinish(sourceHandle int32) int32 {
 
 // MBufferSetRandom VM hook wrapper
 func (w *WrapperVMHooks) MBufferSetRandom(destinationHandle int32, length int32) int32 {
-	callInfo := fmt.Sprintf("MBufferSetRandom(%d, %d)", destinationHandle, length)
+	callInfo := fmt.Sprintf("MBufferSetRandom(%s, %d)", w.handleArg("MBufferSetRandom", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false), length)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MBufferSetRandom(destinationHandle, length)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1898,13 +1971,13 @@ func (w *WrapperVMHooks) ManagedMapNew() int32 {
 	callInfo := "ManagedMapNew()"
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapNew()
-	w.logger.LogVMHookCallAfter(callInfo)
+	w.logger.LogVMHookCallAfter(callInfo + w.handleResult(executor.ManagedMapHandleArg, result))
 	return result
 }
 
 // ManagedMapPut VM hook wrapper
 func (w *WrapperVMHooks) ManagedMapPut(mMapHandle int32, keyHandle int32, valueHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedMapPut(%d, %d, %d)", mMapHandle, keyHandle, valueHandle)
+	callInfo := fmt.Sprintf("ManagedMapPut(%s, %s, %s)", w.handleArg("ManagedMapPut", "mMapHandle", executor.ManagedMapHandleArg, mMapHandle, true), w.handleArg("ManagedMapPut", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedMapPut", "valueHandle", executor.ManagedBufferHandleArg, valueHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapPut(mMapHandle, keyHandle, valueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1913,7 +1986,7 @@ func (w *WrapperVMHooks) ManagedMapPut(mMapHandle int32, keyHandle int32, valueH
 
 // ManagedMapGet VM hook wrapper
 func (w *WrapperVMHooks) ManagedMapGet(mMapHandle int32, keyHandle int32, outValueHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedMapGet(%d, %d, %d)", mMapHandle, keyHandle, outValueHandle)
+	callInfo := fmt.Sprintf("ManagedMapGet(%s, %s, %s)", w.handleArg("ManagedMapGet", "mMapHandle", executor.ManagedMapHandleArg, mMapHandle, true), w.handleArg("ManagedMapGet", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedMapGet", "outValueHandle", executor.ManagedBufferHandleArg, outValueHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapGet(mMapHandle, keyHandle, outValueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1922,7 +1995,7 @@ func (w *WrapperVMHooks) ManagedMapGet(mMapHandle int32, keyHandle int32, outVal
 
 // ManagedMapRemove VM hook wrapper
 func (w *WrapperVMHooks) ManagedMapRemove(mMapHandle int32, keyHandle int32, outValueHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedMapRemove(%d, %d, %d)", mMapHandle, keyHandle, outValueHandle)
+	callInfo := fmt.Sprintf("ManagedMapRemove(%s, %s, %s)", w.handleArg("ManagedMapRemove", "mMapHandle", executor.ManagedMapHandleArg, mMapHandle, true), w.handleArg("ManagedMapRemove", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedMapRemove", "outValueHandle", executor.ManagedBufferHandleArg, outValueHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapRemove(mMapHandle, keyHandle, outValueHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -1931,13 +2004,291 @@ func (w *WrapperVMHooks) ManagedMapRemove(mMapHandle int32, keyHandle int32, out
 
 // ManagedMapContains VM hook wrapper
 func (w *WrapperVMHooks) ManagedMapContains(mMapHandle int32, keyHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedMapContains(%d, %d)", mMapHandle, keyHandle)
+	callInfo := fmt.Sprintf("ManagedMapContains(%s, %s)", w.handleArg("ManagedMapContains", "mMapHandle", executor.ManagedMapHandleArg, mMapHandle, true), w.handleArg("ManagedMapContains", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMapContains(mMapHandle, keyHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
 	return result
 }
 
+// MDecimalFromBigInt VM hook wrapper
+func (w *WrapperVMHooks) MDecimalFromBigInt(destinationHandle int32, bigIntHandle int32, scale int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalFromBigInt(%s, %s, %d)", w.handleArg("MDecimalFromBigInt", "destinationHandle", executor.ManagedDecimalHandleArg, destinationHandle, false), w.handleArg("MDecimalFromBigInt", "bigIntHandle", executor.BigIntHandleArg, bigIntHandle, true), scale)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalFromBigInt(destinationHandle, bigIntHandle, scale)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalToBigInt VM hook wrapper
+func (w *WrapperVMHooks) MDecimalToBigInt(destinationHandle int32, decimalHandle int32, roundingMode int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalToBigInt(%s, %s, %d)", w.handleArg("MDecimalToBigInt", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("MDecimalToBigInt", "decimalHandle", executor.ManagedDecimalHandleArg, decimalHandle, true), roundingMode)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalToBigInt(destinationHandle, decimalHandle, roundingMode)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalGetMantissa VM hook wrapper
+func (w *WrapperVMHooks) MDecimalGetMantissa(destinationHandle int32, decimalHandle int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalGetMantissa(%s, %s)", w.handleArg("MDecimalGetMantissa", "destinationHandle", executor.BigIntHandleArg, destinationHandle, false), w.handleArg("MDecimalGetMantissa", "decimalHandle", executor.ManagedDecimalHandleArg, decimalHandle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalGetMantissa(destinationHandle, decimalHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalGetScale VM hook wrapper
+func (w *WrapperVMHooks) MDecimalGetScale(decimalHandle int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalGetScale(%s)", w.handleArg("MDecimalGetScale", "decimalHandle", executor.ManagedDecimalHandleArg, decimalHandle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalGetScale(decimalHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalFromManagedBuffer VM hook wrapper
+func (w *WrapperVMHooks) MDecimalFromManagedBuffer(destinationHandle int32, mBufferHandle int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalFromManagedBuffer(%s, %s)", w.handleArg("MDecimalFromManagedBuffer", "destinationHandle", executor.ManagedDecimalHandleArg, destinationHandle, false), w.handleArg("MDecimalFromManagedBuffer", "mBufferHandle", executor.ManagedBufferHandleArg, mBufferHandle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalFromManagedBuffer(destinationHandle, mBufferHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalToManagedBuffer VM hook wrapper
+func (w *WrapperVMHooks) MDecimalToManagedBuffer(decimalHandle int32, destinationHandle int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalToManagedBuffer(%s, %s)", w.handleArg("MDecimalToManagedBuffer", "decimalHandle", executor.ManagedDecimalHandleArg, decimalHandle, true), w.handleArg("MDecimalToManagedBuffer", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalToManagedBuffer(decimalHandle, destinationHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalAdd VM hook wrapper
+func (w *WrapperVMHooks) MDecimalAdd(destinationHandle int32, op1Handle int32, op2Handle int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalAdd(%s, %s, %s)", w.handleArg("MDecimalAdd", "destinationHandle", executor.ManagedDecimalHandleArg, destinationHandle, false), w.handleArg("MDecimalAdd", "op1Handle", executor.ManagedDecimalHandleArg, op1Handle, true), w.handleArg("MDecimalAdd", "op2Handle", executor.ManagedDecimalHandleArg, op2Handle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalAdd(destinationHandle, op1Handle, op2Handle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalSub VM hook wrapper
+func (w *WrapperVMHooks) MDecimalSub(destinationHandle int32, op1Handle int32, op2Handle int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalSub(%s, %s, %s)", w.handleArg("MDecimalSub", "destinationHandle", executor.ManagedDecimalHandleArg, destinationHandle, false), w.handleArg("MDecimalSub", "op1Handle", executor.ManagedDecimalHandleArg, op1Handle, true), w.handleArg("MDecimalSub", "op2Handle", executor.ManagedDecimalHandleArg, op2Handle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalSub(destinationHandle, op1Handle, op2Handle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalMul VM hook wrapper
+func (w *WrapperVMHooks) MDecimalMul(destinationHandle int32, op1Handle int32, op2Handle int32, roundingMode int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalMul(%s, %s, %s, %d)", w.handleArg("MDecimalMul", "destinationHandle", executor.ManagedDecimalHandleArg, destinationHandle, false), w.handleArg("MDecimalMul", "op1Handle", executor.ManagedDecimalHandleArg, op1Handle, true), w.handleArg("MDecimalMul", "op2Handle", executor.ManagedDecimalHandleArg, op2Handle, true), roundingMode)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalMul(destinationHandle, op1Handle, op2Handle, roundingMode)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalDiv VM hook wrapper
+func (w *WrapperVMHooks) MDecimalDiv(destinationHandle int32, op1Handle int32, op2Handle int32, roundingMode int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalDiv(%s, %s, %s, %d)", w.handleArg("MDecimalDiv", "destinationHandle", executor.ManagedDecimalHandleArg, destinationHandle, false), w.handleArg("MDecimalDiv", "op1Handle", executor.ManagedDecimalHandleArg, op1Handle, true), w.handleArg("MDecimalDiv", "op2Handle", executor.ManagedDecimalHandleArg, op2Handle, true), roundingMode)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalDiv(destinationHandle, op1Handle, op2Handle, roundingMode)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalRescale VM hook wrapper
+func (w *WrapperVMHooks) MDecimalRescale(destinationHandle int32, opHandle int32, scale int32, roundingMode int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalRescale(%s, %s, %d, %d)", w.handleArg("MDecimalRescale", "destinationHandle", executor.ManagedDecimalHandleArg, destinationHandle, false), w.handleArg("MDecimalRescale", "opHandle", executor.ManagedDecimalHandleArg, opHandle, true), scale, roundingMode)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalRescale(destinationHandle, opHandle, scale, roundingMode)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalCmp VM hook wrapper
+func (w *WrapperVMHooks) MDecimalCmp(op1Handle int32, op2Handle int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalCmp(%s, %s)", w.handleArg("MDecimalCmp", "op1Handle", executor.ManagedDecimalHandleArg, op1Handle, true), w.handleArg("MDecimalCmp", "op2Handle", executor.ManagedDecimalHandleArg, op2Handle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalCmp(op1Handle, op2Handle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalLn VM hook wrapper
+func (w *WrapperVMHooks) MDecimalLn(destinationHandle int32, opHandle int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalLn(%s, %s)", w.handleArg("MDecimalLn", "destinationHandle", executor.ManagedDecimalHandleArg, destinationHandle, false), w.handleArg("MDecimalLn", "opHandle", executor.ManagedDecimalHandleArg, opHandle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalLn(destinationHandle, opHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MDecimalExp VM hook wrapper
+func (w *WrapperVMHooks) MDecimalExp(destinationHandle int32, opHandle int32) int32 {
+	callInfo := fmt.Sprintf("MDecimalExp(%s, %s)", w.handleArg("MDecimalExp", "destinationHandle", executor.ManagedDecimalHandleArg, destinationHandle, false), w.handleArg("MDecimalExp", "opHandle", executor.ManagedDecimalHandleArg, opHandle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MDecimalExp(destinationHandle, opHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MBufferTransientStorageStore VM hook wrapper
+func (w *WrapperVMHooks) MBufferTransientStorageStore(keyHandle int32, sourceHandle int32) int32 {
+	callInfo := fmt.Sprintf("MBufferTransientStorageStore(%s, %s)", w.handleArg("MBufferTransientStorageStore", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("MBufferTransientStorageStore", "sourceHandle", executor.ManagedBufferHandleArg, sourceHandle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MBufferTransientStorageStore(keyHandle, sourceHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// MBufferTransientStorageLoad VM hook wrapper
+func (w *WrapperVMHooks) MBufferTransientStorageLoad(keyHandle int32, destinationHandle int32) int32 {
+	callInfo := fmt.Sprintf("MBufferTransientStorageLoad(%s, %s)", w.handleArg("MBufferTransientStorageLoad", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("MBufferTransientStorageLoad", "destinationHandle", executor.ManagedBufferHandleArg, destinationHandle, false))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.MBufferTransientStorageLoad(keyHandle, destinationHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ProtectContractAgainstReentrancy VM hook wrapper
+func (w *WrapperVMHooks) ProtectContractAgainstReentrancy() int32 {
+	callInfo := "ProtectContractAgainstReentrancy()"
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ProtectContractAgainstReentrancy()
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// ManagedProtectEndpointAgainstReentrancy VM hook wrapper
+func (w *WrapperVMHooks) ManagedProtectEndpointAgainstReentrancy(endpointHandle int32) int32 {
+	callInfo := fmt.Sprintf("ManagedProtectEndpointAgainstReentrancy(%s)", w.handleArg("ManagedProtectEndpointAgainstReentrancy", "endpointHandle", executor.ManagedBufferHandleArg, endpointHandle, true))
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.ManagedProtectEndpointAgainstReentrancy(endpointHandle)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// EthGetCallDataSize VM hook wrapper
+func (w *WrapperVMHooks) EthGetCallDataSize() int32 {
+	callInfo := "EthGetCallDataSize()"
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.EthGetCallDataSize()
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// EthCallDataCopy VM hook wrapper
+func (w *WrapperVMHooks) EthCallDataCopy(resultOffset executor.MemPtr, dataOffset int32, length executor.MemLength) {
+	callInfo := fmt.Sprintf("EthCallDataCopy(%d, %d, %d)", resultOffset, dataOffset, length)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthCallDataCopy(resultOffset, dataOffset, length)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
+// EthStorageStore VM hook wrapper
+func (w *WrapperVMHooks) EthStorageStore(pathOffset executor.MemPtr, valueOffset executor.MemPtr) {
+	callInfo := fmt.Sprintf("EthStorageStore(%d, %d)", pathOffset, valueOffset)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthStorageStore(pathOffset, valueOffset)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
+// EthStorageLoad VM hook wrapper
+func (w *WrapperVMHooks) EthStorageLoad(pathOffset executor.MemPtr, resultOffset executor.MemPtr) {
+	callInfo := fmt.Sprintf("EthStorageLoad(%d, %d)", pathOffset, resultOffset)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthStorageLoad(pathOffset, resultOffset)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
+// EthGetCallValue VM hook wrapper
+func (w *WrapperVMHooks) EthGetCallValue(resultOffset executor.MemPtr) {
+	callInfo := fmt.Sprintf("EthGetCallValue(%d)", resultOffset)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthGetCallValue(resultOffset)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
+// EthGetCaller VM hook wrapper
+func (w *WrapperVMHooks) EthGetCaller(resultOffset executor.MemPtr) {
+	callInfo := fmt.Sprintf("EthGetCaller(%d)", resultOffset)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthGetCaller(resultOffset)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
+// EthGetAddress VM hook wrapper
+func (w *WrapperVMHooks) EthGetAddress(resultOffset executor.MemPtr) {
+	callInfo := fmt.Sprintf("EthGetAddress(%d)", resultOffset)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthGetAddress(resultOffset)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
+// EthGetTxOrigin VM hook wrapper
+func (w *WrapperVMHooks) EthGetTxOrigin(resultOffset executor.MemPtr) {
+	callInfo := fmt.Sprintf("EthGetTxOrigin(%d)", resultOffset)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthGetTxOrigin(resultOffset)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
+// EthGetGasLeft VM hook wrapper
+func (w *WrapperVMHooks) EthGetGasLeft() int64 {
+	callInfo := "EthGetGasLeft()"
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.EthGetGasLeft()
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// EthGetBlockNumber VM hook wrapper
+func (w *WrapperVMHooks) EthGetBlockNumber() int64 {
+	callInfo := "EthGetBlockNumber()"
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.EthGetBlockNumber()
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// EthGetBlockTimestamp VM hook wrapper
+func (w *WrapperVMHooks) EthGetBlockTimestamp() int64 {
+	callInfo := "EthGetBlockTimestamp()"
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.EthGetBlockTimestamp()
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
+// EthLog VM hook wrapper
+func (w *WrapperVMHooks) EthLog(dataOffset executor.MemPtr, dataLength executor.MemLength, numberOfTopics int32, topic1Offset executor.MemPtr, topic2Offset executor.MemPtr, topic3Offset executor.MemPtr, topic4Offset executor.MemPtr) {
+	callInfo := fmt.Sprintf("EthLog(%d, %d, %d, %d, %d, %d, %d)", dataOffset, dataLength, numberOfTopics, topic1Offset, topic2Offset, topic3Offset, topic4Offset)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthLog(dataOffset, dataLength, numberOfTopics, topic1Offset, topic2Offset, topic3Offset, topic4Offset)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
+// EthFinish VM hook wrapper
+func (w *WrapperVMHooks) EthFinish(dataOffset executor.MemPtr, dataLength executor.MemLength) {
+	callInfo := fmt.Sprintf("EthFinish(%d, %d)", dataOffset, dataLength)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthFinish(dataOffset, dataLength)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
+// EthRevert VM hook wrapper
+func (w *WrapperVMHooks) EthRevert(dataOffset executor.MemPtr, dataLength executor.MemLength) {
+	callInfo := fmt.Sprintf("EthRevert(%d, %d)", dataOffset, dataLength)
+	w.logger.LogVMHookCallBefore(callInfo)
+	w.wrappedVMHooks.EthRevert(dataOffset, dataLength)
+	w.logger.LogVMHookCallAfter(callInfo)
+}
+
 // SmallIntGetUnsignedArgument VM hook wrapper
 func (w *WrapperVMHooks) SmallIntGetUnsignedArgument(id int32) int64 {
 	callInfo := fmt.Sprintf("SmallIntGetUnsignedArgument(%d)", id)
@@ -2043,6 +2394,15 @@ func (w *WrapperVMHooks) Int64storageLoad(keyOffset executor.MemPtr, keyLength e
 	return result
 }
 
+// SmallIntGetRandomInRange VM hook wrapper
+func (w *WrapperVMHooks) SmallIntGetRandomInRange(min int64, max int64) int64 {
+	callInfo := fmt.Sprintf("SmallIntGetRandomInRange(%d, %d)", min, max)
+	w.logger.LogVMHookCallBefore(callInfo)
+	result := w.wrappedVMHooks.SmallIntGetRandomInRange(min, max)
+	w.logger.LogVMHookCallAfter(callInfo)
+	return result
+}
+
 // Sha256 VM hook wrapper
 func (w *WrapperVMHooks) Sha256(dataOffset executor.MemPtr, length executor.MemLength, resultOffset executor.MemPtr) int32 {
 	callInfo := fmt.Sprintf("Sha256(%d, %d, %d)", dataOffset, length, resultOffset)
@@ -2054,7 +2414,7 @@ func (w *WrapperVMHooks) Sha256(dataOffset executor.MemPtr, length executor.MemL
 
 // ManagedSha256 VM hook wrapper
 func (w *WrapperVMHooks) ManagedSha256(inputHandle int32, outputHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedSha256(%d, %d)", inputHandle, outputHandle)
+	callInfo := fmt.Sprintf("ManagedSha256(%s, %s)", w.handleArg("ManagedSha256", "inputHandle", executor.ManagedBufferHandleArg, inputHandle, true), w.handleArg("ManagedSha256", "outputHandle", executor.ManagedBufferHandleArg, outputHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedSha256(inputHandle, outputHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2072,7 +2432,7 @@ func (w *WrapperVMHooks) Keccak256(dataOffset executor.MemPtr, length executor.M
 
 // ManagedKeccak256 VM hook wrapper
 func (w *WrapperVMHooks) ManagedKeccak256(inputHandle int32, outputHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedKeccak256(%d, %d)", inputHandle, outputHandle)
+	callInfo := fmt.Sprintf("ManagedKeccak256(%s, %s)", w.handleArg("ManagedKeccak256", "inputHandle", executor.ManagedBufferHandleArg, inputHandle, true), w.handleArg("ManagedKeccak256", "outputHandle", executor.ManagedBufferHandleArg, outputHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedKeccak256(inputHandle, outputHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2090,7 +2450,7 @@ func (w *WrapperVMHooks) Ripemd160(dataOffset executor.MemPtr, length executor.M
 
 // ManagedRipemd160 VM hook wrapper
 func (w *WrapperVMHooks) ManagedRipemd160(inputHandle int32, outputHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedRipemd160(%d, %d)", inputHandle, outputHandle)
+	callInfo := fmt.Sprintf("ManagedRipemd160(%s, %s)", w.handleArg("ManagedRipemd160", "inputHandle", executor.ManagedBufferHandleArg, inputHandle, true), w.handleArg("ManagedRipemd160", "outputHandle", executor.ManagedBufferHandleArg, outputHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedRipemd160(inputHandle, outputHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2108,7 +2468,7 @@ func (w *WrapperVMHooks) VerifyBLS(keyOffset executor.MemPtr, messageOffset exec
 
 // ManagedVerifyBLS VM hook wrapper
 func (w *WrapperVMHooks) ManagedVerifyBLS(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedVerifyBLS(%d, %d, %d)", keyHandle, messageHandle, sigHandle)
+	callInfo := fmt.Sprintf("ManagedVerifyBLS(%s, %s, %s)", w.handleArg("ManagedVerifyBLS", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedVerifyBLS", "messageHandle", executor.ManagedBufferHandleArg, messageHandle, true), w.handleArg("ManagedVerifyBLS", "sigHandle", executor.ManagedBufferHandleArg, sigHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyBLS(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2126,7 +2486,7 @@ func (w *WrapperVMHooks) VerifyEd25519(keyOffset executor.MemPtr, messageOffset
 
 // ManagedVerifyEd25519 VM hook wrapper
 func (w *WrapperVMHooks) ManagedVerifyEd25519(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedVerifyEd25519(%d, %d, %d)", keyHandle, messageHandle, sigHandle)
+	callInfo := fmt.Sprintf("ManagedVerifyEd25519(%s, %s, %s)", w.handleArg("ManagedVerifyEd25519", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedVerifyEd25519", "messageHandle", executor.ManagedBufferHandleArg, messageHandle, true), w.handleArg("ManagedVerifyEd25519", "sigHandle", executor.ManagedBufferHandleArg, sigHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyEd25519(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2144,7 +2504,7 @@ func (w *WrapperVMHooks) VerifyCustomSecp256k1(keyOffset executor.MemPtr, keyLen
 
 // ManagedVerifyCustomSecp256k1 VM hook wrapper
 func (w *WrapperVMHooks) ManagedVerifyCustomSecp256k1(keyHandle int32, messageHandle int32, sigHandle int32, hashType int32) int32 {
-	callInfo := fmt.Sprintf("ManagedVerifyCustomSecp256k1(%d, %d, %d, %d)", keyHandle, messageHandle, sigHandle, hashType)
+	callInfo := fmt.Sprintf("ManagedVerifyCustomSecp256k1(%s, %s, %s, %d)", w.handleArg("ManagedVerifyCustomSecp256k1", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedVerifyCustomSecp256k1", "messageHandle", executor.ManagedBufferHandleArg, messageHandle, true), w.handleArg("ManagedVerifyCustomSecp256k1", "sigHandle", executor.ManagedBufferHandleArg, sigHandle, true), hashType)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyCustomSecp256k1(keyHandle, messageHandle, sigHandle, hashType)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2162,7 +2522,7 @@ func (w *WrapperVMHooks) VerifySecp256k1(keyOffset executor.MemPtr, keyLength ex
 
 // ManagedVerifySecp256k1 VM hook wrapper
 func (w *WrapperVMHooks) ManagedVerifySecp256k1(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedVerifySecp256k1(%d, %d, %d)", keyHandle, messageHandle, sigHandle)
+	callInfo := fmt.Sprintf("ManagedVerifySecp256k1(%s, %s, %s)", w.handleArg("ManagedVerifySecp256k1", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedVerifySecp256k1", "messageHandle", executor.ManagedBufferHandleArg, messageHandle, true), w.handleArg("ManagedVerifySecp256k1", "sigHandle", executor.ManagedBufferHandleArg, sigHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifySecp256k1(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2180,7 +2540,7 @@ func (w *WrapperVMHooks) EncodeSecp256k1DerSignature(rOffset executor.MemPtr, rL
 
 // ManagedEncodeSecp256k1DerSignature VM hook wrapper
 func (w *WrapperVMHooks) ManagedEncodeSecp256k1DerSignature(rHandle int32, sHandle int32, sigHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedEncodeSecp256k1DerSignature(%d, %d, %d)", rHandle, sHandle, sigHandle)
+	callInfo := fmt.Sprintf("ManagedEncodeSecp256k1DerSignature(%s, %s, %s)", w.handleArg("ManagedEncodeSecp256k1DerSignature", "rHandle", executor.ManagedBufferHandleArg, rHandle, true), w.handleArg("ManagedEncodeSecp256k1DerSignature", "sHandle", executor.ManagedBufferHandleArg, sHandle, true), w.handleArg("ManagedEncodeSecp256k1DerSignature", "sigHandle", executor.ManagedBufferHandleArg, sigHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedEncodeSecp256k1DerSignature(rHandle, sHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2189,7 +2549,7 @@ func (w *WrapperVMHooks) ManagedEncodeSecp256k1DerSignature(rHandle int32, sHand
 
 // AddEC VM hook wrapper
 func (w *WrapperVMHooks) AddEC(xResultHandle int32, yResultHandle int32, ecHandle int32, fstPointXHandle int32, fstPointYHandle int32, sndPointXHandle int32, sndPointYHandle int32) {
-	callInfo := fmt.Sprintf("AddEC(%d, %d, %d, %d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, fstPointXHandle, fstPointYHandle, sndPointXHandle, sndPointYHandle)
+	callInfo := fmt.Sprintf("AddEC(%s, %s, %s, %s, %s, %s, %s)", w.handleArg("AddEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("AddEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("AddEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("AddEC", "fstPointXHandle", executor.BigIntHandleArg, fstPointXHandle, true), w.handleArg("AddEC", "fstPointYHandle", executor.BigIntHandleArg, fstPointYHandle, true), w.handleArg("AddEC", "sndPointXHandle", executor.BigIntHandleArg, sndPointXHandle, true), w.handleArg("AddEC", "sndPointYHandle", executor.BigIntHandleArg, sndPointYHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.AddEC(xResultHandle, yResultHandle, ecHandle, fstPointXHandle, fstPointYHandle, sndPointXHandle, sndPointYHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2197,7 +2557,7 @@ func (w *WrapperVMHooks) AddEC(xResultHandle int32, yResultHandle int32, ecHandl
 
 // DoubleEC VM hook wrapper
 func (w *WrapperVMHooks) DoubleEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32) {
-	callInfo := fmt.Sprintf("DoubleEC(%d, %d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle)
+	callInfo := fmt.Sprintf("DoubleEC(%s, %s, %s, %s, %s)", w.handleArg("DoubleEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("DoubleEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("DoubleEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("DoubleEC", "pointXHandle", executor.BigIntHandleArg, pointXHandle, true), w.handleArg("DoubleEC", "pointYHandle", executor.BigIntHandleArg, pointYHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	w.wrappedVMHooks.DoubleEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2205,7 +2565,7 @@ func (w *WrapperVMHooks) DoubleEC(xResultHandle int32, yResultHandle int32, ecHa
 
 // IsOnCurveEC VM hook wrapper
 func (w *WrapperVMHooks) IsOnCurveEC(ecHandle int32, pointXHandle int32, pointYHandle int32) int32 {
-	callInfo := fmt.Sprintf("IsOnCurveEC(%d, %d, %d)", ecHandle, pointXHandle, pointYHandle)
+	callInfo := fmt.Sprintf("IsOnCurveEC(%s, %s, %s)", w.handleArg("IsOnCurveEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("IsOnCurveEC", "pointXHandle", executor.BigIntHandleArg, pointXHandle, true), w.handleArg("IsOnCurveEC", "pointYHandle", executor.BigIntHandleArg, pointYHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.IsOnCurveEC(ecHandle, pointXHandle, pointYHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2214,7 +2574,7 @@ func (w *WrapperVMHooks) IsOnCurveEC(ecHandle int32, pointXHandle int32, pointYH
 
 // ScalarBaseMultEC VM hook wrapper
 func (w *WrapperVMHooks) ScalarBaseMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset executor.MemPtr, length executor.MemLength) int32 {
-	callInfo := fmt.Sprintf("ScalarBaseMultEC(%d, %d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, dataOffset, length)
+	callInfo := fmt.Sprintf("ScalarBaseMultEC(%s, %s, %s, %d, %d)", w.handleArg("ScalarBaseMultEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("ScalarBaseMultEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("ScalarBaseMultEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), dataOffset, length)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ScalarBaseMultEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2223,7 +2583,7 @@ func (w *WrapperVMHooks) ScalarBaseMultEC(xResultHandle int32, yResultHandle int
 
 // ManagedScalarBaseMultEC VM hook wrapper
 func (w *WrapperVMHooks) ManagedScalarBaseMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedScalarBaseMultEC(%d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, dataHandle)
+	callInfo := fmt.Sprintf("ManagedScalarBaseMultEC(%s, %s, %s, %s)", w.handleArg("ManagedScalarBaseMultEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("ManagedScalarBaseMultEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("ManagedScalarBaseMultEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("ManagedScalarBaseMultEC", "dataHandle", executor.ManagedBufferHandleArg, dataHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedScalarBaseMultEC(xResultHandle, yResultHandle, ecHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2232,7 +2592,7 @@ func (w *WrapperVMHooks) ManagedScalarBaseMultEC(xResultHandle int32, yResultHan
 
 // ScalarMultEC VM hook wrapper
 func (w *WrapperVMHooks) ScalarMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32, dataOffset executor.MemPtr, length executor.MemLength) int32 {
-	callInfo := fmt.Sprintf("ScalarMultEC(%d, %d, %d, %d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataOffset, length)
+	callInfo := fmt.Sprintf("ScalarMultEC(%s, %s, %s, %s, %s, %d, %d)", w.handleArg("ScalarMultEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("ScalarMultEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("ScalarMultEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("ScalarMultEC", "pointXHandle", executor.BigIntHandleArg, pointXHandle, true), w.handleArg("ScalarMultEC", "pointYHandle", executor.BigIntHandleArg, pointYHandle, true), dataOffset, length)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ScalarMultEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2241,7 +2601,7 @@ func (w *WrapperVMHooks) ScalarMultEC(xResultHandle int32, yResultHandle int32,
 
 // ManagedScalarMultEC VM hook wrapper
 func (w *WrapperVMHooks) ManagedScalarMultEC(xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32, dataHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedScalarMultEC(%d, %d, %d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataHandle)
+	callInfo := fmt.Sprintf("ManagedScalarMultEC(%s, %s, %s, %s, %s, %s)", w.handleArg("ManagedScalarMultEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("ManagedScalarMultEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("ManagedScalarMultEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("ManagedScalarMultEC", "pointXHandle", executor.BigIntHandleArg, pointXHandle, true), w.handleArg("ManagedScalarMultEC", "pointYHandle", executor.BigIntHandleArg, pointYHandle, true), w.handleArg("ManagedScalarMultEC", "dataHandle", executor.ManagedBufferHandleArg, dataHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedScalarMultEC(xResultHandle, yResultHandle, ecHandle, pointXHandle, pointYHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2250,7 +2610,7 @@ func (w *WrapperVMHooks) ManagedScalarMultEC(xResultHandle int32, yResultHandle
 
 // MarshalEC VM hook wrapper
 func (w *WrapperVMHooks) MarshalEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("MarshalEC(%d, %d, %d, %d)", xPairHandle, yPairHandle, ecHandle, resultOffset)
+	callInfo := fmt.Sprintf("MarshalEC(%s, %s, %s, %d)", w.handleArg("MarshalEC", "xPairHandle", executor.BigIntHandleArg, xPairHandle, true), w.handleArg("MarshalEC", "yPairHandle", executor.BigIntHandleArg, yPairHandle, true), w.handleArg("MarshalEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), resultOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MarshalEC(xPairHandle, yPairHandle, ecHandle, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2259,7 +2619,7 @@ func (w *WrapperVMHooks) MarshalEC(xPairHandle int32, yPairHandle int32, ecHandl
 
 // ManagedMarshalEC VM hook wrapper
 func (w *WrapperVMHooks) ManagedMarshalEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedMarshalEC(%d, %d, %d, %d)", xPairHandle, yPairHandle, ecHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedMarshalEC(%s, %s, %s, %s)", w.handleArg("ManagedMarshalEC", "xPairHandle", executor.BigIntHandleArg, xPairHandle, true), w.handleArg("ManagedMarshalEC", "yPairHandle", executor.BigIntHandleArg, yPairHandle, true), w.handleArg("ManagedMarshalEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("ManagedMarshalEC", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMarshalEC(xPairHandle, yPairHandle, ecHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2268,7 +2628,7 @@ func (w *WrapperVMHooks) ManagedMarshalEC(xPairHandle int32, yPairHandle int32,
 
 // MarshalCompressedEC VM hook wrapper
 func (w *WrapperVMHooks) MarshalCompressedEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("MarshalCompressedEC(%d, %d, %d, %d)", xPairHandle, yPairHandle, ecHandle, resultOffset)
+	callInfo := fmt.Sprintf("MarshalCompressedEC(%s, %s, %s, %d)", w.handleArg("MarshalCompressedEC", "xPairHandle", executor.BigIntHandleArg, xPairHandle, true), w.handleArg("MarshalCompressedEC", "yPairHandle", executor.BigIntHandleArg, yPairHandle, true), w.handleArg("MarshalCompressedEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), resultOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.MarshalCompressedEC(xPairHandle, yPairHandle, ecHandle, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2277,7 +2637,7 @@ func (w *WrapperVMHooks) MarshalCompressedEC(xPairHandle int32, yPairHandle int3
 
 // ManagedMarshalCompressedEC VM hook wrapper
 func (w *WrapperVMHooks) ManagedMarshalCompressedEC(xPairHandle int32, yPairHandle int32, ecHandle int32, resultHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedMarshalCompressedEC(%d, %d, %d, %d)", xPairHandle, yPairHandle, ecHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedMarshalCompressedEC(%s, %s, %s, %s)", w.handleArg("ManagedMarshalCompressedEC", "xPairHandle", executor.BigIntHandleArg, xPairHandle, true), w.handleArg("ManagedMarshalCompressedEC", "yPairHandle", executor.BigIntHandleArg, yPairHandle, true), w.handleArg("ManagedMarshalCompressedEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("ManagedMarshalCompressedEC", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedMarshalCompressedEC(xPairHandle, yPairHandle, ecHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2286,7 +2646,7 @@ func (w *WrapperVMHooks) ManagedMarshalCompressedEC(xPairHandle int32, yPairHand
 
 // UnmarshalEC VM hook wrapper
 func (w *WrapperVMHooks) UnmarshalEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset executor.MemPtr, length executor.MemLength) int32 {
-	callInfo := fmt.Sprintf("UnmarshalEC(%d, %d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, dataOffset, length)
+	callInfo := fmt.Sprintf("UnmarshalEC(%s, %s, %s, %d, %d)", w.handleArg("UnmarshalEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("UnmarshalEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("UnmarshalEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), dataOffset, length)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.UnmarshalEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2295,7 +2655,7 @@ func (w *WrapperVMHooks) UnmarshalEC(xResultHandle int32, yResultHandle int32, e
 
 // ManagedUnmarshalEC VM hook wrapper
 func (w *WrapperVMHooks) ManagedUnmarshalEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedUnmarshalEC(%d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, dataHandle)
+	callInfo := fmt.Sprintf("ManagedUnmarshalEC(%s, %s, %s, %s)", w.handleArg("ManagedUnmarshalEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("ManagedUnmarshalEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("ManagedUnmarshalEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("ManagedUnmarshalEC", "dataHandle", executor.ManagedBufferHandleArg, dataHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedUnmarshalEC(xResultHandle, yResultHandle, ecHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2304,7 +2664,7 @@ func (w *WrapperVMHooks) ManagedUnmarshalEC(xResultHandle int32, yResultHandle i
 
 // UnmarshalCompressedEC VM hook wrapper
 func (w *WrapperVMHooks) UnmarshalCompressedEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataOffset executor.MemPtr, length executor.MemLength) int32 {
-	callInfo := fmt.Sprintf("UnmarshalCompressedEC(%d, %d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, dataOffset, length)
+	callInfo := fmt.Sprintf("UnmarshalCompressedEC(%s, %s, %s, %d, %d)", w.handleArg("UnmarshalCompressedEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("UnmarshalCompressedEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("UnmarshalCompressedEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), dataOffset, length)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.UnmarshalCompressedEC(xResultHandle, yResultHandle, ecHandle, dataOffset, length)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2313,7 +2673,7 @@ func (w *WrapperVMHooks) UnmarshalCompressedEC(xResultHandle int32, yResultHandl
 
 // ManagedUnmarshalCompressedEC VM hook wrapper
 func (w *WrapperVMHooks) ManagedUnmarshalCompressedEC(xResultHandle int32, yResultHandle int32, ecHandle int32, dataHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedUnmarshalCompressedEC(%d, %d, %d, %d)", xResultHandle, yResultHandle, ecHandle, dataHandle)
+	callInfo := fmt.Sprintf("ManagedUnmarshalCompressedEC(%s, %s, %s, %s)", w.handleArg("ManagedUnmarshalCompressedEC", "xResultHandle", executor.BigIntHandleArg, xResultHandle, true), w.handleArg("ManagedUnmarshalCompressedEC", "yResultHandle", executor.BigIntHandleArg, yResultHandle, true), w.handleArg("ManagedUnmarshalCompressedEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("ManagedUnmarshalCompressedEC", "dataHandle", executor.ManagedBufferHandleArg, dataHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedUnmarshalCompressedEC(xResultHandle, yResultHandle, ecHandle, dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2322,7 +2682,7 @@ func (w *WrapperVMHooks) ManagedUnmarshalCompressedEC(xResultHandle int32, yResu
 
 // GenerateKeyEC VM hook wrapper
 func (w *WrapperVMHooks) GenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle int32, ecHandle int32, resultOffset executor.MemPtr) int32 {
-	callInfo := fmt.Sprintf("GenerateKeyEC(%d, %d, %d, %d)", xPubKeyHandle, yPubKeyHandle, ecHandle, resultOffset)
+	callInfo := fmt.Sprintf("GenerateKeyEC(%s, %s, %s, %d)", w.handleArg("GenerateKeyEC", "xPubKeyHandle", executor.BigIntHandleArg, xPubKeyHandle, true), w.handleArg("GenerateKeyEC", "yPubKeyHandle", executor.BigIntHandleArg, yPubKeyHandle, true), w.handleArg("GenerateKeyEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), resultOffset)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GenerateKeyEC(xPubKeyHandle, yPubKeyHandle, ecHandle, resultOffset)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2331,7 +2691,7 @@ func (w *WrapperVMHooks) GenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle int32,
 
 // ManagedGenerateKeyEC VM hook wrapper
 func (w *WrapperVMHooks) ManagedGenerateKeyEC(xPubKeyHandle int32, yPubKeyHandle int32, ecHandle int32, resultHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedGenerateKeyEC(%d, %d, %d, %d)", xPubKeyHandle, yPubKeyHandle, ecHandle, resultHandle)
+	callInfo := fmt.Sprintf("ManagedGenerateKeyEC(%s, %s, %s, %s)", w.handleArg("ManagedGenerateKeyEC", "xPubKeyHandle", executor.BigIntHandleArg, xPubKeyHandle, true), w.handleArg("ManagedGenerateKeyEC", "yPubKeyHandle", executor.BigIntHandleArg, yPubKeyHandle, true), w.handleArg("ManagedGenerateKeyEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("ManagedGenerateKeyEC", "resultHandle", executor.ManagedBufferHandleArg, resultHandle, false))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedGenerateKeyEC(xPubKeyHandle, yPubKeyHandle, ecHandle, resultHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2343,13 +2703,13 @@ func (w *WrapperVMHooks) CreateEC(dataOffset executor.MemPtr, dataLength executo
 	callInfo := fmt.Sprintf("CreateEC(%d, %d)", dataOffset, dataLength)
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.CreateEC(dataOffset, dataLength)
-	w.logger.LogVMHookCallAfter(callInfo)
+	w.logger.LogVMHookCallAfter(callInfo + w.handleResult(executor.EllipticCurveHandleArg, result))
 	return result
 }
 
 // ManagedCreateEC VM hook wrapper
 func (w *WrapperVMHooks) ManagedCreateEC(dataHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedCreateEC(%d)", dataHandle)
+	callInfo := fmt.Sprintf("ManagedCreateEC(%s)", w.handleArg("ManagedCreateEC", "dataHandle", executor.ManagedBufferHandleArg, dataHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedCreateEC(dataHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2358,7 +2718,7 @@ func (w *WrapperVMHooks) ManagedCreateEC(dataHandle int32) int32 {
 
 // GetCurveLengthEC VM hook wrapper
 func (w *WrapperVMHooks) GetCurveLengthEC(ecHandle int32) int32 {
-	callInfo := fmt.Sprintf("GetCurveLengthEC(%d)", ecHandle)
+	callInfo := fmt.Sprintf("GetCurveLengthEC(%s)", w.handleArg("GetCurveLengthEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetCurveLengthEC(ecHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2367,7 +2727,7 @@ func (w *WrapperVMHooks) GetCurveLengthEC(ecHandle int32) int32 {
 
 // GetPrivKeyByteLengthEC VM hook wrapper
 func (w *WrapperVMHooks) GetPrivKeyByteLengthEC(ecHandle int32) int32 {
-	callInfo := fmt.Sprintf("GetPrivKeyByteLengthEC(%d)", ecHandle)
+	callInfo := fmt.Sprintf("GetPrivKeyByteLengthEC(%s)", w.handleArg("GetPrivKeyByteLengthEC", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.GetPrivKeyByteLengthEC(ecHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2376,7 +2736,7 @@ func (w *WrapperVMHooks) GetPrivKeyByteLengthEC(ecHandle int32) int32 {
 
 // EllipticCurveGetValues VM hook wrapper
 func (w *WrapperVMHooks) EllipticCurveGetValues(ecHandle int32, fieldOrderHandle int32, basePointOrderHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32) int32 {
-	callInfo := fmt.Sprintf("EllipticCurveGetValues(%d, %d, %d, %d, %d, %d)", ecHandle, fieldOrderHandle, basePointOrderHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle)
+	callInfo := fmt.Sprintf("EllipticCurveGetValues(%s, %s, %s, %s, %s, %s)", w.handleArg("EllipticCurveGetValues", "ecHandle", executor.EllipticCurveHandleArg, ecHandle, true), w.handleArg("EllipticCurveGetValues", "fieldOrderHandle", executor.BigIntHandleArg, fieldOrderHandle, true), w.handleArg("EllipticCurveGetValues", "basePointOrderHandle", executor.BigIntHandleArg, basePointOrderHandle, true), w.handleArg("EllipticCurveGetValues", "eqConstantHandle", executor.BigIntHandleArg, eqConstantHandle, true), w.handleArg("EllipticCurveGetValues", "xBasePointHandle", executor.BigIntHandleArg, xBasePointHandle, true), w.handleArg("EllipticCurveGetValues", "yBasePointHandle", executor.BigIntHandleArg, yBasePointHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.EllipticCurveGetValues(ecHandle, fieldOrderHandle, basePointOrderHandle, eqConstantHandle, xBasePointHandle, yBasePointHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2385,7 +2745,7 @@ func (w *WrapperVMHooks) EllipticCurveGetValues(ecHandle int32, fieldOrderHandle
 
 // ManagedVerifySecp256r1 VM hook wrapper
 func (w *WrapperVMHooks) ManagedVerifySecp256r1(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedVerifySecp256r1(%d, %d, %d)", keyHandle, messageHandle, sigHandle)
+	callInfo := fmt.Sprintf("ManagedVerifySecp256r1(%s, %s, %s)", w.handleArg("ManagedVerifySecp256r1", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedVerifySecp256r1", "messageHandle", executor.ManagedBufferHandleArg, messageHandle, true), w.handleArg("ManagedVerifySecp256r1", "sigHandle", executor.ManagedBufferHandleArg, sigHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifySecp256r1(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2394,7 +2754,7 @@ func (w *WrapperVMHooks) ManagedVerifySecp256r1(keyHandle int32, messageHandle i
 
 // ManagedVerifyBLSSignatureShare VM hook wrapper
 func (w *WrapperVMHooks) ManagedVerifyBLSSignatureShare(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedVerifyBLSSignatureShare(%d, %d, %d)", keyHandle, messageHandle, sigHandle)
+	callInfo := fmt.Sprintf("ManagedVerifyBLSSignatureShare(%s, %s, %s)", w.handleArg("ManagedVerifyBLSSignatureShare", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedVerifyBLSSignatureShare", "messageHandle", executor.ManagedBufferHandleArg, messageHandle, true), w.handleArg("ManagedVerifyBLSSignatureShare", "sigHandle", executor.ManagedBufferHandleArg, sigHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyBLSSignatureShare(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -2403,7 +2763,7 @@ func (w *WrapperVMHooks) ManagedVerifyBLSSignatureShare(keyHandle int32, message
 
 // ManagedVerifyBLSAggregatedSignature VM hook wrapper
 func (w *WrapperVMHooks) ManagedVerifyBLSAggregatedSignature(keyHandle int32, messageHandle int32, sigHandle int32) int32 {
-	callInfo := fmt.Sprintf("ManagedVerifyBLSAggregatedSignature(%d, %d, %d)", keyHandle, messageHandle, sigHandle)
+	callInfo := fmt.Sprintf("ManagedVerifyBLSAggregatedSignature(%s, %s, %s)", w.handleArg("ManagedVerifyBLSAggregatedSignature", "keyHandle", executor.ManagedBufferHandleArg, keyHandle, true), w.handleArg("ManagedVerifyBLSAggregatedSignature", "messageHandle", executor.ManagedBufferHandleArg, messageHandle, true), w.handleArg("ManagedVerifyBLSAggregatedSignature", "sigHandle", executor.ManagedBufferHandleArg, sigHandle, true))
 	w.logger.LogVMHookCallBefore(callInfo)
 	result := w.wrappedVMHooks.ManagedVerifyBLSAggregatedSignature(keyHandle, messageHandle, sigHandle)
 	w.logger.LogVMHookCallAfter(callInfo)
@@ -0,0 +1,98 @@
+package forensics
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+const (
+	bundleFileName = "bundle.json"
+	memoryFileName = "memory.bin"
+)
+
+// SaveBundle writes the bundle as a zip archive, holding the JSON-encoded state and the raw WASM memory.
+func SaveBundle(path string, bundle *vmhost.ForensicsBundle) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	archive := zip.NewWriter(file)
+
+	// the memory is kept apart, so that the JSON stays readable
+	bundleWithoutMemory := *bundle
+	bundleWithoutMemory.Memory = nil
+	bundleJSON, err := json.MarshalIndent(&bundleWithoutMemory, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = writeArchiveFile(archive, bundleFileName, bundleJSON)
+	if err != nil {
+		return err
+	}
+	err = writeArchiveFile(archive, memoryFileName, bundle.Memory)
+	if err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+// LoadBundle reads a bundle saved by SaveBundle, e.g. to replay the failed input in a test.
+func LoadBundle(path string) (*vmhost.ForensicsBundle, error) {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = archive.Close()
+	}()
+
+	bundleJSON, err := readArchiveFile(&archive.Reader, bundleFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &vmhost.ForensicsBundle{}
+	err = json.Unmarshal(bundleJSON, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle.Memory, err = readArchiveFile(&archive.Reader, memoryFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+func writeArchiveFile(archive *zip.Writer, name string, data []byte) error {
+	fileWriter, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = fileWriter.Write(data)
+	return err
+}
+
+func readArchiveFile(archive *zip.Reader, name string) ([]byte, error) {
+	fileReader, err := archive.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = fileReader.Close()
+	}()
+
+	return io.ReadAll(fileReader)
+}
@@ -0,0 +1,113 @@
+package forensics
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var log = logger.GetOrCreate("vm/forensics")
+
+var _ vmhost.ForensicsWriter = (*Writer)(nil)
+var _ executorwrapper.ExecutorLogger = (*Writer)(nil)
+
+// DefaultMaxVMHookCalls is the number of VM hook calls kept when no other value is configured.
+const DefaultMaxVMHookCalls = 100
+
+// Writer is an opt-in vmhost.ForensicsWriter, which saves every bundle as an archive in a directory.
+// It also remembers the last VM hook calls, by wrapping the executor of the VM host.
+type Writer struct {
+	directory      string
+	maxVMHookCalls int
+
+	mutex         sync.Mutex
+	vmHookCalls   []string
+	nextCallIndex int
+	numArchives   int
+}
+
+// NewWriter creates a forensics Writer that saves its archives in the given directory, creating it if needed.
+func NewWriter(directory string, maxVMHookCalls int) (*Writer, error) {
+	if maxVMHookCalls <= 0 {
+		maxVMHookCalls = DefaultMaxVMHookCalls
+	}
+
+	err := os.MkdirAll(directory, 0750)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		directory:      directory,
+		maxVMHookCalls: maxVMHookCalls,
+		vmHookCalls:    make([]string, 0, maxVMHookCalls),
+	}, nil
+}
+
+// WrapExecutorFactory wraps the executor factory, so that the writer sees every VM hook call.
+func (writer *Writer) WrapExecutorFactory(factory executor.ExecutorAbstractFactory) executor.ExecutorAbstractFactory {
+	return executorwrapper.NewWrappedExecutorFactory(writer, factory)
+}
+
+// LogExecutorEvent ignores the executor events, only the VM hook calls are kept.
+func (writer *Writer) LogExecutorEvent(_ string) {
+}
+
+// LogVMHookCallBefore remembers the VM hook call, discarding the oldest one when the limit is reached.
+func (writer *Writer) LogVMHookCallBefore(callInfo string) {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if len(writer.vmHookCalls) < writer.maxVMHookCalls {
+		writer.vmHookCalls = append(writer.vmHookCalls, callInfo)
+		return
+	}
+
+	writer.vmHookCalls[writer.nextCallIndex] = callInfo
+	writer.nextCallIndex = (writer.nextCallIndex + 1) % writer.maxVMHookCalls
+}
+
+// LogVMHookCallAfter does nothing, the call was already recorded before being processed.
+func (writer *Writer) LogVMHookCallAfter(_ string) {
+}
+
+// LastVMHookCalls returns the remembered VM hook calls, from the oldest to the most recent.
+func (writer *Writer) LastVMHookCalls() []string {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	result := make([]string, 0, len(writer.vmHookCalls))
+	result = append(result, writer.vmHookCalls[writer.nextCallIndex:]...)
+	return append(result, writer.vmHookCalls[:writer.nextCallIndex]...)
+}
+
+// WriteForensics completes the bundle with the last VM hook calls and saves it as a new archive.
+func (writer *Writer) WriteForensics(bundle *vmhost.ForensicsBundle) error {
+	bundle.VMHookCalls = writer.LastVMHookCalls()
+
+	writer.mutex.Lock()
+	writer.numArchives++
+	fileName := fmt.Sprintf("forensics-%s-%d.zip", time.Now().UTC().Format("20060102T150405"), writer.numArchives)
+	writer.mutex.Unlock()
+
+	path := filepath.Join(writer.directory, fileName)
+	err := SaveBundle(path, bundle)
+	if err != nil {
+		return err
+	}
+
+	log.Warn("forensics archive written", "path", path, "reason", bundle.Reason)
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (writer *Writer) IsInterfaceNil() bool {
+	return writer == nil
+}
@@ -0,0 +1,78 @@
+package forensics
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter_LastVMHookCalls(t *testing.T) {
+	t.Parallel()
+
+	writer, err := NewWriter(t.TempDir(), 3)
+	require.Nil(t, err)
+	require.Empty(t, writer.LastVMHookCalls())
+
+	writer.LogVMHookCallBefore("GetGasLeft()")
+	writer.LogVMHookCallAfter("GetGasLeft()")
+	require.Equal(t, []string{"GetGasLeft()"}, writer.LastVMHookCalls())
+
+	for i := 0; i < 4; i++ {
+		writer.LogVMHookCallBefore(fmt.Sprintf("BigIntAdd(%d, %d, %d)", i, i, i))
+	}
+	require.Equal(t, []string{
+		"BigIntAdd(1, 1, 1)",
+		"BigIntAdd(2, 2, 2)",
+		"BigIntAdd(3, 3, 3)",
+	}, writer.LastVMHookCalls())
+}
+
+func TestWriter_WriteForensicsAndLoad(t *testing.T) {
+	t.Parallel()
+
+	directory := filepath.Join(t.TempDir(), "forensics")
+	writer, err := NewWriter(directory, 0)
+	require.Nil(t, err)
+	writer.LogVMHookCallBefore("SignalError(0, 5)")
+
+	bundle := &vmhost.ForensicsBundle{
+		Reason:     vmhost.ErrExecutionPanicked.Error(),
+		PanicValue: "runtime error: index out of range",
+		GoStack:    "goroutine 1 [running]",
+		CallInput: &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  []byte("caller"),
+				Arguments:   [][]byte{{1, 2}},
+				CallValue:   big.NewInt(10),
+				CallType:    vm.DirectCall,
+				GasProvided: 100000,
+			},
+			RecipientAddr: []byte("contract"),
+			Function:      "doSomething",
+		},
+		CallStack: []vmhost.CallFrame{
+			{ContractAddress: []byte("contract"), CodeAddress: []byte("contract"), Function: "doSomething", GasProvided: 100000},
+		},
+		GasProvided:  100000,
+		GasLeft:      4000,
+		ManagedTypes: vmhost.ManagedTypesSummary{BigInts: 2, ManagedBuffers: 1, ManagedBufferBytes: 5},
+		Memory:       []byte{0, 1, 2, 3},
+	}
+	require.Nil(t, writer.WriteForensics(bundle))
+
+	entries, err := os.ReadDir(directory)
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+
+	loaded, err := LoadBundle(filepath.Join(directory, entries[0].Name()))
+	require.Nil(t, err)
+	require.Equal(t, []string{"SignalError(0, 5)"}, loaded.VMHookCalls)
+	require.Equal(t, bundle, loaded)
+}
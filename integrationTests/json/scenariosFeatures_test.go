@@ -3,6 +3,9 @@ package vmjsonintegrationtest
 import (
 	"path/filepath"
 	"testing"
+
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
 )
 
 func TestRustAllocFeatures(t *testing.T) {
@@ -37,6 +40,7 @@ func TestRustBasicFeaturesLatest(t *testing.T) {
 		Folder("features/basic-features/scenarios").
 		Exclude("features/basic-features/scenarios/storage_mapper_fungible_token.scen.json").
 		Exclude("features/basic-features/scenarios/get_shard_of_address.scen.json").
+		Exclude("features/basic-features/scenarios/managed_buffer_set_random.scen.json").
 		Run().
 		CheckNoError()
 }
@@ -50,6 +54,7 @@ func TestRustBasicFeaturesBarnard(t *testing.T) {
 		Folder("features/basic-features/scenarios").
 		Exclude("features/basic-features/scenarios/storage_mapper_fungible_token.scen.json").
 		Exclude("features/basic-features/scenarios/get_shard_of_address.scen.json").
+		Exclude("features/basic-features/scenarios/managed_buffer_set_random.scen.json").
 		ReplacePath(
 			"../output/basic-features.mxsc.json",
 			filepath.Join(getTestRoot(), "features/basic-features/output/basic-features-barnard.mxsc.json"),
@@ -58,6 +63,19 @@ func TestRustBasicFeaturesBarnard(t *testing.T) {
 		CheckNoError()
 }
 
+func TestRustBasicFeaturesRandomWithoutPerCallRandomness(t *testing.T) {
+	if testing.Short() {
+		t.Skip("not a short test")
+	}
+
+	ScenariosTest(t).
+		Folder("features/basic-features/scenarios").
+		File("managed_buffer_set_random.scen.json").
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
+		Run().
+		CheckNoError()
+}
+
 func TestRustBasicFeaturesNoSmallIntApi(t *testing.T) {
 	if testing.Short() {
 		t.Skip("not a short test")
@@ -148,6 +166,7 @@ func TestRustPayableFeaturesBarnard(t *testing.T) {
 func TestRustComposability(t *testing.T) {
 	ScenariosTest(t).
 		Folder("features/composability/scenarios").
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.StructuredVMErrorsFlag)).
 		Run().
 		CheckNoError()
 }
@@ -170,6 +189,7 @@ func TestForwarderTransfExecFallibleMultiReject(t *testing.T) {
 	ScenariosTest(t).
 		Folder("features/composability/scenarios").
 		File("forw_raw_transf_exec_fallible_multi_egld_reject.scen.json").
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.StructuredVMErrorsFlag)).
 		Run().
 		CheckNoError()
 }
@@ -0,0 +1,361 @@
+package math
+
+import (
+	"math/big"
+)
+
+// RoundingMode selects how a decimal value is rounded when digits are dropped
+type RoundingMode int32
+
+const (
+	// RoundFloor rounds towards negative infinity
+	RoundFloor RoundingMode = 0
+	// RoundCeil rounds towards positive infinity
+	RoundCeil RoundingMode = 1
+	// RoundHalfEven rounds to the nearest value, ties going to the even neighbour
+	RoundHalfEven RoundingMode = 2
+)
+
+// MaxDecimalScale is the maximum number of fractional digits a decimal can have
+const MaxDecimalScale = 64
+
+// decimalGuardDigits are the extra digits used internally by the ln and exp approximations
+const decimalGuardDigits = 10
+
+// maxDecimalSeriesIterations bounds the number of terms evaluated by the ln and exp series
+const maxDecimalSeriesIterations = 300
+
+// maxDecimalExpArgument is the largest absolute integer part accepted by ExpDecimal
+const maxDecimalExpArgument = 256
+
+var bigTen = big.NewInt(10)
+
+// Decimal is a fixed-point number, with the value Mantissa * 10^-Scale
+type Decimal struct {
+	Mantissa *big.Int
+	Scale    uint32
+}
+
+// NewDecimal creates a decimal from the raw mantissa and scale
+func NewDecimal(mantissa *big.Int, scale uint32) (*Decimal, error) {
+	if scale > MaxDecimalScale {
+		return nil, ErrDecimalScaleTooLarge
+	}
+	if mantissa == nil {
+		mantissa = big.NewInt(0)
+	}
+
+	return &Decimal{
+		Mantissa: big.NewInt(0).Set(mantissa),
+		Scale:    scale,
+	}, nil
+}
+
+// Clone returns a deep copy of the decimal
+func (d *Decimal) Clone() *Decimal {
+	return &Decimal{
+		Mantissa: big.NewInt(0).Set(d.Mantissa),
+		Scale:    d.Scale,
+	}
+}
+
+// IsValidRoundingMode checks if the rounding mode is one of the supported ones
+func IsValidRoundingMode(mode RoundingMode) bool {
+	return mode == RoundFloor || mode == RoundCeil || mode == RoundHalfEven
+}
+
+// RescaleDecimal changes the scale of a decimal, rounding with the given mode when digits are dropped
+func RescaleDecimal(d *Decimal, scale uint32, mode RoundingMode) (*Decimal, error) {
+	if scale > MaxDecimalScale {
+		return nil, ErrDecimalScaleTooLarge
+	}
+	if !IsValidRoundingMode(mode) {
+		return nil, ErrInvalidRoundingMode
+	}
+
+	return &Decimal{
+		Mantissa: rescaleMantissa(d.Mantissa, d.Scale, scale, mode),
+		Scale:    scale,
+	}, nil
+}
+
+// AddDecimal adds two decimals. The result has the larger of the two scales and is exact.
+func AddDecimal(a, b *Decimal) *Decimal {
+	scale, mantissaA, mantissaB := alignDecimals(a, b)
+	return &Decimal{
+		Mantissa: big.NewInt(0).Add(mantissaA, mantissaB),
+		Scale:    scale,
+	}
+}
+
+// SubDecimal subtracts b from a. The result has the larger of the two scales and is exact.
+func SubDecimal(a, b *Decimal) *Decimal {
+	scale, mantissaA, mantissaB := alignDecimals(a, b)
+	return &Decimal{
+		Mantissa: big.NewInt(0).Sub(mantissaA, mantissaB),
+		Scale:    scale,
+	}
+}
+
+// MulDecimal multiplies two decimals. The result has the larger of the two scales,
+// the dropped digits are rounded with the given mode.
+func MulDecimal(a, b *Decimal, mode RoundingMode) (*Decimal, error) {
+	if !IsValidRoundingMode(mode) {
+		return nil, ErrInvalidRoundingMode
+	}
+
+	product := big.NewInt(0).Mul(a.Mantissa, b.Mantissa)
+	scale := maxScale(a, b)
+	return &Decimal{
+		Mantissa: rescaleMantissa(product, a.Scale+b.Scale, scale, mode),
+		Scale:    scale,
+	}, nil
+}
+
+// DivDecimal divides a by b. The result has the larger of the two scales,
+// the dropped digits are rounded with the given mode.
+func DivDecimal(a, b *Decimal, mode RoundingMode) (*Decimal, error) {
+	if !IsValidRoundingMode(mode) {
+		return nil, ErrInvalidRoundingMode
+	}
+	if b.Mantissa.Sign() == 0 {
+		return nil, ErrDecimalDivisionByZero
+	}
+
+	scale := maxScale(a, b)
+	numerator := big.NewInt(0).Mul(a.Mantissa, pow10(scale+b.Scale-a.Scale))
+	return &Decimal{
+		Mantissa: divRound(numerator, b.Mantissa, mode),
+		Scale:    scale,
+	}, nil
+}
+
+// CmpDecimal compares two decimals, returning -1, 0 or +1
+func CmpDecimal(a, b *Decimal) int {
+	_, mantissaA, mantissaB := alignDecimals(a, b)
+	return mantissaA.Cmp(mantissaB)
+}
+
+// DecimalToBigInt rounds the decimal to an integer with the given mode
+func DecimalToBigInt(d *Decimal, mode RoundingMode) (*big.Int, error) {
+	rounded, err := RescaleDecimal(d, 0, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return rounded.Mantissa, nil
+}
+
+// LnDecimal approximates the natural logarithm of a strictly positive decimal.
+// The result has the same scale as the argument and is within one unit in the last place.
+func LnDecimal(d *Decimal) (*Decimal, error) {
+	if d.Mantissa.Sign() <= 0 {
+		return nil, ErrDecimalLnOfNonPositive
+	}
+
+	workingScale := lnWorkingScale(d)
+	one := pow10(workingScale)
+	x := rescaleMantissa(d.Mantissa, d.Scale, workingScale, RoundHalfEven)
+
+	// x = y * 2^k, with y in [1, 2); once x has as many bits as one, y is in (1/2, 2), so one more shift may be needed
+	k := int64(x.BitLen() - one.BitLen())
+	if k > 0 {
+		x.Rsh(x, uint(k))
+	} else {
+		x.Lsh(x, uint(-k))
+	}
+	if x.Cmp(one) < 0 {
+		x.Lsh(x, 1)
+		k--
+	}
+	two := big.NewInt(0).Lsh(one, 1)
+
+	lnY, err := lnNearOne(x, one)
+	if err != nil {
+		return nil, err
+	}
+
+	ln2, err := lnNearOne(two, one)
+	if err != nil {
+		return nil, err
+	}
+
+	result := big.NewInt(0).Mul(ln2, big.NewInt(k))
+	result.Add(result, lnY)
+
+	return &Decimal{
+		Mantissa: rescaleMantissa(result, workingScale, d.Scale, RoundHalfEven),
+		Scale:    d.Scale,
+	}, nil
+}
+
+// ExpDecimal approximates e raised to the given decimal.
+// The result has the same scale as the argument and is within one unit in the last place.
+func ExpDecimal(d *Decimal) (*Decimal, error) {
+	integerPart := big.NewInt(0).Quo(d.Mantissa, pow10(d.Scale))
+	if integerPart.CmpAbs(big.NewInt(maxDecimalExpArgument)) > 0 {
+		return nil, ErrDecimalExpArgumentTooLarge
+	}
+
+	workingScale := expWorkingScale(d, integerPart)
+	one := pow10(workingScale)
+	x := rescaleMantissa(d.Mantissa, d.Scale, workingScale, RoundHalfEven)
+
+	// x = k*ln2 + r, with |r| <= ln2/2, so that exp(x) = 2^k * exp(r)
+	ln2, err := lnNearOne(big.NewInt(0).Lsh(one, 1), one)
+	if err != nil {
+		return nil, err
+	}
+	k := divRound(x, ln2, RoundHalfEven)
+	r := big.NewInt(0).Sub(x, big.NewInt(0).Mul(k, ln2))
+
+	sum := big.NewInt(0).Set(one)
+	term := big.NewInt(0).Set(one)
+	converged := false
+	for i := int64(1); i <= maxDecimalSeriesIterations; i++ {
+		term.Mul(term, r)
+		term.Quo(term, one)
+		term.Quo(term, big.NewInt(i))
+		if term.Sign() == 0 {
+			converged = true
+			break
+		}
+		sum.Add(sum, term)
+	}
+	if !converged {
+		return nil, ErrDecimalSeriesDidNotConverge
+	}
+
+	if k.Sign() >= 0 {
+		sum.Lsh(sum, uint(k.Uint64()))
+	} else {
+		sum.Rsh(sum, uint(big.NewInt(0).Neg(k).Uint64()))
+	}
+
+	return &Decimal{
+		Mantissa: rescaleMantissa(sum, workingScale, d.Scale, RoundHalfEven),
+		Scale:    d.Scale,
+	}, nil
+}
+
+// LnDecimalPrecision returns the number of fractional digits the series of LnDecimal are evaluated with. Both series
+// need about one term per digit, each term costing operations on numbers of that many digits.
+func LnDecimalPrecision(d *Decimal) uint64 {
+	return uint64(lnWorkingScale(d))
+}
+
+// ExpDecimalPrecision returns the number of fractional digits the series of ExpDecimal are evaluated with, which
+// grows with the integer part of the argument.
+func ExpDecimalPrecision(d *Decimal) uint64 {
+	integerPart := big.NewInt(0).Quo(d.Mantissa, pow10(d.Scale))
+	if integerPart.CmpAbs(big.NewInt(maxDecimalExpArgument)) > 0 {
+		// rejected by ExpDecimal before any series is evaluated
+		return uint64(d.Scale + decimalGuardDigits)
+	}
+
+	return uint64(expWorkingScale(d, integerPart))
+}
+
+// MantissaDigits returns an upper bound of the number of decimal digits of the mantissa, derived from its bit length.
+// Rescaling the argument of LnDecimal and ExpDecimal to the working precision takes time linear in this length.
+func MantissaDigits(d *Decimal) uint64 {
+	// log10(2) < 0.30103
+	return uint64(d.Mantissa.BitLen())*30103/100000 + 1
+}
+
+func lnWorkingScale(d *Decimal) uint32 {
+	return d.Scale + decimalGuardDigits
+}
+
+// expWorkingScale adds extra digits for large results, so that the relative error stays below one unit in the last
+// place, e^n having less than n/2+1 integer digits
+func expWorkingScale(d *Decimal, integerPart *big.Int) uint32 {
+	workingScale := d.Scale + decimalGuardDigits
+	if integerPart.Sign() > 0 {
+		workingScale += uint32(integerPart.Uint64()/2 + 1)
+	}
+
+	return workingScale
+}
+
+// lnNearOne computes ln(x) for x in [1, 2], all values being fixed-point with the given one,
+// using ln(x) = 2 * atanh((x-1)/(x+1))
+func lnNearOne(x *big.Int, one *big.Int) (*big.Int, error) {
+	numerator := big.NewInt(0).Sub(x, one)
+	denominator := big.NewInt(0).Add(x, one)
+	z := big.NewInt(0).Mul(numerator, one)
+	z.Quo(z, denominator)
+
+	zSquared := big.NewInt(0).Mul(z, z)
+	zSquared.Quo(zSquared, one)
+
+	sum := big.NewInt(0)
+	power := big.NewInt(0).Set(z)
+	for i := int64(0); i < maxDecimalSeriesIterations; i++ {
+		term := big.NewInt(0).Quo(power, big.NewInt(2*i+1))
+		if term.Sign() == 0 {
+			return sum.Lsh(sum, 1), nil
+		}
+		sum.Add(sum, term)
+		power.Mul(power, zSquared)
+		power.Quo(power, one)
+	}
+
+	return nil, ErrDecimalSeriesDidNotConverge
+}
+
+func alignDecimals(a, b *Decimal) (uint32, *big.Int, *big.Int) {
+	scale := maxScale(a, b)
+	mantissaA := big.NewInt(0).Mul(a.Mantissa, pow10(scale-a.Scale))
+	mantissaB := big.NewInt(0).Mul(b.Mantissa, pow10(scale-b.Scale))
+	return scale, mantissaA, mantissaB
+}
+
+func maxScale(a, b *Decimal) uint32 {
+	if a.Scale > b.Scale {
+		return a.Scale
+	}
+	return b.Scale
+}
+
+func rescaleMantissa(mantissa *big.Int, fromScale uint32, toScale uint32, mode RoundingMode) *big.Int {
+	if toScale >= fromScale {
+		return big.NewInt(0).Mul(mantissa, pow10(toScale-fromScale))
+	}
+
+	return divRound(mantissa, pow10(fromScale-toScale), mode)
+}
+
+// divRound divides two integers, rounding the quotient with the given mode
+func divRound(numerator *big.Int, denominator *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := big.NewInt(0).QuoRem(numerator, denominator, big.NewInt(0))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	// the exact result lies strictly between quotient and quotient+sign
+	sign := numerator.Sign() * denominator.Sign()
+	switch mode {
+	case RoundFloor:
+		if sign < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		}
+	case RoundCeil:
+		if sign > 0 {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	case RoundHalfEven:
+		doubleRemainder := big.NewInt(0).Abs(remainder)
+		doubleRemainder.Lsh(doubleRemainder, 1)
+		cmp := doubleRemainder.CmpAbs(denominator)
+		if cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1) {
+			quotient.Add(quotient, big.NewInt(int64(sign)))
+		}
+	}
+
+	return quotient
+}
+
+func pow10(exponent uint32) *big.Int {
+	return big.NewInt(0).Exp(bigTen, big.NewInt(int64(exponent)), nil)
+}
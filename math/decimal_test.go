@@ -0,0 +1,181 @@
+package math
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func decimalFromString(t *testing.T, mantissa string, scale uint32) *Decimal {
+	value, ok := big.NewInt(0).SetString(mantissa, 10)
+	require.True(t, ok)
+	d, err := NewDecimal(value, scale)
+	require.Nil(t, err)
+	return d
+}
+
+func requireDecimal(t *testing.T, expectedMantissa string, expectedScale uint32, actual *Decimal) {
+	require.Equal(t, expectedMantissa, actual.Mantissa.String())
+	require.Equal(t, expectedScale, actual.Scale)
+}
+
+func TestNewDecimal_ScaleTooLarge(t *testing.T) {
+	_, err := NewDecimal(big.NewInt(1), MaxDecimalScale+1)
+	require.Equal(t, ErrDecimalScaleTooLarge, err)
+}
+
+func TestRescaleDecimal_RoundingModes(t *testing.T) {
+	cases := []struct {
+		mantissa string
+		mode     RoundingMode
+		expected string
+	}{
+		{"125", RoundFloor, "12"},
+		{"125", RoundCeil, "13"},
+		{"125", RoundHalfEven, "12"},
+		{"135", RoundHalfEven, "14"},
+		{"126", RoundHalfEven, "13"},
+		{"-125", RoundFloor, "-13"},
+		{"-125", RoundCeil, "-12"},
+		{"-125", RoundHalfEven, "-12"},
+		{"-135", RoundHalfEven, "-14"},
+		{"-124", RoundHalfEven, "-12"},
+		{"120", RoundCeil, "12"},
+	}
+
+	for _, c := range cases {
+		result, err := RescaleDecimal(decimalFromString(t, c.mantissa, 2), 1, c.mode)
+		require.Nil(t, err)
+		requireDecimal(t, c.expected, 1, result)
+	}
+
+	result, err := RescaleDecimal(decimalFromString(t, "5", 0), 3, RoundFloor)
+	require.Nil(t, err)
+	requireDecimal(t, "5000", 3, result)
+
+	_, err = RescaleDecimal(decimalFromString(t, "5", 0), 3, RoundingMode(7))
+	require.Equal(t, ErrInvalidRoundingMode, err)
+}
+
+func TestDecimalArithmetic_ScaleAlignment(t *testing.T) {
+	a := decimalFromString(t, "150", 2) // 1.50
+	b := decimalFromString(t, "2", 0)   // 2
+
+	requireDecimal(t, "350", 2, AddDecimal(a, b))
+	requireDecimal(t, "-50", 2, SubDecimal(a, b))
+	require.Equal(t, -1, CmpDecimal(a, b))
+	require.Equal(t, 0, CmpDecimal(decimalFromString(t, "20", 1), b))
+
+	product, err := MulDecimal(a, decimalFromString(t, "333", 3), RoundHalfEven)
+	require.Nil(t, err)
+	requireDecimal(t, "500", 3, product) // 1.5 * 0.333 = 0.4995
+
+	quotient, err := DivDecimal(b, decimalFromString(t, "3", 0), RoundFloor)
+	require.Nil(t, err)
+	requireDecimal(t, "0", 0, quotient)
+
+	quotient, err = DivDecimal(decimalFromString(t, "200", 2), decimalFromString(t, "3", 0), RoundCeil)
+	require.Nil(t, err)
+	requireDecimal(t, "67", 2, quotient)
+
+	_, err = DivDecimal(a, decimalFromString(t, "0", 5), RoundFloor)
+	require.Equal(t, ErrDecimalDivisionByZero, err)
+}
+
+func TestDecimalToBigInt(t *testing.T) {
+	value, err := DecimalToBigInt(decimalFromString(t, "-2500", 3), RoundHalfEven)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(-2), value)
+
+	value, err = DecimalToBigInt(decimalFromString(t, "-2500", 3), RoundFloor)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(-3), value)
+}
+
+func TestLnDecimal(t *testing.T) {
+	result, err := LnDecimal(decimalFromString(t, "1000000000000000000", 18))
+	require.Nil(t, err)
+	requireDecimal(t, "0", 18, result)
+
+	result, err = LnDecimal(decimalFromString(t, "2000000000000000000", 18))
+	require.Nil(t, err)
+	requireDecimal(t, "693147180559945309", 18, result)
+
+	result, err = LnDecimal(decimalFromString(t, "1", 18))
+	require.Nil(t, err)
+	requireDecimal(t, "-41446531673892822312", 18, result)
+
+	result, err = LnDecimal(decimalFromString(t, "10", 0))
+	require.Nil(t, err)
+	requireDecimal(t, "2", 0, result)
+
+	_, err = LnDecimal(decimalFromString(t, "0", 3))
+	require.Equal(t, ErrDecimalLnOfNonPositive, err)
+}
+
+func TestLnDecimal_LongMantissa(t *testing.T) {
+	// ln(2^100000) = 69314.718...
+	x := &Decimal{Mantissa: big.NewInt(0).Lsh(big.NewInt(1), 100000), Scale: 0}
+	result, err := LnDecimal(x)
+	require.Nil(t, err)
+	requireDecimal(t, "69315", 0, result)
+
+	// ln(2^-3) = -2.0794415...
+	x = &Decimal{Mantissa: big.NewInt(125), Scale: 3}
+	result, err = LnDecimal(x)
+	require.Nil(t, err)
+	requireDecimal(t, "-2079", 3, result)
+}
+
+func TestExpDecimal(t *testing.T) {
+	result, err := ExpDecimal(decimalFromString(t, "0", 18))
+	require.Nil(t, err)
+	requireDecimal(t, "1000000000000000000", 18, result)
+
+	result, err = ExpDecimal(decimalFromString(t, "1000000000000000000", 18))
+	require.Nil(t, err)
+	requireDecimal(t, "2718281828459045235", 18, result)
+
+	result, err = ExpDecimal(decimalFromString(t, "-1000000000000000000", 18))
+	require.Nil(t, err)
+	requireDecimal(t, "367879441171442322", 18, result)
+
+	result, err = ExpDecimal(decimalFromString(t, "100", 0))
+	require.Nil(t, err)
+	requireDecimal(t, "26881171418161354484126255515800135873611119", 0, result)
+
+	_, err = ExpDecimal(decimalFromString(t, "257", 0))
+	require.Equal(t, ErrDecimalExpArgumentTooLarge, err)
+}
+
+func TestLnExpDecimal_RoundTrip(t *testing.T) {
+	x := decimalFromString(t, "123456789", 6)
+	ln, err := LnDecimal(x)
+	require.Nil(t, err)
+
+	back, err := ExpDecimal(ln)
+	require.Nil(t, err)
+
+	diff := SubDecimal(back, x)
+	require.True(t, diff.Mantissa.CmpAbs(big.NewInt(200)) <= 0)
+}
+
+func TestLnExpDecimalPrecision(t *testing.T) {
+	require.Equal(t, uint64(18+decimalGuardDigits), LnDecimalPrecision(decimalFromString(t, "123456789", 18)))
+
+	require.Equal(t, uint64(18+decimalGuardDigits), ExpDecimalPrecision(decimalFromString(t, "-1000000000000000000", 18)))
+	require.Equal(t, uint64(decimalGuardDigits+51), ExpDecimalPrecision(decimalFromString(t, "100", 0)))
+	require.Equal(t, uint64(decimalGuardDigits), ExpDecimalPrecision(decimalFromString(t, "257", 0)))
+}
+
+func TestMantissaDigits(t *testing.T) {
+	require.Equal(t, uint64(1), MantissaDigits(decimalFromString(t, "0", 0)))
+	require.Equal(t, uint64(1), MantissaDigits(decimalFromString(t, "7", 0)))
+	require.Equal(t, uint64(2), MantissaDigits(decimalFromString(t, "9", 0)))
+	require.Equal(t, uint64(4), MantissaDigits(decimalFromString(t, "-999", 2)))
+
+	// 2^100000 has 30103 digits and 100001 bits
+	x := &Decimal{Mantissa: big.NewInt(0).Lsh(big.NewInt(1), 100000), Scale: 0}
+	require.Equal(t, uint64(30104), MantissaDigits(x))
+}
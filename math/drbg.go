@@ -0,0 +1,129 @@
+package math
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/big"
+)
+
+// drbgDomainSeparator prefixes the key derivation, so that the DRBG keys can never collide with other hashes of the seed
+var drbgDomainSeparator = []byte("MVX_VM_DRBG_V1")
+
+// maxRandomRangeAttempts bounds the rejection sampling in the random range functions
+const maxRandomRangeAttempts = 1000
+
+type hmacDRBG struct {
+	key     []byte
+	counter uint64
+	block   []byte
+	offset  int
+}
+
+// NewHMACDRBG creates a deterministic random bit generator that runs HMAC-SHA256 in counter mode.
+// The key is derived from the seed and the domain parts, so different domains yield independent streams.
+func NewHMACDRBG(seed []byte, domain ...[]byte) *hmacDRBG {
+	keyHash := sha256.New()
+	writeLengthPrefixed(keyHash, drbgDomainSeparator)
+	writeLengthPrefixed(keyHash, seed)
+	for _, part := range domain {
+		writeLengthPrefixed(keyHash, part)
+	}
+
+	return &hmacDRBG{
+		key: keyHash.Sum(nil),
+	}
+}
+
+// Read generates len(p) random bytes and writes them into p. It always returns len(p) and a nil error.
+func (drbg *hmacDRBG) Read(p []byte) (n int, err error) {
+	for n < len(p) {
+		if drbg.offset == len(drbg.block) {
+			drbg.nextBlock()
+		}
+
+		copied := copy(p[n:], drbg.block[drbg.offset:])
+		drbg.offset += copied
+		n += copied
+	}
+
+	return n, nil
+}
+
+func (drbg *hmacDRBG) nextBlock() {
+	counterBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(counterBytes, drbg.counter)
+	drbg.counter++
+
+	mac := hmac.New(sha256.New, drbg.key)
+	_, _ = mac.Write(counterBytes)
+	drbg.block = mac.Sum(nil)
+	drbg.offset = 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drbg *hmacDRBG) IsInterfaceNil() bool {
+	return drbg == nil
+}
+
+func writeLengthPrefixed(writer io.Writer, data []byte) {
+	lengthBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(lengthBytes, uint32(len(data)))
+	_, _ = writer.Write(lengthBytes)
+	_, _ = writer.Write(data)
+}
+
+// RandomBigIntInRange draws a uniformly distributed integer from [min, max), using rejection sampling
+// so that the result has no modulo bias
+func RandomBigIntInRange(reader io.Reader, min *big.Int, max *big.Int) (*big.Int, error) {
+	span := big.NewInt(0).Sub(max, min)
+	if span.Sign() <= 0 {
+		return nil, ErrInvalidRandomRange
+	}
+
+	offset, err := randomBelow(reader, span)
+	if err != nil {
+		return nil, err
+	}
+
+	return offset.Add(offset, min), nil
+}
+
+// RandomInt64InRange draws a uniformly distributed integer from [min, max) without modulo bias
+func RandomInt64InRange(reader io.Reader, min int64, max int64) (int64, error) {
+	result, err := RandomBigIntInRange(reader, big.NewInt(min), big.NewInt(max))
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Int64(), nil
+}
+
+// randomBelow draws a uniform integer from [0, bound), discarding the candidates that fall outside the bound
+func randomBelow(reader io.Reader, bound *big.Int) (*big.Int, error) {
+	maxValue := big.NewInt(0).Sub(bound, big.NewInt(1))
+	bitLen := maxValue.BitLen()
+	if bitLen == 0 {
+		return big.NewInt(0), nil
+	}
+
+	byteLen := (bitLen + 7) / 8
+	excessBits := uint(byteLen*8 - bitLen)
+	buffer := make([]byte, byteLen)
+	candidate := big.NewInt(0)
+	for i := 0; i < maxRandomRangeAttempts; i++ {
+		_, err := io.ReadFull(reader, buffer)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer[0] &= byte(0xff >> excessBits)
+		candidate.SetBytes(buffer)
+		if candidate.Cmp(bound) < 0 {
+			return candidate, nil
+		}
+	}
+
+	return nil, ErrRandomRangeSamplingFailed
+}
@@ -0,0 +1,107 @@
+package math
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHMACDRBG_Deterministic(t *testing.T) {
+	t.Parallel()
+
+	seed := []byte("seed")
+	first := NewHMACDRBG(seed, []byte("tx"), []byte("sc"))
+	second := NewHMACDRBG(seed, []byte("tx"), []byte("sc"))
+	require.False(t, first.IsInterfaceNil())
+
+	a := make([]byte, 100)
+	n, err := first.Read(a)
+	require.Nil(t, err)
+	require.Equal(t, 100, n)
+
+	// reading in uneven chunks must yield the same stream
+	b := make([]byte, 100)
+	_, _ = second.Read(b[:7])
+	_, _ = second.Read(b[7:40])
+	_, _ = second.Read(b[40:])
+	require.Equal(t, a, b)
+
+	n, err = first.Read(nil)
+	require.Nil(t, err)
+	require.Equal(t, 0, n)
+}
+
+func TestHMACDRBG_DomainSeparation(t *testing.T) {
+	t.Parallel()
+
+	seed := []byte("seed")
+	streams := [][]byte{
+		readDRBG(NewHMACDRBG(seed, []byte("tx"), []byte("sc"))),
+		readDRBG(NewHMACDRBG(seed, []byte("tx"), []byte("sc2"))),
+		readDRBG(NewHMACDRBG(seed, []byte("txs"), []byte("c"))),
+		readDRBG(NewHMACDRBG(seed, []byte("tx"))),
+		readDRBG(NewHMACDRBG([]byte("other seed"), []byte("tx"), []byte("sc"))),
+	}
+
+	for i := range streams {
+		for j := i + 1; j < len(streams); j++ {
+			require.False(t, bytes.Equal(streams[i], streams[j]), "streams %d and %d are equal", i, j)
+		}
+	}
+}
+
+func TestHMACDRBG_BackwardsCompatible(t *testing.T) {
+	t.Parallel()
+
+	drbg := NewHMACDRBG([]byte("Backwards compatible test string"), []byte{0x01})
+	require.Equal(t, "2af3a7aa9d67271d59e1cb27565c80bba0ccdb6d6aa19ecdaf6e295a353602ce", hex.EncodeToString(readDRBG(drbg)))
+}
+
+func TestRandomBigIntInRange(t *testing.T) {
+	t.Parallel()
+
+	drbg := NewHMACDRBG([]byte("range"))
+	min := big.NewInt(-5)
+	max := big.NewInt(5)
+	seen := make(map[int64]int)
+	for i := 0; i < 2000; i++ {
+		value, err := RandomBigIntInRange(drbg, min, max)
+		require.Nil(t, err)
+		require.True(t, value.Cmp(min) >= 0)
+		require.True(t, value.Cmp(max) < 0)
+		seen[value.Int64()]++
+	}
+	require.Equal(t, 10, len(seen))
+
+	value, err := RandomBigIntInRange(drbg, big.NewInt(3), big.NewInt(4))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(3), value)
+
+	_, err = RandomBigIntInRange(drbg, max, min)
+	require.Equal(t, ErrInvalidRandomRange, err)
+	_, err = RandomBigIntInRange(drbg, min, min)
+	require.Equal(t, ErrInvalidRandomRange, err)
+}
+
+func TestRandomInt64InRange(t *testing.T) {
+	t.Parallel()
+
+	drbg := NewHMACDRBG([]byte("range"))
+	for i := 0; i < 100; i++ {
+		value, err := RandomInt64InRange(drbg, -1<<63, 1<<63-1)
+		require.Nil(t, err)
+		require.NotEqual(t, int64(1<<63-1), value)
+	}
+
+	_, err := RandomInt64InRange(drbg, 10, 0)
+	require.Equal(t, ErrInvalidRandomRange, err)
+}
+
+func readDRBG(drbg *hmacDRBG) []byte {
+	buffer := make([]byte, 32)
+	_, _ = drbg.Read(buffer)
+	return buffer
+}
@@ -24,3 +24,27 @@ var ErrBigFloatMul = errors.New("this big Float operation is not permitted while
 
 // ErrBigFloatSqrt is raised when sqrt of floats produces a panic
 var ErrBigFloatSqrt = errors.New("this big Float operation is not permitted while doing float.Sqrt")
+
+// ErrDecimalScaleTooLarge is raised when a decimal scale exceeds the maximum allowed scale
+var ErrDecimalScaleTooLarge = errors.New("decimal scale too large")
+
+// ErrInvalidRoundingMode is raised when the rounding mode is not floor, ceil or half-even
+var ErrInvalidRoundingMode = errors.New("invalid rounding mode")
+
+// ErrDecimalDivisionByZero is raised when a decimal is divided by zero
+var ErrDecimalDivisionByZero = errors.New("decimal division by zero")
+
+// ErrDecimalLnOfNonPositive is raised when the natural logarithm of a non-positive decimal is requested
+var ErrDecimalLnOfNonPositive = errors.New("natural logarithm of non-positive decimal")
+
+// ErrDecimalExpArgumentTooLarge is raised when the exponential of a decimal would be too large to represent
+var ErrDecimalExpArgumentTooLarge = errors.New("decimal exponential argument too large")
+
+// ErrDecimalSeriesDidNotConverge is raised when a decimal approximation exceeds its iteration budget
+var ErrDecimalSeriesDidNotConverge = errors.New("decimal series did not converge")
+
+// ErrInvalidRandomRange is raised when a random value is requested from an empty range
+var ErrInvalidRandomRange = errors.New("invalid random range")
+
+// ErrRandomRangeSamplingFailed is raised when the rejection sampling of a random value exceeds its attempt budget
+var ErrRandomRangeSamplingFailed = errors.New("random range sampling failed")
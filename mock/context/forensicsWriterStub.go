@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ vmhost.ForensicsWriter = (*ForensicsWriterStub)(nil)
+
+// ForensicsWriterStub is used in tests to check the ForensicsWriter interface method calls
+type ForensicsWriterStub struct {
+	WrapExecutorFactoryCalled func(factory executor.ExecutorAbstractFactory) executor.ExecutorAbstractFactory
+	WriteForensicsCalled      func(bundle *vmhost.ForensicsBundle) error
+}
+
+// WrapExecutorFactory mocked method, returns the given factory unless overridden
+func (f *ForensicsWriterStub) WrapExecutorFactory(factory executor.ExecutorAbstractFactory) executor.ExecutorAbstractFactory {
+	if f.WrapExecutorFactoryCalled != nil {
+		return f.WrapExecutorFactoryCalled(factory)
+	}
+	return factory
+}
+
+// WriteForensics mocked method
+func (f *ForensicsWriterStub) WriteForensics(bundle *vmhost.ForensicsBundle) error {
+	if f.WriteForensicsCalled != nil {
+		return f.WriteForensicsCalled(bundle)
+	}
+	return nil
+}
+
+// IsInterfaceNil mocked method
+func (f *ForensicsWriterStub) IsInterfaceNil() bool {
+	return f == nil
+}
@@ -117,8 +117,10 @@ func (instance *InstanceMock) IsAlreadyCleaned() bool {
 	return instance.AlreadyClean
 }
 
-// Reset mocked method
+// Reset mocked method; clears the gas used and the breakpoint, like the reset of the globals
 func (instance *InstanceMock) Reset() bool {
+	instance.Points = 0
+	instance.BreakpointValue = vmhost.BreakpointNone
 	return true
 }
 
@@ -146,6 +146,20 @@ func (r *RuntimeContextMock) CountSameContractInstancesOnStack(_ []byte) uint64
 	return r.SameContractOnStackCount
 }
 
+// IsContractOnTheStack mocked method
+func (r *RuntimeContextMock) IsContractOnTheStack(_ []byte) bool {
+	return r.SameContractOnStackCount > 0
+}
+
+// GetCallStack mocked method
+func (r *RuntimeContextMock) GetCallStack() []vmhost.CallFrame {
+	return []vmhost.CallFrame{{
+		ContractAddress: r.SCAddress,
+		CodeAddress:     r.SCAddress,
+		Function:        r.CallFunction,
+	}}
+}
+
 // GetContextAddress mocked method
 func (r *RuntimeContextMock) GetContextAddress() []byte {
 	return r.SCAddress
@@ -280,6 +294,15 @@ func (r *RuntimeContextMock) CallSCFunction(_ string) error {
 	return r.Err
 }
 
+// SetFootprintTrace mocked method
+func (r *RuntimeContextMock) SetFootprintTrace(_ bool) {
+}
+
+// GetInstanceFootprints mocked method
+func (r *RuntimeContextMock) GetInstanceFootprints() []*vmhost.InstanceFootprint {
+	return nil
+}
+
 // BaseOpsErrorShouldFailExecution mocked method
 func (r *RuntimeContextMock) BaseOpsErrorShouldFailExecution() bool {
 	return r.FailBaseOpsAPI
@@ -329,6 +352,15 @@ func (r *RuntimeContextMock) AddAsyncContextCall(_ []byte, _ *vmhost.AsyncGenera
 	return r.Err
 }
 
+// GetEthCallData mocked method
+func (r *RuntimeContextMock) GetEthCallData() []byte {
+	return nil
+}
+
+// SetEthCallData mocked method
+func (r *RuntimeContextMock) SetEthCallData(_ []byte) {
+}
+
 // SetCustomCallFunction mocked method
 func (r *RuntimeContextMock) SetCustomCallFunction(_ string) {
 }
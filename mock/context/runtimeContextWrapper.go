@@ -22,6 +22,10 @@ type RuntimeContextWrapper struct {
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	SetVMInputFunc func(vmInput *vmcommon.ContractCallInput)
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	GetEthCallDataFunc func() []byte
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	SetEthCallDataFunc func(callData []byte)
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	GetSCAddressFunc func() []byte
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	GetOriginalCallerAddressFunc func() []byte
@@ -66,6 +70,10 @@ type RuntimeContextWrapper struct {
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	CountSameContractInstancesOnStackFunc func(address []byte) uint64
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	IsContractOnTheStackFunc func(address []byte) bool
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	GetCallStackFunc func() []vmhost.CallFrame
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	IsFunctionImportedFunc func(name string) bool
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	ReadOnlyFunc func() bool
@@ -86,6 +94,10 @@ type RuntimeContextWrapper struct {
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	CallSCFunctionFunc func(string) error
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	SetFootprintTraceFunc func(footprintTrace bool)
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
+	GetInstanceFootprintsFunc func() []*vmhost.InstanceFootprint
+	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	GetPointsUsedFunc func() uint64
 	// function that will be called by the corresponding RuntimeContext function implementation (by default this will call the same wrapped context function)
 	SetPointsUsedFunc func(gasPoints uint64)
@@ -148,6 +160,14 @@ func NewRuntimeContextWrapper(inputRuntimeContext *vmhost.RuntimeContext) *Runti
 		runtimeWrapper.runtimeContext.SetVMInput(vmInput)
 	}
 
+	runtimeWrapper.GetEthCallDataFunc = func() []byte {
+		return runtimeWrapper.runtimeContext.GetEthCallData()
+	}
+
+	runtimeWrapper.SetEthCallDataFunc = func(callData []byte) {
+		runtimeWrapper.runtimeContext.SetEthCallData(callData)
+	}
+
 	runtimeWrapper.GetSCAddressFunc = func() []byte {
 		return runtimeWrapper.runtimeContext.GetContextAddress()
 	}
@@ -212,6 +232,18 @@ func NewRuntimeContextWrapper(inputRuntimeContext *vmhost.RuntimeContext) *Runti
 		return runtimeWrapper.runtimeContext.GetInstanceStackSize()
 	}
 
+	runtimeWrapper.CountSameContractInstancesOnStackFunc = func(address []byte) uint64 {
+		return runtimeWrapper.runtimeContext.CountSameContractInstancesOnStack(address)
+	}
+
+	runtimeWrapper.IsContractOnTheStackFunc = func(address []byte) bool {
+		return runtimeWrapper.runtimeContext.IsContractOnTheStack(address)
+	}
+
+	runtimeWrapper.GetCallStackFunc = func() []vmhost.CallFrame {
+		return runtimeWrapper.runtimeContext.GetCallStack()
+	}
+
 	runtimeWrapper.IsFunctionImportedFunc = func(name string) bool {
 		return runtimeWrapper.runtimeContext.IsFunctionImported(name)
 	}
@@ -252,6 +284,14 @@ func NewRuntimeContextWrapper(inputRuntimeContext *vmhost.RuntimeContext) *Runti
 		return runtimeWrapper.runtimeContext.CallSCFunction(functionName)
 	}
 
+	runtimeWrapper.SetFootprintTraceFunc = func(footprintTrace bool) {
+		runtimeWrapper.runtimeContext.SetFootprintTrace(footprintTrace)
+	}
+
+	runtimeWrapper.GetInstanceFootprintsFunc = func() []*vmhost.InstanceFootprint {
+		return runtimeWrapper.runtimeContext.GetInstanceFootprints()
+	}
+
 	runtimeWrapper.GetPointsUsedFunc = func() uint64 {
 		return runtimeWrapper.runtimeContext.GetPointsUsed()
 	}
@@ -324,6 +364,16 @@ func (contextWrapper *RuntimeContextWrapper) SetVMInput(vmInput *vmcommon.Contra
 	contextWrapper.SetVMInputFunc(vmInput)
 }
 
+// GetEthCallData calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) GetEthCallData() []byte {
+	return contextWrapper.GetEthCallDataFunc()
+}
+
+// SetEthCallData calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) SetEthCallData(callData []byte) {
+	contextWrapper.SetEthCallDataFunc(callData)
+}
+
 // GetContextAddress calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
 func (contextWrapper *RuntimeContextWrapper) GetContextAddress() []byte {
 	return contextWrapper.GetSCAddressFunc()
@@ -409,6 +459,16 @@ func (contextWrapper *RuntimeContextWrapper) CountSameContractInstancesOnStack(a
 	return contextWrapper.CountSameContractInstancesOnStackFunc(address)
 }
 
+// IsContractOnTheStack calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) IsContractOnTheStack(address []byte) bool {
+	return contextWrapper.IsContractOnTheStackFunc(address)
+}
+
+// GetCallStack calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) GetCallStack() []vmhost.CallFrame {
+	return contextWrapper.GetCallStackFunc()
+}
+
 // GetInstanceStackSize calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
 func (contextWrapper *RuntimeContextWrapper) GetInstanceStackSize() uint64 {
 	return contextWrapper.GetInstanceStackSizeFunc()
@@ -464,6 +524,16 @@ func (contextWrapper *RuntimeContextWrapper) CallSCFunction(functionName string)
 	return contextWrapper.CallSCFunctionFunc(functionName)
 }
 
+// SetFootprintTrace calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) SetFootprintTrace(footprintTrace bool) {
+	contextWrapper.SetFootprintTraceFunc(footprintTrace)
+}
+
+// GetInstanceFootprints calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
+func (contextWrapper *RuntimeContextWrapper) GetInstanceFootprints() []*vmhost.InstanceFootprint {
+	return contextWrapper.GetInstanceFootprintsFunc()
+}
+
 // GetPointsUsed calls corresponding xxxFunc function, that by default in turn calls the original method of the wrapped RuntimeContext
 func (contextWrapper *RuntimeContextWrapper) GetPointsUsed() uint64 {
 	return contextWrapper.GetPointsUsedFunc()
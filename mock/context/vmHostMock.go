@@ -23,10 +23,12 @@ type VMHostMock struct {
 	OutputContext            vmhost.OutputContext
 	MeteringContext          vmhost.MeteringContext
 	StorageContext           vmhost.StorageContext
+	TransientStorageContext  vmhost.TransientStorageContext
 	EnableEpochsHandlerField vmhost.EnableEpochsHandler
 	ManagedTypesContext      vmhost.ManagedTypesContext
 
 	IsBuiltinFunc bool
+	CallPolicy    vmhost.CallPolicy
 
 	StoredInputs []*vmcommon.ContractCallInput
 
@@ -70,6 +72,11 @@ func (host *VMHostMock) Storage() vmhost.StorageContext {
 	return host.StorageContext
 }
 
+// TransientStorage mocked method
+func (host *VMHostMock) TransientStorage() vmhost.TransientStorageContext {
+	return host.TransientStorageContext
+}
+
 // EnableEpochsHandler mocked method
 func (host *VMHostMock) EnableEpochsHandler() vmhost.EnableEpochsHandler {
 	return host.EnableEpochsHandlerField
@@ -107,6 +114,24 @@ func (host *VMHostMock) IsAllowedToExecute(_ string) bool {
 	return true
 }
 
+// HasCallPolicy mocked method
+func (host *VMHostMock) HasCallPolicy() bool {
+	return host.CallPolicy != nil
+}
+
+// CheckCallPolicy mocked method
+func (host *VMHostMock) CheckCallPolicy(call *vmhost.PolicyCall) error {
+	if host.CallPolicy == nil {
+		return nil
+	}
+	return host.CallPolicy.CheckCall(call)
+}
+
+// GetNativeContract mocked method
+func (host *VMHostMock) GetNativeContract(_ []byte) (vmhost.NativeContract, bool) {
+	return nil, false
+}
+
 // ExecuteESDTTransfer mocked method
 func (host *VMHostMock) ExecuteESDTTransfer(_ *vmhost.ESDTTransfersArgs, _ vm.CallType) (*vmcommon.VMOutput, uint64, error) {
 	return nil, 0, nil
@@ -117,6 +142,11 @@ func (host *VMHostMock) CreateNewContract(_ *vmcommon.ContractCreateInput, _ int
 	return nil, nil
 }
 
+// CreateNewContractWithOutput mocked method
+func (host *VMHostMock) CreateNewContractWithOutput(_ *vmcommon.ContractCreateInput, _ int) ([]byte, *vmcommon.VMOutput, error) {
+	return nil, nil, nil
+}
+
 // ExecuteOnSameContext mocked method
 func (host *VMHostMock) ExecuteOnSameContext(_ *vmcommon.ContractCallInput) error {
 	return nil
@@ -162,11 +192,26 @@ func (host *VMHostMock) GetGasScheduleMap() config.GasScheduleMap {
 	return make(config.GasScheduleMap)
 }
 
+// ApplyGasSchedule mocked method
+func (host *VMHostMock) ApplyGasSchedule(_ config.GasScheduleMap) (config.GasScheduleDiff, error) {
+	return nil, nil
+}
+
+// RollbackGasSchedule mocked method
+func (host *VMHostMock) RollbackGasSchedule() (config.GasScheduleDiff, error) {
+	return nil, nil
+}
+
 // RunSmartContractCall mocked method
 func (host *VMHostMock) RunSmartContractCall(_ *vmcommon.ContractCallInput) (vmOutput *vmcommon.VMOutput, err error) {
 	return nil, nil
 }
 
+// RunSmartContractCallWithOverrides mocked method
+func (host *VMHostMock) RunSmartContractCallWithOverrides(_ *vmcommon.ContractCallInput, _ *vmhost.StateOverrides) (*vmcommon.VMOutput, error) {
+	return nil, nil
+}
+
 // RunSmartContractCreate mocked method
 func (host *VMHostMock) RunSmartContractCreate(_ *vmcommon.ContractCreateInput) (vmOutput *vmcommon.VMOutput, err error) {
 	return nil, nil
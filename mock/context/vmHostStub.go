@@ -25,25 +25,33 @@ type VMHostStub struct {
 	MeteringCalled            func() vmhost.MeteringContext
 	AsyncCalled               func() vmhost.AsyncContext
 	StorageCalled             func() vmhost.StorageContext
+	TransientStorageCalled    func() vmhost.TransientStorageContext
 	EnableEpochsHandlerCalled func() vmhost.EnableEpochsHandler
 	GetContextsCalled         func() (vmhost.ManagedTypesContext, vmhost.BlockchainContext, vmhost.MeteringContext, vmhost.OutputContext, vmhost.RuntimeContext, vmhost.AsyncContext, vmhost.StorageContext)
 	ManagedTypesCalled        func() vmhost.ManagedTypesContext
 
-	ExecuteESDTTransferCalled   func(transfersArgs *vmhost.ESDTTransfersArgs, callType vm.CallType) (*vmcommon.VMOutput, uint64, error)
-	CreateNewContractCalled     func(input *vmcommon.ContractCreateInput, createContractCallType int) ([]byte, error)
-	ExecuteOnSameContextCalled  func(input *vmcommon.ContractCallInput) error
-	ExecuteOnDestContextCalled  func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, bool, error)
-	IsBuiltinFunctionNameCalled func(functionName string) bool
-	IsBuiltinFunctionCallCalled func(data []byte) bool
-	AreInSameShardCalled        func(left []byte, right []byte) bool
-	IsAllowedToExecuteCalled    func(opcode string) bool
-
-	RunSmartContractCallCalled           func(input *vmcommon.ContractCallInput) (vmOutput *vmcommon.VMOutput, err error)
-	RunSmartContractCreateCalled         func(input *vmcommon.ContractCreateInput) (vmOutput *vmcommon.VMOutput, err error)
-	GetGasScheduleMapCalled              func() config.GasScheduleMap
-	GasScheduleChangeCalled              func(newGasSchedule config.GasScheduleMap)
-	IsInterfaceNilCalled                 func() bool
-	CompleteLogEntriesWithCallTypeCalled func(vmOutput *vmcommon.VMOutput, callType string)
+	ExecuteESDTTransferCalled         func(transfersArgs *vmhost.ESDTTransfersArgs, callType vm.CallType) (*vmcommon.VMOutput, uint64, error)
+	CreateNewContractCalled           func(input *vmcommon.ContractCreateInput, createContractCallType int) ([]byte, error)
+	CreateNewContractWithOutputCalled func(input *vmcommon.ContractCreateInput, createContractCallType int) ([]byte, *vmcommon.VMOutput, error)
+	ExecuteOnSameContextCalled        func(input *vmcommon.ContractCallInput) error
+	ExecuteOnDestContextCalled        func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, bool, error)
+	IsBuiltinFunctionNameCalled       func(functionName string) bool
+	IsBuiltinFunctionCallCalled       func(data []byte) bool
+	AreInSameShardCalled              func(left []byte, right []byte) bool
+	IsAllowedToExecuteCalled          func(opcode string) bool
+	HasCallPolicyCalled               func() bool
+	CheckCallPolicyCalled             func(call *vmhost.PolicyCall) error
+	GetNativeContractCalled           func(address []byte) (vmhost.NativeContract, bool)
+
+	RunSmartContractCallCalled              func(input *vmcommon.ContractCallInput) (vmOutput *vmcommon.VMOutput, err error)
+	RunSmartContractCreateCalled            func(input *vmcommon.ContractCreateInput) (vmOutput *vmcommon.VMOutput, err error)
+	RunSmartContractCallWithOverridesCalled func(input *vmcommon.ContractCallInput, overrides *vmhost.StateOverrides) (*vmcommon.VMOutput, error)
+	GetGasScheduleMapCalled                 func() config.GasScheduleMap
+	GasScheduleChangeCalled                 func(newGasSchedule config.GasScheduleMap)
+	ApplyGasScheduleCalled                  func(newGasSchedule config.GasScheduleMap) (config.GasScheduleDiff, error)
+	RollbackGasScheduleCalled               func() (config.GasScheduleDiff, error)
+	IsInterfaceNilCalled                    func() bool
+	CompleteLogEntriesWithCallTypeCalled    func(vmOutput *vmcommon.VMOutput, callType string)
 
 	SetRuntimeContextCalled func(runtime vmhost.RuntimeContext)
 
@@ -160,6 +168,14 @@ func (vhs *VMHostStub) Storage() vmhost.StorageContext {
 	return nil
 }
 
+// TransientStorage mocked method
+func (vhs *VMHostStub) TransientStorage() vmhost.TransientStorageContext {
+	if vhs.TransientStorageCalled != nil {
+		return vhs.TransientStorageCalled()
+	}
+	return nil
+}
+
 // EnableEpochsHandler mocked method
 func (vhs *VMHostStub) EnableEpochsHandler() vmhost.EnableEpochsHandler {
 	if vhs.EnableEpochsHandlerCalled != nil {
@@ -192,6 +208,14 @@ func (vhs *VMHostStub) CreateNewContract(input *vmcommon.ContractCreateInput, cr
 	return nil, nil
 }
 
+// CreateNewContractWithOutput mocked method
+func (vhs *VMHostStub) CreateNewContractWithOutput(input *vmcommon.ContractCreateInput, createContractCallType int) ([]byte, *vmcommon.VMOutput, error) {
+	if vhs.CreateNewContractWithOutputCalled != nil {
+		return vhs.CreateNewContractWithOutputCalled(input, createContractCallType)
+	}
+	return nil, nil, nil
+}
+
 // ExecuteOnSameContext mocked method
 func (vhs *VMHostStub) ExecuteOnSameContext(input *vmcommon.ContractCallInput) error {
 	if vhs.ExecuteOnSameContextCalled != nil {
@@ -224,6 +248,30 @@ func (vhs *VMHostStub) IsAllowedToExecute(opcode string) bool {
 	return true
 }
 
+// HasCallPolicy mocked method; without HasCallPolicyCalled, the host has a policy when CheckCallPolicyCalled is set
+func (vhs *VMHostStub) HasCallPolicy() bool {
+	if vhs.HasCallPolicyCalled != nil {
+		return vhs.HasCallPolicyCalled()
+	}
+	return vhs.CheckCallPolicyCalled != nil
+}
+
+// CheckCallPolicy mocked method
+func (vhs *VMHostStub) CheckCallPolicy(call *vmhost.PolicyCall) error {
+	if vhs.CheckCallPolicyCalled != nil {
+		return vhs.CheckCallPolicyCalled(call)
+	}
+	return nil
+}
+
+// GetNativeContract mocked method
+func (vhs *VMHostStub) GetNativeContract(address []byte) (vmhost.NativeContract, bool) {
+	if vhs.GetNativeContractCalled != nil {
+		return vhs.GetNativeContractCalled(address)
+	}
+	return nil, false
+}
+
 // IsBuiltinFunctionName mocked method
 func (vhs *VMHostStub) IsBuiltinFunctionName(functionName string) bool {
 	if vhs.IsBuiltinFunctionNameCalled != nil {
@@ -248,6 +296,22 @@ func (vhs *VMHostStub) GetGasScheduleMap() config.GasScheduleMap {
 	return nil
 }
 
+// ApplyGasSchedule mocked method
+func (vhs *VMHostStub) ApplyGasSchedule(newGasSchedule config.GasScheduleMap) (config.GasScheduleDiff, error) {
+	if vhs.ApplyGasScheduleCalled != nil {
+		return vhs.ApplyGasScheduleCalled(newGasSchedule)
+	}
+	return nil, nil
+}
+
+// RollbackGasSchedule mocked method
+func (vhs *VMHostStub) RollbackGasSchedule() (config.GasScheduleDiff, error) {
+	if vhs.RollbackGasScheduleCalled != nil {
+		return vhs.RollbackGasScheduleCalled()
+	}
+	return nil, nil
+}
+
 // RunSmartContractCall mocked method
 func (vhs *VMHostStub) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmOutput *vmcommon.VMOutput, err error) {
 	if vhs.RunSmartContractCallCalled != nil {
@@ -256,6 +320,14 @@ func (vhs *VMHostStub) RunSmartContractCall(input *vmcommon.ContractCallInput) (
 	return nil, nil
 }
 
+// RunSmartContractCallWithOverrides mocked method
+func (vhs *VMHostStub) RunSmartContractCallWithOverrides(input *vmcommon.ContractCallInput, overrides *vmhost.StateOverrides) (*vmcommon.VMOutput, error) {
+	if vhs.RunSmartContractCallWithOverridesCalled != nil {
+		return vhs.RunSmartContractCallWithOverridesCalled(input, overrides)
+	}
+	return nil, nil
+}
+
 // RunSmartContractCreate mocked method
 func (vhs *VMHostStub) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (vmOutput *vmcommon.VMOutput, err error) {
 	if vhs.RunSmartContractCreateCalled != nil {
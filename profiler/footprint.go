@@ -0,0 +1,208 @@
+package profiler
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// FootprintReportVersion is the version of the JSON layout of the footprint reports, increased when it changes.
+const FootprintReportVersion = 1
+
+// ErrFootprintReportVersion signals that a footprint report was written with another layout.
+var ErrFootprintReportVersion = errors.New("unsupported footprint report version")
+
+// InstantiationStatistics holds how many instances were started from a cache level and how long it took.
+type InstantiationStatistics struct {
+	Count            uint64 `json:"count"`
+	TotalNanoseconds int64  `json:"totalNanoseconds"`
+	MaxNanoseconds   int64  `json:"maxNanoseconds"`
+}
+
+// FootprintStatistics aggregates the footprints of the calls of a contract function. Sizes are the largest ones
+// seen, since the code of a contract may be upgraded.
+type FootprintStatistics struct {
+	Contract              string                              `json:"contract"`
+	Function              string                              `json:"function"`
+	Calls                 uint64                              `json:"calls"`
+	CodeSize              uint64                              `json:"codeSize"`
+	CompiledCodeSize      uint64                              `json:"compiledCodeSize"`
+	DataSegmentsSize      uint64                              `json:"dataSegmentsSize"`
+	MaxInitialMemoryPages uint32                              `json:"maxInitialMemoryPages"`
+	MaxPeakMemoryPages    uint32                              `json:"maxPeakMemoryPages"`
+	MaxManagedHeapBytes   int                                 `json:"maxManagedHeapBytes"`
+	Instantiations        map[string]*InstantiationStatistics `json:"instantiations"`
+}
+
+// FootprintReport aggregates the instance footprints per contract and function. Reports can be merged, so that the
+// footprints of separate runs, e.g. of several scenario sets, are gathered in a single report.
+type FootprintReport struct {
+	statistics map[string]*FootprintStatistics
+}
+
+type footprintReportJSON struct {
+	Version   int                    `json:"version"`
+	Functions []*FootprintStatistics `json:"functions"`
+}
+
+// NewFootprintReport creates an empty FootprintReport.
+func NewFootprintReport() *FootprintReport {
+	return &FootprintReport{
+		statistics: make(map[string]*FootprintStatistics),
+	}
+}
+
+// Add aggregates the footprint of a function call.
+func (report *FootprintReport) Add(footprint *vmhost.InstanceFootprint) {
+	contract := hex.EncodeToString(footprint.Contract)
+	statistics := report.getOrCreate(contract, footprint.Function)
+
+	statistics.Calls++
+	statistics.CodeSize = max(statistics.CodeSize, footprint.CodeSize)
+	statistics.CompiledCodeSize = max(statistics.CompiledCodeSize, footprint.CompiledCodeSize)
+	statistics.DataSegmentsSize = max(statistics.DataSegmentsSize, footprint.DataSegmentsSize)
+	statistics.MaxInitialMemoryPages = max(statistics.MaxInitialMemoryPages, footprint.InitialMemoryPages)
+	statistics.MaxPeakMemoryPages = max(statistics.MaxPeakMemoryPages, footprint.PeakMemoryPages)
+	statistics.MaxManagedHeapBytes = max(statistics.MaxManagedHeapBytes, footprint.ManagedTypes.HeapBytes())
+
+	instantiations := getOrCreateInstantiations(statistics, footprint.CacheLevel)
+	instantiations.Count++
+	instantiations.TotalNanoseconds += footprint.InstantiationTime.Nanoseconds()
+	instantiations.MaxNanoseconds = max(instantiations.MaxNanoseconds, footprint.InstantiationTime.Nanoseconds())
+}
+
+// Merge aggregates all the statistics of the other report into this one.
+func (report *FootprintReport) Merge(other *FootprintReport) {
+	for _, otherStatistics := range other.statistics {
+		statistics := report.getOrCreate(otherStatistics.Contract, otherStatistics.Function)
+
+		statistics.Calls += otherStatistics.Calls
+		statistics.CodeSize = max(statistics.CodeSize, otherStatistics.CodeSize)
+		statistics.CompiledCodeSize = max(statistics.CompiledCodeSize, otherStatistics.CompiledCodeSize)
+		statistics.DataSegmentsSize = max(statistics.DataSegmentsSize, otherStatistics.DataSegmentsSize)
+		statistics.MaxInitialMemoryPages = max(statistics.MaxInitialMemoryPages, otherStatistics.MaxInitialMemoryPages)
+		statistics.MaxPeakMemoryPages = max(statistics.MaxPeakMemoryPages, otherStatistics.MaxPeakMemoryPages)
+		statistics.MaxManagedHeapBytes = max(statistics.MaxManagedHeapBytes, otherStatistics.MaxManagedHeapBytes)
+
+		for cacheLevel, otherInstantiations := range otherStatistics.Instantiations {
+			instantiations := getOrCreateInstantiations(statistics, cacheLevel)
+			instantiations.Count += otherInstantiations.Count
+			instantiations.TotalNanoseconds += otherInstantiations.TotalNanoseconds
+			instantiations.MaxNanoseconds = max(instantiations.MaxNanoseconds, otherInstantiations.MaxNanoseconds)
+		}
+	}
+}
+
+// Functions returns the statistics of every contract function, sorted by contract and function.
+func (report *FootprintReport) Functions() []*FootprintStatistics {
+	functions := make([]*FootprintStatistics, 0, len(report.statistics))
+	for _, statistics := range report.statistics {
+		functions = append(functions, statistics)
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		if functions[i].Contract != functions[j].Contract {
+			return functions[i].Contract < functions[j].Contract
+		}
+		return functions[i].Function < functions[j].Function
+	})
+
+	return functions
+}
+
+// WriteJSON writes the report as JSON.
+func (report *FootprintReport) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(&footprintReportJSON{
+		Version:   FootprintReportVersion,
+		Functions: report.Functions(),
+	})
+}
+
+// ReadFootprintReport reads a report written by WriteJSON.
+func ReadFootprintReport(reader io.Reader) (*FootprintReport, error) {
+	decoded := &footprintReportJSON{}
+	err := json.NewDecoder(reader).Decode(decoded)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.Version != FootprintReportVersion {
+		return nil, ErrFootprintReportVersion
+	}
+
+	report := NewFootprintReport()
+	for _, statistics := range decoded.Functions {
+		if statistics.Instantiations == nil {
+			statistics.Instantiations = make(map[string]*InstantiationStatistics)
+		}
+		report.statistics[footprintKey(statistics.Contract, statistics.Function)] = statistics
+	}
+
+	return report, nil
+}
+
+// MergeIntoFile merges the report into the one found in the file, if any, and writes the result back to the file.
+func (report *FootprintReport) MergeIntoFile(path string) error {
+	merged := NewFootprintReport()
+
+	file, err := os.Open(path)
+	switch {
+	case err == nil:
+		existing, errRead := ReadFootprintReport(file)
+		_ = file.Close()
+		if errRead != nil {
+			return errRead
+		}
+		merged.Merge(existing)
+	case !errors.Is(err, fs.ErrNotExist):
+		return err
+	}
+	merged.Merge(report)
+
+	file, err = os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = merged.WriteJSON(file)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func (report *FootprintReport) getOrCreate(contract string, function string) *FootprintStatistics {
+	key := footprintKey(contract, function)
+	statistics, ok := report.statistics[key]
+	if !ok {
+		statistics = &FootprintStatistics{
+			Contract:       contract,
+			Function:       function,
+			Instantiations: make(map[string]*InstantiationStatistics),
+		}
+		report.statistics[key] = statistics
+	}
+
+	return statistics
+}
+
+func getOrCreateInstantiations(statistics *FootprintStatistics, cacheLevel string) *InstantiationStatistics {
+	instantiations, ok := statistics.Instantiations[cacheLevel]
+	if !ok {
+		instantiations = &InstantiationStatistics{}
+		statistics.Instantiations[cacheLevel] = instantiations
+	}
+
+	return instantiations
+}
+
+func footprintKey(contract string, function string) string {
+	return contract + "/" + function
+}
@@ -0,0 +1,139 @@
+package profiler
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFootprint(function string, cacheLevel string, instantiationTime time.Duration, peakPages uint32) *vmhost.InstanceFootprint {
+	return &vmhost.InstanceFootprint{
+		Contract:           []byte{0xab},
+		Function:           function,
+		CacheLevel:         cacheLevel,
+		InstantiationTime:  instantiationTime,
+		CodeSize:           100,
+		CompiledCodeSize:   400,
+		DataSegmentsSize:   20,
+		InitialMemoryPages: 2,
+		PeakMemoryPages:    peakPages,
+		ManagedTypes:       vmhost.ManagedTypesSummary{ManagedBufferBytes: 10, BigIntBytes: 8},
+	}
+}
+
+func TestFootprintReport_Add(t *testing.T) {
+	t.Parallel()
+
+	report := NewFootprintReport()
+	report.Add(newTestFootprint("add", "bytecode", 30*time.Millisecond, 3))
+	report.Add(newTestFootprint("add", "warm", time.Millisecond, 5))
+	report.Add(newTestFootprint("add", "warm", 3*time.Millisecond, 4))
+	report.Add(newTestFootprint("getSum", "warm", 2*time.Millisecond, 2))
+
+	functions := report.Functions()
+	require.Len(t, functions, 2)
+	require.Equal(t, "getSum", functions[1].Function)
+
+	add := functions[0]
+	require.Equal(t, "ab", add.Contract)
+	require.Equal(t, "add", add.Function)
+	require.Equal(t, uint64(3), add.Calls)
+	require.Equal(t, uint64(100), add.CodeSize)
+	require.Equal(t, uint64(400), add.CompiledCodeSize)
+	require.Equal(t, uint64(20), add.DataSegmentsSize)
+	require.Equal(t, uint32(2), add.MaxInitialMemoryPages)
+	require.Equal(t, uint32(5), add.MaxPeakMemoryPages)
+	require.Equal(t, 18, add.MaxManagedHeapBytes)
+	require.Equal(t, map[string]*InstantiationStatistics{
+		"bytecode": {Count: 1, TotalNanoseconds: int64(30 * time.Millisecond), MaxNanoseconds: int64(30 * time.Millisecond)},
+		"warm":     {Count: 2, TotalNanoseconds: int64(4 * time.Millisecond), MaxNanoseconds: int64(3 * time.Millisecond)},
+	}, add.Instantiations)
+}
+
+func TestFootprintReport_Merge(t *testing.T) {
+	t.Parallel()
+
+	first := NewFootprintReport()
+	first.Add(newTestFootprint("add", "warm", time.Millisecond, 3))
+
+	second := NewFootprintReport()
+	second.Add(newTestFootprint("add", "warm", 2*time.Millisecond, 6))
+	second.Add(newTestFootprint("add", "precompiled", 5*time.Millisecond, 2))
+
+	first.Merge(second)
+
+	functions := first.Functions()
+	require.Len(t, functions, 1)
+	require.Equal(t, uint64(3), functions[0].Calls)
+	require.Equal(t, uint32(6), functions[0].MaxPeakMemoryPages)
+	require.Equal(t, &InstantiationStatistics{Count: 2, TotalNanoseconds: int64(3 * time.Millisecond), MaxNanoseconds: int64(2 * time.Millisecond)}, functions[0].Instantiations["warm"])
+	require.Equal(t, uint64(1), functions[0].Instantiations["precompiled"].Count)
+}
+
+func TestFootprintReport_JSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	report := NewFootprintReport()
+	report.Add(newTestFootprint("add", "warm", time.Millisecond, 3))
+
+	buffer := &bytes.Buffer{}
+	require.Nil(t, report.WriteJSON(buffer))
+
+	read, err := ReadFootprintReport(buffer)
+	require.Nil(t, err)
+	require.Equal(t, report.Functions(), read.Functions())
+
+	_, err = ReadFootprintReport(bytes.NewBufferString(`{"version": 0, "functions": []}`))
+	require.Equal(t, ErrFootprintReportVersion, err)
+}
+
+func TestFootprintReport_MergeIntoFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "footprints.json")
+
+	report := NewFootprintReport()
+	report.Add(newTestFootprint("add", "warm", time.Millisecond, 3))
+	require.Nil(t, report.MergeIntoFile(path))
+	require.Nil(t, report.MergeIntoFile(path))
+
+	merged := NewFootprintReport()
+	merged.Merge(report)
+	merged.Merge(report)
+
+	written := NewFootprintReport()
+	require.Nil(t, written.MergeIntoFile(path))
+
+	file, err := os.Open(path)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	read, err := ReadFootprintReport(file)
+	require.Nil(t, err)
+	require.Equal(t, merged.Functions(), read.Functions())
+	require.Equal(t, uint64(2), read.Functions()[0].Calls)
+}
+
+func TestProfiler_AggregatesFootprints(t *testing.T) {
+	t.Parallel()
+
+	profiler := NewProfiler(1)
+	for i := 0; i < 3; i++ {
+		profiler.BeginExecution()
+		profiler.EndExecution(&vmhost.ExecutionProfile{
+			Footprints: []*vmhost.InstanceFootprint{newTestFootprint("add", "warm", time.Millisecond, 3)},
+		})
+	}
+
+	require.Len(t, profiler.Profiles(), 1)
+	functions := profiler.FootprintReport().Functions()
+	require.Len(t, functions, 1)
+	require.Equal(t, uint64(3), functions[0].Calls)
+}
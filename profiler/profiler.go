@@ -0,0 +1,181 @@
+package profiler
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ vmhost.ExecutionProfiler = (*Profiler)(nil)
+var _ executorwrapper.ExecutorLogger = (*Profiler)(nil)
+
+// DefaultMaxProfiles is the number of execution profiles kept when no other value is configured.
+const DefaultMaxProfiles = 1000
+
+// Profiler is an opt-in vmhost.ExecutionProfiler, which keeps the profiles of the last top-level executions.
+// It counts the VM hook calls by wrapping the executor of the VM host. The instance footprints of all the executions,
+// including the discarded ones, are aggregated in a FootprintReport.
+type Profiler struct {
+	maxProfiles int
+
+	mutex       sync.Mutex
+	vmHookCalls map[string]uint64
+	profiles    []*vmhost.ExecutionProfile
+	footprints  *FootprintReport
+}
+
+// NewProfiler creates a Profiler keeping at most maxProfiles profiles, discarding the oldest ones.
+func NewProfiler(maxProfiles int) *Profiler {
+	if maxProfiles <= 0 {
+		maxProfiles = DefaultMaxProfiles
+	}
+
+	return &Profiler{
+		maxProfiles: maxProfiles,
+		vmHookCalls: make(map[string]uint64),
+		profiles:    make([]*vmhost.ExecutionProfile, 0),
+		footprints:  NewFootprintReport(),
+	}
+}
+
+// WrapExecutorFactory wraps the executor factory, so that the profiler sees every VM hook call.
+func (profiler *Profiler) WrapExecutorFactory(factory executor.ExecutorAbstractFactory) executor.ExecutorAbstractFactory {
+	return executorwrapper.NewWrappedExecutorFactory(profiler, factory)
+}
+
+// LogExecutorEvent ignores the executor events, only the VM hook calls are counted.
+func (profiler *Profiler) LogExecutorEvent(_ string) {
+}
+
+// LogVMHookCallBefore counts the VM hook call by its name, regardless of its arguments.
+func (profiler *Profiler) LogVMHookCallBefore(callInfo string) {
+	name, _, _ := strings.Cut(callInfo, "(")
+
+	profiler.mutex.Lock()
+	profiler.vmHookCalls[name]++
+	profiler.mutex.Unlock()
+}
+
+// LogVMHookCallAfter does nothing, the call was already counted before being processed.
+func (profiler *Profiler) LogVMHookCallAfter(_ string) {
+}
+
+// BeginExecution forgets the VM hook calls of the previous execution.
+func (profiler *Profiler) BeginExecution() {
+	profiler.mutex.Lock()
+	profiler.vmHookCalls = make(map[string]uint64)
+	profiler.mutex.Unlock()
+}
+
+// EndExecution completes the profile with the VM hook calls of the execution, keeps it and aggregates its footprints.
+func (profiler *Profiler) EndExecution(profile *vmhost.ExecutionProfile) {
+	profiler.mutex.Lock()
+	defer profiler.mutex.Unlock()
+
+	profile.VMHookCalls = profiler.vmHookCalls
+	profiler.vmHookCalls = make(map[string]uint64)
+
+	for _, footprint := range profile.Footprints {
+		profiler.footprints.Add(footprint)
+	}
+
+	if len(profiler.profiles) == profiler.maxProfiles {
+		profiler.profiles = profiler.profiles[1:]
+	}
+	profiler.profiles = append(profiler.profiles, profile)
+}
+
+// Profiles returns the kept profiles, from the oldest to the most recent.
+func (profiler *Profiler) Profiles() []*vmhost.ExecutionProfile {
+	profiler.mutex.Lock()
+	defer profiler.mutex.Unlock()
+
+	return append(make([]*vmhost.ExecutionProfile, 0, len(profiler.profiles)), profiler.profiles...)
+}
+
+// FootprintReport returns a copy of the footprints aggregated over all the executions.
+func (profiler *Profiler) FootprintReport() *FootprintReport {
+	profiler.mutex.Lock()
+	defer profiler.mutex.Unlock()
+
+	report := NewFootprintReport()
+	report.Merge(profiler.footprints)
+	return report
+}
+
+// profileJSON is the exported form of an ExecutionProfile, without the full VM input.
+type profileJSON struct {
+	Contract    string            `json:"contract"`
+	Function    string            `json:"function"`
+	VMHookCalls map[string]uint64 `json:"vmHookCalls"`
+	Footprints  []*footprintJSON  `json:"footprints,omitempty"`
+}
+
+// footprintJSON is the exported form of an InstanceFootprint.
+type footprintJSON struct {
+	Contract                 string `json:"contract"`
+	Function                 string `json:"function"`
+	CacheLevel               string `json:"cacheLevel"`
+	InstantiationNanoseconds int64  `json:"instantiationNanoseconds"`
+	CodeSize                 uint64 `json:"codeSize"`
+	CompiledCodeSize         uint64 `json:"compiledCodeSize"`
+	DataSegmentsSize         uint64 `json:"dataSegmentsSize"`
+	InitialMemoryPages       uint32 `json:"initialMemoryPages"`
+	PeakMemoryPages          uint32 `json:"peakMemoryPages"`
+	ManagedHeapBytes         int    `json:"managedHeapBytes"`
+}
+
+// WriteJSON writes the kept profiles as a JSON array, from the oldest to the most recent.
+func (profiler *Profiler) WriteJSON(writer io.Writer) error {
+	profiles := profiler.Profiles()
+	exported := make([]*profileJSON, 0, len(profiles))
+	for _, profile := range profiles {
+		exported = append(exported, newProfileJSON(profile))
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(exported)
+}
+
+func newProfileJSON(profile *vmhost.ExecutionProfile) *profileJSON {
+	exported := &profileJSON{
+		VMHookCalls: profile.VMHookCalls,
+	}
+
+	switch {
+	case profile.CallInput != nil:
+		exported.Contract = hex.EncodeToString(profile.CallInput.RecipientAddr)
+		exported.Function = profile.CallInput.Function
+	case profile.CreateInput != nil:
+		exported.Function = vmhost.InitFunctionName
+	}
+
+	for _, footprint := range profile.Footprints {
+		exported.Footprints = append(exported.Footprints, &footprintJSON{
+			Contract:                 hex.EncodeToString(footprint.Contract),
+			Function:                 footprint.Function,
+			CacheLevel:               footprint.CacheLevel,
+			InstantiationNanoseconds: footprint.InstantiationTime.Nanoseconds(),
+			CodeSize:                 footprint.CodeSize,
+			CompiledCodeSize:         footprint.CompiledCodeSize,
+			DataSegmentsSize:         footprint.DataSegmentsSize,
+			InitialMemoryPages:       footprint.InitialMemoryPages,
+			PeakMemoryPages:          footprint.PeakMemoryPages,
+			ManagedHeapBytes:         footprint.ManagedTypes.HeapBytes(),
+		})
+	}
+
+	return exported
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (profiler *Profiler) IsInterfaceNil() bool {
+	return profiler == nil
+}
@@ -0,0 +1,75 @@
+package profiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfiler_CountsVMHookCallsPerExecution(t *testing.T) {
+	t.Parallel()
+
+	profiler := NewProfiler(0)
+	profiler.LogVMHookCallBefore("GetGasLeft()")
+
+	profiler.BeginExecution()
+	profiler.LogVMHookCallBefore("BigIntAdd(1, 2, 3)")
+	profiler.LogVMHookCallAfter("BigIntAdd(1, 2, 3)")
+	profiler.LogVMHookCallBefore("BigIntAdd(4, 5, 6)")
+	profiler.LogVMHookCallBefore("GetGasLeft()")
+	profiler.EndExecution(&vmhost.ExecutionProfile{})
+
+	profiler.BeginExecution()
+	profiler.EndExecution(&vmhost.ExecutionProfile{})
+
+	profiles := profiler.Profiles()
+	require.Len(t, profiles, 2)
+	require.Equal(t, map[string]uint64{"BigIntAdd": 2, "GetGasLeft": 1}, profiles[0].VMHookCalls)
+	require.Empty(t, profiles[1].VMHookCalls)
+}
+
+func TestProfiler_KeepsTheLastProfiles(t *testing.T) {
+	t.Parallel()
+
+	profiler := NewProfiler(2)
+	for _, function := range []string{"a", "b", "c"} {
+		profiler.BeginExecution()
+		profiler.EndExecution(&vmhost.ExecutionProfile{
+			CallInput: &vmcommon.ContractCallInput{Function: function},
+		})
+	}
+
+	profiles := profiler.Profiles()
+	require.Len(t, profiles, 2)
+	require.Equal(t, "b", profiles[0].CallInput.Function)
+	require.Equal(t, "c", profiles[1].CallInput.Function)
+}
+
+func TestProfiler_WriteJSON(t *testing.T) {
+	t.Parallel()
+
+	profiler := NewProfiler(0)
+	profiler.BeginExecution()
+	profiler.LogVMHookCallBefore("Finish(0, 4)")
+	profiler.EndExecution(&vmhost.ExecutionProfile{
+		CallInput: &vmcommon.ContractCallInput{RecipientAddr: []byte{0xab}, Function: "add"},
+	})
+	profiler.BeginExecution()
+	profiler.EndExecution(&vmhost.ExecutionProfile{CreateInput: &vmcommon.ContractCreateInput{}})
+
+	buffer := &bytes.Buffer{}
+	require.Nil(t, profiler.WriteJSON(buffer))
+
+	var exported []*profileJSON
+	require.Nil(t, json.Unmarshal(buffer.Bytes(), &exported))
+	require.Len(t, exported, 2)
+	require.Equal(t, "ab", exported[0].Contract)
+	require.Equal(t, "add", exported[0].Function)
+	require.Equal(t, map[string]uint64{"Finish": 1}, exported[0].VMHookCalls)
+	require.Equal(t, vmhost.InitFunctionName, exported[1].Function)
+	require.Empty(t, exported[1].VMHookCalls)
+}
@@ -0,0 +1,79 @@
+package scenario
+
+import (
+	"fmt"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/abi"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var log = logger.GetOrCreate("vm/scenario")
+
+var _ vmhost.VMHost = (*abiDecodingVM)(nil)
+
+// abiDecodingVM wraps the scenario VM host and logs call results and events decoded with the configured ABIs. It is
+// a VMHost itself, so that the callers of NewVM may still use the returned VM as a host.
+type abiDecodingVM struct {
+	vmhost.VMHost
+	codecs []*abi.Codec
+}
+
+func newABIDecodingVM(host vmhost.VMHost, codecs []*abi.Codec) vmhost.VMHost {
+	if len(codecs) == 0 {
+		return host
+	}
+
+	return &abiDecodingVM{
+		VMHost: host,
+		codecs: codecs,
+	}
+}
+
+// RunSmartContractCreate runs the deployment and logs the decoded output.
+func (vm *abiDecodingVM) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	vmOutput, err := vm.VMHost.RunSmartContractCreate(input)
+	vm.logDecodedOutput("init", vmOutput)
+	return vmOutput, err
+}
+
+// RunSmartContractCall runs the call and logs the decoded output.
+func (vm *abiDecodingVM) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	vmOutput, err := vm.VMHost.RunSmartContractCall(input)
+	vm.logDecodedOutput(input.Function, vmOutput)
+	return vmOutput, err
+}
+
+func (vm *abiDecodingVM) logDecodedOutput(function string, vmOutput *vmcommon.VMOutput) {
+	if vmOutput == nil || vmOutput.ReturnCode != vmcommon.Ok {
+		return
+	}
+
+	for _, abiCodec := range vm.codecs {
+		results, err := abiCodec.DecodeOutput(function, vmOutput)
+		if err != nil {
+			continue
+		}
+
+		log.Info("decoded results",
+			"contract", abiCodec.ABI().Name,
+			"function", function,
+			"results", fmt.Sprintf("%v", results))
+		break
+	}
+
+	for _, abiCodec := range vm.codecs {
+		events, err := abiCodec.DecodeEvents(vmOutput.Logs)
+		if err != nil {
+			continue
+		}
+
+		for _, event := range events {
+			log.Info("decoded event",
+				"contract", abiCodec.ABI().Name,
+				"identifier", event.Identifier,
+				"fields", fmt.Sprintf("%v", event.Fields))
+		}
+	}
+}
@@ -127,6 +127,7 @@ const (
     EpochStartBlockTimeStamp = 100
     EpochStartBlockNonce = 100
     EpochStartBlockRound = 100
+    Int64GetRandomInRange = 6000
 
 [EthAPICost]
     UseGas = 100
@@ -207,6 +208,7 @@ const (
     BigIntGetCallValue = 1000
     BigIntGetExternalBalance = 10000
     CopyPerByteForTooBig = 1000
+    BigIntSetRandomInRange = 8000
 
 [BigFloatAPICost]
     BigFloatNewFromParts = 3000
@@ -273,6 +275,8 @@ const (
     MBufferFromBigFloat = 2000
     MBufferStorageStore = 75000
     MBufferStorageLoad = 50000
+    MBufferStorageLoadBatch = 10000
+    MBufferStorageLoadBatchPerKey = 5000
     MBufferGetArgument = 1000
     MBufferFinish = 1000
     MBufferSetRandom = 6000
@@ -284,6 +288,27 @@ const (
     ManagedMapRemove   = 10000
     ManagedMapContains = 10000
 
+[ManagedDecimalAPICost]
+    MDecimalFromBigInt = 2000
+    MDecimalToBigInt = 3000
+    MDecimalGetMantissa = 2000
+    MDecimalGetScale = 1000
+    MDecimalFromManagedBuffer = 3000
+    MDecimalToManagedBuffer = 3000
+    MDecimalAdd = 3000
+    MDecimalSub = 3000
+    MDecimalMul = 7000
+    MDecimalDiv = 7000
+    MDecimalRescale = 4000
+    MDecimalCmp = 2000
+    MDecimalLn = 100000
+    MDecimalExp = 100000
+    MDecimalApproximationPerDigit = 5000
+
+[TransientStorageAPICost]
+    TransientStorageStore = 2000
+    TransientStorageLoad = 1000
+
 [WASMOpcodeCost]
     AtomicFence = 10
     AtomicNotify = 10
@@ -975,6 +1000,7 @@ const (
     EpochStartBlockTimeStamp = 100
     EpochStartBlockNonce = 100
     EpochStartBlockRound = 100
+    Int64GetRandomInRange = 6000
 
 [EthAPICost]
     UseGas = 100
@@ -1055,6 +1081,7 @@ const (
     BigIntGetCallValue = 1000
     BigIntGetExternalBalance = 10000
     CopyPerByteForTooBig = 1000
+    BigIntSetRandomInRange = 8000
 
 [BigFloatAPICost]
     BigFloatNewFromParts = 3000
@@ -1121,6 +1148,8 @@ const (
     MBufferFromBigFloat = 2000
     MBufferStorageStore = 75000
     MBufferStorageLoad = 50000
+    MBufferStorageLoadBatch = 10000
+    MBufferStorageLoadBatchPerKey = 5000
     MBufferGetArgument = 1000
     MBufferFinish = 1000
     MBufferSetRandom = 6000
@@ -1132,6 +1161,27 @@ const (
     ManagedMapRemove   = 10000
     ManagedMapContains = 10000
 
+[ManagedDecimalAPICost]
+    MDecimalFromBigInt = 2000
+    MDecimalToBigInt = 3000
+    MDecimalGetMantissa = 2000
+    MDecimalGetScale = 1000
+    MDecimalFromManagedBuffer = 3000
+    MDecimalToManagedBuffer = 3000
+    MDecimalAdd = 3000
+    MDecimalSub = 3000
+    MDecimalMul = 7000
+    MDecimalDiv = 7000
+    MDecimalRescale = 4000
+    MDecimalCmp = 2000
+    MDecimalLn = 100000
+    MDecimalExp = 100000
+    MDecimalApproximationPerDigit = 5000
+
+[TransientStorageAPICost]
+    TransientStorageStore = 2000
+    TransientStorageLoad = 1000
+
 [WASMOpcodeCost]
     AtomicFence = 10
     AtomicNotify = 10
@@ -0,0 +1,6 @@
+package scenariorecorder
+
+import "errors"
+
+// ErrNilBlockchainHook signals that a nil blockchain hook was provided
+var ErrNilBlockchainHook = errors.New("nil blockchain hook")
@@ -0,0 +1,238 @@
+package scenariorecorder
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+type esdtKey struct {
+	tokenID string
+	nonce   uint64
+}
+
+// accountState is the state of an account, as it was before the first recorded call.
+type accountState struct {
+	address         []byte
+	exists          bool
+	nonce           uint64
+	balance         *big.Int
+	username        []byte
+	code            []byte
+	codeMetadata    []byte
+	owner           []byte
+	developerReward *big.Int
+	storage         map[string][]byte
+	esdt            map[esdtKey]*esdt.ESDigitalToken
+}
+
+type newAddress struct {
+	creatorAddress []byte
+	creatorNonce   uint64
+	address        []byte
+}
+
+// preState collects the state touched by the recorded calls. The first read of a value wins, after
+// removing the effects of the previously recorded calls, which the scenario will replay anyway.
+type preState struct {
+	accounts        map[string]*accountState
+	createdAccounts map[string]struct{}
+	nonceDeltas     map[string]uint64
+	balanceDeltas   map[string]*big.Int
+	esdtDeltas      map[string]map[esdtKey]*big.Int
+	writtenStorage  map[string]map[string]struct{}
+	newAddresses    []*newAddress
+}
+
+func newPreState() *preState {
+	return &preState{
+		accounts:        make(map[string]*accountState),
+		createdAccounts: make(map[string]struct{}),
+		nonceDeltas:     make(map[string]uint64),
+		balanceDeltas:   make(map[string]*big.Int),
+		esdtDeltas:      make(map[string]map[esdtKey]*big.Int),
+		writtenStorage:  make(map[string]map[string]struct{}),
+	}
+}
+
+// touchAccount captures the account fields and code the first time the account is touched.
+// It returns nil for the accounts created by the recorded calls, which are not part of the pre-state.
+func (state *preState) touchAccount(hook vmcommon.BlockchainHook, address []byte) *accountState {
+	if _, isCreated := state.createdAccounts[string(address)]; isCreated {
+		return nil
+	}
+
+	account, captured := state.accounts[string(address)]
+	if captured {
+		return account
+	}
+
+	account = &accountState{
+		address:         address,
+		balance:         big.NewInt(0),
+		developerReward: big.NewInt(0),
+		storage:         make(map[string][]byte),
+		esdt:            make(map[esdtKey]*esdt.ESDigitalToken),
+	}
+	state.accounts[string(address)] = account
+
+	userAccount, err := hook.GetUserAccount(address)
+	if err != nil || userAccount == nil || userAccount.IsInterfaceNil() {
+		return account
+	}
+
+	account.exists = true
+	account.nonce = userAccount.GetNonce()
+	if nonceDelta := state.nonceDeltas[string(address)]; account.nonce >= nonceDelta {
+		account.nonce -= nonceDelta
+	}
+	if userAccount.GetBalance() != nil {
+		account.balance.Set(userAccount.GetBalance())
+	}
+	if delta, ok := state.balanceDeltas[string(address)]; ok {
+		account.balance.Sub(account.balance, delta)
+	}
+	if userAccount.GetDeveloperReward() != nil {
+		account.developerReward.Set(userAccount.GetDeveloperReward())
+	}
+	account.username = userAccount.GetUserName()
+	account.codeMetadata = userAccount.GetCodeMetadata()
+	account.owner = userAccount.GetOwnerAddress()
+	account.code = hook.GetCode(userAccount)
+
+	return account
+}
+
+func (state *preState) captureStorage(hook vmcommon.BlockchainHook, address []byte, key []byte, value []byte) {
+	if _, isWritten := state.writtenStorage[string(address)][string(key)]; isWritten {
+		return
+	}
+
+	account := state.touchAccount(hook, address)
+	if account == nil {
+		return
+	}
+	if _, captured := account.storage[string(key)]; captured {
+		return
+	}
+
+	account.storage[string(key)] = value
+}
+
+func (state *preState) captureESDT(hook vmcommon.BlockchainHook, address []byte, tokenID []byte, nonce uint64, token *esdt.ESDigitalToken) {
+	account := state.touchAccount(hook, address)
+	if account == nil {
+		return
+	}
+
+	key := esdtKey{tokenID: string(tokenID), nonce: nonce}
+	if _, captured := account.esdt[key]; captured {
+		return
+	}
+
+	tokenCopy := &esdt.ESDigitalToken{
+		Value: big.NewInt(0),
+	}
+	if token != nil {
+		tokenCopy.Type = token.Type
+		tokenCopy.Properties = token.Properties
+		tokenCopy.TokenMetaData = token.TokenMetaData
+		if token.Value != nil {
+			tokenCopy.Value.Set(token.Value)
+		}
+	}
+	if delta, ok := state.esdtDeltas[string(address)][key]; ok {
+		tokenCopy.Value.Sub(tokenCopy.Value, delta)
+	}
+
+	account.esdt[key] = tokenCopy
+}
+
+// fetchESDT captures a token balance directly from the hook, when it is changed without being read through the recorder.
+func (state *preState) fetchESDT(hook vmcommon.BlockchainHook, address []byte, tokenID []byte, nonce uint64) {
+	account := state.touchAccount(hook, address)
+	if account == nil {
+		return
+	}
+	if _, captured := account.esdt[esdtKey{tokenID: string(tokenID), nonce: nonce}]; captured {
+		return
+	}
+
+	token, err := hook.GetESDTToken(address, tokenID, nonce)
+	if err != nil {
+		return
+	}
+	state.captureESDT(hook, address, tokenID, nonce, token)
+}
+
+func (state *preState) addNewAddress(creatorAddress []byte, creatorNonce uint64, address []byte) {
+	state.newAddresses = append(state.newAddresses, &newAddress{
+		creatorAddress: creatorAddress,
+		creatorNonce:   creatorNonce,
+		address:        address,
+	})
+}
+
+func (state *preState) addNonceDelta(address []byte) {
+	state.nonceDeltas[string(address)]++
+}
+
+func (state *preState) addBalanceDelta(address []byte, delta *big.Int) {
+	if delta == nil {
+		return
+	}
+
+	current, ok := state.balanceDeltas[string(address)]
+	if !ok {
+		current = big.NewInt(0)
+		state.balanceDeltas[string(address)] = current
+	}
+	current.Add(current, delta)
+}
+
+func (state *preState) addESDTDelta(address []byte, tokenID []byte, nonce uint64, delta *big.Int) {
+	if delta == nil {
+		return
+	}
+
+	deltas, ok := state.esdtDeltas[string(address)]
+	if !ok {
+		deltas = make(map[esdtKey]*big.Int)
+		state.esdtDeltas[string(address)] = deltas
+	}
+
+	key := esdtKey{tokenID: string(tokenID), nonce: nonce}
+	current, ok := deltas[key]
+	if !ok {
+		current = big.NewInt(0)
+		deltas[key] = current
+	}
+	current.Add(current, delta)
+}
+
+// applyOutput registers the effects of a successful call, so that later reads are not mistaken for pre-state.
+func (state *preState) applyOutput(vmOutput *vmcommon.VMOutput) {
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		address := string(outputAccount.Address)
+		_, captured := state.accounts[address]
+		if !captured && len(outputAccount.Code) > 0 {
+			state.createdAccounts[address] = struct{}{}
+		}
+
+		state.addBalanceDelta(outputAccount.Address, outputAccount.BalanceDelta)
+
+		for _, storageUpdate := range outputAccount.StorageUpdates {
+			if !storageUpdate.Written {
+				continue
+			}
+
+			writtenKeys, ok := state.writtenStorage[address]
+			if !ok {
+				writtenKeys = make(map[string]struct{})
+				state.writtenStorage[address] = writtenKeys
+			}
+			writtenKeys[string(storageUpdate.Offset)] = struct{}{}
+		}
+	}
+}
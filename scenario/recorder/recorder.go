@@ -0,0 +1,171 @@
+package scenariorecorder
+
+import (
+	"math/big"
+	"os"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var log = logger.GetOrCreate("vm/scenariorecorder")
+
+// ArgsNewRecorder holds the arguments needed to create a Recorder
+type ArgsNewRecorder struct {
+	BlockchainHook     vmcommon.BlockchainHook
+	ESDTTransferParser vmcommon.ESDTTransferParser
+	VMType             []byte
+}
+
+// Recorder records a sequence of calls executed by a VM host, together with the minimal state they touch,
+// and replays them as a scenario: a setState step with the touched accounts, then one scCall or scDeploy step
+// per recorded call, with an expect block taken from the actual VMOutput.
+//
+// The VM host must be created with the recording BlockchainHook and its calls must go through the host
+// returned by WrapHost. The recorded calls are expected to see the protocol effects of the transaction
+// already applied (the sender nonce, the call value and the ESDT transfers of the sender), which the
+// scenario replays itself. The gas price is not recorded, so fees are ignored.
+type Recorder struct {
+	mutex              sync.Mutex
+	hook               vmcommon.BlockchainHook
+	esdtTransferParser vmcommon.ESDTTransferParser
+	vmType             []byte
+	state              *preState
+	steps              []*recordedStep
+	pendingESDTDeltas  []*esdtDelta
+}
+
+type esdtDelta struct {
+	address []byte
+	tokenID []byte
+	nonce   uint64
+	value   *big.Int
+}
+
+// NewRecorder creates a new Recorder around the given BlockchainHook
+func NewRecorder(args ArgsNewRecorder) (*Recorder, error) {
+	if check.IfNil(args.BlockchainHook) {
+		return nil, ErrNilBlockchainHook
+	}
+	if check.IfNil(args.ESDTTransferParser) {
+		return nil, vmhost.ErrNilESDTTransferParser
+	}
+
+	return &Recorder{
+		hook:               args.BlockchainHook,
+		esdtTransferParser: args.ESDTTransferParser,
+		vmType:             args.VMType,
+		state:              newPreState(),
+	}, nil
+}
+
+// BlockchainHook returns the hook to create the recorded VM host with. It captures the state read by the VM.
+func (recorder *Recorder) BlockchainHook() vmcommon.BlockchainHook {
+	return &recordingBlockchainHook{
+		BlockchainHook: recorder.hook,
+		recorder:       recorder,
+	}
+}
+
+// WrapHost returns a VM host which records the calls and their outputs, then delegates them to the given host.
+func (recorder *Recorder) WrapHost(host vmhost.VMHost) vmhost.VMHost {
+	return &recordingVMHost{
+		VMHost:   host,
+		recorder: recorder,
+	}
+}
+
+// NumRecordedCalls returns the number of calls recorded so far.
+func (recorder *Recorder) NumRecordedCalls() int {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	return len(recorder.steps)
+}
+
+// WriteScenario writes the recorded scenario as a .scen.json file.
+func (recorder *Recorder) WriteScenario(path string, name string) error {
+	scenarioJSON, err := recorder.ScenarioJSON(name)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, []byte(scenarioJSON), 0644)
+}
+
+// beginCall applies the protocol effects of a transaction that are already visible when the VM runs,
+// then captures the sender and the recipient.
+func (recorder *Recorder) beginCall(input *vmcommon.VMInput, recipient []byte) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	recorder.pendingESDTDeltas = nil
+
+	state := recorder.state
+	state.addNonceDelta(input.CallerAddr)
+	if input.CallValue != nil {
+		state.addBalanceDelta(input.CallerAddr, big.NewInt(0).Neg(input.CallValue))
+	}
+	for _, transfer := range input.ESDTTransfers {
+		state.addESDTDelta(input.CallerAddr, transfer.ESDTTokenName, transfer.ESDTTokenNonce, big.NewInt(0).Neg(transfer.ESDTValue))
+		state.addESDTDelta(recipient, transfer.ESDTTokenName, transfer.ESDTTokenNonce, transfer.ESDTValue)
+	}
+
+	state.touchAccount(recorder.hook, input.CallerAddr)
+	if len(recipient) > 0 {
+		state.touchAccount(recorder.hook, recipient)
+	}
+	for _, transfer := range input.ESDTTransfers {
+		state.fetchESDT(recorder.hook, input.CallerAddr, transfer.ESDTTokenName, transfer.ESDTTokenNonce)
+		state.fetchESDT(recorder.hook, recipient, transfer.ESDTTokenName, transfer.ESDTTokenNonce)
+	}
+}
+
+// endCall records the step of a finished call. Only successful calls change the state replayed afterwards.
+func (recorder *Recorder) endCall(step *recordedStep, vmOutput *vmcommon.VMOutput, err error) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	pendingESDTDeltas := recorder.pendingESDTDeltas
+	recorder.pendingESDTDeltas = nil
+
+	if err != nil || vmOutput == nil {
+		log.Debug("call not recorded", "error", err)
+		return
+	}
+
+	step.expect = expectFromVMOutput(vmOutput)
+	recorder.steps = append(recorder.steps, step)
+
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return
+	}
+
+	recorder.state.applyOutput(vmOutput)
+	for _, delta := range pendingESDTDeltas {
+		recorder.state.addESDTDelta(delta.address, delta.tokenID, delta.nonce, delta.value)
+	}
+}
+
+func (recorder *Recorder) currentBlockInfo() *blockInfo {
+	return &blockInfo{
+		timestamp:  recorder.hook.CurrentTimeStamp(),
+		nonce:      recorder.hook.CurrentNonce(),
+		round:      recorder.hook.CurrentRound(),
+		epoch:      recorder.hook.CurrentEpoch(),
+		randomSeed: recorder.hook.CurrentRandomSeed(),
+	}
+}
+
+func (recorder *Recorder) previousBlockInfo() *blockInfo {
+	return &blockInfo{
+		timestamp:  recorder.hook.LastTimeStamp(),
+		nonce:      recorder.hook.LastNonce(),
+		round:      recorder.hook.LastRound(),
+		epoch:      recorder.hook.LastEpoch(),
+		randomSeed: recorder.hook.LastRandomSeed(),
+	}
+}
@@ -0,0 +1,211 @@
+package scenariorecorder
+
+import (
+	"bytes"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	scenio "github.com/multiversx/mx-chain-scenario-go/scenario/io"
+	scenjsonparse "github.com/multiversx/mx-chain-scenario-go/scenario/json/parse"
+	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
+	worldmock "github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var vmType = []byte{5, 0}
+
+var userAddress = bytes.Repeat([]byte{'u'}, 32)
+var contractAddress = bytes.Repeat([]byte{'c'}, 32)
+
+var counterKey = []byte("counter")
+
+// counterHost increments the counter of the contract, reading it through the hook it was created with
+type counterHost struct {
+	vmhost.VMHost
+	hook vmcommon.BlockchainHook
+}
+
+func (host *counterHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	value, _, err := host.hook.GetStorageData(input.RecipientAddr, counterKey)
+	if err != nil {
+		return nil, err
+	}
+	_, _, _ = host.hook.GetStorageData(input.RecipientAddr, []byte("missing"))
+
+	counter := big.NewInt(0).SetBytes(value)
+	counter.Add(counter, big.NewInt(1))
+
+	return &vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		ReturnData: [][]byte{counter.Bytes()},
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(input.RecipientAddr): {
+				Address:      input.RecipientAddr,
+				BalanceDelta: input.CallValue,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					string(counterKey): {Offset: counterKey, Data: counter.Bytes(), Written: true},
+				},
+			},
+		},
+	}, nil
+}
+
+func (host *counterHost) IsInterfaceNil() bool {
+	return host == nil
+}
+
+func newTestRecorder(t *testing.T) (*Recorder, *worldmock.MockWorld) {
+	world := worldmock.NewMockWorld()
+	world.AcctMap.PutAccount(&worldmock.Account{
+		Address: userAddress,
+		Nonce:   7,
+		Balance: big.NewInt(1000),
+	})
+	world.AcctMap.PutAccount(&worldmock.Account{
+		Address:         contractAddress,
+		Balance:         big.NewInt(50),
+		Code:            []byte("counter code"),
+		OwnerAddress:    userAddress,
+		IsSmartContract: true,
+		Storage: map[string][]byte{
+			string(counterKey): {5},
+			"unread":           {1},
+		},
+	})
+	world.CurrentBlockInfo = &worldmock.BlockInfo{BlockNonce: 10, BlockRound: 11}
+
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
+	recorder, err := NewRecorder(ArgsNewRecorder{
+		BlockchainHook:     world,
+		ESDTTransferParser: esdtTransferParser,
+		VMType:             vmType,
+	})
+	require.Nil(t, err)
+
+	return recorder, world
+}
+
+// applyCall mimics the protocol, which debits the sender before running the VM, then commits the output
+func applyCall(world *worldmock.MockWorld, host vmhost.VMHost, input *vmcommon.ContractCallInput) *vmcommon.VMOutput {
+	sender := world.AcctMap.GetAccount(input.CallerAddr)
+	sender.Nonce++
+	sender.Balance = big.NewInt(0).Sub(sender.Balance, input.CallValue)
+
+	vmOutput, _ := host.RunSmartContractCall(input)
+	_ = world.UpdateAccounts(vmOutput.OutputAccounts, nil)
+
+	return vmOutput
+}
+
+func newCallInput(callValue int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  userAddress,
+			CallValue:   big.NewInt(callValue),
+			GasProvided: 1000000,
+			Arguments:   [][]byte{{1}},
+		},
+		RecipientAddr: contractAddress,
+		Function:      "increment",
+	}
+}
+
+func TestNewRecorder(t *testing.T) {
+	t.Parallel()
+
+	recorder, err := NewRecorder(ArgsNewRecorder{})
+	require.Nil(t, recorder)
+	require.Equal(t, ErrNilBlockchainHook, err)
+
+	recorder, err = NewRecorder(ArgsNewRecorder{BlockchainHook: worldmock.NewMockWorld()})
+	require.Nil(t, recorder)
+	require.Equal(t, vmhost.ErrNilESDTTransferParser, err)
+}
+
+func TestRecorder_RecordsPreStateAndSteps(t *testing.T) {
+	t.Parallel()
+
+	recorder, world := newTestRecorder(t)
+	host := recorder.WrapHost(&counterHost{hook: recorder.BlockchainHook()})
+
+	applyCall(world, host, newCallInput(100))
+	world.CurrentBlockInfo = &worldmock.BlockInfo{BlockNonce: 12, BlockRound: 13}
+	applyCall(world, host, newCallInput(0))
+	require.Equal(t, 2, recorder.NumRecordedCalls())
+
+	scenarioPath := filepath.Join(t.TempDir(), "recorded.scen.json")
+	require.Nil(t, recorder.WriteScenario(scenarioPath, "recorded"))
+
+	parser := scenjsonparse.NewParser(scenio.NewDefaultFileResolver(), vmType)
+	scenarioJSON, err := os.ReadFile(scenarioPath)
+	require.Nil(t, err)
+	scenario, err := parser.ParseScenarioFile(scenarioJSON)
+	require.Nil(t, err)
+
+	require.Equal(t, "recorded", scenario.Name)
+	require.Len(t, scenario.Steps, 5)
+
+	setState := scenario.Steps[0].(*scenmodel.SetStateStep)
+	require.Len(t, setState.Accounts, 2)
+	for _, account := range setState.Accounts {
+		if bytes.Equal(account.Address.Value, userAddress) {
+			require.Equal(t, uint64(7), account.Nonce.Value)
+			require.Equal(t, big.NewInt(1000), account.Balance.Value)
+			continue
+		}
+
+		require.Equal(t, big.NewInt(50), account.Balance.Value)
+		require.Equal(t, []byte("counter code"), account.Code.Value)
+		require.Equal(t, userAddress, account.Owner.Value)
+		require.Len(t, account.Storage, 1)
+		require.Equal(t, counterKey, account.Storage[0].Key.Value)
+		require.Equal(t, []byte{5}, account.Storage[0].Value.Value)
+	}
+
+	require.Equal(t, uint64(10), scenario.Steps[1].(*scenmodel.SetStateStep).CurrentBlockInfo.BlockNonce.Value)
+
+	firstCall := scenario.Steps[2].(*scenmodel.TxStep)
+	require.Equal(t, "1", firstCall.TxIdent)
+	require.Equal(t, "increment", firstCall.Tx.Function)
+	require.Equal(t, big.NewInt(100), firstCall.Tx.EGLDValue.Value)
+	require.Equal(t, []byte{6}, firstCall.ExpectedResult.Out.Values[0].Value)
+
+	require.Equal(t, uint64(12), scenario.Steps[3].(*scenmodel.SetStateStep).CurrentBlockInfo.BlockNonce.Value)
+
+	secondCall := scenario.Steps[4].(*scenmodel.TxStep)
+	require.Equal(t, "2", secondCall.TxIdent)
+	require.Equal(t, []byte{7}, secondCall.ExpectedResult.Out.Values[0].Value)
+}
+
+func TestRecorder_FailedCallsDoNotChangeThePreState(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := newTestRecorder(t)
+	state := recorder.state
+
+	recorder.beginCall(&newCallInput(0).VMInput, contractAddress)
+	recorder.endCall(&recordedStep{}, &vmcommon.VMOutput{
+		ReturnCode:    vmcommon.UserError,
+		ReturnMessage: "fail",
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contractAddress): {
+				Address: contractAddress,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"other": {Offset: []byte("other"), Data: []byte{1}, Written: true},
+				},
+			},
+		},
+	}, nil)
+	require.Equal(t, 1, recorder.NumRecordedCalls())
+	require.Empty(t, state.writtenStorage)
+	require.Equal(t, "str:fail", recorder.steps[0].expect["message"])
+
+	recorder.beginCall(&newCallInput(0).VMInput, contractAddress)
+	recorder.endCall(&recordedStep{}, nil, vmhost.ErrExecutionFailed)
+	require.Equal(t, 1, recorder.NumRecordedCalls())
+}
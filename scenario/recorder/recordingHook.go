@@ -0,0 +1,112 @@
+package scenariorecorder
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// recordingBlockchainHook captures the state read by the VM, then delegates to the wrapped hook.
+type recordingBlockchainHook struct {
+	vmcommon.BlockchainHook
+	recorder *Recorder
+}
+
+// GetUserAccount returns the account from the wrapped hook, capturing it the first time it is read.
+func (hook *recordingBlockchainHook) GetUserAccount(address []byte) (vmcommon.UserAccountHandler, error) {
+	hook.recorder.mutex.Lock()
+	hook.recorder.state.touchAccount(hook.BlockchainHook, address)
+	hook.recorder.mutex.Unlock()
+
+	return hook.BlockchainHook.GetUserAccount(address)
+}
+
+// GetStorageData returns the storage value from the wrapped hook, capturing it the first time it is read.
+func (hook *recordingBlockchainHook) GetStorageData(accountAddress []byte, index []byte) ([]byte, uint32, error) {
+	value, depth, err := hook.BlockchainHook.GetStorageData(accountAddress, index)
+	if err != nil {
+		return value, depth, err
+	}
+
+	hook.recorder.mutex.Lock()
+	hook.recorder.state.captureStorage(hook.BlockchainHook, accountAddress, index, value)
+	hook.recorder.mutex.Unlock()
+
+	return value, depth, nil
+}
+
+// GetESDTToken returns the token from the wrapped hook, capturing it the first time it is read.
+func (hook *recordingBlockchainHook) GetESDTToken(address []byte, tokenID []byte, nonce uint64) (*esdt.ESDigitalToken, error) {
+	token, err := hook.BlockchainHook.GetESDTToken(address, tokenID, nonce)
+	if err != nil {
+		return token, err
+	}
+
+	hook.recorder.mutex.Lock()
+	hook.recorder.state.captureESDT(hook.BlockchainHook, address, tokenID, nonce, token)
+	hook.recorder.mutex.Unlock()
+
+	return token, nil
+}
+
+// NewAddress returns the address from the wrapped hook, recording it for the newAddresses of the scenario.
+func (hook *recordingBlockchainHook) NewAddress(creatorAddress []byte, creatorNonce uint64, vmType []byte) ([]byte, error) {
+	address, err := hook.BlockchainHook.NewAddress(creatorAddress, creatorNonce, vmType)
+	if err != nil || len(address) == 0 {
+		return address, err
+	}
+
+	hook.recorder.mutex.Lock()
+	hook.recorder.state.addNewAddress(creatorAddress, creatorNonce, address)
+	hook.recorder.mutex.Unlock()
+
+	return address, nil
+}
+
+// ProcessBuiltInFunction captures the balances moved by ESDT transfers before the wrapped hook changes them.
+func (hook *recordingBlockchainHook) ProcessBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	parsedTransfers, errParse := hook.recorder.esdtTransferParser.ParseESDTTransfers(
+		input.CallerAddr,
+		input.RecipientAddr,
+		input.Function,
+		input.Arguments)
+	if errParse == nil {
+		hook.recorder.mutex.Lock()
+		for _, transfer := range parsedTransfers.ESDTTransfers {
+			hook.recorder.state.fetchESDT(hook.BlockchainHook, input.CallerAddr, transfer.ESDTTokenName, transfer.ESDTTokenNonce)
+			hook.recorder.state.fetchESDT(hook.BlockchainHook, parsedTransfers.RcvAddr, transfer.ESDTTokenName, transfer.ESDTTokenNonce)
+		}
+		hook.recorder.mutex.Unlock()
+	}
+
+	vmOutput, err := hook.BlockchainHook.ProcessBuiltInFunction(input)
+	if err != nil || errParse != nil || vmOutput == nil || vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput, err
+	}
+
+	hook.recorder.mutex.Lock()
+	for _, transfer := range parsedTransfers.ESDTTransfers {
+		hook.recorder.pendingESDTDeltas = append(hook.recorder.pendingESDTDeltas,
+			&esdtDelta{
+				address: input.CallerAddr,
+				tokenID: transfer.ESDTTokenName,
+				nonce:   transfer.ESDTTokenNonce,
+				value:   big.NewInt(0).Neg(transfer.ESDTValue),
+			},
+			&esdtDelta{
+				address: parsedTransfers.RcvAddr,
+				tokenID: transfer.ESDTTokenName,
+				nonce:   transfer.ESDTTokenNonce,
+				value:   transfer.ESDTValue,
+			})
+	}
+	hook.recorder.mutex.Unlock()
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hook *recordingBlockchainHook) IsInterfaceNil() bool {
+	return hook == nil
+}
@@ -0,0 +1,49 @@
+package scenariorecorder
+
+import (
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// recordingVMHost records every call and its output, then delegates to the wrapped host.
+type recordingVMHost struct {
+	vmhost.VMHost
+	recorder *Recorder
+}
+
+// RunSmartContractCreate records a deployment as a scDeploy step.
+func (host *recordingVMHost) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	step := &recordedStep{
+		stepType:      stepDeploy,
+		tx:            txFromCreateInput(input),
+		currentBlock:  host.recorder.currentBlockInfo(),
+		previousBlock: host.recorder.previousBlockInfo(),
+	}
+	host.recorder.beginCall(&input.VMInput, nil)
+
+	vmOutput, err := host.VMHost.RunSmartContractCreate(input)
+	host.recorder.endCall(step, vmOutput, err)
+
+	return vmOutput, err
+}
+
+// RunSmartContractCall records a call as a scCall step.
+func (host *recordingVMHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	step := &recordedStep{
+		stepType:      stepCall,
+		tx:            txFromCallInput(input),
+		currentBlock:  host.recorder.currentBlockInfo(),
+		previousBlock: host.recorder.previousBlockInfo(),
+	}
+	host.recorder.beginCall(&input.VMInput, input.RecipientAddr)
+
+	vmOutput, err := host.VMHost.RunSmartContractCall(input)
+	host.recorder.endCall(step, vmOutput, err)
+
+	return vmOutput, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (host *recordingVMHost) IsInterfaceNil() bool {
+	return host == nil
+}
@@ -0,0 +1,348 @@
+package scenariorecorder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	scenio "github.com/multiversx/mx-chain-scenario-go/scenario/io"
+	scenjsonparse "github.com/multiversx/mx-chain-scenario-go/scenario/json/parse"
+	scenjsonwrite "github.com/multiversx/mx-chain-scenario-go/scenario/json/write"
+	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	stepCall     = "scCall"
+	stepDeploy   = "scDeploy"
+	stepSetState = "setState"
+)
+
+const randomSeedLength = 48
+
+// jsonObject is a JSON snippet, in the format of the scenario parser.
+type jsonObject = map[string]interface{}
+
+type blockInfo struct {
+	timestamp  uint64
+	nonce      uint64
+	round      uint64
+	epoch      uint32
+	randomSeed []byte
+}
+
+type recordedStep struct {
+	stepType      string
+	tx            jsonObject
+	expect        jsonObject
+	currentBlock  *blockInfo
+	previousBlock *blockInfo
+}
+
+// Scenario builds the scenario of the calls recorded so far.
+func (recorder *Recorder) Scenario(name string) (*scenmodel.Scenario, error) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	parser := scenjsonparse.NewParser(scenio.NewDefaultFileResolver(), recorder.vmType)
+	scenario := &scenmodel.Scenario{
+		Name: name,
+	}
+
+	addStep := func(snippet string) error {
+		step, err := parser.ParseScenarioStep(snippet)
+		if err != nil {
+			return err
+		}
+
+		scenario.Steps = append(scenario.Steps, step)
+		return nil
+	}
+
+	snippet, err := json.Marshal(recorder.state.setStateStep())
+	if err != nil {
+		return nil, err
+	}
+	err = addStep(string(snippet))
+	if err != nil {
+		return nil, err
+	}
+
+	var lastCurrentBlock, lastPreviousBlock *blockInfo
+	for index, step := range recorder.steps {
+		if !step.currentBlock.equals(lastCurrentBlock) || !step.previousBlock.equals(lastPreviousBlock) {
+			snippet, err = json.Marshal(jsonObject{
+				"step":              stepSetState,
+				"currentBlockInfo":  step.currentBlock.toJSON(),
+				"previousBlockInfo": step.previousBlock.toJSON(),
+			})
+			if err == nil {
+				err = addStep(string(snippet))
+			}
+			if err != nil {
+				return nil, err
+			}
+			lastCurrentBlock, lastPreviousBlock = step.currentBlock, step.previousBlock
+		}
+
+		snippet, err = step.toJSON(strconv.Itoa(index + 1))
+		if err == nil {
+			err = addStep(string(snippet))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("recorded step %d: %w", index+1, err)
+		}
+	}
+
+	return scenario, nil
+}
+
+// ScenarioJSON builds the scenario of the calls recorded so far, in the .scen.json format.
+func (recorder *Recorder) ScenarioJSON(name string) (string, error) {
+	scenario, err := recorder.Scenario(name)
+	if err != nil {
+		return "", err
+	}
+
+	return scenjsonwrite.ScenarioToJSONString(scenario), nil
+}
+
+// toJSON writes the step by hand, because the parser needs the tx before the expect block.
+func (step *recordedStep) toJSON(id string) ([]byte, error) {
+	txJSON, err := json.Marshal(step.tx)
+	if err != nil {
+		return nil, err
+	}
+
+	expectJSON, err := json.Marshal(step.expect)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(fmt.Sprintf(`{"step": %q, "id": %q, "tx": %s, "expect": %s}`, step.stepType, id, txJSON, expectJSON)), nil
+}
+
+func (state *preState) setStateStep() jsonObject {
+	accounts := jsonObject{}
+	for _, account := range state.accounts {
+		if !account.exists {
+			continue
+		}
+		accounts[hexBytes(account.address)] = account.toJSON()
+	}
+
+	newAddresses := make([]jsonObject, 0, len(state.newAddresses))
+	for _, entry := range state.newAddresses {
+		newAddresses = append(newAddresses, jsonObject{
+			"creatorAddress": hexBytes(entry.creatorAddress),
+			"creatorNonce":   strconv.FormatUint(entry.creatorNonce, 10),
+			"newAddress":     hexBytes(entry.address),
+		})
+	}
+
+	return jsonObject{
+		"step":         stepSetState,
+		"accounts":     accounts,
+		"newAddresses": newAddresses,
+	}
+}
+
+func (account *accountState) toJSON() jsonObject {
+	storage := jsonObject{}
+	for key, value := range account.storage {
+		if len(value) > 0 {
+			storage[hexBytes([]byte(key))] = hexBytes(value)
+		}
+	}
+
+	result := jsonObject{
+		"nonce":   strconv.FormatUint(account.nonce, 10),
+		"balance": account.balance.String(),
+		"storage": storage,
+		"code":    hexBytes(account.code),
+	}
+	if len(account.esdt) > 0 {
+		result["esdt"] = account.esdtToJSON()
+	}
+	if len(account.username) > 0 {
+		result["username"] = hexBytes(account.username)
+	}
+	if len(account.codeMetadata) > 0 {
+		result["codeMetadata"] = hexBytes(account.codeMetadata)
+	}
+	if len(account.owner) > 0 {
+		result["owner"] = hexBytes(account.owner)
+	}
+	if account.developerReward.Sign() != 0 {
+		result["developerRewards"] = account.developerReward.String()
+	}
+
+	return result
+}
+
+func (account *accountState) esdtToJSON() jsonObject {
+	keys := make([]esdtKey, 0, len(account.esdt))
+	for key := range account.esdt {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].tokenID != keys[j].tokenID {
+			return keys[i].tokenID < keys[j].tokenID
+		}
+		return keys[i].nonce < keys[j].nonce
+	})
+
+	instancesByToken := make(map[string][]jsonObject)
+	for _, key := range keys {
+		token := account.esdt[key]
+		if token.Value.Sign() == 0 {
+			continue
+		}
+
+		instance := jsonObject{
+			"nonce":   strconv.FormatUint(key.nonce, 10),
+			"balance": token.Value.String(),
+		}
+		metadata := token.TokenMetaData
+		if metadata != nil {
+			uris := make([]string, 0, len(metadata.URIs))
+			for _, uri := range metadata.URIs {
+				uris = append(uris, hexBytes(uri))
+			}
+			instance["creator"] = hexBytes(metadata.Creator)
+			instance["royalties"] = strconv.FormatUint(uint64(metadata.Royalties), 10)
+			instance["hash"] = hexBytes(metadata.Hash)
+			instance["uri"] = uris
+			instance["attributes"] = hexBytes(metadata.Attributes)
+		}
+
+		tokenKey := "str:" + key.tokenID
+		instancesByToken[tokenKey] = append(instancesByToken[tokenKey], instance)
+	}
+
+	result := jsonObject{}
+	for tokenKey, instances := range instancesByToken {
+		result[tokenKey] = jsonObject{
+			"instances": instances,
+		}
+	}
+
+	return result
+}
+
+func (info *blockInfo) equals(other *blockInfo) bool {
+	if other == nil {
+		return false
+	}
+
+	return info.timestamp == other.timestamp &&
+		info.nonce == other.nonce &&
+		info.round == other.round &&
+		info.epoch == other.epoch &&
+		bytes.Equal(info.randomSeed, other.randomSeed)
+}
+
+func (info *blockInfo) toJSON() jsonObject {
+	result := jsonObject{
+		"blockTimestamp": strconv.FormatUint(info.timestamp, 10),
+		"blockNonce":     strconv.FormatUint(info.nonce, 10),
+		"blockRound":     strconv.FormatUint(info.round, 10),
+		"blockEpoch":     strconv.FormatUint(uint64(info.epoch), 10),
+	}
+	if len(info.randomSeed) == randomSeedLength {
+		result["blockRandomSeed"] = hexBytes(info.randomSeed)
+	}
+
+	return result
+}
+
+func txFromCallInput(input *vmcommon.ContractCallInput) jsonObject {
+	tx := txFromVMInput(&input.VMInput)
+	tx["to"] = hexBytes(input.RecipientAddr)
+	tx["function"] = input.Function
+
+	if len(input.ESDTTransfers) > 0 {
+		esdtValue := make([]jsonObject, 0, len(input.ESDTTransfers))
+		for _, transfer := range input.ESDTTransfers {
+			esdtValue = append(esdtValue, jsonObject{
+				"tokenIdentifier": "str:" + string(transfer.ESDTTokenName),
+				"nonce":           strconv.FormatUint(transfer.ESDTTokenNonce, 10),
+				"value":           bigIntString(transfer.ESDTValue),
+			})
+		}
+		tx["esdtValue"] = esdtValue
+	}
+
+	return tx
+}
+
+func txFromCreateInput(input *vmcommon.ContractCreateInput) jsonObject {
+	tx := txFromVMInput(&input.VMInput)
+	tx["contractCode"] = hexBytes(input.ContractCode)
+	tx["codeMetadata"] = hexBytes(input.ContractCodeMetadata)
+
+	return tx
+}
+
+func txFromVMInput(input *vmcommon.VMInput) jsonObject {
+	return jsonObject{
+		"from":      hexBytes(input.CallerAddr),
+		"egldValue": bigIntString(input.CallValue),
+		"arguments": hexList(input.Arguments),
+		"gasLimit":  strconv.FormatUint(input.GasProvided, 10),
+		"gasPrice":  "0",
+	}
+}
+
+func expectFromVMOutput(vmOutput *vmcommon.VMOutput) jsonObject {
+	logs := make([]jsonObject, 0, len(vmOutput.Logs))
+	for _, logEntry := range vmOutput.Logs {
+		logs = append(logs, jsonObject{
+			"address":  hexBytes(logEntry.Address),
+			"endpoint": hexBytes(logEntry.Identifier),
+			"topics":   hexList(logEntry.Topics),
+			"data":     hexList(logEntry.Data),
+		})
+	}
+
+	message := ""
+	if len(vmOutput.ReturnMessage) > 0 {
+		message = "str:" + vmOutput.ReturnMessage
+	}
+
+	return jsonObject{
+		"out":     hexList(vmOutput.ReturnData),
+		"status":  strconv.Itoa(int(vmOutput.ReturnCode)),
+		"message": message,
+		"logs":    logs,
+		"gas":     "*",
+		"refund":  "*",
+	}
+}
+
+// hexBytes formats a byte array as a scenario value. The empty value is written as "".
+func hexBytes(value []byte) string {
+	if len(value) == 0 {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(value)
+}
+
+func hexList(values [][]byte) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, hexBytes(value))
+	}
+	return result
+}
+
+func bigIntString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+	return value.String()
+}
@@ -2,18 +2,23 @@ package scenario
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	scenexec "github.com/multiversx/mx-chain-scenario-go/scenario/executor"
 	scenmodel "github.com/multiversx/mx-chain-scenario-go/scenario/model"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/multiversx/mx-chain-vm-go/abi"
 	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/debugger"
 	"github.com/multiversx/mx-chain-vm-go/executor"
+	executorwrapper "github.com/multiversx/mx-chain-vm-go/executor/wrapper"
 	gasSchedules "github.com/multiversx/mx-chain-vm-go/scenario/gasSchedules"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/hostCore"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/mock"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
 )
 
 var _ scenexec.VMBuilder = (*ScenarioVMHostBuilder)(nil)
@@ -24,11 +29,21 @@ var DefaultVMType = []byte{5, 0}
 // DefaultTimeOutForSCExecutionInMilliseconds is the mainnet timeout.
 var DefaultTimeOutForSCExecutionInMilliseconds uint32 = 10000
 
+// DebuggerTimeOutForSCExecutionInMilliseconds replaces the timeout when a debugger is attached, since the execution
+// stays paused for as long as the debugger client needs.
+var DebuggerTimeOutForSCExecutionInMilliseconds uint32 = math.MaxUint32
+
 // VMTestExecutor parses, interprets and executes both .test.json tests and .scen.json scenarios with VM.
 type ScenarioVMHostBuilder struct {
 	OverrideVMExecutor                  executor.ExecutorAbstractFactory
 	VMType                              []byte
 	TimeOutForSCExecutionInMilliseconds uint32
+	ABICodecs                           []*abi.Codec
+	Debugger                            *debugger.Debugger
+	ForensicsWriter                     vmhost.ForensicsWriter
+	ExecutionProfiler                   vmhost.ExecutionProfiler
+	CallPolicy                          vmhost.CallPolicy
+	NativeContracts                     map[string]vmhost.NativeContract
 }
 
 // NewScenarioVMHostBuilder creates a default ScenarioVMHostBuilder.
@@ -80,11 +95,21 @@ func (svb *ScenarioVMHostBuilder) NewVM(
 	blockGasLimit := uint64(10000000)
 	esdtTransferParser, _ := parsers.NewESDTTransferParser(worldmock.WorldMarshalizer)
 
-	return hostCore.NewVMHost(
+	vmExecutor := svb.OverrideVMExecutor
+	timeOutForSCExecution := svb.TimeOutForSCExecutionInMilliseconds
+	if svb.Debugger != nil {
+		if vmExecutor == nil {
+			vmExecutor = wasmer2.ExecutorFactory()
+		}
+		vmExecutor = executorwrapper.NewDecodingWrappedExecutorFactory(svb.Debugger, vmExecutor)
+		timeOutForSCExecution = DebuggerTimeOutForSCExecutionInMilliseconds
+	}
+
+	vmHost, err := hostCore.NewVMHost(
 		world,
 		&vmhost.VMHostParameters{
 			VMType:                    svb.VMType,
-			OverrideVMExecutor:        svb.OverrideVMExecutor,
+			OverrideVMExecutor:        vmExecutor,
 			BlockGasLimit:             blockGasLimit,
 			GasSchedule:               gasSchedule,
 			BuiltInFuncContainer:      world.BuiltinFuncs.Container,
@@ -95,9 +120,21 @@ func (svb *ScenarioVMHostBuilder) NewVM(
 			WasmerSIGSEGVPassthrough:  false,
 			Hasher:                    worldmock.DefaultHasher,
 			MapOpcodeAddressIsAllowed: map[string]map[string]struct{}{},
-			TimeOutForSCExecutionInMilliseconds: svb.TimeOutForSCExecutionInMilliseconds,
+			ForensicsWriter:           svb.ForensicsWriter,
+			ExecutionProfiler:         svb.ExecutionProfiler,
+			CallPolicy:                svb.CallPolicy,
+			NativeContracts:           svb.NativeContracts,
+			TimeOutForSCExecutionInMilliseconds: timeOutForSCExecution,
 		})
+	if err != nil {
+		return nil, err
+	}
+
+	if svb.Debugger != nil {
+		svb.Debugger.SetHost(vmHost)
+	}
 
+	return newABIDecodingVM(vmHost, svb.ABICodecs), nil
 }
 
 // DefaultScenarioExecutor provides a scenario executor with VM 1.5, default configuration
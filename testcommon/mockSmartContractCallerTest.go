@@ -42,9 +42,11 @@ type testTemplateConfig struct {
 // MockInstancesTestTemplate holds the data to build a mock contract call test
 type MockInstancesTestTemplate struct {
 	testTemplateConfig
-	contracts     *[]MockTestSmartContract
-	setup         SetupFunction
-	assertResults func(*TestCallNode, *worldmock.MockWorld, *VMOutputVerifier, []string)
+	contracts       *[]MockTestSmartContract
+	nativeContracts map[string]vmhost.NativeContract
+	forensicsWriter vmhost.ForensicsWriter
+	setup           SetupFunction
+	assertResults   func(*TestCallNode, *worldmock.MockWorld, *VMOutputVerifier, []string)
 }
 
 // BuildMockInstanceCallTest starts the building process for a mock contract call test
@@ -83,6 +85,18 @@ func (callerTest *MockInstancesTestTemplate) WithWasmerSIGSEGVPassthrough(wasmer
 	return callerTest
 }
 
+// WithNativeContracts provides the native contracts registered in the host of the mock contract call test
+func (callerTest *MockInstancesTestTemplate) WithNativeContracts(nativeContracts map[string]vmhost.NativeContract) *MockInstancesTestTemplate {
+	callerTest.nativeContracts = nativeContracts
+	return callerTest
+}
+
+// WithForensicsWriter provides the forensics writer used by the host of the mock contract call test
+func (callerTest *MockInstancesTestTemplate) WithForensicsWriter(forensicsWriter vmhost.ForensicsWriter) *MockInstancesTestTemplate {
+	callerTest.forensicsWriter = forensicsWriter
+	return callerTest
+}
+
 // AndAssertResults provides the function that will aserts the results
 func (callerTest *MockInstancesTestTemplate) AndAssertResults(assertResults AssertResultsFunc) (*vmcommon.VMOutput, error) {
 	return callerTest.andAssertResultsWithWorld(nil, true, nil, RunTest, nil, func(startNode *TestCallNode, world *worldmock.MockWorld, verify *VMOutputVerifier, expectedErrorsForRound []string) {
@@ -157,6 +171,8 @@ func (callerTest *MockInstancesTestTemplate) RunTest(
 	host := NewTestHostBuilder(callerTest.tb).
 		WithExecutorFactory(executorFactory).
 		WithBlockchainHook(world).
+		WithNativeContracts(callerTest.nativeContracts).
+		WithForensicsWriter(callerTest.forensicsWriter).
 		Build()
 
 	defer func() {
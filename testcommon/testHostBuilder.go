@@ -3,6 +3,7 @@ package testcommon
 import (
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -118,6 +119,37 @@ func (thb *TestHostBuilder) WithEnableEpochsHandler(handler vmcommon.EnableEpoch
 	return thb
 }
 
+// EnableEpochsHandlerStubWithoutFlags returns an enable epochs handler with all the flags active, except the given ones.
+func EnableEpochsHandlerStubWithoutFlags(disabledFlags ...core.EnableEpochFlag) *worldmock.EnableEpochsHandlerStub {
+	isFlagEnabled := func(flag core.EnableEpochFlag) bool {
+		for _, disabledFlag := range disabledFlags {
+			if flag == disabledFlag {
+				return false
+			}
+		}
+		return true
+	}
+
+	enableEpochsHandler := worldmock.EnableEpochsHandlerStubAllFlags()
+	enableEpochsHandler.IsFlagEnabledCalled = isFlagEnabled
+	enableEpochsHandler.IsFlagEnabledInEpochCalled = func(flag core.EnableEpochFlag, _ uint32) bool {
+		return isFlagEnabled(flag)
+	}
+	return enableEpochsHandler
+}
+
+// WithNativeContracts registers native contracts in the VM host, by address.
+func (thb *TestHostBuilder) WithNativeContracts(nativeContracts map[string]vmhost.NativeContract) *TestHostBuilder {
+	thb.vmHostParameters.NativeContracts = nativeContracts
+	return thb
+}
+
+// WithForensicsWriter sets the writer which receives the forensics of panicked and failed executions.
+func (thb *TestHostBuilder) WithForensicsWriter(forensicsWriter vmhost.ForensicsWriter) *TestHostBuilder {
+	thb.vmHostParameters.ForensicsWriter = forensicsWriter
+	return thb
+}
+
 // Build initializes the VM host with all configured options.
 func (thb *TestHostBuilder) Build() vmhost.VMHost {
 	thb.initializeHost()
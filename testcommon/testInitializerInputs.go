@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/abi"
 	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
 )
 
@@ -330,6 +331,15 @@ func (contractInput *ContractCallInputBuilder) WithArguments(arguments ...[]byte
 	return contractInput
 }
 
+// WithABIArguments sets the function and encodes the arguments of an endpoint using its ABI
+func (contractInput *ContractCallInputBuilder) WithABIArguments(abiCodec *abi.Codec, endpoint string, values ...interface{}) *ContractCallInputBuilder {
+	err := abiCodec.SetCallArguments(&contractInput.ContractCallInput, endpoint, values...)
+	if err != nil {
+		panic(fmt.Sprintf("cannot encode arguments of %s: %s", endpoint, err.Error()))
+	}
+	return contractInput
+}
+
 // WithAsyncArguments provides the async arguments to be called for ContractCallInputBuilder
 func (contractInput *ContractCallInputBuilder) WithAsyncArguments(arguments *vmcommon.AsyncArguments) *ContractCallInputBuilder {
 	contractInput.ContractCallInput.VMInput.AsyncArguments = arguments
@@ -438,6 +448,15 @@ func (contractInput *ContractCreateInputBuilder) WithArguments(arguments ...[]by
 	return contractInput
 }
 
+// WithABIArguments encodes the constructor arguments using the contract ABI
+func (contractInput *ContractCreateInputBuilder) WithABIArguments(abiCodec *abi.Codec, values ...interface{}) *ContractCreateInputBuilder {
+	err := abiCodec.SetDeployArguments(&contractInput.ContractCreateInput, values...)
+	if err != nil {
+		panic(fmt.Sprintf("cannot encode constructor arguments: %s", err.Error()))
+	}
+	return contractInput
+}
+
 // Build completes the build of a ContractCreateInput
 func (contractInput *ContractCreateInputBuilder) Build() *vmcommon.ContractCreateInput {
 	return &contractInput.ContractCreateInput
@@ -0,0 +1,106 @@
+package upgradecheck
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+)
+
+// ReadPersistedAsyncContexts decodes the async contexts persisted in the storage of a contract, given as a map from
+// keys to values. Only the keys under the VM protected prefix for async data are read, the protected key prefix
+// being the one configured for the VM host.
+func ReadPersistedAsyncContexts(
+	storage map[string][]byte,
+	protectedKeyPrefix []byte,
+) ([]*contexts.SerializableAsyncContext, error) {
+	asyncKeyPrefix := string(protectedKeyPrefix) + contexts.VMStoragePrefix + vmhost.AsyncDataPrefix
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+
+	keys := make([]string, 0)
+	for key, value := range storage {
+		if strings.HasPrefix(key, asyncKeyPrefix) && len(value) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	asyncContexts := make([]*contexts.SerializableAsyncContext, 0, len(keys))
+	for _, key := range keys {
+		asyncContext := &contexts.SerializableAsyncContext{}
+		err := marshalizer.Unmarshal(asyncContext, storage[key])
+		if err != nil {
+			return nil, fmt.Errorf("async context %s: %w", hex.EncodeToString([]byte(key)), err)
+		}
+		asyncContexts = append(asyncContexts, asyncContext)
+	}
+
+	return asyncContexts, nil
+}
+
+// ParseStorageDump parses the storage of a contract written as a JSON object, with hex encoded keys and values,
+// optionally prefixed by 0x.
+func ParseStorageDump(content []byte) (map[string][]byte, error) {
+	encoded := make(map[string]string)
+	err := json.Unmarshal(content, &encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	storage := make(map[string][]byte, len(encoded))
+	for encodedKey, encodedValue := range encoded {
+		key, err := hex.DecodeString(strings.TrimPrefix(encodedKey, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %s", ErrInvalidStorageEntry, encodedKey)
+		}
+		value, err := hex.DecodeString(strings.TrimPrefix(encodedValue, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: value of %s", ErrInvalidStorageEntry, encodedKey)
+		}
+		storage[string(key)] = value
+	}
+
+	return storage, nil
+}
+
+// checkPendingCallbacks reports the callbacks referred to by the async contexts which the new code does not export:
+// the callback of the context itself, the callbacks of its groups and those of its async calls
+func checkPendingCallbacks(
+	report *Report,
+	pendingContexts []*contexts.SerializableAsyncContext,
+	exported map[string]struct{},
+) {
+	for _, asyncContext := range pendingContexts {
+		callbacks := []string{asyncContext.Callback}
+		for _, group := range asyncContext.AsyncCallGroups {
+			callbacks = append(callbacks, group.Callback)
+			for _, asyncCall := range group.AsyncCalls {
+				callbacks = append(callbacks, asyncCall.SuccessCallback, asyncCall.ErrorCallback)
+			}
+		}
+
+		reported := make(map[string]struct{})
+		for _, callback := range callbacks {
+			if len(callback) == 0 {
+				continue
+			}
+			_, ok := exported[callback]
+			if ok {
+				continue
+			}
+			_, ok = reported[callback]
+			if ok {
+				continue
+			}
+
+			reported[callback] = struct{}{}
+			detail := "pending in the async context " + hex.EncodeToString(asyncContext.CallID)
+			report.add(OrphanedCallback, Breaking, callback, detail)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package upgradecheck
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-vm-go/abi"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+)
+
+const readonlyMutability = "readonly"
+
+// CodeVersion is a version of the code of a contract, with its ABI when available
+type CodeVersion struct {
+	Code []byte
+	ABI  *abi.ABI
+}
+
+// Check compares the old and the new code of a contract and reports the changes which make the upgrade fail, which
+// break the existing callers, or which leave the callbacks of the given persisted async contexts without a function
+// to run. The endpoints are compared using the functions exported by the code, their signatures only when both
+// versions come with an ABI.
+func Check(
+	oldVersion *CodeVersion,
+	newVersion *CodeVersion,
+	pendingContexts []*contexts.SerializableAsyncContext,
+) (*Report, error) {
+	if oldVersion == nil || newVersion == nil {
+		return nil, ErrNilCodeVersion
+	}
+
+	oldFunctions, err := contexts.ExportedFunctions(oldVersion.Code)
+	if err != nil {
+		return nil, fmt.Errorf("old code: %w", err)
+	}
+	newFunctions, err := contexts.ExportedFunctions(newVersion.Code)
+	if err != nil {
+		return nil, fmt.Errorf("new code: %w", err)
+	}
+
+	exported := make(map[string]struct{}, len(newFunctions))
+	for _, function := range newFunctions {
+		exported[function] = struct{}{}
+	}
+
+	report := &Report{Findings: make([]*Finding, 0)}
+	checkExportedFunctions(report, oldFunctions, exported)
+	if oldVersion.ABI != nil && newVersion.ABI != nil {
+		checkABIs(report, oldVersion.ABI, newVersion.ABI)
+	}
+	checkPendingCallbacks(report, pendingContexts, exported)
+	report.sort()
+
+	return report, nil
+}
+
+func checkExportedFunctions(report *Report, oldFunctions []string, exported map[string]struct{}) {
+	_, ok := exported[vmhost.ContractsUpgradeFunctionName]
+	if !ok {
+		report.add(MissingUpgradeFunction, Breaking, vmhost.ContractsUpgradeFunctionName, "called on the new code by the upgrade")
+	}
+
+	for _, function := range oldFunctions {
+		_, ok = exported[function]
+		switch {
+		case ok:
+		case function == vmhost.InitFunctionName || function == vmhost.ContractsUpgradeFunctionName:
+			// never called once the contract is deployed, the upgrade function being already reported
+		case function == vmhost.CallbackFunctionName:
+			report.add(RemovedCallback, Breaking, function, "")
+		default:
+			report.add(RemovedEndpoint, Breaking, function, "")
+		}
+	}
+}
+
+// checkABIs compares the endpoints present in both ABIs, the missing ones being reported from the exported functions
+func checkABIs(report *Report, oldABI *abi.ABI, newABI *abi.ABI) {
+	newEndpoints := make(map[string]*abi.Endpoint, len(newABI.Endpoints))
+	for _, endpoint := range newABI.Endpoints {
+		newEndpoints[endpoint.Name] = endpoint
+	}
+
+	for _, oldEndpoint := range oldABI.Endpoints {
+		newEndpoint, ok := newEndpoints[oldEndpoint.Name]
+		if !ok {
+			continue
+		}
+
+		oldInputs, newInputs := describeInputs(oldEndpoint.Inputs), describeInputs(newEndpoint.Inputs)
+		if oldInputs != newInputs {
+			report.add(ChangedInputs, Breaking, oldEndpoint.Name, fmt.Sprintf("(%s) -> (%s)", oldInputs, newInputs))
+		}
+
+		oldOutputs, newOutputs := describeOutputs(oldEndpoint.Outputs), describeOutputs(newEndpoint.Outputs)
+		if oldOutputs != newOutputs {
+			report.add(ChangedOutputs, Breaking, oldEndpoint.Name, fmt.Sprintf("(%s) -> (%s)", oldOutputs, newOutputs))
+		}
+
+		if oldEndpoint.Mutability == readonlyMutability && newEndpoint.Mutability != readonlyMutability {
+			report.add(ChangedMutability, Warning, oldEndpoint.Name, "no longer readonly")
+		}
+
+		removedTokens := removedPayableTokens(oldEndpoint.PayableInTokens, newEndpoint.PayableInTokens)
+		if len(removedTokens) > 0 {
+			report.add(RemovedPayableTokens, Breaking, oldEndpoint.Name, strings.Join(removedTokens, ", "))
+		}
+	}
+
+	newEvents := make(map[string]struct{}, len(newABI.Events))
+	for _, event := range newABI.Events {
+		newEvents[event.Identifier] = struct{}{}
+	}
+	for _, event := range oldABI.Events {
+		_, ok := newEvents[event.Identifier]
+		if !ok {
+			report.add(RemovedEvent, Warning, event.Identifier, "")
+		}
+	}
+}
+
+func describeInputs(inputs []*abi.Input) string {
+	types := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		if input.MultiArg {
+			types = append(types, "multi "+input.Type)
+			continue
+		}
+		types = append(types, input.Type)
+	}
+
+	return strings.Join(types, ", ")
+}
+
+func describeOutputs(outputs []*abi.Output) string {
+	types := make([]string, 0, len(outputs))
+	for _, output := range outputs {
+		if output.MultiResult {
+			types = append(types, "multi "+output.Type)
+			continue
+		}
+		types = append(types, output.Type)
+	}
+
+	return strings.Join(types, ", ")
+}
+
+// removedPayableTokens returns the tokens accepted by the old endpoint and no longer by the new one, "*" standing
+// for any token
+func removedPayableTokens(oldTokens []string, newTokens []string) []string {
+	accepted := make(map[string]struct{}, len(newTokens))
+	for _, token := range newTokens {
+		if token == "*" {
+			return nil
+		}
+		accepted[token] = struct{}{}
+	}
+
+	removed := make([]string, 0)
+	for _, token := range oldTokens {
+		_, ok := accepted[token]
+		if !ok {
+			removed = append(removed, token)
+		}
+	}
+
+	return removed
+}
@@ -0,0 +1,150 @@
+package upgradecheck
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-vm-go/abi"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/contexts"
+	"github.com/stretchr/testify/require"
+)
+
+var protectedKeyPrefix = []byte("ELROND")
+
+// wasmExportingFunctions builds a WASM module made only of an export section, exporting the given functions
+func wasmExportingFunctions(functions ...string) []byte {
+	section := []byte{byte(len(functions))}
+	for i, function := range functions {
+		section = append(section, byte(len(function)))
+		section = append(section, function...)
+		section = append(section, 0, byte(i))
+	}
+
+	code := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	code = append(code, 7, byte(len(section)))
+	return append(code, section...)
+}
+
+func requireFindings(t *testing.T, report *Report, expected ...*Finding) {
+	require.Equal(t, expected, report.Findings)
+}
+
+func TestCheck_ExportedFunctions(t *testing.T) {
+	t.Parallel()
+
+	oldVersion := &CodeVersion{Code: wasmExportingFunctions("init", "upgrade", "add", "getSum", "callBack")}
+
+	report, err := Check(oldVersion, &CodeVersion{Code: wasmExportingFunctions("upgrade", "add", "getSum", "callBack", "sub")}, nil)
+	require.Nil(t, err)
+	require.Empty(t, report.Findings)
+	require.False(t, report.HasBreakingChanges())
+
+	report, err = Check(oldVersion, &CodeVersion{Code: wasmExportingFunctions("init", "add")}, nil)
+	require.Nil(t, err)
+	requireFindings(t, report,
+		&Finding{Kind: MissingUpgradeFunction, Severity: Breaking, Name: "upgrade", Detail: "called on the new code by the upgrade"},
+		&Finding{Kind: RemovedCallback, Severity: Breaking, Name: "callBack"},
+		&Finding{Kind: RemovedEndpoint, Severity: Breaking, Name: "getSum"},
+	)
+	require.True(t, report.HasBreakingChanges())
+
+	_, err = Check(oldVersion, &CodeVersion{Code: []byte("not wasm")}, nil)
+	require.ErrorIs(t, err, vmhost.ErrMalformedBytecode)
+
+	_, err = Check(oldVersion, nil, nil)
+	require.Equal(t, ErrNilCodeVersion, err)
+}
+
+func TestCheck_ABIs(t *testing.T) {
+	t.Parallel()
+
+	oldABI, err := abi.ParseABI([]byte(`{
+		"name": "adder",
+		"endpoints": [
+			{"name": "add", "mutability": "mutable", "payableInTokens": ["EGLD", "TKN-123456"], "inputs": [{"name": "value", "type": "BigUint"}], "outputs": []},
+			{"name": "getSum", "mutability": "readonly", "inputs": [], "outputs": [{"type": "BigUint"}]},
+			{"name": "deposit", "mutability": "mutable", "payableInTokens": ["EGLD"], "inputs": [], "outputs": []}
+		],
+		"events": [{"identifier": "added", "inputs": []}, {"identifier": "reset", "inputs": []}]
+	}`))
+	require.Nil(t, err)
+	newABI, err := abi.ParseABI([]byte(`{
+		"name": "adder",
+		"endpoints": [
+			{"name": "add", "mutability": "mutable", "payableInTokens": ["EGLD"], "inputs": [{"name": "value", "type": "BigUint"}, {"name": "times", "type": "u32"}], "outputs": []},
+			{"name": "getSum", "mutability": "mutable", "inputs": [], "outputs": [{"type": "u64"}]},
+			{"name": "deposit", "mutability": "mutable", "payableInTokens": ["*"], "inputs": [], "outputs": []}
+		],
+		"events": [{"identifier": "added", "inputs": []}]
+	}`))
+	require.Nil(t, err)
+
+	code := wasmExportingFunctions("upgrade", "add", "getSum", "deposit")
+	report, err := Check(&CodeVersion{Code: code, ABI: oldABI}, &CodeVersion{Code: code, ABI: newABI}, nil)
+	require.Nil(t, err)
+	requireFindings(t, report,
+		&Finding{Kind: ChangedInputs, Severity: Breaking, Name: "add", Detail: "(BigUint) -> (BigUint, u32)"},
+		&Finding{Kind: ChangedOutputs, Severity: Breaking, Name: "getSum", Detail: "(BigUint) -> (u64)"},
+		&Finding{Kind: RemovedPayableTokens, Severity: Breaking, Name: "add", Detail: "TKN-123456"},
+		&Finding{Kind: ChangedMutability, Severity: Warning, Name: "getSum", Detail: "no longer readonly"},
+		&Finding{Kind: RemovedEvent, Severity: Warning, Name: "reset"},
+	)
+}
+
+func TestCheck_PendingCallbacks(t *testing.T) {
+	t.Parallel()
+
+	asyncContext := &contexts.SerializableAsyncContext{
+		CallID:   []byte{0x01},
+		Callback: "contextCallback",
+		AsyncCallGroups: []*vmhost.SerializableAsyncCallGroup{
+			{
+				Callback: "groupCallback",
+				AsyncCalls: []*vmhost.SerializableAsyncCall{
+					{SuccessCallback: "onSuccess", ErrorCallback: "onError"},
+					{SuccessCallback: "onSuccess", ErrorCallback: "onError"},
+				},
+			},
+		},
+	}
+	data, err := (&marshal.GogoProtoMarshalizer{}).Marshal(asyncContext)
+	require.Nil(t, err)
+
+	storage := map[string][]byte{
+		"ELRONDVM@ASYNC\x01": data,
+		// completed async contexts are deleted by writing an empty value
+		"ELRONDVM@ASYNC\x02": nil,
+		"ELRONDVM@OTHER":     []byte("other"),
+		"sum":                {0x05},
+	}
+	pendingContexts, err := ReadPersistedAsyncContexts(storage, protectedKeyPrefix)
+	require.Nil(t, err)
+	require.Len(t, pendingContexts, 1)
+	require.Equal(t, asyncContext.Callback, pendingContexts[0].Callback)
+
+	oldVersion := &CodeVersion{Code: wasmExportingFunctions("upgrade", "contextCallback", "groupCallback", "onSuccess", "onError")}
+	newVersion := &CodeVersion{Code: wasmExportingFunctions("upgrade", "contextCallback", "onSuccess", "onFailure")}
+	report, err := Check(oldVersion, newVersion, pendingContexts)
+	require.Nil(t, err)
+	requireFindings(t, report,
+		&Finding{Kind: OrphanedCallback, Severity: Breaking, Name: "groupCallback", Detail: "pending in the async context 01"},
+		&Finding{Kind: OrphanedCallback, Severity: Breaking, Name: "onError", Detail: "pending in the async context 01"},
+		&Finding{Kind: RemovedEndpoint, Severity: Breaking, Name: "groupCallback"},
+		&Finding{Kind: RemovedEndpoint, Severity: Breaking, Name: "onError"},
+	)
+
+	_, err = ReadPersistedAsyncContexts(map[string][]byte{"ELRONDVM@ASYNC\x03": {0xff}}, protectedKeyPrefix)
+	require.NotNil(t, err)
+}
+
+func TestParseStorageDump(t *testing.T) {
+	t.Parallel()
+
+	storage, err := ParseStorageDump([]byte(`{"0x73756d": "05", "6b6579": ""}`))
+	require.Nil(t, err)
+	require.Equal(t, map[string][]byte{"sum": {0x05}, "key": {}}, storage)
+
+	_, err = ParseStorageDump([]byte(`{"zz": "05"}`))
+	require.ErrorIs(t, err, ErrInvalidStorageEntry)
+}
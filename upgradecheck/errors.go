@@ -0,0 +1,9 @@
+package upgradecheck
+
+import "errors"
+
+// ErrNilCodeVersion signals that a code version was not provided
+var ErrNilCodeVersion = errors.New("nil code version")
+
+// ErrInvalidStorageEntry signals that a storage dump contains a key or a value which is not hex encoded
+var ErrInvalidStorageEntry = errors.New("invalid storage entry")
@@ -0,0 +1,104 @@
+package upgradecheck
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Severity tells whether a finding breaks the contract or only deserves attention
+type Severity string
+
+const (
+	// Breaking findings make the upgrade fail, or make existing calls or pending callbacks fail after it
+	Breaking Severity = "breaking"
+
+	// Warning findings change the behavior of the contract for its clients, without making calls fail
+	Warning Severity = "warning"
+)
+
+// FindingKind identifies what changed between the two code versions
+type FindingKind string
+
+const (
+	// MissingUpgradeFunction is reported when the new code does not export the upgrade function, which the VM
+	// calls on the new code, so the upgrade itself fails
+	MissingUpgradeFunction FindingKind = "missing-upgrade-function"
+
+	// RemovedEndpoint is reported when the new code no longer exports a function of the old code
+	RemovedEndpoint FindingKind = "removed-endpoint"
+
+	// RemovedCallback is reported when the new code no longer exports the callback of the old code
+	RemovedCallback FindingKind = "removed-callback"
+
+	// OrphanedCallback is reported when a persisted async context refers to a callback the new code does not export
+	OrphanedCallback FindingKind = "orphaned-callback"
+
+	// ChangedInputs is reported when the ABI inputs of an endpoint change
+	ChangedInputs FindingKind = "changed-inputs"
+
+	// ChangedOutputs is reported when the ABI outputs of an endpoint change
+	ChangedOutputs FindingKind = "changed-outputs"
+
+	// ChangedMutability is reported when a readonly endpoint of the old ABI becomes mutable
+	ChangedMutability FindingKind = "changed-mutability"
+
+	// RemovedPayableTokens is reported when an endpoint of the new ABI no longer accepts some tokens
+	RemovedPayableTokens FindingKind = "removed-payable-tokens"
+
+	// RemovedEvent is reported when an event of the old ABI is missing from the new ABI
+	RemovedEvent FindingKind = "removed-event"
+)
+
+// Finding describes a single change between the two code versions
+type Finding struct {
+	Kind     FindingKind `json:"kind"`
+	Severity Severity    `json:"severity"`
+	Name     string      `json:"name"`
+	Detail   string      `json:"detail,omitempty"`
+}
+
+// String returns a readable description of the finding
+func (finding *Finding) String() string {
+	if len(finding.Detail) == 0 {
+		return fmt.Sprintf("%s %s: %s", finding.Severity, finding.Kind, finding.Name)
+	}
+	return fmt.Sprintf("%s %s: %s (%s)", finding.Severity, finding.Kind, finding.Name, finding.Detail)
+}
+
+// Report holds the findings of an upgrade check, the breaking ones first
+type Report struct {
+	Findings []*Finding `json:"findings"`
+}
+
+// HasBreakingChanges returns true if any finding is breaking
+func (report *Report) HasBreakingChanges() bool {
+	for _, finding := range report.Findings {
+		if finding.Severity == Breaking {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (report *Report) add(kind FindingKind, severity Severity, name string, detail string) {
+	report.Findings = append(report.Findings, &Finding{
+		Kind:     kind,
+		Severity: severity,
+		Name:     name,
+		Detail:   detail,
+	})
+}
+
+func (report *Report) sort() {
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		first, second := report.Findings[i], report.Findings[j]
+		if first.Severity != second.Severity {
+			return first.Severity == Breaking
+		}
+		if first.Kind != second.Kind {
+			return first.Kind < second.Kind
+		}
+		return first.Name < second.Name
+	})
+}
@@ -2,6 +2,10 @@
 package vmhost
 
 import (
+	"math/big"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/config"
 	"github.com/multiversx/mx-chain-vm-go/executor"
@@ -39,6 +43,10 @@ const (
 	// BreakpointMemoryLimit means that Wasmer must stop immediately
 	// due to over-allocation of WASM memory
 	BreakpointMemoryLimit
+
+	// BreakpointFinish means that Wasmer must stop immediately
+	// because the contract ended its execution successfully
+	BreakpointFinish
 )
 
 const (
@@ -57,6 +65,9 @@ const (
 	// BreakpointOutOfGasString is the human-readable name of BreakpointOutOfGas
 	BreakpointOutOfGasString = "BreakpointOutOfGas"
 
+	// BreakpointFinishString is the human-readable name of BreakpointFinish
+	BreakpointFinishString = "BreakpointFinish"
+
 	// UnknownBreakpointString is the human-readable label for an unknown breakpoint value
 	UnknownBreakpointString = "unknown breakpoint"
 
@@ -107,6 +118,8 @@ func (b BreakpointValue) String() string {
 		return BreakpointSignalErrorString
 	case BreakpointOutOfGas:
 		return BreakpointOutOfGasString
+	case BreakpointFinish:
+		return BreakpointFinishString
 	default:
 		return UnknownBreakpointString
 	}
@@ -145,6 +158,9 @@ const TimeLockKeyPrefix = "TIMELOCK"
 // AsyncDataPrefix is the storage key prefix used for AsyncContext-related storage.
 const AsyncDataPrefix = "ASYNC"
 
+// ReentrancyProtectionKeyPrefix is the storage key, under the VM protected prefix, holding the marks of the contract and of the endpoints protected against reentrancy.
+const ReentrancyProtectionKeyPrefix = "REENTRANCY"
+
 // AsyncCallStatus represents the different status an async call can have
 type AsyncCallStatus uint8
 
@@ -210,6 +226,161 @@ type VMHostParameters struct {
 	Hasher                              HashComputer
 	TimeOutForSCExecutionInMilliseconds uint32
 	MapOpcodeAddressIsAllowed           map[string]map[string]struct{}
+	ForensicsWriter                     ForensicsWriter
+	ExecutionProfiler                   ExecutionProfiler
+	CallPolicy                          CallPolicy
+	NativeContracts                     map[string]NativeContract
+}
+
+// NativeAsyncCall describes an async call registered by a NativeContract
+type NativeAsyncCall struct {
+	Destination     []byte
+	Function        string
+	Arguments       [][]byte
+	Value           *big.Int
+	GasLimit        uint64
+	GasForCallback  uint64
+	SuccessCallback string
+	ErrorCallback   string
+}
+
+// CallFrame describes one of the calls currently executing, as seen by the runtime
+type CallFrame struct {
+	ContractAddress []byte
+	CodeAddress     []byte
+	Function        string
+	CallType        vm.CallType
+	GasProvided     uint64
+	ReadOnly        bool
+}
+
+// ManagedTypesSummary counts the live managed type handles, by kind
+type ManagedTypesSummary struct {
+	BigInts            int
+	BigFloats          int
+	EllipticCurves     int
+	ManagedBuffers     int
+	ManagedBufferBytes int
+	BigIntBytes        int
+	ManagedMaps        int
+	ManagedMapBytes    int
+	Decimals           int
+}
+
+// HeapBytes returns the bytes held by the managed buffers, the big ints and the managed maps
+func (summary ManagedTypesSummary) HeapBytes() int {
+	return summary.ManagedBufferBytes + summary.BigIntBytes + summary.ManagedMapBytes
+}
+
+// ForensicsBundle is the state of the VM captured when an execution panicked or failed unexpectedly
+type ForensicsBundle struct {
+	Reason       string
+	PanicValue   string
+	GoStack      string
+	CallInput    *vmcommon.ContractCallInput
+	CreateInput  *vmcommon.ContractCreateInput
+	CallStack    []CallFrame
+	GasProvided  uint64
+	GasLeft      uint64
+	ManagedTypes ManagedTypesSummary
+	Memory       []byte
+	VMHookCalls  []string
+}
+
+// CallPolicyKind identifies the operation checked against the CallPolicy
+type CallPolicyKind uint8
+
+const (
+	// PolicyDirectCall is the top-level call of a contract
+	PolicyDirectCall CallPolicyKind = iota
+
+	// PolicyExecuteOnDestContext is a synchronous call from a contract to another contract
+	PolicyExecuteOnDestContext
+
+	// PolicyExecuteOnSameContext is a synchronous call from a contract to a library contract
+	PolicyExecuteOnSameContext
+
+	// PolicyAsyncCall is the registration of an asynchronous call
+	PolicyAsyncCall
+
+	// PolicyDeploy is the deployment or the upgrade of a contract
+	PolicyDeploy
+
+	// PolicyTransfer is a transfer of EGLD or ESDT tokens made by a contract, with or without execution
+	PolicyTransfer
+)
+
+// DeployString is the human-readable label for the deployment policy kind
+const DeployString = "Deploy"
+
+// TransferString is the human-readable label for the transfer policy kind
+const TransferString = "Transfer"
+
+// String returns the human-readable name of a CallPolicyKind
+func (kind CallPolicyKind) String() string {
+	switch kind {
+	case PolicyDirectCall:
+		return DirectCallString
+	case PolicyExecuteOnDestContext:
+		return ExecuteOnDestContextString
+	case PolicyExecuteOnSameContext:
+		return ExecuteOnSameContextString
+	case PolicyAsyncCall:
+		return AsyncCallString
+	case PolicyDeploy:
+		return DeployString
+	case PolicyTransfer:
+		return TransferString
+	default:
+		return "unknown"
+	}
+}
+
+// PolicyCall describes an operation which is checked against the CallPolicy before it happens
+type PolicyCall struct {
+	Kind          CallPolicyKind
+	Caller        []byte
+	Destination   []byte
+	Function      string
+	Value         *big.Int
+	ESDTTransfers []*vmcommon.ESDTTransfer
+}
+
+// InstanceFootprint holds the code size and the memory used by a contract instance while running one of its functions
+type InstanceFootprint struct {
+	Contract           []byte
+	Function           string
+	CacheLevel         string
+	InstantiationTime  time.Duration
+	CodeSize           uint64
+	CompiledCodeSize   uint64
+	DataSegmentsSize   uint64
+	InitialMemoryPages uint32
+	PeakMemoryPages    uint32
+	ManagedTypes       ManagedTypesSummary
+}
+
+// InstancePoolMetrics holds the counters of the pool of reset instances, which are reused by the nested calls of the
+// contracts already running
+type InstancePoolMetrics struct {
+	Hits          uint64
+	Misses        uint64
+	Released      uint64
+	Discarded     uint64
+	ResetFailures uint64
+	Evicted       uint64
+	IdleInstances int
+	CodeHashes    int
+	PooledMemory  uint64
+}
+
+// ExecutionProfile holds the VM hooks called by a top-level execution, along with the footprints of the contract
+// instances it ran
+type ExecutionProfile struct {
+	CallInput   *vmcommon.ContractCallInput
+	CreateInput *vmcommon.ContractCreateInput
+	VMHookCalls map[string]uint64
+	Footprints  []*InstanceFootprint
 }
 
 // AsyncCallInfo contains the information required to handle the asynchronous call of another SmartContract
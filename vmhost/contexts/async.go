@@ -498,6 +498,11 @@ func (context *asyncContext) RegisterAsyncCall(groupID string, call *vmhost.Asyn
 	runtime := context.host.Runtime()
 	metering := context.host.Metering()
 
+	err := context.checkCallPolicy(call.Destination, call.Data, call.ValueBytes)
+	if err != nil {
+		return err
+	}
+
 	// Lock gas only if a callback is defined (either for success or for error).
 	shouldLockGas := false
 	if call.SuccessCallback != "" {
@@ -519,7 +524,7 @@ func (context *asyncContext) RegisterAsyncCall(groupID string, call *vmhost.Asyn
 		call.GasLocked = math.AddUint64(call.GasLocked, metering.ComputeExtraGasLockedForAsync())
 	}
 
-	err := metering.UseGasForAsyncStep()
+	err = metering.UseGasForAsyncStep()
 	if err != nil {
 		return err
 	}
@@ -551,6 +556,11 @@ func (context *asyncContext) RegisterLegacyAsyncCall(address []byte, data []byte
 		return vmhost.ErrOnlyOneLegacyAsyncCallAllowed
 	}
 
+	err := context.checkCallPolicy(address, data, value)
+	if err != nil {
+		return err
+	}
+
 	gasToLock, err := context.computeGasLockForLegacyAsyncCall()
 	if err != nil {
 		return err
@@ -585,6 +595,35 @@ func (context *asyncContext) RegisterLegacyAsyncCall(address []byte, data []byte
 	return nil
 }
 
+// checkCallPolicy checks an async call against the CallPolicy of the host, looking through the ESDT transfers it makes
+// for the function which is finally called.
+func (context *asyncContext) checkCallPolicy(destination []byte, data []byte, value []byte) error {
+	if !context.host.HasCallPolicy() {
+		return nil
+	}
+
+	sender := context.host.Runtime().GetContextAddress()
+	policyCall := &vmhost.PolicyCall{
+		Kind:        vmhost.PolicyAsyncCall,
+		Caller:      sender,
+		Destination: destination,
+		Value:       big.NewInt(0).SetBytes(value),
+	}
+
+	function, args, err := context.callArgsParser.ParseData(string(data))
+	if err == nil {
+		policyCall.Function = function
+		parsedTransfers, errESDT := context.esdtTransferParser.ParseESDTTransfers(sender, destination, function, args)
+		if errESDT == nil {
+			policyCall.Destination = parsedTransfers.RcvAddr
+			policyCall.Function = parsedTransfers.CallFunction
+			policyCall.ESDTTransfers = parsedTransfers.ESDTTransfers
+		}
+	}
+
+	return context.host.CheckCallPolicy(policyCall)
+}
+
 func (context *asyncContext) canRegisterLegacyAsyncCall() bool {
 	vmInput := context.host.Runtime().GetVMInput()
 	noGroups := len(context.asyncCallGroups) == 0
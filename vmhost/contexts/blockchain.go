@@ -18,6 +18,7 @@ type blockchainContext struct {
 	host           vmhost.VMHost
 	blockChainHook vmcommon.BlockchainHook
 	stateStack     []int
+	stateOverrides *vmhost.StateOverrides
 }
 
 // NewBlockchainContext creates a new blockchainContext
@@ -77,29 +78,43 @@ func (context *blockchainContext) GetBalanceBigInt(address []byte) *big.Int {
 	if !isNew {
 		isBarnardActive := context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.FixGetBalanceFlag)
 		if outputAccount.Balance == nil || isBarnardActive {
-			account, err := context.blockChainHook.GetUserAccount(address)
-			if err != nil || vmhost.IfNil(account) {
+			balance, exists := context.readBalance(address)
+			if !exists {
 				return big.NewInt(0)
 			}
 
-			outputAccount.Balance = account.GetBalance()
+			outputAccount.Balance = balance
 		}
 
 		balance := big.NewInt(0).Add(outputAccount.Balance, outputAccount.BalanceDelta)
 		return balance
 	}
 
-	account, err := context.blockChainHook.GetUserAccount(address)
-	if err != nil || vmhost.IfNil(account) {
+	balance, exists := context.readBalance(address)
+	if !exists {
 		return big.NewInt(0)
 	}
 
-	balance := account.GetBalance()
 	outputAccount.Balance = balance
 
 	return balance
 }
 
+// readBalance reads the balance of an account from the state overrides, or else from the BlockchainHook.
+func (context *blockchainContext) readBalance(address []byte) (*big.Int, bool) {
+	balance, isOverridden := context.stateOverrides.Balance(address)
+	if isOverridden {
+		return balance, true
+	}
+
+	account, err := context.blockChainHook.GetUserAccount(address)
+	if err != nil || vmhost.IfNil(account) {
+		return nil, false
+	}
+
+	return account.GetBalance(), true
+}
+
 // GetNonce retrieves the nonce of the account at the given address.
 func (context *blockchainContext) GetNonce(address []byte) (uint64, error) {
 	outputAccount, isNew := context.host.Output().GetOutputAccount(address)
@@ -129,11 +144,33 @@ func (context *blockchainContext) IncreaseNonce(address []byte) {
 
 // GetESDTToken returns the unmarshalled esdt token for the given address and nonce for NFTs
 func (context *blockchainContext) GetESDTToken(address []byte, tokenID []byte, nonce uint64) (*esdt.ESDigitalToken, error) {
-	return context.blockChainHook.GetESDTToken(address, tokenID, nonce)
+	token, err := context.blockChainHook.GetESDTToken(address, tokenID, nonce)
+
+	balance, isOverridden := context.stateOverrides.ESDTBalance(address, tokenID, nonce)
+	if !isOverridden || err != nil {
+		return token, err
+	}
+
+	overriddenToken := &esdt.ESDigitalToken{
+		Value: balance,
+	}
+	if token != nil {
+		overriddenToken.Type = token.Type
+		overriddenToken.Properties = token.Properties
+		overriddenToken.TokenMetaData = token.TokenMetaData
+		overriddenToken.Reserved = token.Reserved
+	}
+
+	return overriddenToken, nil
 }
 
 // GetCodeHash retrieves the hash of the code stored under the given address.
 func (context *blockchainContext) GetCodeHash(address []byte) []byte {
+	override := context.stateOverrides.Account(address)
+	if override != nil && override.Code != nil {
+		return override.CodeHash
+	}
+
 	account, err := context.blockChainHook.GetUserAccount(address)
 	if err != nil {
 		return nil
@@ -154,6 +191,16 @@ func (context *blockchainContext) GetCode(address []byte) ([]byte, error) {
 		return outputAccount.Code, nil
 	}
 
+	overriddenCode, isOverridden := context.stateOverrides.Code(address)
+	if isOverridden {
+		if len(overriddenCode) == 0 {
+			return nil, vmhost.ErrContractNotFound
+		}
+
+		outputAccount.Code = overriddenCode
+		return overriddenCode, nil
+	}
+
 	account, err := context.blockChainHook.GetUserAccount(address)
 	if err != nil {
 		return nil, err
@@ -174,6 +221,11 @@ func (context *blockchainContext) GetCode(address []byte) ([]byte, error) {
 
 // GetCodeSize returns the size of the code stored under the given address.
 func (context *blockchainContext) GetCodeSize(address []byte) (int32, error) {
+	overriddenCode, isOverridden := context.stateOverrides.Code(address)
+	if isOverridden {
+		return int32(len(overriddenCode)), nil
+	}
+
 	account, err := context.blockChainHook.GetUserAccount(address)
 	if err != nil || vmhost.IfNil(account) {
 		return 0, err
@@ -196,11 +248,21 @@ func (context *blockchainContext) BlockHash(number uint64) []byte {
 
 // CurrentEpoch returns the number of the current epoch.
 func (context *blockchainContext) CurrentEpoch() uint32 {
+	block := context.stateOverrides.Block()
+	if block != nil && block.Epoch != nil {
+		return *block.Epoch
+	}
+
 	return context.blockChainHook.CurrentEpoch()
 }
 
 // CurrentNonce returns the nonce of the block currently being built.
 func (context *blockchainContext) CurrentNonce() uint64 {
+	block := context.stateOverrides.Block()
+	if block != nil && block.Nonce != nil {
+		return *block.Nonce
+	}
+
 	return context.blockChainHook.CurrentNonce()
 }
 
@@ -236,16 +298,31 @@ func (context *blockchainContext) LastEpoch() uint32 {
 
 // CurrentRound returns the round of the block currently being built.
 func (context *blockchainContext) CurrentRound() uint64 {
+	block := context.stateOverrides.Block()
+	if block != nil && block.Round != nil {
+		return *block.Round
+	}
+
 	return context.blockChainHook.CurrentRound()
 }
 
 // CurrentTimeStamp returns the timestamp of the block currently being built.
 func (context *blockchainContext) CurrentTimeStamp() uint64 {
+	block := context.stateOverrides.Block()
+	if block != nil && block.TimeStamp != nil {
+		return *block.TimeStamp
+	}
+
 	return context.blockChainHook.CurrentTimeStamp()
 }
 
 // CurrentTimeStampMs returns the timestamp of the block currently being built in milliseconds
 func (context *blockchainContext) CurrentTimeStampMs() uint64 {
+	block := context.stateOverrides.Block()
+	if block != nil && block.TimeStamp != nil {
+		return *block.TimeStamp * 1000
+	}
+
 	return context.blockChainHook.CurrentTimeStampMs()
 }
 
@@ -256,6 +333,11 @@ func (context *blockchainContext) LastRandomSeed() []byte {
 
 // CurrentRandomSeed returns the random seed from header of the block being built.
 func (context *blockchainContext) CurrentRandomSeed() []byte {
+	block := context.stateOverrides.Block()
+	if block != nil && block.RandomSeed != nil {
+		return block.RandomSeed
+	}
+
 	return context.blockChainHook.CurrentRandomSeed()
 }
 
@@ -282,6 +364,11 @@ func (context *blockchainContext) EpochStartBlockRound() uint64 {
 // GetOwnerAddress returns the owner address of the contract being executed.
 func (context *blockchainContext) GetOwnerAddress() ([]byte, error) {
 	scAddress := context.host.Runtime().GetContextAddress()
+	owner, isOverridden := context.stateOverrides.OwnerAddress(scAddress)
+	if isOverridden {
+		return owner, nil
+	}
+
 	scAccount, err := context.blockChainHook.GetUserAccount(scAddress)
 	if err != nil || vmhost.IfNil(scAccount) {
 		return nil, err
@@ -398,3 +485,13 @@ func (context *blockchainContext) ClearCompiledCodes() {
 func (context *blockchainContext) ExecuteSmartContractCallOnOtherVM(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
 	return context.blockChainHook.ExecuteSmartContractCallOnOtherVM(input)
 }
+
+// SetStateOverrides sets the overrides consulted before the BlockchainHook. Nil removes them.
+func (context *blockchainContext) SetStateOverrides(overrides *vmhost.StateOverrides) {
+	context.stateOverrides = overrides
+}
+
+// GetStateOverrides returns the overrides consulted before the BlockchainHook, or nil if there are none.
+func (context *blockchainContext) GetStateOverrides() *vmhost.StateOverrides {
+	return context.stateOverrides
+}
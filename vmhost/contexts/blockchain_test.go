@@ -447,3 +447,56 @@ func TestBlockchainContext_Getters(t *testing.T) {
 	require.Equal(t, randomSeed1[:], blockchainContext.LastRandomSeed())
 	require.Equal(t, randomSeed2[:], blockchainContext.CurrentRandomSeed())
 }
+
+func TestBlockchainContext_StateOverrides(t *testing.T) {
+	t.Parallel()
+
+	mockWorld := worldmock.NewMockWorld()
+	mockWorld.AcctMap.PutAccounts(testAccounts)
+	mockWorld.CurrentBlockInfo = &worldmock.BlockInfo{BlockNonce: 98, BlockRound: 99}
+	mockOutput := &contextmock.OutputContextMock{}
+	host := &contextmock.VMHostMock{
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{},
+	}
+	host.OutputContext = mockOutput
+	blockchainContext, _ := NewBlockchainContext(host, mockWorld)
+
+	address := []byte("account_with_code")
+	overriddenCode := []byte("othercode")
+	overriddenNonce := uint64(1000)
+	overrides := &vmhost.StateOverrides{
+		Accounts: map[string]*vmhost.AccountOverride{
+			string(address): {
+				Balance: big.NewInt(7),
+				Code:    overriddenCode,
+			},
+		},
+		CurrentBlock: &vmhost.BlockOverride{
+			Nonce: &overriddenNonce,
+		},
+	}
+	overrides.ComputeCodeHashes(defaultHasher)
+	blockchainContext.SetStateOverrides(overrides)
+	require.Equal(t, overrides, blockchainContext.GetStateOverrides())
+
+	mockOutput.OutputAccountMock = &vmcommon.OutputAccount{BalanceDelta: big.NewInt(0)}
+	mockOutput.OutputAccountIsNew = true
+	require.Equal(t, big.NewInt(7), blockchainContext.GetBalanceBigInt(address))
+	require.Equal(t, big.NewInt(1000), blockchainContext.GetBalanceBigInt([]byte("account_new_with_money")))
+
+	mockOutput.OutputAccountMock = &vmcommon.OutputAccount{}
+	code, err := blockchainContext.GetCode(address)
+	require.Nil(t, err)
+	require.Equal(t, overriddenCode, code)
+	require.Equal(t, defaultHasher.Compute(string(overriddenCode)), blockchainContext.GetCodeHash(address))
+	codeSize, err := blockchainContext.GetCodeSize(address)
+	require.Nil(t, err)
+	require.Equal(t, int32(len(overriddenCode)), codeSize)
+
+	require.Equal(t, overriddenNonce, blockchainContext.CurrentNonce())
+	require.Equal(t, uint64(99), blockchainContext.CurrentRound())
+
+	blockchainContext.SetStateOverrides(nil)
+	require.Equal(t, uint64(98), blockchainContext.CurrentNonce())
+	require.Equal(t, defaultHasher.Compute("somecode"), blockchainContext.GetCodeHash(address))
+}
@@ -0,0 +1,198 @@
+package contexts
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-storage-go/lrucache"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// instancePoolSize is the number of code hashes for which idle instances are kept
+const instancePoolSize = 20
+
+// maxPooledInstancesPerCodeHash bounds the idle instances kept for a single code hash
+const maxPooledInstancesPerCodeHash = 8
+
+// maxPooledMemory bounds the bytes held by the pool, in the memories of the idle instances and in the initial memories
+// kept for their verification; the idle instances no longer count as running, so the pool accounts for them itself
+const maxPooledMemory = 64 * 1024 * 1024
+
+// pooledInstances holds the idle instances of a code hash, along with the memory of a new instance of the code, which
+// every reset instance must match before being reused
+type pooledInstances struct {
+	initialMemory []byte
+	idle          []executor.Instance
+}
+
+// instancePool keeps the instances started while their code was already running, i.e. for recursive calls, once
+// they return. Such instances cannot be the warm instance of their code, which is still running below them, so they
+// used to be cleaned and a new one instantiated for every nested call. Now they are reset and reused by the next
+// nested calls of the same code, in the same transaction or in the following ones. A new instance is created only
+// when no idle one is left, so the pool of a code hash grows up to the largest number of its instances running at
+// once, bounded by maxPooledInstancesPerCodeHash and by the memory the pool may hold, maxPooledMemory.
+type instancePool struct {
+	cache   Cacher
+	idleIDs map[string]struct{}
+	memory  uint64
+	metrics vmhost.InstancePoolMetrics
+}
+
+func newInstancePool() (*instancePool, error) {
+	pool := &instancePool{
+		idleIDs: make(map[string]struct{}),
+	}
+
+	var err error
+	pool.cache, err = lrucache.NewCacheWithEviction(instancePoolSize, pool.makeEvictionCallback())
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// saveInitialMemory keeps a copy of the memory of a new instance, before any of its functions is called; the
+// instances of the code hash are pooled only after their initial memory is known
+func (pool *instancePool) saveInitialMemory(codeHash []byte, instance executor.Instance) {
+	if len(codeHash) == 0 || check.IfNil(instance) || !instance.HasMemory() || pool.cache.Has(codeHash) {
+		return
+	}
+
+	initialMemory := append([]byte(nil), instance.MemDump()...)
+	if !pool.reserveMemory(uint64(len(initialMemory))) {
+		return
+	}
+
+	pool.cache.Put(codeHash, &pooledInstances{
+		initialMemory: initialMemory,
+		idle:          make([]executor.Instance, 0),
+	}, 1)
+}
+
+// acquire returns an idle instance of the code hash, reset and verified, if there is one
+func (pool *instancePool) acquire(codeHash []byte) (executor.Instance, bool) {
+	entry, ok := pool.get(codeHash)
+	if !ok {
+		pool.metrics.Misses++
+		return nil, false
+	}
+
+	for len(entry.idle) > 0 {
+		instance := entry.idle[len(entry.idle)-1]
+		entry.idle = entry.idle[:len(entry.idle)-1]
+		delete(pool.idleIDs, instance.ID())
+		pool.memory -= uint64(instance.MemLength())
+
+		if resetAndVerify(instance, entry.initialMemory) {
+			pool.metrics.Hits++
+			return instance, true
+		}
+
+		pool.metrics.ResetFailures++
+		logTracker.Trace("pooled instance not reset, cleaning", "id", instance.ID(), "codeHash", codeHash)
+		instance.Clean()
+	}
+
+	pool.metrics.Misses++
+	return nil, false
+}
+
+// release keeps the given instance as idle, returning false if it must be cleaned instead
+func (pool *instancePool) release(codeHash []byte, instance executor.Instance) bool {
+	if check.IfNil(instance) || instance.IsAlreadyCleaned() {
+		return false
+	}
+
+	entry, ok := pool.get(codeHash)
+	if !ok {
+		return false
+	}
+	if len(entry.idle) >= maxPooledInstancesPerCodeHash || !pool.reserveMemory(uint64(instance.MemLength())) {
+		pool.metrics.Discarded++
+		return false
+	}
+
+	entry.idle = append(entry.idle, instance)
+	pool.idleIDs[instance.ID()] = struct{}{}
+	pool.metrics.Released++
+	return true
+}
+
+// reserveMemory accounts for the given number of bytes kept by the pool, returning false if they exceed its bound
+func (pool *instancePool) reserveMemory(size uint64) bool {
+	if pool.memory+size > maxPooledMemory {
+		return false
+	}
+
+	pool.memory += size
+	return true
+}
+
+// isIdle returns true if the instance with the given ID is kept by the pool
+func (pool *instancePool) isIdle(id string) bool {
+	_, ok := pool.idleIDs[id]
+	return ok
+}
+
+// clear cleans all the idle instances and forgets the initial memories
+func (pool *instancePool) clear() {
+	pool.cache.Clear()
+}
+
+// getMetrics returns a copy of the counters of the pool
+func (pool *instancePool) getMetrics() vmhost.InstancePoolMetrics {
+	metrics := pool.metrics
+	metrics.IdleInstances = len(pool.idleIDs)
+	metrics.CodeHashes = pool.cache.Len()
+	metrics.PooledMemory = pool.memory
+	return metrics
+}
+
+func (pool *instancePool) get(codeHash []byte) (*pooledInstances, bool) {
+	cachedObject, ok := pool.cache.Get(codeHash)
+	if !ok {
+		return nil, false
+	}
+
+	entry, ok := cachedObject.(*pooledInstances)
+	return entry, ok
+}
+
+func (pool *instancePool) makeEvictionCallback() func(interface{}, interface{}) {
+	return func(_ interface{}, value interface{}) {
+		entry, ok := value.(*pooledInstances)
+		if !ok {
+			return
+		}
+
+		pool.memory -= uint64(len(entry.initialMemory))
+		for _, instance := range entry.idle {
+			delete(pool.idleIDs, instance.ID())
+			pool.memory -= uint64(instance.MemLength())
+			instance.Clean()
+			pool.metrics.Evicted++
+			logTracker.Trace("evicted pooled instance", "id", instance.ID())
+		}
+		entry.idle = nil
+	}
+}
+
+// resetAndVerify resets the instance and checks that nothing of its previous run is left: the memory must be
+// identical to the one of a new instance, while the gas used and the breakpoint, the globals which the executor
+// exposes, must be back to zero. The other globals of the contract are not exposed by the executor, which guarantees
+// instead that a successful reset restores all of them to their initial values, as it does for the warm instances.
+func resetAndVerify(instance executor.Instance, initialMemory []byte) bool {
+	if !instance.Reset() {
+		return false
+	}
+	if instance.GetPointsUsed() != 0 || vmhost.BreakpointValue(instance.GetBreakpointValue()) != vmhost.BreakpointNone {
+		return false
+	}
+	if !instance.HasMemory() || instance.MemLength() != uint32(len(initialMemory)) {
+		return false
+	}
+
+	return bytes.Equal(instance.MemDump(), initialMemory)
+}
@@ -0,0 +1,221 @@
+package contexts
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/wasmer2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstancePool_AcquireRequiresInitialMemory(t *testing.T) {
+	pool, err := newInstancePool()
+	require.Nil(t, err)
+
+	codeHash := []byte("alpha")
+	instance := mock.NewInstanceMock(codeHash)
+	require.False(t, pool.release(codeHash, instance))
+
+	pool.saveInitialMemory(codeHash, mock.NewInstanceMock(codeHash))
+	require.True(t, pool.release(codeHash, instance))
+	require.True(t, pool.isIdle(instance.ID()))
+
+	acquired, ok := pool.acquire(codeHash)
+	require.True(t, ok)
+	require.Equal(t, instance, acquired)
+	require.False(t, pool.isIdle(instance.ID()))
+
+	_, ok = pool.acquire(codeHash)
+	require.False(t, ok)
+
+	metrics := pool.getMetrics()
+	require.Equal(t, uint64(1), metrics.Hits)
+	require.Equal(t, uint64(1), metrics.Misses)
+	require.Equal(t, uint64(1), metrics.Released)
+	require.Equal(t, 0, metrics.IdleInstances)
+	require.Equal(t, 1, metrics.CodeHashes)
+}
+
+func TestInstancePool_ResetVerification(t *testing.T) {
+	pool, err := newInstancePool()
+	require.Nil(t, err)
+
+	codeHash := []byte("alpha")
+	pool.saveInitialMemory(codeHash, mock.NewInstanceMock(codeHash))
+
+	instance := mock.NewInstanceMock(codeHash)
+	instance.Points = 100
+	instance.BreakpointValue = vmhost.BreakpointSignalError
+	require.True(t, pool.release(codeHash, instance))
+
+	acquired, ok := pool.acquire(codeHash)
+	require.True(t, ok)
+	require.Equal(t, instance, acquired)
+	require.Zero(t, instance.Points)
+	require.Equal(t, vmhost.BreakpointNone, instance.BreakpointValue)
+
+	// the mocked reset does not restore the memory, so the instance must not be reused
+	err = instance.MemStore(10, []byte("leftover"))
+	require.Nil(t, err)
+	require.True(t, pool.release(codeHash, instance))
+
+	_, ok = pool.acquire(codeHash)
+	require.False(t, ok)
+	require.True(t, instance.IsAlreadyCleaned())
+
+	grownInstance := mock.NewInstanceMock(codeHash)
+	err = grownInstance.MemGrow(1)
+	require.Nil(t, err)
+	require.True(t, pool.release(codeHash, grownInstance))
+
+	_, ok = pool.acquire(codeHash)
+	require.False(t, ok)
+	require.True(t, grownInstance.IsAlreadyCleaned())
+
+	metrics := pool.getMetrics()
+	require.Equal(t, uint64(1), metrics.Hits)
+	require.Equal(t, uint64(2), metrics.ResetFailures)
+	require.Equal(t, uint64(2), metrics.Misses)
+}
+
+func TestInstancePool_BoundedPerCodeHash(t *testing.T) {
+	pool, err := newInstancePool()
+	require.Nil(t, err)
+
+	codeHash := []byte("alpha")
+	pool.saveInitialMemory(codeHash, mock.NewInstanceMock(codeHash))
+
+	for i := 0; i < maxPooledInstancesPerCodeHash; i++ {
+		require.True(t, pool.release(codeHash, mock.NewInstanceMock(codeHash)))
+	}
+	require.False(t, pool.release(codeHash, mock.NewInstanceMock(codeHash)))
+
+	cleanedInstance := mock.NewInstanceMock(codeHash)
+	cleanedInstance.Clean()
+	require.False(t, pool.release(codeHash, cleanedInstance))
+
+	metrics := pool.getMetrics()
+	require.Equal(t, uint64(maxPooledInstancesPerCodeHash), metrics.Released)
+	require.Equal(t, uint64(1), metrics.Discarded)
+	require.Equal(t, maxPooledInstancesPerCodeHash, metrics.IdleInstances)
+}
+
+func TestInstancePool_EvictionCleansIdleInstances(t *testing.T) {
+	pool, err := newInstancePool()
+	require.Nil(t, err)
+
+	firstCodeHash := []byte("codeHash0")
+	pool.saveInitialMemory(firstCodeHash, mock.NewInstanceMock(firstCodeHash))
+	instance := mock.NewInstanceMock(firstCodeHash)
+	require.True(t, pool.release(firstCodeHash, instance))
+
+	for i := 1; i <= instancePoolSize; i++ {
+		codeHash := []byte{byte(i)}
+		pool.saveInitialMemory(codeHash, mock.NewInstanceMock(codeHash))
+	}
+
+	require.True(t, instance.IsAlreadyCleaned())
+	require.False(t, pool.isIdle(instance.ID()))
+
+	metrics := pool.getMetrics()
+	require.Equal(t, uint64(1), metrics.Evicted)
+	require.Equal(t, instancePoolSize, metrics.CodeHashes)
+
+	idleInstance := mock.NewInstanceMock(nil)
+	require.True(t, pool.release([]byte{1}, idleInstance))
+	pool.clear()
+	require.True(t, idleInstance.IsAlreadyCleaned())
+	require.Zero(t, pool.getMetrics().IdleInstances)
+}
+
+func TestInstancePool_BoundedMemory(t *testing.T) {
+	pool, err := newInstancePool()
+	require.Nil(t, err)
+
+	codeHash := []byte("alpha")
+	initialInstance := mock.NewInstanceMock(codeHash)
+	pool.saveInitialMemory(codeHash, initialInstance)
+	initialMemorySize := uint64(initialInstance.MemLength())
+	require.Equal(t, initialMemorySize, pool.getMetrics().PooledMemory)
+
+	instance := mock.NewInstanceMock(codeHash)
+	require.True(t, pool.release(codeHash, instance))
+	require.Equal(t, initialMemorySize+uint64(instance.MemLength()), pool.getMetrics().PooledMemory)
+
+	_, ok := pool.acquire(codeHash)
+	require.True(t, ok)
+	require.Equal(t, initialMemorySize, pool.getMetrics().PooledMemory)
+
+	largeInstance := mock.NewInstanceMock(codeHash)
+	err = largeInstance.MemGrow(maxPooledMemory / vmhost.WASMPageSize)
+	require.Nil(t, err)
+	require.False(t, pool.release(codeHash, largeInstance))
+	require.Equal(t, uint64(1), pool.getMetrics().Discarded)
+
+	require.True(t, pool.release(codeHash, mock.NewInstanceMock(codeHash)))
+	pool.clear()
+	require.Zero(t, pool.getMetrics().PooledMemory)
+}
+
+// globalsTestCode is a contract with a mutable i64 global starting at 5 and an "inc" function, which increments the
+// global and stores it at the offset 0 of the memory
+var globalsTestCode = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+	// type section: func () -> ()
+	0x01, 0x04, 0x01, 0x60, 0x00, 0x00,
+	// function section
+	0x03, 0x02, 0x01, 0x00,
+	// memory section: 1 page
+	0x05, 0x03, 0x01, 0x00, 0x01,
+	// global section: (mut i64) (i64.const 5)
+	0x06, 0x06, 0x01, 0x7e, 0x01, 0x42, 0x05, 0x0b,
+	// export section: "memory" and "inc"
+	0x07, 0x10, 0x02,
+	0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00,
+	0x03, 'i', 'n', 'c', 0x00, 0x00,
+	// code section: global.get 0, i64.const 1, i64.add, global.set 0, i32.const 0, global.get 0, i64.store, end
+	0x0a, 0x12, 0x01, 0x10, 0x00,
+	0x23, 0x00, 0x42, 0x01, 0x7c, 0x24, 0x00,
+	0x41, 0x00, 0x23, 0x00, 0x37, 0x03, 0x00, 0x0b,
+}
+
+func TestInstancePool_ResetRestoresGlobals(t *testing.T) {
+	gasCostConfig, err := config.CreateGasConfig(config.MakeGasMapForTests())
+	require.Nil(t, err)
+	wasmerExecutor, err := wasmer2.CreateExecutor()
+	require.Nil(t, err)
+	wasmerExecutor.SetOpcodeCosts(gasCostConfig.WASMOpcodeCost)
+
+	instance, err := wasmerExecutor.NewInstanceWithOptions(globalsTestCode, executor.CompilationOptions{
+		GasLimit: 1_000_000,
+		Metering: true,
+	})
+	require.Nil(t, err)
+	defer instance.Clean()
+
+	pool, err := newInstancePool()
+	require.Nil(t, err)
+	codeHash := []byte("globals")
+	pool.saveInitialMemory(codeHash, instance)
+
+	callInc := func() uint64 {
+		err := instance.CallFunction("inc")
+		require.Nil(t, err)
+		value, err := instance.MemLoad(0, 8)
+		require.Nil(t, err)
+		return binary.LittleEndian.Uint64(value)
+	}
+	require.Equal(t, uint64(6), callInc())
+	require.Equal(t, uint64(7), callInc())
+
+	// the reset restores the global, which the pool cannot verify itself
+	require.True(t, pool.release(codeHash, instance))
+	acquired, ok := pool.acquire(codeHash)
+	require.True(t, ok)
+	require.Equal(t, instance, acquired)
+	require.Equal(t, uint64(6), callInc())
+}
@@ -27,8 +27,32 @@ const (
 
 	// Bytecode indicates that the instance to track is cold and has been created from raw bytecode
 	Bytecode
+
+	// Native indicates that the instance to track runs a native contract
+	Native
+
+	// Pooled indicates that the instance to track has been reset and reused from the instance pool
+	Pooled
 )
 
+// String returns the name of the cache level
+func (level instanceCacheLevel) String() string {
+	switch level {
+	case Warm:
+		return "warm"
+	case Precompiled:
+		return "precompiled"
+	case Bytecode:
+		return "bytecode"
+	case Native:
+		return "native"
+	case Pooled:
+		return "pooled"
+	default:
+		return "unknown"
+	}
+}
+
 var _ vmhost.StateStack = (*instanceTracker)(nil)
 
 var logTracker = logger.GetOrCreate("vm/tracker")
@@ -38,6 +62,7 @@ type instanceTracker struct {
 	codeSize            uint64
 	numRunningInstances int
 	warmInstanceCache   Cacher
+	pool                *instancePool
 	instance            executor.Instance
 	cacheLevel          instanceCacheLevel
 	instanceStack       []executor.Instance
@@ -64,6 +89,11 @@ func NewInstanceTracker() (*instanceTracker, error) {
 		return nil, err
 	}
 
+	tracker.pool, err = newInstancePool()
+	if err != nil {
+		return nil, err
+	}
+
 	return tracker, nil
 }
 
@@ -112,6 +142,12 @@ func (tracker *instanceTracker) PopSetActiveState() {
 
 func (tracker *instanceTracker) cleanPoppedInstance(instance executor.Instance, codeHash []byte) {
 	if !check.IfNil(instance) {
+		if tracker.releaseToPool(instance, codeHash) {
+			tracker.updateNumRunningInstances(-1)
+			logTracker.Trace("pool popped instance", "id", instance.ID(), "codeHash", codeHash)
+			return
+		}
+
 		if instance.Clean() {
 			tracker.updateNumRunningInstances(-1)
 		}
@@ -120,6 +156,22 @@ func (tracker *instanceTracker) cleanPoppedInstance(instance executor.Instance,
 	}
 }
 
+// releaseToPool gives the popped instance to the instance pool, unless it is still in use, as the warm instance of
+// its code or by a call below on the stack
+func (tracker *instanceTracker) releaseToPool(instance executor.Instance, codeHash []byte) bool {
+	warmInstance, isWarm := tracker.GetWarmInstance(codeHash)
+	if isWarm && warmInstance.ID() == instance.ID() {
+		return false
+	}
+	for _, stackedInstance := range tracker.instanceStack {
+		if !check.IfNil(stackedInstance) && stackedInstance.ID() == instance.ID() {
+			return false
+		}
+	}
+
+	return tracker.pool.release(codeHash, instance)
+}
+
 // PopDiscard does nothing for the instanceTracker
 func (tracker *instanceTracker) PopDiscard() {
 }
@@ -161,9 +213,10 @@ func (tracker *instanceTracker) CodeHash() []byte {
 	return tracker.codeHash
 }
 
-// ClearWarmInstanceCache clears the internal warm instance cache
+// ClearWarmInstanceCache clears the internal warm instance cache, along with the instance pool
 func (tracker *instanceTracker) ClearWarmInstanceCache() {
 	tracker.warmInstanceCache.Clear()
+	tracker.pool.clear()
 }
 
 // TrackedInstances returns the internal map of tracked instances
@@ -196,6 +249,29 @@ func (tracker *instanceTracker) UseWarmInstance(codeHash []byte, newCode bool) (
 	return true, err
 }
 
+// UsePooledInstance attempts to retrieve an idle instance for the given codeHash from the instance pool and to set
+// it as active; returns false if not possible
+func (tracker *instanceTracker) UsePooledInstance(codeHash []byte) (bool, error) {
+	instance, ok := tracker.pool.acquire(codeHash)
+	if !ok {
+		return false, nil
+	}
+
+	err := tracker.SetNewInstance(instance, Pooled)
+	return true, err
+}
+
+// SaveInitialMemory keeps the memory of the active instance, which has just been created, so that the instances of
+// its code may be pooled and verified once reset
+func (tracker *instanceTracker) SaveInitialMemory() {
+	tracker.pool.saveInitialMemory(tracker.codeHash, tracker.instance)
+}
+
+// InstancePoolMetrics returns the counters of the instance pool
+func (tracker *instanceTracker) InstancePoolMetrics() vmhost.InstancePoolMetrics {
+	return tracker.pool.getMetrics()
+}
+
 // ForceCleanInstance cleans the active instance and evicts it from the
 // internal warm instance cache if possible
 func (tracker *instanceTracker) ForceCleanInstance(bypassWarmAndStackChecks bool) {
@@ -370,7 +446,7 @@ func (tracker *instanceTracker) CheckInstances() error {
 	}
 
 	for id, instance := range tracker.instances {
-		if instance.IsAlreadyCleaned() {
+		if instance.IsAlreadyCleaned() || tracker.pool.isIdle(id) {
 			continue
 		}
 		_, isWarm := warmInstanceCacheByID[id]
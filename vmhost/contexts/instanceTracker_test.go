@@ -275,6 +275,62 @@ func TestInstanceTracker_PopSetActiveWarmOnlyScenario(t *testing.T) {
 	checkInstances(t, iTracker)
 }
 
+// stack: alpha<-alpha(pooled), in two consecutive transactions
+func TestInstanceTracker_PopSetActivePooledScenario(t *testing.T) {
+	iTracker, err := NewInstanceTracker()
+	require.Nil(t, err)
+
+	alpha := []byte("alpha")
+	_ = iTracker.SetNewInstance(mock.NewInstanceMock(alpha), Bytecode)
+	iTracker.codeHash = alpha
+	iTracker.SaveAsWarmInstance()
+	iTracker.PushState()
+
+	nestedInstance := mock.NewInstanceMock(alpha)
+	_ = iTracker.SetNewInstance(nestedInstance, Bytecode)
+	iTracker.codeHash = alpha
+	iTracker.SaveInitialMemory()
+
+	ok, err := iTracker.UsePooledInstance(alpha)
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	checkColdInstancesAfterEmptyingStack(t, iTracker)
+	require.False(t, nestedInstance.IsAlreadyCleaned())
+	require.Nil(t, iTracker.CheckInstances())
+	require.Equal(t, 1, iTracker.InstancePoolMetrics().IdleInstances)
+
+	iTracker.InitState()
+	ok, err = iTracker.UseWarmInstance(alpha, false)
+	require.Nil(t, err)
+	require.True(t, ok)
+	iTracker.codeHash = alpha
+	iTracker.PushState()
+
+	ok, err = iTracker.UsePooledInstance(alpha)
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, nestedInstance, iTracker.instance)
+	require.Equal(t, Pooled, iTracker.cacheLevel)
+	iTracker.codeHash = alpha
+
+	warm, cold := iTracker.NumRunningInstances()
+	require.Equal(t, 1, warm)
+	require.Equal(t, 1, cold)
+
+	checkColdInstancesAfterEmptyingStack(t, iTracker)
+	require.Nil(t, iTracker.CheckInstances())
+
+	metrics := iTracker.InstancePoolMetrics()
+	require.Equal(t, uint64(1), metrics.Hits)
+	require.Equal(t, uint64(1), metrics.Misses)
+	require.Equal(t, uint64(2), metrics.Released)
+
+	iTracker.ClearWarmInstanceCache()
+	require.True(t, nestedInstance.IsAlreadyCleaned())
+	checkInstances(t, iTracker)
+}
+
 func TestInstanceTracker_ForceCleanInstanceWithBypass(t *testing.T) {
 	iTracker, err := NewInstanceTracker()
 	require.Nil(t, err)
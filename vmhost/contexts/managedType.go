@@ -8,6 +8,7 @@ import (
 	"io"
 	basicMath "math"
 	"math/big"
+	"math/bits"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
@@ -52,12 +53,14 @@ type bigIntMap map[int32]*big.Int
 type bigFloatMap map[int32]*big.Float
 type ellipticCurveMap map[int32]*elliptic.CurveParams
 type managedMapMap map[int32]map[string][]byte
+type decimalMap map[int32]*math.Decimal
 
 type managedTypesContext struct {
-	host                vmhost.VMHost
-	managedTypesValues  managedTypesState
-	managedTypesStack   []managedTypesState
-	randomnessGenerator math.RandomnessGenerator
+	host                     vmhost.VMHost
+	managedTypesValues       managedTypesState
+	managedTypesStack        []managedTypesState
+	randomnessGenerator      math.RandomnessGenerator
+	callRandomnessGenerators map[string]math.RandomnessGenerator
 }
 
 // structure for transfers where scA call scB and scB makes transfers without execution to scA
@@ -73,6 +76,7 @@ type managedTypesState struct {
 	ecValues       ellipticCurveMap
 	mBufferValues  managedBufferMap
 	mMapValues     managedMapMap
+	decimalValues  decimalMap
 	backTransfers  backTransfers
 }
 
@@ -90,13 +94,15 @@ func NewManagedTypesContext(host vmhost.VMHost) (*managedTypesContext, error) {
 			ecValues:       make(ellipticCurveMap),
 			mBufferValues:  make(managedBufferMap),
 			mMapValues:     make(managedMapMap),
+			decimalValues:  make(decimalMap),
 			backTransfers: backTransfers{
 				ESDTTransfers: make([]*vmcommon.ESDTTransfer, 0),
 				CallValue:     big.NewInt(0),
 			},
 		},
-		managedTypesStack:   make([]managedTypesState, 0),
-		randomnessGenerator: nil,
+		managedTypesStack:        make([]managedTypesState, 0),
+		randomnessGenerator:      nil,
+		callRandomnessGenerators: make(map[string]math.RandomnessGenerator),
 	}
 
 	return context, nil
@@ -115,8 +121,84 @@ func (context *managedTypesContext) initRandomizer() {
 	context.randomnessGenerator = randomizer
 }
 
+// getCallRandomizer returns the generator dedicated to the current call, creating it if needed.
+// The stream is domain-separated by tx hash, contract address, call depth and call type,
+// so that nested and async calls draw from independent deterministic streams.
+func (context *managedTypesContext) getCallRandomizer() math.RandomnessGenerator {
+	blockchainContext := context.host.Blockchain()
+	runtime := context.host.Runtime()
+
+	depth := make([]byte, 8)
+	binary.BigEndian.PutUint64(depth, runtime.GetInstanceStackSize())
+	callType := make([]byte, 4)
+	vmInput := runtime.GetVMInput()
+	if vmInput != nil {
+		binary.BigEndian.PutUint32(callType, uint32(vmInput.CallType))
+	}
+
+	domain := [][]byte{
+		runtime.GetCurrentTxHash(),
+		runtime.GetContextAddress(),
+		depth,
+		callType,
+	}
+
+	key := randomizerKey(domain)
+	randomizer, ok := context.callRandomnessGenerators[key]
+	if ok {
+		return randomizer
+	}
+
+	seed := make([]byte, 0)
+	seed = append(seed, blockchainContext.LastRandomSeed()...)
+	seed = append(seed, blockchainContext.CurrentRandomSeed()...)
+	randomizer = math.NewHMACDRBG(seed, domain...)
+	context.callRandomnessGenerators[key] = randomizer
+
+	return randomizer
+}
+
+func randomizerKey(domain [][]byte) string {
+	key := make([]byte, 0)
+	for _, part := range domain {
+		key = binary.BigEndian.AppendUint32(key, uint32(len(part)))
+		key = append(key, part...)
+	}
+	return string(key)
+}
+
+// GetSummary counts the managed type handles of the current state
+func (context *managedTypesContext) GetSummary() vmhost.ManagedTypesSummary {
+	values := context.managedTypesValues
+	summary := vmhost.ManagedTypesSummary{
+		BigInts:        len(values.bigIntValues),
+		BigFloats:      len(values.bigFloatValues),
+		EllipticCurves: len(values.ecValues),
+		ManagedBuffers: len(values.mBufferValues),
+		ManagedMaps:    len(values.mMapValues),
+		Decimals:       len(values.decimalValues),
+	}
+	for _, buffer := range values.mBufferValues {
+		summary.ManagedBufferBytes += len(buffer)
+	}
+	for _, value := range values.bigIntValues {
+		summary.BigIntBytes += len(value.Bits()) * bits.UintSize / 8
+	}
+	for _, mMap := range values.mMapValues {
+		for key, value := range mMap {
+			summary.ManagedMapBytes += len(key) + len(value)
+		}
+	}
+
+	return summary
+}
+
 // GetRandReader returns pseudo-randomness generator that implements io.Reader interface
 func (context *managedTypesContext) GetRandReader() io.Reader {
+	if context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.PerCallRandomnessFlag) {
+		return context.getCallRandomizer()
+	}
+
 	if check.IfNil(context.randomnessGenerator) {
 		context.initRandomizer()
 	}
@@ -131,6 +213,7 @@ func (context *managedTypesContext) InitState() {
 		ecValues:       make(ellipticCurveMap),
 		mBufferValues:  make(managedBufferMap),
 		mMapValues:     make(managedMapMap),
+		decimalValues:  make(decimalMap),
 		backTransfers: backTransfers{
 			ESDTTransfers: make([]*vmcommon.ESDTTransfer, 0),
 			CallValue:     big.NewInt(0),
@@ -140,7 +223,7 @@ func (context *managedTypesContext) InitState() {
 
 // PushState appends the values map to the state stack
 func (context *managedTypesContext) PushState() {
-	newBigIntState, newBigFloatState, newEcState, newmBufferState, newmMapState := context.clone()
+	newBigIntState, newBigFloatState, newEcState, newmBufferState, newmMapState, newDecimalState := context.clone()
 	newTransfers := cloneBackTransfers(context.managedTypesValues.backTransfers)
 	context.managedTypesStack = append(context.managedTypesStack, managedTypesState{
 		bigIntValues:   newBigIntState,
@@ -148,6 +231,7 @@ func (context *managedTypesContext) PushState() {
 		ecValues:       newEcState,
 		mBufferValues:  newmBufferState,
 		mMapValues:     newmMapState,
+		decimalValues:  newDecimalState,
 		backTransfers:  newTransfers,
 	})
 }
@@ -180,6 +264,7 @@ func (context *managedTypesContext) PopSetActiveState() {
 	prevEcValues := prevState.ecValues
 	prevmBufferValues := prevState.mBufferValues
 	prevmMapValues := prevState.mMapValues
+	prevDecimalValues := prevState.decimalValues
 	prevBackTransfers := prevState.backTransfers
 
 	context.managedTypesValues.bigIntValues = prevBigIntValues
@@ -187,6 +272,7 @@ func (context *managedTypesContext) PopSetActiveState() {
 	context.managedTypesValues.ecValues = prevEcValues
 	context.managedTypesValues.mBufferValues = prevmBufferValues
 	context.managedTypesValues.mMapValues = prevmMapValues
+	context.managedTypesValues.decimalValues = prevDecimalValues
 	context.managedTypesValues.backTransfers = prevBackTransfers
 
 	context.managedTypesStack = context.managedTypesStack[:managedTypesStackLen-1]
@@ -205,14 +291,16 @@ func (context *managedTypesContext) PopDiscard() {
 func (context *managedTypesContext) ClearStateStack() {
 	context.managedTypesStack = make([]managedTypesState, 0)
 	context.randomnessGenerator = nil
+	context.callRandomnessGenerators = make(map[string]math.RandomnessGenerator)
 }
 
-func (context *managedTypesContext) clone() (bigIntMap, bigFloatMap, ellipticCurveMap, managedBufferMap, managedMapMap) {
+func (context *managedTypesContext) clone() (bigIntMap, bigFloatMap, ellipticCurveMap, managedBufferMap, managedMapMap, decimalMap) {
 	newBigIntState := make(bigIntMap, len(context.managedTypesValues.bigIntValues))
 	newBigFloatState := make(bigFloatMap, len(context.managedTypesValues.bigFloatValues))
 	newEcState := make(ellipticCurveMap, len(context.managedTypesValues.ecValues))
 	newmBufferState := make(managedBufferMap, len(context.managedTypesValues.mBufferValues))
 	newmMapState := make(managedMapMap, len(context.managedTypesValues.mMapValues))
+	newDecimalState := make(decimalMap, len(context.managedTypesValues.decimalValues))
 	for bigIntHandle, bigInt := range context.managedTypesValues.bigIntValues {
 		newBigIntState[bigIntHandle] = big.NewInt(0).Set(bigInt)
 	}
@@ -228,7 +316,10 @@ func (context *managedTypesContext) clone() (bigIntMap, bigFloatMap, ellipticCur
 	for mMapHandle, mMap := range context.managedTypesValues.mMapValues {
 		newmMapState[mMapHandle] = mMap
 	}
-	return newBigIntState, newBigFloatState, newEcState, newmBufferState, newmMapState
+	for decimalHandle, decimal := range context.managedTypesValues.decimalValues {
+		newDecimalState[decimalHandle] = decimal.Clone()
+	}
+	return newBigIntState, newBigFloatState, newEcState, newmBufferState, newmMapState, newDecimalState
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -468,6 +559,57 @@ func (context *managedTypesContext) NewBigIntFromInt64(int64Value int64) int32 {
 	return context.newBigIntNoCopy(big.NewInt(int64Value))
 }
 
+// DECIMALS
+
+// GetDecimal returns the decimal at the given handle. If there is no value under that handle, it will return error
+func (context *managedTypesContext) GetDecimal(handle int32) (*math.Decimal, error) {
+	value, ok := context.managedTypesValues.decimalValues[handle]
+	if !ok {
+		logMTypes.Trace("missing decimal", "handle", handle)
+		return nil, vmhost.ErrNoDecimalUnderThisHandle
+	}
+	return value, nil
+}
+
+// GetTwoDecimals returns the decimals at the two given handles. If there is at least one missing value, it will return error
+func (context *managedTypesContext) GetTwoDecimals(handle1 int32, handle2 int32) (*math.Decimal, *math.Decimal, error) {
+	value1, err := context.GetDecimal(handle1)
+	if err != nil {
+		return nil, nil, err
+	}
+	value2, err := context.GetDecimal(handle2)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value1, value2, nil
+}
+
+// SetDecimal stores a copy of the given decimal under the given handle, creating it if needed
+func (context *managedTypesContext) SetDecimal(handle int32, value *math.Decimal) error {
+	if value.Scale > math.MaxDecimalScale {
+		return math.ErrDecimalScaleTooLarge
+	}
+	context.managedTypesValues.decimalValues[handle] = value.Clone()
+	return nil
+}
+
+// PutDecimal adds a copy of the given decimal to the current values map and returns the handle
+func (context *managedTypesContext) PutDecimal(value *math.Decimal) (int32, error) {
+	newHandle := int32(len(context.managedTypesValues.decimalValues))
+	for {
+		if _, ok := context.managedTypesValues.decimalValues[newHandle]; !ok {
+			break
+		}
+		newHandle++
+	}
+
+	err := context.SetDecimal(newHandle, value)
+	if err != nil {
+		return 0, err
+	}
+	return newHandle, nil
+}
+
 // ELLIPTIC CURVES
 
 // GetEllipticCurve returns the elliptic curve under the given handle. If there is no value under that handle, it will return error
@@ -817,6 +959,16 @@ func (context *managedTypesContext) ManagedMapContains(mMapHandle int32, keyHand
 	return foundValue && len(value) > 0, nil
 }
 
+// GetManagedMapLength returns the number of entries of the managed map
+func (context *managedTypesContext) GetManagedMapLength(mMapHandle int32) (int, error) {
+	mMap, ok := context.managedTypesValues.mMapValues[mMapHandle]
+	if !ok {
+		return 0, vmhost.ErrNoManagedMapUnderThisHandle
+	}
+
+	return len(mMap), nil
+}
+
 func (context *managedTypesContext) getKeyValueFromManagedMap(mMapHandle int32, keyHandle int32) (map[string][]byte, []byte, []byte, bool, error) {
 	mMap, ok := context.managedTypesValues.mMapValues[mMapHandle]
 	if !ok {
@@ -4,9 +4,14 @@ import (
 	"bytes"
 	"crypto/elliptic"
 	"math/big"
+	"math/bits"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/config"
+	vmMath "github.com/multiversx/mx-chain-vm-go/math"
 	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	"github.com/multiversx/mx-chain-vm-go/vmhost/mock"
@@ -43,7 +48,8 @@ func TestManagedTypesContext_Randomness(t *testing.T) {
 		CurrentTxHash: []byte{0xf, 0xf, 0xf, 0xf, 0xf, 0xf},
 	}
 	host := &contextmock.VMHostMock{
-		RuntimeContext: mockRuntime,
+		RuntimeContext:           mockRuntime,
+		EnableEpochsHandlerField: &worldmock.EnableEpochsHandlerStub{},
 	}
 	mockBlockchain := &contextmock.BlockchainHookStub{
 		CurrentRandomSeedCalled: func() []byte {
@@ -77,6 +83,63 @@ func TestManagedTypesContext_Randomness(t *testing.T) {
 	}
 }
 
+func TestManagedTypesContext_PerCallRandomness(t *testing.T) {
+	t.Parallel()
+
+	mockRuntime := &contextmock.RuntimeContextMock{
+		CurrentTxHash: []byte("txHash"),
+		SCAddress:     []byte("contractA"),
+		VMInput:       &vmcommon.ContractCallInput{},
+	}
+	host := &contextmock.VMHostMock{
+		RuntimeContext:           mockRuntime,
+		EnableEpochsHandlerField: worldmock.EnableEpochsHandlerStubAllFlags(),
+	}
+	mockBlockchain := &contextmock.BlockchainHookStub{
+		CurrentRandomSeedCalled: func() []byte {
+			return []byte("current seed")
+		},
+	}
+	blockchainCtx, _ := NewBlockchainContext(host, mockBlockchain)
+	host.BlockchainContext = blockchainCtx
+
+	readRandom := func(managedTypesCtx *managedTypesContext) []byte {
+		buffer := make([]byte, 32)
+		_, _ = managedTypesCtx.GetRandReader().Read(buffer)
+		return buffer
+	}
+
+	managedTypesCtx, _ := NewManagedTypesContext(host)
+	first := readRandom(managedTypesCtx)
+
+	// a nested call gets its own stream
+	mockRuntime.SCAddress = []byte("contractB")
+	mockRuntime.InstanceStackSize = 1
+	nested := readRandom(managedTypesCtx)
+	require.NotEqual(t, first, nested)
+
+	// returning to the caller continues its stream
+	mockRuntime.SCAddress = []byte("contractA")
+	mockRuntime.InstanceStackSize = 0
+	second := readRandom(managedTypesCtx)
+	require.NotEqual(t, first, second)
+
+	// the same contract called back at another depth gets yet another stream
+	mockRuntime.InstanceStackSize = 2
+	reentrant := readRandom(managedTypesCtx)
+	require.NotEqual(t, first, reentrant)
+	require.NotEqual(t, nested, reentrant)
+
+	// the streams are deterministic
+	mockRuntime.InstanceStackSize = 0
+	replayCtx, _ := NewManagedTypesContext(host)
+	require.Equal(t, first, readRandom(replayCtx))
+	require.Equal(t, second, readRandom(replayCtx))
+
+	managedTypesCtx.ClearStateStack()
+	require.Equal(t, first, readRandom(managedTypesCtx))
+}
+
 func TestManagedTypesContext_ClearStateStack(t *testing.T) {
 	t.Parallel()
 	host := &contextmock.VMHostStub{
@@ -86,6 +149,9 @@ func TestManagedTypesContext_ClearStateStack(t *testing.T) {
 		RuntimeCalled: func() vmhost.RuntimeContext {
 			return &contextmock.RuntimeContextMock{CurrentTxHash: bytes.Repeat([]byte{1}, 32)}
 		},
+		EnableEpochsHandlerCalled: func() vmhost.EnableEpochsHandler {
+			return &worldmock.EnableEpochsHandlerStub{}
+		},
 	}
 	intValue1, intValue2 := int64(100), int64(200)
 	floatValue1, floatValue2 := 307.72, 78.008
@@ -547,6 +613,95 @@ func TestManagedTypesContext_PutGetBigFloat(t *testing.T) {
 	require.Nil(t, nonInfFloat)
 	require.Equal(t, vmhost.ErrInfinityFloatOperation, err)
 }
+
+func TestManagedTypesContext_PutGetDecimal(t *testing.T) {
+	t.Parallel()
+	host := &contextmock.VMHostStub{}
+
+	managedTypesCtx, _ := NewManagedTypesContext(host)
+
+	decimal1, _ := vmMath.NewDecimal(big.NewInt(150), 2)
+	handle1, err := managedTypesCtx.PutDecimal(decimal1)
+	require.Nil(t, err)
+	require.Equal(t, int32(0), handle1)
+
+	decimal1.Mantissa.SetInt64(999)
+	stored, err := managedTypesCtx.GetDecimal(handle1)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(150), stored.Mantissa)
+	require.Equal(t, uint32(2), stored.Scale)
+
+	_, err = managedTypesCtx.GetDecimal(1)
+	require.Equal(t, vmhost.ErrNoDecimalUnderThisHandle, err)
+	_, _, err = managedTypesCtx.GetTwoDecimals(handle1, 1)
+	require.Equal(t, vmhost.ErrNoDecimalUnderThisHandle, err)
+
+	err = managedTypesCtx.SetDecimal(1, &vmMath.Decimal{Mantissa: big.NewInt(1), Scale: vmMath.MaxDecimalScale + 1})
+	require.Equal(t, vmMath.ErrDecimalScaleTooLarge, err)
+
+	managedTypesCtx.PushState()
+	decimal2, _ := vmMath.NewDecimal(big.NewInt(7), 0)
+	_ = managedTypesCtx.SetDecimal(handle1, decimal2)
+	managedTypesCtx.PopSetActiveState()
+
+	stored, err = managedTypesCtx.GetDecimal(handle1)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(150), stored.Mantissa)
+}
+
+func TestManagedTypesContext_GetSummary(t *testing.T) {
+	t.Parallel()
+	host := &contextmock.VMHostStub{}
+	managedTypesCtx, _ := NewManagedTypesContext(host)
+
+	managedTypesCtx.NewBigIntFromInt64(1)
+	managedTypesCtx.NewBigIntFromInt64(2)
+	managedTypesCtx.NewManagedBufferFromBytes([]byte("abc"))
+	managedTypesCtx.NewManagedBufferFromBytes([]byte("de"))
+	mMapHandle := managedTypesCtx.NewManagedMap()
+	managedTypesCtx.managedTypesValues.mMapValues[mMapHandle]["f"] = []byte("gh")
+
+	summary := managedTypesCtx.GetSummary()
+	require.Equal(t, vmhost.ManagedTypesSummary{
+		BigInts:            2,
+		ManagedBuffers:     2,
+		ManagedBufferBytes: 5,
+		BigIntBytes:        2 * bits.UintSize / 8,
+		ManagedMaps:        1,
+		ManagedMapBytes:    3,
+	}, summary)
+	require.Equal(t, 8+2*bits.UintSize/8, summary.HeapBytes())
+}
+
+func TestManagedTypesContext_GetManagedMapLength(t *testing.T) {
+	t.Parallel()
+	host := &contextmock.VMHostMock{
+		MeteringContext: &contextmock.MeteringContextMock{
+			GasCost:     &config.GasCost{},
+			GasLeftMock: 1000,
+		},
+		RuntimeContext: &contextmock.RuntimeContextMock{},
+	}
+	managedTypesCtx, _ := NewManagedTypesContext(host)
+
+	mMapHandle := managedTypesCtx.NewManagedMap()
+	length, err := managedTypesCtx.GetManagedMapLength(mMapHandle)
+	require.Nil(t, err)
+	require.Equal(t, 0, length)
+
+	keyHandle := managedTypesCtx.NewManagedBufferFromBytes([]byte("key"))
+	valueHandle := managedTypesCtx.NewManagedBufferFromBytes([]byte("value"))
+	err = managedTypesCtx.ManagedMapPut(mMapHandle, keyHandle, valueHandle)
+	require.Nil(t, err)
+
+	length, err = managedTypesCtx.GetManagedMapLength(mMapHandle)
+	require.Nil(t, err)
+	require.Equal(t, 1, length)
+
+	_, err = managedTypesCtx.GetManagedMapLength(mMapHandle + 1)
+	require.Equal(t, vmhost.ErrNoManagedMapUnderThisHandle, err)
+}
+
 func TestManagedTypesContext_NewBigIntCopied(t *testing.T) {
 	t.Parallel()
 	host := &contextmock.VMHostStub{}
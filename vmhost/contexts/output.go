@@ -363,6 +363,15 @@ func (context *outputContext) Transfer(
 	input []byte,
 	callType vm.CallType,
 ) error {
+	// the async calls were checked when they were registered, and the callbacks are never denied
+	if callType == vm.DirectCall && context.host.HasCallPolicy() {
+		function, _, _ := context.callArgsParser.ParseData(string(input))
+		err := context.checkTransferPolicy(sender, destination, function, value, nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	checkPayableIfNotCallback := gasLimit > 0 && callType != vm.AsynchronousCallBack
 	isBackTransfer := context.isBackTransferWithoutExecution(sender, destination, input)
 	checkPayable := checkPayableIfNotCallback || !isBackTransfer
@@ -418,6 +427,23 @@ func (context *outputContext) Transfer(
 	return nil
 }
 
+func (context *outputContext) checkTransferPolicy(
+	sender []byte,
+	destination []byte,
+	function string,
+	value *big.Int,
+	transfers []*vmcommon.ESDTTransfer,
+) error {
+	return context.host.CheckCallPolicy(&vmhost.PolicyCall{
+		Kind:          vmhost.PolicyTransfer,
+		Caller:        sender,
+		Destination:   destination,
+		Function:      function,
+		Value:         value,
+		ESDTTransfers: transfers,
+	})
+}
+
 func getExecutionTypeString(callType vm.CallType, isBackTransfer bool) string {
 	if isBackTransfer {
 		return vmhost.BackTransferString
@@ -455,6 +481,20 @@ func (context *outputContext) TransferESDT(
 		transfersArgs.Function = callInput.Function
 		transfersArgs.Arguments = callInput.Arguments
 	}
+
+	// the tokens returned after a failed execution and the callbacks are never denied
+	if !transfersArgs.ReturnAfterError && callType != vm.AsynchronousCallBack {
+		err := context.checkTransferPolicy(
+			transfersArgs.Sender,
+			transfersArgs.Destination,
+			transfersArgs.Function,
+			big.NewInt(0),
+			transfersArgs.Transfers,
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
 	executionType := callType
 	if callType == vm.DirectCall && (isExecution || isBackTransfer) {
 		executionType = vm.ESDTTransferAndExecute
@@ -622,6 +662,12 @@ func (context *outputContext) resolveReturnCodeFromError(err error) vmcommon.Ret
 	if errors.Is(err, vmhost.ErrSignalError) {
 		return vmcommon.UserError
 	}
+	if errors.Is(err, vmhost.ErrReentrancyNotAllowed) {
+		return vmcommon.UserError
+	}
+	if errors.Is(err, vmhost.ErrCallDeniedByPolicy) {
+		return vmcommon.UserError
+	}
 	if errors.Is(err, executor.ErrFuncNotFound) {
 		return vmcommon.FunctionNotFound
 	}
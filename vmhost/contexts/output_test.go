@@ -1,9 +1,11 @@
 package contexts
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
@@ -396,6 +398,23 @@ func TestOutputContext_VMOutputError(t *testing.T) {
 	require.Equal(t, expected, vmOutput)
 }
 
+func TestOutputContext_VMOutputError_Reentrancy(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostMock{
+		MeteringContext: &contextmock.MeteringContextMock{},
+		RuntimeContext: &contextmock.RuntimeContextMock{
+			VMInput: &vmcommon.ContractCallInput{},
+		},
+	}
+
+	outputContext, _ := NewOutputContext(host)
+
+	vmOutput := outputContext.CreateVMOutputInCaseOfError(vmhost.ErrReentrancyNotAllowed)
+	require.Equal(t, vmcommon.UserError, vmOutput.ReturnCode)
+	require.Equal(t, vmhost.ErrReentrancyNotAllowed.Error(), vmOutput.ReturnMessage)
+}
+
 func TestOutputContext_Transfer(t *testing.T) {
 	t.Parallel()
 
@@ -433,6 +452,68 @@ func TestOutputContext_Transfer(t *testing.T) {
 	require.Equal(t, []byte("txdata"), destAccount.OutputTransfers[0].Data)
 }
 
+type transferPolicyStub struct {
+	calls []*vmhost.PolicyCall
+	err   error
+}
+
+func (policy *transferPolicyStub) CheckCall(call *vmhost.PolicyCall) error {
+	policy.calls = append(policy.calls, call)
+	return policy.err
+}
+
+func (policy *transferPolicyStub) IsInterfaceNil() bool {
+	return policy == nil
+}
+
+func TestOutputContext_Transfer_CallPolicy(t *testing.T) {
+	t.Parallel()
+
+	sender := []byte("sender")
+	receiver := []byte("receiver")
+
+	createOutputContext := func(policy vmhost.CallPolicy) *outputContext {
+		host := &contextmock.VMHostMock{CallPolicy: policy}
+		host.RuntimeContext = &contextmock.RuntimeContextMock{VMInput: &vmcommon.ContractCallInput{}}
+		mockWorld := worldmock.NewMockWorld()
+		mockWorld.AcctMap.PutAccount(&worldmock.Account{
+			Address: sender,
+			Balance: big.NewInt(10000),
+		})
+		host.BlockchainContext, _ = NewBlockchainContext(host, mockWorld)
+		outputContext, _ := NewOutputContext(host)
+		host.OutputContext = outputContext
+		return outputContext
+	}
+
+	t.Run("CheckedWithTheCalledFunction", func(t *testing.T) {
+		policy := &transferPolicyStub{}
+		outputContext := createOutputContext(policy)
+
+		err := outputContext.Transfer(receiver, sender, 54, 0, big.NewInt(1000), nil, []byte("function@01"), vm.DirectCall)
+		require.Nil(t, err)
+		require.Len(t, policy.calls, 1)
+		require.Equal(t, vmhost.PolicyTransfer, policy.calls[0].Kind)
+		require.Equal(t, "function", policy.calls[0].Function)
+		require.Equal(t, receiver, policy.calls[0].Destination)
+	})
+	t.Run("Denied", func(t *testing.T) {
+		policy := &transferPolicyStub{err: errors.New("denied")}
+		outputContext := createOutputContext(policy)
+
+		err := outputContext.Transfer(receiver, sender, 54, 0, big.NewInt(1000), nil, []byte("function"), vm.DirectCall)
+		require.Equal(t, policy.err, err)
+		_, isNew := outputContext.GetOutputAccount(receiver)
+		require.True(t, isNew)
+	})
+	t.Run("NotCheckedWithoutPolicy", func(t *testing.T) {
+		outputContext := createOutputContext(nil)
+
+		err := outputContext.Transfer(receiver, sender, 54, 0, big.NewInt(1000), nil, []byte("function"), vm.DirectCall)
+		require.Nil(t, err)
+	})
+}
+
 func TestOutputContext_Transfer_Errors_And_Checks(t *testing.T) {
 	t.Parallel()
 
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	builtinMath "math"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-go/executor"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/native"
 )
 
 var logRuntime = logger.GetOrCreate("vm/runtime")
@@ -45,6 +47,38 @@ var mapBarnardOpcodes = map[string]struct{}{
 	"managedGetESDTTokenType":                      {},
 }
 
+var mapManagedDecimalOpcodes = map[string]struct{}{
+	"mDecimalFromBigInt":        {},
+	"mDecimalToBigInt":          {},
+	"mDecimalGetMantissa":       {},
+	"mDecimalGetScale":          {},
+	"mDecimalFromManagedBuffer": {},
+	"mDecimalToManagedBuffer":   {},
+	"mDecimalAdd":               {},
+	"mDecimalSub":               {},
+	"mDecimalMul":               {},
+	"mDecimalDiv":               {},
+	"mDecimalRescale":           {},
+	"mDecimalCmp":               {},
+	"mDecimalLn":                {},
+	"mDecimalExp":               {},
+}
+
+var mapPerCallRandomnessOpcodes = map[string]struct{}{
+	"bigIntSetRandomInRange":   {},
+	"smallIntGetRandomInRange": {},
+}
+
+var mapTransientStorageOpcodes = map[string]struct{}{
+	"mBufferTransientStorageStore": {},
+	"mBufferTransientStorageLoad":  {},
+}
+
+var mapReentrancyProtectionOpcodes = map[string]struct{}{
+	"protectContractAgainstReentrancy":        {},
+	"managedProtectEndpointAgainstReentrancy": {},
+}
+
 const warmCacheSize = 100
 
 type runtimeContext struct {
@@ -52,10 +86,14 @@ type runtimeContext struct {
 	vmInput              *vmcommon.ContractCallInput
 	codeAddress          []byte
 	callFunction         string
+	ethCallData          []byte
 	vmType               []byte
 	readOnly             bool
 	verifyCode           bool
 	maxInstanceStackSize uint64
+	footprintTrace       bool
+	footprints           []*vmhost.InstanceFootprint
+	instanceFootprints   map[string]*vmhost.InstanceFootprint
 
 	vmExecutor executor.Executor
 
@@ -97,12 +135,14 @@ func NewRuntimeContext(
 	enableEpochsHandler := host.EnableEpochsHandler()
 
 	context := &runtimeContext{
-		host:       host,
-		vmType:     vmType,
-		stateStack: make([]*runtimeContext, 0),
-		validator:  newWASMValidator(scAPINames, builtInFuncContainer, enableEpochsHandler),
-		hasher:     hasher,
-		errors:     nil,
+		host:               host,
+		vmType:             vmType,
+		stateStack:         make([]*runtimeContext, 0),
+		validator:          newWASMValidator(scAPINames, builtInFuncContainer, enableEpochsHandler),
+		hasher:             hasher,
+		errors:             nil,
+		footprints:         make([]*vmhost.InstanceFootprint, 0),
+		instanceFootprints: make(map[string]*vmhost.InstanceFootprint),
 	}
 
 	iTracker, err := NewInstanceTracker()
@@ -122,10 +162,13 @@ func (context *runtimeContext) InitState() {
 	context.vmInput = &vmcommon.ContractCallInput{}
 	context.codeAddress = make([]byte, 0)
 	context.callFunction = ""
+	context.ethCallData = nil
 	context.verifyCode = false
 	context.readOnly = false
 	context.iTracker.InitState()
 	context.errors = nil
+	context.footprints = make([]*vmhost.InstanceFootprint, 0)
+	context.instanceFootprints = make(map[string]*vmhost.InstanceFootprint)
 
 	logRuntime.Trace("init state")
 }
@@ -154,6 +197,20 @@ func (context *runtimeContext) GetInstanceTracker() vmhost.InstanceTracker {
 
 // StartWasmerInstance creates a new wasmer instance if the maxInstanceStackSize has not been reached.
 func (context *runtimeContext) StartWasmerInstance(contract []byte, gasLimit uint64, newCode bool) error {
+	if !context.footprintTrace {
+		return context.startWasmerInstance(contract, gasLimit, newCode)
+	}
+
+	start := time.Now()
+	err := context.startWasmerInstance(contract, gasLimit, newCode)
+	if err == nil {
+		context.traceInstanceFootprint(contract, time.Since(start))
+	}
+
+	return err
+}
+
+func (context *runtimeContext) startWasmerInstance(contract []byte, gasLimit uint64, newCode bool) error {
 	context.iTracker.UnsetInstance()
 
 	if context.GetInstanceStackSize() >= context.maxInstanceStackSize {
@@ -161,6 +218,14 @@ func (context *runtimeContext) StartWasmerInstance(contract []byte, gasLimit uin
 		return vmhost.ErrMaxInstancesReached
 	}
 
+	nativeContract, isNative := context.host.GetNativeContract(context.codeAddress)
+	if isNative {
+		if newCode {
+			return vmhost.ErrNativeContractWithoutCode
+		}
+		return context.makeNativeInstance(nativeContract, gasLimit)
+	}
+
 	var codeHash []byte
 	if newCode {
 		codeHash = context.hasher.Compute(string(contract))
@@ -196,6 +261,24 @@ func (context *runtimeContext) StartWasmerInstance(contract []byte, gasLimit uin
 	return context.makeInstanceFromContractByteCode(contract, gasLimit, newCode)
 }
 
+// makeNativeInstance creates the instance running a native contract; it is neither compiled nor kept warm, and its
+// code hash is derived from its address, to keep the native contracts apart on the instance stack
+func (context *runtimeContext) makeNativeInstance(contract vmhost.NativeContract, gasLimit uint64) error {
+	newInstance := native.NewInstance(context.host, contract)
+	newInstance.SetGasLimit(gasLimit)
+
+	err := context.iTracker.SetNewInstance(newInstance, Native)
+	if err != nil {
+		return err
+	}
+	context.iTracker.SetCodeSize(0)
+	context.iTracker.SetCodeHash(context.hasher.Compute(string(context.codeAddress)))
+	context.verifyCode = false
+
+	logRuntime.Trace("start instance", "from", "native", "id", newInstance.ID())
+	return nil
+}
+
 func (context *runtimeContext) makeInstanceFromCompiledCode(gasLimit uint64, newCode bool) (bool, error) {
 	codeHash := context.iTracker.CodeHash()
 	if newCode || len(codeHash) == 0 {
@@ -293,7 +376,7 @@ func (context *runtimeContext) useWarmInstanceIfExists(gasLimit uint64, newCode
 	}
 
 	if context.isContractOrCodeHashOnTheStack() {
-		return false, nil
+		return context.usePooledInstanceIfExists(codeHash, gasLimit)
 	}
 
 	ok, err := context.iTracker.UseWarmInstance(codeHash, newCode)
@@ -304,16 +387,41 @@ func (context *runtimeContext) useWarmInstanceIfExists(gasLimit uint64, newCode
 		return false, nil
 	}
 
+	context.prepareReusedInstance(gasLimit)
+	logRuntime.Trace("start instance", "from", "warm", "id", context.iTracker.Instance().ID())
+	return true, nil
+}
+
+// usePooledInstanceIfExists reuses an idle instance from the pool, since the warm instance of the code is running
+// below on the stack
+func (context *runtimeContext) usePooledInstanceIfExists(codeHash []byte, gasLimit uint64) (bool, error) {
+	ok, err := context.iTracker.UsePooledInstance(codeHash)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, nil
+	}
+
+	context.prepareReusedInstance(gasLimit)
+	logRuntime.Trace("start instance", "from", "pool", "id", context.iTracker.Instance().ID())
+	return true, nil
+}
+
+func (context *runtimeContext) prepareReusedInstance(gasLimit uint64) {
 	context.SetPointsUsed(0)
 	context.iTracker.Instance().SetGasLimit(gasLimit)
 	context.SetRuntimeBreakpointValue(vmhost.BreakpointNone)
 	context.verifyCode = false
-	logRuntime.Trace("start instance", "from", "warm", "id", context.iTracker.Instance().ID())
-	return true, nil
 }
 
-// GetSCCode returns the SC code of the current SC.
+// GetSCCode returns the SC code of the current SC. The native contracts have no code.
 func (context *runtimeContext) GetSCCode() ([]byte, error) {
+	_, isNative := context.host.GetNativeContract(context.codeAddress)
+	if isNative {
+		return nil, nil
+	}
+
 	blockchain := context.host.Blockchain()
 
 	code, err := blockchain.GetCode(context.codeAddress)
@@ -352,6 +460,8 @@ func (context *runtimeContext) saveCompiledCode() {
 func (context *runtimeContext) saveWarmInstance() {
 	codeHash := context.iTracker.CodeHash()
 	if context.iTracker.IsCodeHashOnTheStack(codeHash) {
+		// the warm instance of the code is running below, this one will be pooled once it returns
+		context.iTracker.SaveInitialMemory()
 		return
 	}
 
@@ -388,6 +498,7 @@ func (context *runtimeContext) InitStateFromContractCallInput(input *vmcommon.Co
 // the one specified by the current ContractCallInput.
 func (context *runtimeContext) SetCustomCallFunction(callFunction string) {
 	context.callFunction = callFunction
+	context.ethCallData = nil
 	logRuntime.Trace("set custom call function", "function", callFunction)
 }
 
@@ -400,6 +511,7 @@ func (context *runtimeContext) PushState() {
 		readOnly:     context.readOnly,
 	}
 	newState.SetVMInput(context.vmInput)
+	newState.ethCallData = context.ethCallData
 
 	context.stateStack = append(context.stateStack, newState)
 
@@ -425,6 +537,7 @@ func (context *runtimeContext) PopSetActiveState() {
 	context.SetVMInput(prevState.vmInput)
 	context.codeAddress = prevState.codeAddress
 	context.callFunction = prevState.callFunction
+	context.ethCallData = prevState.ethCallData
 	context.readOnly = prevState.readOnly
 }
 
@@ -485,6 +598,7 @@ func copyESDTTransfer(esdtTransfer *vmcommon.ESDTTransfer) *vmcommon.ESDTTransfe
 
 // SetVMInput sets the given vm input as the current context vm input.
 func (context *runtimeContext) SetVMInput(vmInput *vmcommon.ContractCallInput) {
+	context.ethCallData = nil
 	if vmInput == nil {
 		context.vmInput = vmInput
 		return
@@ -551,6 +665,16 @@ func (context *runtimeContext) SetVMInput(vmInput *vmcommon.ContractCallInput) {
 	}
 }
 
+// GetEthCallData returns the Ethereum call data built for the current call, or nil if it was not built yet.
+func (context *runtimeContext) GetEthCallData() []byte {
+	return context.ethCallData
+}
+
+// SetEthCallData keeps the Ethereum call data built for the current call, until the vm input or the function changes.
+func (context *runtimeContext) SetEthCallData(callData []byte) {
+	context.ethCallData = callData
+}
+
 // GetOriginalCallerAddress returns the original caller's address
 func (context *runtimeContext) GetOriginalCallerAddress() []byte {
 	return context.vmInput.OriginalCallerAddr
@@ -705,6 +829,38 @@ func (context *runtimeContext) VerifyContractCode() error {
 		}
 	}
 
+	if !enableEpochsHandler.IsFlagEnabled(vmhost.ManagedDecimalOpcodesFlag) {
+		err = context.checkIfContainsManagedDecimalOpcodes()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !enableEpochsHandler.IsFlagEnabled(vmhost.PerCallRandomnessFlag) {
+		err = context.checkIfContainsPerCallRandomnessOpcodes()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !enableEpochsHandler.IsFlagEnabled(vmhost.TransientStorageFlag) {
+		err = context.checkIfContainsTransientStorageOpcodes()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
+	if !enableEpochsHandler.IsFlagEnabled(vmhost.ReentrancyProtectionFlag) {
+		err = context.checkIfContainsReentrancyProtectionOpcodes()
+		if err != nil {
+			logRuntime.Trace("verify contract code", "error", err)
+			return err
+		}
+	}
+
 	logRuntime.Trace("verified contract code")
 
 	return nil
@@ -728,6 +884,42 @@ func (context *runtimeContext) checkIfContainsBarnardOpcodes() error {
 	return nil
 }
 
+func (context *runtimeContext) checkIfContainsManagedDecimalOpcodes() error {
+	for funcName := range mapManagedDecimalOpcodes {
+		if context.iTracker.Instance().IsFunctionImported(funcName) {
+			return vmhost.ErrContractInvalid
+		}
+	}
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsPerCallRandomnessOpcodes() error {
+	for funcName := range mapPerCallRandomnessOpcodes {
+		if context.iTracker.Instance().IsFunctionImported(funcName) {
+			return vmhost.ErrContractInvalid
+		}
+	}
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsTransientStorageOpcodes() error {
+	for funcName := range mapTransientStorageOpcodes {
+		if context.iTracker.Instance().IsFunctionImported(funcName) {
+			return vmhost.ErrContractInvalid
+		}
+	}
+	return nil
+}
+
+func (context *runtimeContext) checkIfContainsReentrancyProtectionOpcodes() error {
+	for funcName := range mapReentrancyProtectionOpcodes {
+		if context.iTracker.Instance().IsFunctionImported(funcName) {
+			return vmhost.ErrContractInvalid
+		}
+	}
+	return nil
+}
+
 // UseGasBoundedShouldFailExecution returns true when flag activated
 func (context *runtimeContext) UseGasBoundedShouldFailExecution() bool {
 	return context.host.EnableEpochsHandler().IsFlagEnabled(vmhost.UseGasBoundedShouldFailExecutionFlag)
@@ -800,6 +992,39 @@ func (context *runtimeContext) CountSameContractInstancesOnStack(address []byte)
 	return count
 }
 
+// IsContractOnTheStack returns true if the code of the given contract is currently
+// executing, either in the active instance or in one suspended on the state stack.
+func (context *runtimeContext) IsContractOnTheStack(address []byte) bool {
+	if bytes.Equal(address, context.codeAddress) {
+		return true
+	}
+	return context.isScAddressOnTheStack(address)
+}
+
+// GetCallStack returns the calls suspended on the state stack, starting with the outermost one,
+// followed by the active call.
+func (context *runtimeContext) GetCallStack() []vmhost.CallFrame {
+	callStack := make([]vmhost.CallFrame, 0, len(context.stateStack)+1)
+	for _, state := range context.stateStack {
+		callStack = append(callStack, state.callFrame())
+	}
+	return append(callStack, context.callFrame())
+}
+
+func (context *runtimeContext) callFrame() vmhost.CallFrame {
+	frame := vmhost.CallFrame{
+		CodeAddress: context.codeAddress,
+		Function:    context.callFunction,
+		ReadOnly:    context.readOnly,
+	}
+	if context.vmInput != nil {
+		frame.ContractAddress = context.vmInput.RecipientAddr
+		frame.CallType = context.vmInput.CallType
+		frame.GasProvided = context.vmInput.GasProvided
+	}
+	return frame
+}
+
 // FunctionNameChecked returns the function name, after checking that it exists in the contract.
 func (context *runtimeContext) FunctionNameChecked() (string, error) {
 	functionName := context.FunctionName()
@@ -824,7 +1049,68 @@ func (context *runtimeContext) FunctionNameChecked() (string, error) {
 
 // CallSCFunction will execute the function with given name from the loaded contract.
 func (context *runtimeContext) CallSCFunction(functionName string) error {
-	return context.iTracker.Instance().CallFunction(functionName)
+	instance := context.iTracker.Instance()
+	if context.footprintTrace {
+		defer context.completeInstanceFootprint(instance, functionName)
+	}
+
+	return instance.CallFunction(functionName)
+}
+
+// SetFootprintTrace enables recording the footprint of the instances started from now on
+func (context *runtimeContext) SetFootprintTrace(footprintTrace bool) {
+	context.footprintTrace = footprintTrace
+}
+
+// GetInstanceFootprints returns the footprints of the functions called since the last InitState, in the order in
+// which they returned, when the footprint trace is enabled
+func (context *runtimeContext) GetInstanceFootprints() []*vmhost.InstanceFootprint {
+	return context.footprints
+}
+
+// traceInstanceFootprint records the sizes and the memory of the instance just started, until a function is called
+func (context *runtimeContext) traceInstanceFootprint(contract []byte, instantiationTime time.Duration) {
+	instance := context.iTracker.Instance()
+	footprint := &vmhost.InstanceFootprint{
+		Contract:          append([]byte(nil), context.codeAddress...),
+		CacheLevel:        context.iTracker.cacheLevel.String(),
+		InstantiationTime: instantiationTime,
+		CodeSize:          uint64(len(contract)),
+	}
+	if context.iTracker.cacheLevel != Native {
+		footprint.DataSegmentsSize, _ = dataSegmentsSize(contract)
+		compiledCode, err := instance.Cache()
+		if err == nil {
+			footprint.CompiledCodeSize = uint64(len(compiledCode))
+		}
+	}
+	if instance.HasMemory() {
+		footprint.InitialMemoryPages = instance.MemLength() / vmhost.WASMPageSize
+	}
+
+	context.instanceFootprints[instance.ID()] = footprint
+}
+
+// completeInstanceFootprint records the footprint of the instance after running the function; the WASM memory never
+// shrinks, so its size after the call is its peak
+func (context *runtimeContext) completeInstanceFootprint(instance executor.Instance, functionName string) {
+	if check.IfNil(instance) {
+		return
+	}
+	instanceFootprint, ok := context.instanceFootprints[instance.ID()]
+	if !ok {
+		return
+	}
+
+	footprint := *instanceFootprint
+	footprint.Function = functionName
+	footprint.PeakMemoryPages = footprint.InitialMemoryPages
+	if !instance.IsAlreadyCleaned() && instance.HasMemory() {
+		footprint.PeakMemoryPages = instance.MemLength() / vmhost.WASMPageSize
+	}
+	footprint.ManagedTypes = context.host.ManagedTypes().GetSummary()
+
+	context.footprints = append(context.footprints, &footprint)
 }
 
 // IsFunctionImported returns true if the WASM module imports the specified function.
@@ -433,6 +433,58 @@ func TestRuntimeContext_CountContractInstancesOnStack(t *testing.T) {
 	require.Equal(t, uint64(0), runtime.CountSameContractInstancesOnStack(gamma))
 }
 
+func TestRuntimeContext_IsContractOnTheStack(t *testing.T) {
+	alpha := []byte("alpha")
+	beta := []byte("beta")
+	gamma := []byte("gamma")
+
+	host := &contextmock.VMHostMock{}
+
+	testVMType := []byte("type")
+	execFactory := testexecutor.NewDefaultTestExecutorFactory(t)
+	exec, err := execFactory.CreateExecutor(executor.ExecutorFactoryArgs{
+		VMHooks: vmhooks.NewVMHooksImpl(host),
+	})
+	require.Nil(t, err)
+	runtime, _ := NewRuntimeContext(
+		host,
+		testVMType,
+		builtInFunctions.NewBuiltInFunctionContainer(),
+		exec,
+		defaultHasher,
+	)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("caller"),
+			GasProvided: 1000,
+			CallValue:   big.NewInt(0),
+		},
+		Function: "function",
+	}
+
+	input.RecipientAddr = alpha
+	runtime.InitStateFromContractCallInput(input)
+	require.True(t, runtime.IsContractOnTheStack(alpha))
+	require.False(t, runtime.IsContractOnTheStack(beta))
+
+	runtime.iTracker.instance = &wasmer2.Wasmer2Instance{}
+	runtime.PushState()
+	input.RecipientAddr = beta
+	runtime.InitStateFromContractCallInput(input)
+	require.True(t, runtime.IsContractOnTheStack(alpha))
+	require.True(t, runtime.IsContractOnTheStack(beta))
+	require.False(t, runtime.IsContractOnTheStack(gamma))
+
+	runtime.SetCodeAddress(gamma)
+	require.False(t, runtime.IsContractOnTheStack(beta))
+	require.True(t, runtime.IsContractOnTheStack(gamma))
+
+	runtime.PopSetActiveState()
+	require.True(t, runtime.IsContractOnTheStack(alpha))
+	require.False(t, runtime.IsContractOnTheStack(gamma))
+}
+
 func TestRuntimeContext_Instance(t *testing.T) {
 	host := InitializeVMAndWasmer()
 	runtimeCtx := makeDefaultRuntimeContext(t, host)
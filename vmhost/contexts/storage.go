@@ -242,6 +242,14 @@ func (context *storageContext) getStorageFromAddressUnmetered(address []byte, ke
 }
 
 func (context *storageContext) readFromBlockchain(address []byte, key []byte) ([]byte, uint32, error) {
+	blockchain := context.host.Blockchain()
+	if blockchain != nil {
+		value, isOverridden := blockchain.GetStateOverrides().Storage(address, key)
+		if isOverridden {
+			return value, 0, nil
+		}
+	}
+
 	return context.blockChainHook.GetStorageData(address, key)
 }
 
@@ -0,0 +1,148 @@
+package contexts
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var logTransientStorage = logger.GetOrCreate("vm/transientStorage")
+
+var _ vmhost.TransientStorageContext = (*transientStorageContext)(nil)
+
+// transientStorageJournalEntry records the value a key had before it was overwritten,
+// so that the write can be undone when the call that made it fails
+type transientStorageJournalEntry struct {
+	address       string
+	key           string
+	previousValue []byte
+	existed       bool
+}
+
+type transientStorageContext struct {
+	host       vmhost.VMHost
+	values     map[string]map[string][]byte
+	journal    []transientStorageJournalEntry
+	stateStack []int
+}
+
+// NewTransientStorageContext creates a new transientStorageContext
+func NewTransientStorageContext(host vmhost.VMHost) (*transientStorageContext, error) {
+	if check.IfNil(host) {
+		return nil, vmhost.ErrNilVMHost
+	}
+
+	context := &transientStorageContext{
+		host: host,
+	}
+	context.InitState()
+
+	return context, nil
+}
+
+// InitState discards all the transient values
+func (context *transientStorageContext) InitState() {
+	context.values = make(map[string]map[string][]byte)
+	context.journal = make([]transientStorageJournalEntry, 0)
+}
+
+// PushState marks the current position in the journal, so that the following writes can be reverted
+func (context *transientStorageContext) PushState() {
+	context.stateStack = append(context.stateStack, len(context.journal))
+}
+
+// PopSetActiveState reverts all the writes made since the latest PushState
+func (context *transientStorageContext) PopSetActiveState() {
+	stateStackLen := len(context.stateStack)
+	if stateStackLen == 0 {
+		return
+	}
+
+	journalMark := context.stateStack[stateStackLen-1]
+	context.stateStack = context.stateStack[:stateStackLen-1]
+
+	for i := len(context.journal) - 1; i >= journalMark; i-- {
+		entry := context.journal[i]
+		if entry.existed {
+			addressValues, ok := context.values[entry.address]
+			if !ok {
+				addressValues = make(map[string][]byte)
+				context.values[entry.address] = addressValues
+			}
+			addressValues[entry.key] = entry.previousValue
+			continue
+		}
+
+		delete(context.values[entry.address], entry.key)
+		if len(context.values[entry.address]) == 0 {
+			delete(context.values, entry.address)
+		}
+	}
+	context.journal = context.journal[:journalMark]
+}
+
+// PopDiscard removes the latest mark from the state stack, keeping the writes made since
+func (context *transientStorageContext) PopDiscard() {
+	stateStackLen := len(context.stateStack)
+	if stateStackLen == 0 {
+		return
+	}
+
+	context.stateStack = context.stateStack[:stateStackLen-1]
+	if len(context.stateStack) == 0 {
+		context.journal = make([]transientStorageJournalEntry, 0)
+	}
+}
+
+// ClearStateStack clears the state stack from the current context.
+func (context *transientStorageContext) ClearStateStack() {
+	context.stateStack = make([]int, 0)
+}
+
+// GetTransientStorage returns the transient value stored by the given address under the given key
+func (context *transientStorageContext) GetTransientStorage(address []byte, key []byte) []byte {
+	return context.values[string(address)][string(key)]
+}
+
+// SetTransientStorage stores a transient value under the given key of the given address.
+// An empty value removes the key.
+func (context *transientStorageContext) SetTransientStorage(address []byte, key []byte, value []byte) error {
+	if context.host.Runtime().ReadOnly() {
+		logTransientStorage.Trace("transient storage set", "error", "cannot set storage in readonly mode")
+		return vmhost.ErrCannotWriteOnReadOnly
+	}
+
+	addressValues, ok := context.values[string(address)]
+	if !ok {
+		addressValues = make(map[string][]byte)
+		context.values[string(address)] = addressValues
+	}
+
+	previousValue, existed := addressValues[string(key)]
+	if len(context.stateStack) > 0 {
+		context.journal = append(context.journal, transientStorageJournalEntry{
+			address:       string(address),
+			key:           string(key),
+			previousValue: previousValue,
+			existed:       existed,
+		})
+	}
+
+	if len(value) == 0 {
+		delete(addressValues, string(key))
+		if len(addressValues) == 0 {
+			delete(context.values, string(address))
+		}
+	} else {
+		addressValues[string(key)] = append([]byte{}, value...)
+	}
+
+	logTransientStorage.Trace("transient storage set", "address", address, "key", key, "value", value)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (context *transientStorageContext) IsInterfaceNil() bool {
+	return context == nil
+}
@@ -0,0 +1,110 @@
+package contexts
+
+import (
+	"testing"
+
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransientStorageContext(t *testing.T) {
+	t.Parallel()
+
+	transientStorage, err := NewTransientStorageContext(nil)
+	require.Nil(t, transientStorage)
+	require.Equal(t, vmhost.ErrNilVMHost, err)
+
+	transientStorage, err = NewTransientStorageContext(&contextmock.VMHostMock{})
+	require.Nil(t, err)
+	require.False(t, transientStorage.IsInterfaceNil())
+}
+
+func TestTransientStorageContext_SetGet(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostMock{
+		RuntimeContext: &contextmock.RuntimeContextMock{},
+	}
+	transientStorage, _ := NewTransientStorageContext(host)
+
+	addressA := []byte("addressA")
+	addressB := []byte("addressB")
+	key := []byte("key")
+
+	require.Nil(t, transientStorage.GetTransientStorage(addressA, key))
+
+	value := []byte("value")
+	err := transientStorage.SetTransientStorage(addressA, key, value)
+	require.Nil(t, err)
+	value[0] = 'X'
+	require.Equal(t, []byte("value"), transientStorage.GetTransientStorage(addressA, key))
+	require.Nil(t, transientStorage.GetTransientStorage(addressB, key))
+
+	err = transientStorage.SetTransientStorage(addressA, key, nil)
+	require.Nil(t, err)
+	require.Nil(t, transientStorage.GetTransientStorage(addressA, key))
+	require.Equal(t, 0, len(transientStorage.values))
+
+	_ = transientStorage.SetTransientStorage(addressA, key, []byte("value"))
+	transientStorage.InitState()
+	require.Nil(t, transientStorage.GetTransientStorage(addressA, key))
+}
+
+func TestTransientStorageContext_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostMock{
+		RuntimeContext: &contextmock.RuntimeContextMock{ReadOnlyFlag: true},
+	}
+	transientStorage, _ := NewTransientStorageContext(host)
+
+	err := transientStorage.SetTransientStorage([]byte("address"), []byte("key"), []byte("value"))
+	require.Equal(t, vmhost.ErrCannotWriteOnReadOnly, err)
+}
+
+func TestTransientStorageContext_PushPopState(t *testing.T) {
+	t.Parallel()
+
+	host := &contextmock.VMHostMock{
+		RuntimeContext: &contextmock.RuntimeContextMock{},
+	}
+	transientStorage, _ := NewTransientStorageContext(host)
+
+	address := []byte("address")
+	key1 := []byte("key1")
+	key2 := []byte("key2")
+
+	_ = transientStorage.SetTransientStorage(address, key1, []byte("parent"))
+
+	// failed child call: all its writes are reverted
+	transientStorage.PushState()
+	_ = transientStorage.SetTransientStorage(address, key1, []byte("child"))
+	_ = transientStorage.SetTransientStorage(address, key1, nil)
+	_ = transientStorage.SetTransientStorage(address, key2, []byte("child"))
+	transientStorage.PopSetActiveState()
+
+	require.Equal(t, []byte("parent"), transientStorage.GetTransientStorage(address, key1))
+	require.Nil(t, transientStorage.GetTransientStorage(address, key2))
+
+	// successful child with a failed grandchild
+	transientStorage.PushState()
+	_ = transientStorage.SetTransientStorage(address, key2, []byte("child"))
+	transientStorage.PushState()
+	_ = transientStorage.SetTransientStorage(address, key2, []byte("grandchild"))
+	transientStorage.PopSetActiveState()
+	require.Equal(t, []byte("child"), transientStorage.GetTransientStorage(address, key2))
+	transientStorage.PopDiscard()
+
+	require.Equal(t, []byte("child"), transientStorage.GetTransientStorage(address, key2))
+	require.Equal(t, 0, len(transientStorage.journal))
+
+	// popping an empty stack does nothing
+	transientStorage.PopSetActiveState()
+	transientStorage.PopDiscard()
+	require.Equal(t, []byte("parent"), transientStorage.GetTransientStorage(address, key1))
+
+	transientStorage.PushState()
+	transientStorage.ClearStateStack()
+	require.Equal(t, 0, len(transientStorage.stateStack))
+}
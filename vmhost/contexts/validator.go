@@ -13,19 +13,60 @@ import (
 const allowedCharsInFunctionName = "abcdefghijklmnopqrstuvwxyz0123456789_"
 
 var reservedFunctionsActivationFlag = map[string]core.EnableEpochFlag{
-	"mbufferToSmallIntUnsigned":                    vmhost.BarnardOpcodesFlag,
-	"mbufferToSmallIntSigned":                      vmhost.BarnardOpcodesFlag,
-	"mbufferFromSmallIntUnsigned":                  vmhost.BarnardOpcodesFlag,
-	"mbufferFromSmallIntSigned":                    vmhost.BarnardOpcodesFlag,
-	"getBlockRoundTimeMs":                          vmhost.BarnardOpcodesFlag,
-	"epochStartBlockTimeStamp":                     vmhost.BarnardOpcodesFlag,
-	"epochStartBlockNonce":                         vmhost.BarnardOpcodesFlag,
-	"epochStartBlockRound":                         vmhost.BarnardOpcodesFlag,
-	"managedGetAllTransfersCallValue":              vmhost.BarnardOpcodesFlag,
-	"managedExecuteOnDestContextWithErrorReturn":   vmhost.BarnardOpcodesFlag,
-	"managedMultiTransferESDTNFTExecuteWithReturn": vmhost.BarnardOpcodesFlag,
-	"managedGetCodeHash":                           vmhost.BarnardOpcodesFlag,
-	"managedGetESDTTokenType":                      vmhost.BarnardOpcodesFlag,
+	"mbufferToSmallIntUnsigned":                       vmhost.BarnardOpcodesFlag,
+	"mbufferToSmallIntSigned":                         vmhost.BarnardOpcodesFlag,
+	"mbufferFromSmallIntUnsigned":                     vmhost.BarnardOpcodesFlag,
+	"mbufferFromSmallIntSigned":                       vmhost.BarnardOpcodesFlag,
+	"getBlockRoundTimeMs":                             vmhost.BarnardOpcodesFlag,
+	"epochStartBlockTimeStamp":                        vmhost.BarnardOpcodesFlag,
+	"epochStartBlockNonce":                            vmhost.BarnardOpcodesFlag,
+	"epochStartBlockRound":                            vmhost.BarnardOpcodesFlag,
+	"managedGetAllTransfersCallValue":                 vmhost.BarnardOpcodesFlag,
+	"managedExecuteOnDestContextWithErrorReturn":      vmhost.BarnardOpcodesFlag,
+	"managedMultiTransferESDTNFTExecuteWithReturn":    vmhost.BarnardOpcodesFlag,
+	"managedGetCodeHash":                              vmhost.BarnardOpcodesFlag,
+	"managedGetESDTTokenType":                         vmhost.BarnardOpcodesFlag,
+	"mDecimalFromBigInt":                              vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalToBigInt":                                vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalGetMantissa":                             vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalGetScale":                                vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalFromManagedBuffer":                       vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalToManagedBuffer":                         vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalAdd":                                     vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalSub":                                     vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalMul":                                     vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalDiv":                                     vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalRescale":                                 vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalCmp":                                     vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalLn":                                      vmhost.ManagedDecimalOpcodesFlag,
+	"mDecimalExp":                                     vmhost.ManagedDecimalOpcodesFlag,
+	"bigIntSetRandomInRange":                          vmhost.PerCallRandomnessFlag,
+	"smallIntGetRandomInRange":                        vmhost.PerCallRandomnessFlag,
+	"mBufferTransientStorageStore":                    vmhost.TransientStorageFlag,
+	"mBufferTransientStorageLoad":                     vmhost.TransientStorageFlag,
+	"protectContractAgainstReentrancy":                vmhost.ReentrancyProtectionFlag,
+	"managedProtectEndpointAgainstReentrancy":         vmhost.ReentrancyProtectionFlag,
+	"managedCreateContractWithErrorReturn":            vmhost.ErrorReturnOpcodesFlag,
+	"managedDeployFromSourceContractWithErrorReturn":  vmhost.ErrorReturnOpcodesFlag,
+	"managedUpgradeContractWithErrorReturn":           vmhost.ErrorReturnOpcodesFlag,
+	"managedUpgradeFromSourceContractWithErrorReturn": vmhost.ErrorReturnOpcodesFlag,
+	"managedTransferValueExecuteWithErrorReturn":      vmhost.ErrorReturnOpcodesFlag,
+	"mBufferStorageLoadBatch":                         vmhost.BatchedStorageLoadFlag,
+	"mBufferStorageLoadBatchFromAddress":              vmhost.BatchedStorageLoadFlag,
+	"ethGetCallDataSize":                              vmhost.EthereumAPIFlag,
+	"ethCallDataCopy":                                 vmhost.EthereumAPIFlag,
+	"ethStorageStore":                                 vmhost.EthereumAPIFlag,
+	"ethStorageLoad":                                  vmhost.EthereumAPIFlag,
+	"ethGetCallValue":                                 vmhost.EthereumAPIFlag,
+	"ethGetCaller":                                    vmhost.EthereumAPIFlag,
+	"ethGetAddress":                                   vmhost.EthereumAPIFlag,
+	"ethGetTxOrigin":                                  vmhost.EthereumAPIFlag,
+	"ethGetGasLeft":                                   vmhost.EthereumAPIFlag,
+	"ethGetBlockNumber":                               vmhost.EthereumAPIFlag,
+	"ethGetBlockTimestamp":                            vmhost.EthereumAPIFlag,
+	"ethLog":                                          vmhost.EthereumAPIFlag,
+	"ethFinish":                                       vmhost.EthereumAPIFlag,
+	"ethRevert":                                       vmhost.EthereumAPIFlag,
 }
 
 // wasmValidator is a validator for WASM SmartContracts
@@ -0,0 +1,194 @@
+package contexts
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var wasmHeader = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+const (
+	exportSectionID = 7
+	dataSectionID   = 11
+
+	opcodeEnd       = 0x0b
+	opcodeGlobalGet = 0x23
+	opcodeI32Const  = 0x41
+
+	dataSegmentActive           = 0
+	dataSegmentPassive          = 1
+	dataSegmentActiveWithMemory = 2
+
+	exportKindFunction = 0
+)
+
+// wasmReader decodes the primitive values of the WASM binary format
+type wasmReader struct {
+	data   []byte
+	offset int
+	err    error
+}
+
+func (reader *wasmReader) done() bool {
+	return reader.err != nil || reader.offset >= len(reader.data)
+}
+
+func (reader *wasmReader) readByte() byte {
+	if reader.err != nil {
+		return 0
+	}
+	if reader.offset >= len(reader.data) {
+		reader.err = vmhost.ErrMalformedBytecode
+		return 0
+	}
+
+	value := reader.data[reader.offset]
+	reader.offset++
+	return value
+}
+
+func (reader *wasmReader) readBytes(length uint64) []byte {
+	if reader.err != nil {
+		return nil
+	}
+	if length > uint64(len(reader.data)-reader.offset) {
+		reader.err = vmhost.ErrMalformedBytecode
+		return nil
+	}
+
+	value := reader.data[reader.offset : reader.offset+int(length)]
+	reader.offset += int(length)
+	return value
+}
+
+// readLEB decodes an unsigned or signed LEB128 value of at most maxBits bits, only the unsigned value is returned
+func (reader *wasmReader) readLEB(maxBits uint) uint64 {
+	value := uint64(0)
+	for shift := uint(0); shift < maxBits; shift += 7 {
+		b := reader.readByte()
+		if reader.err != nil {
+			return 0
+		}
+		value |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return value
+		}
+	}
+
+	reader.err = vmhost.ErrMalformedBytecode
+	return 0
+}
+
+func (reader *wasmReader) readU32() uint64 {
+	return reader.readLEB(32)
+}
+
+// dataSegmentsSize returns the number of bytes held by the data segments of the contract code, which are copied
+// into the WASM memory of every new instance
+func dataSegmentsSize(code []byte) (uint64, error) {
+	if !bytes.HasPrefix(code, wasmHeader) {
+		return 0, vmhost.ErrMalformedBytecode
+	}
+
+	reader := &wasmReader{data: code, offset: len(wasmHeader)}
+	for !reader.done() {
+		sectionID := reader.readByte()
+		section := reader.readBytes(reader.readU32())
+		if reader.err != nil {
+			return 0, reader.err
+		}
+
+		if sectionID == dataSectionID {
+			return readDataSection(section)
+		}
+	}
+
+	return 0, nil
+}
+
+func readDataSection(section []byte) (uint64, error) {
+	reader := &wasmReader{data: section}
+	size := uint64(0)
+	numSegments := reader.readU32()
+	for i := uint64(0); i < numSegments && reader.err == nil; i++ {
+		switch reader.readU32() {
+		case dataSegmentActive:
+			readConstantExpression(reader)
+		case dataSegmentPassive:
+			// passive segments have no offset
+		case dataSegmentActiveWithMemory:
+			reader.readU32()
+			readConstantExpression(reader)
+		default:
+			reader.err = vmhost.ErrMalformedBytecode
+		}
+
+		size += uint64(len(reader.readBytes(reader.readU32())))
+	}
+
+	if reader.err != nil {
+		return 0, reader.err
+	}
+	return size, nil
+}
+
+// readConstantExpression skips the offset of an active data segment, an i32.const or a global.get followed by end
+func readConstantExpression(reader *wasmReader) {
+	switch reader.readByte() {
+	case opcodeI32Const:
+		reader.readLEB(35)
+	case opcodeGlobalGet:
+		reader.readU32()
+	default:
+		reader.err = vmhost.ErrMalformedBytecode
+		return
+	}
+
+	if reader.readByte() != opcodeEnd {
+		reader.err = vmhost.ErrMalformedBytecode
+	}
+}
+
+// ExportedFunctions returns the names of the functions exported by the contract code, in the order of their
+// declaration, without instantiating it.
+func ExportedFunctions(code []byte) ([]string, error) {
+	if !bytes.HasPrefix(code, wasmHeader) {
+		return nil, vmhost.ErrMalformedBytecode
+	}
+
+	reader := &wasmReader{data: code, offset: len(wasmHeader)}
+	for !reader.done() {
+		sectionID := reader.readByte()
+		section := reader.readBytes(reader.readU32())
+		if reader.err != nil {
+			return nil, reader.err
+		}
+
+		if sectionID == exportSectionID {
+			return readExportSection(section)
+		}
+	}
+
+	return make([]string, 0), nil
+}
+
+func readExportSection(section []byte) ([]string, error) {
+	reader := &wasmReader{data: section}
+	functions := make([]string, 0)
+	numExports := reader.readU32()
+	for i := uint64(0); i < numExports && reader.err == nil; i++ {
+		name := reader.readBytes(reader.readU32())
+		kind := reader.readByte()
+		reader.readU32()
+
+		if kind == exportKindFunction {
+			functions = append(functions, string(name))
+		}
+	}
+
+	if reader.err != nil {
+		return nil, reader.err
+	}
+	return functions, nil
+}
@@ -0,0 +1,53 @@
+package contexts
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSegmentsSize(t *testing.T) {
+	t.Parallel()
+
+	code := append([]byte{}, wasmHeader...)
+	code = append(code,
+		dataSectionID, 13, 2,
+		// an active segment at the offset 0, holding 3 bytes
+		dataSegmentActive, opcodeI32Const, 0, opcodeEnd, 3, 'a', 'b', 'c',
+		// a passive segment holding 2 bytes
+		dataSegmentPassive, 2, 'd', 'e',
+	)
+
+	size, err := dataSegmentsSize(code)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), size)
+
+	size, err = dataSegmentsSize(wasmHeader)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), size)
+
+	_, err = dataSegmentsSize(code[:len(code)-1])
+	require.Equal(t, vmhost.ErrMalformedBytecode, err)
+}
+
+func TestExportedFunctions(t *testing.T) {
+	t.Parallel()
+
+	code := append([]byte{}, wasmHeader...)
+	code = append(code, exportSectionID, 17, 2)
+	code = append(code, 4, 'i', 'n', 'i', 't', exportKindFunction, 0)
+	// the memory is exported as well, but is not a function
+	code = append(code, 6, 'm', 'e', 'm', 'o', 'r', 'y', 2, 0)
+
+	functions, err := ExportedFunctions(code)
+	require.Nil(t, err)
+	require.Equal(t, []string{"init"}, functions)
+
+	functions, err = ExportedFunctions(wasmHeader)
+	require.Nil(t, err)
+	require.Empty(t, functions)
+
+	_, err = ExportedFunctions(code[:len(code)-1])
+	require.Equal(t, vmhost.ErrMalformedBytecode, err)
+}
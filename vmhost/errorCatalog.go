@@ -0,0 +1,320 @@
+package vmhost
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+)
+
+// ErrorCategory groups the VM errors by the area of the VM which produced them
+type ErrorCategory string
+
+const (
+	// ErrorCategoryGeneric is the category of the errors which are not in the catalog
+	ErrorCategoryGeneric ErrorCategory = "generic"
+
+	// ErrorCategoryExecution is the category of the errors about the execution flow of a contract
+	ErrorCategoryExecution ErrorCategory = "execution"
+
+	// ErrorCategoryGas is the category of the errors about gas
+	ErrorCategoryGas ErrorCategory = "gas"
+
+	// ErrorCategoryMemory is the category of the errors about the WASM memory
+	ErrorCategoryMemory ErrorCategory = "memory"
+
+	// ErrorCategoryValidation is the category of the errors about invalid contracts, functions or accounts
+	ErrorCategoryValidation ErrorCategory = "validation"
+
+	// ErrorCategoryAsync is the category of the errors about asynchronous calls and callbacks
+	ErrorCategoryAsync ErrorCategory = "async"
+
+	// ErrorCategoryStorage is the category of the errors about the contract storage
+	ErrorCategoryStorage ErrorCategory = "storage"
+
+	// ErrorCategoryTransfer is the category of the errors about EGLD and ESDT transfers
+	ErrorCategoryTransfer ErrorCategory = "transfer"
+
+	// ErrorCategoryManagedTypes is the category of the errors about big numbers, managed buffers and other managed types
+	ErrorCategoryManagedTypes ErrorCategory = "managedTypes"
+
+	// ErrorCategoryCrypto is the category of the errors about hashing and signature verification
+	ErrorCategoryCrypto ErrorCategory = "crypto"
+
+	// ErrorCategoryConfiguration is the category of the errors about the setup of the VM
+	ErrorCategoryConfiguration ErrorCategory = "configuration"
+
+	// ErrorCategoryAccess is the category of the errors about call policies and reentrancy
+	ErrorCategoryAccess ErrorCategory = "access"
+)
+
+// ErrorCode is the stable numeric code of a VM error
+type ErrorCode uint32
+
+// UnknownErrorCode is the code of the errors which are not in the catalog
+const UnknownErrorCode ErrorCode = 0
+
+// ErrorReportVersion is the version of the ErrorReport encoding, bumped when its layout changes
+const ErrorReportVersion = 1
+
+// ErrorCatalogEntry describes a VM error known to the catalog
+type ErrorCatalogEntry struct {
+	Code     ErrorCode
+	Category ErrorCategory
+	Name     string
+	Err      error
+}
+
+// reservedErrorCodes holds the codes released for errors which were later dropped, so that no other error gets them
+var reservedErrorCodes = map[ErrorCode]string{
+	409: "OpcodeNotAllowed",
+	410: "InvalidDataSegmentIndex",
+}
+
+// errorCatalog lists every error of the vmhost and executor packages. Codes are grouped by category in ranges of
+// 100; once released, a code must never change or be reused, so new errors get the next free code of their category.
+var errorCatalog = []ErrorCatalogEntry{
+	// Execution
+	{Code: 101, Category: ErrorCategoryExecution, Name: "ReturnCodeNotOk", Err: ErrReturnCodeNotOk},
+	{Code: 102, Category: ErrorCategoryExecution, Name: "InvalidCallOnReadOnlyMode", Err: ErrInvalidCallOnReadOnlyMode},
+	{Code: 103, Category: ErrorCategoryExecution, Name: "UnhandledRuntimeBreakpoint", Err: ErrUnhandledRuntimeBreakpoint},
+	{Code: 104, Category: ErrorCategoryExecution, Name: "SignalError", Err: ErrSignalError},
+	{Code: 105, Category: ErrorCategoryExecution, Name: "ExecutionFailed", Err: ErrExecutionFailed},
+	{Code: 106, Category: ErrorCategoryExecution, Name: "ExecutionPanicked", Err: ErrExecutionPanicked},
+	{Code: 107, Category: ErrorCategoryExecution, Name: "ExecutionFailedWithTimeout", Err: ErrExecutionFailedWithTimeout},
+	{Code: 108, Category: ErrorCategoryExecution, Name: "MaxInstancesReached", Err: ErrMaxInstancesReached},
+	{Code: 109, Category: ErrorCategoryExecution, Name: "ArgIndexOutOfRange", Err: ErrArgIndexOutOfRange},
+	{Code: 110, Category: ErrorCategoryExecution, Name: "ArgOutOfRange", Err: ErrArgOutOfRange},
+	{Code: 111, Category: ErrorCategoryExecution, Name: "UnknownCallType", Err: ErrUnknownCallType},
+	{Code: 112, Category: ErrorCategoryExecution, Name: "NilContract", Err: ErrNilContract},
+	{Code: 113, Category: ErrorCategoryExecution, Name: "BuiltinCallOnSameContextDisallowed", Err: ErrBuiltinCallOnSameContextDisallowed},
+	{Code: 114, Category: ErrorCategoryExecution, Name: "SyncExecutionNotInSameShard", Err: ErrSyncExecutionNotInSameShard},
+	{Code: 115, Category: ErrorCategoryExecution, Name: "InputAndOutputGasDoesNotMatch", Err: ErrInputAndOutputGasDoesNotMatch},
+	{Code: 116, Category: ErrorCategoryExecution, Name: "VMIsClosing", Err: ErrVMIsClosing},
+	{Code: 117, Category: ErrorCategoryExecution, Name: "InvalidArgument", Err: ErrInvalidArgument},
+	{Code: 118, Category: ErrorCategoryExecution, Name: "InvalidBuiltInFunctionCall", Err: ErrInvalidBuiltInFunctionCall},
+	{Code: 119, Category: ErrorCategoryExecution, Name: "CannotWriteOnReadOnly", Err: ErrCannotWriteOnReadOnly},
+	{Code: 120, Category: ErrorCategoryExecution, Name: "NativeContractWithoutCode", Err: ErrNativeContractWithoutCode},
+	{Code: 121, Category: ErrorCategoryExecution, Name: "NativeContractWithoutMemory", Err: ErrNativeContractWithoutMemory},
+	{Code: 122, Category: ErrorCategoryExecution, Name: "TooManyLogTopics", Err: ErrTooManyLogTopics},
+
+	// Gas
+	{Code: 201, Category: ErrorCategoryGas, Name: "NotEnoughGas", Err: ErrNotEnoughGas},
+	{Code: 202, Category: ErrorCategoryGas, Name: "InvalidGasProvided", Err: ErrInvalidGasProvided},
+
+	// Memory
+	{Code: 301, Category: ErrorCategoryMemory, Name: "MemoryLimit", Err: ErrMemoryLimit},
+	{Code: 302, Category: ErrorCategoryMemory, Name: "BadBounds", Err: ErrBadBounds},
+	{Code: 303, Category: ErrorCategoryMemory, Name: "BadLowerBounds", Err: ErrBadLowerBounds},
+	{Code: 304, Category: ErrorCategoryMemory, Name: "BadUpperBounds", Err: ErrBadUpperBounds},
+	{Code: 305, Category: ErrorCategoryMemory, Name: "NegativeLength", Err: ErrNegativeLength},
+	{Code: 306, Category: ErrorCategoryMemory, Name: "MemoryDeclarationMissing", Err: ErrMemoryDeclarationMissing},
+	{Code: 307, Category: ErrorCategoryMemory, Name: "ExecutorMemoryBadBounds", Err: executor.ErrMemoryBadBounds},
+	{Code: 308, Category: ErrorCategoryMemory, Name: "ExecutorMemoryBadBoundsLower", Err: executor.ErrMemoryBadBoundsLower},
+	{Code: 309, Category: ErrorCategoryMemory, Name: "ExecutorMemoryBadBoundsUpper", Err: executor.ErrMemoryBadBoundsUpper},
+	{Code: 310, Category: ErrorCategoryMemory, Name: "ExecutorMemoryNegativeLength", Err: executor.ErrMemoryNegativeLength},
+
+	// Validation
+	{Code: 401, Category: ErrorCategoryValidation, Name: "UpgradeFailed", Err: ErrUpgradeFailed},
+	{Code: 402, Category: ErrorCategoryValidation, Name: "InvalidUpgradeArguments", Err: ErrInvalidUpgradeArguments},
+	{Code: 403, Category: ErrorCategoryValidation, Name: "InitFuncCalledInRun", Err: ErrInitFuncCalledInRun},
+	{Code: 404, Category: ErrorCategoryValidation, Name: "CallBackFuncCalledInRun", Err: ErrCallBackFuncCalledInRun},
+	{Code: 405, Category: ErrorCategoryValidation, Name: "InvalidFunctionName", Err: ErrInvalidFunctionName},
+	{Code: 406, Category: ErrorCategoryValidation, Name: "ContractInvalid", Err: ErrContractInvalid},
+	{Code: 407, Category: ErrorCategoryValidation, Name: "ContractNotFound", Err: ErrContractNotFound},
+	{Code: 408, Category: ErrorCategoryValidation, Name: "MalformedBytecode", Err: ErrMalformedBytecode},
+	{Code: 411, Category: ErrorCategoryValidation, Name: "InvalidAccount", Err: ErrInvalidAccount},
+	{Code: 412, Category: ErrorCategoryValidation, Name: "DeploymentOverExistingAccount", Err: ErrDeploymentOverExistingAccount},
+	{Code: 413, Category: ErrorCategoryValidation, Name: "InvalidPublicKeySize", Err: ErrInvalidPublicKeySize},
+	{Code: 414, Category: ErrorCategoryValidation, Name: "UpgradeNotAllowed", Err: ErrUpgradeNotAllowed},
+	{Code: 415, Category: ErrorCategoryValidation, Name: "OpcodeIsNotAllowed", Err: ErrOpcodeIsNotAllowed},
+	{Code: 416, Category: ErrorCategoryValidation, Name: "InvalidSignature", Err: ErrInvalidSignature},
+	{Code: 417, Category: ErrorCategoryValidation, Name: "ExecutorInvalidFunction", Err: executor.ErrInvalidFunction},
+	{Code: 418, Category: ErrorCategoryValidation, Name: "ExecutorFunctionNonvoidSignature", Err: executor.ErrFunctionNonvoidSignature},
+	{Code: 419, Category: ErrorCategoryValidation, Name: "ExecutorFuncNotFound", Err: executor.ErrFuncNotFound},
+
+	// Async
+	{Code: 501, Category: ErrorCategoryAsync, Name: "AsyncCallGroupExistsAlready", Err: ErrAsyncCallGroupExistsAlready},
+	{Code: 502, Category: ErrorCategoryAsync, Name: "NilDestinationCallVMOutput", Err: ErrNilDestinationCallVMOutput},
+	{Code: 503, Category: ErrorCategoryAsync, Name: "AsyncCallNotFound", Err: ErrAsyncCallNotFound},
+	{Code: 504, Category: ErrorCategoryAsync, Name: "AsyncNotAllowed", Err: ErrAsyncNotAllowed},
+	{Code: 505, Category: ErrorCategoryAsync, Name: "CannotUseBuiltinAsCallback", Err: ErrCannotUseBuiltinAsCallback},
+	{Code: 506, Category: ErrorCategoryAsync, Name: "OnlyOneLegacyAsyncCallAllowed", Err: ErrOnlyOneLegacyAsyncCallAllowed},
+	{Code: 507, Category: ErrorCategoryAsync, Name: "LegacyAsyncCallNotFound", Err: ErrLegacyAsyncCallNotFound},
+	{Code: 508, Category: ErrorCategoryAsync, Name: "LegacyAsyncCallInvalid", Err: ErrLegacyAsyncCallInvalid},
+	{Code: 509, Category: ErrorCategoryAsync, Name: "NoStoredAsyncContextFound", Err: ErrNoStoredAsyncContextFound},
+	{Code: 510, Category: ErrorCategoryAsync, Name: "CannotInterpretCallbackArgs", Err: ErrCannotInterpretCallbackArgs},
+	{Code: 511, Category: ErrorCategoryAsync, Name: "ContextCallbackDisabled", Err: ErrContextCallbackDisabled},
+	{Code: 512, Category: ErrorCategoryAsync, Name: "NilCallbackFunction", Err: ErrNilCallbackFunction},
+	{Code: 513, Category: ErrorCategoryAsync, Name: "NoAsyncParentContext", Err: ErrNoAsyncParentContext},
+	{Code: 514, Category: ErrorCategoryAsync, Name: "AsyncInit", Err: ErrAsyncInit},
+	{Code: 515, Category: ErrorCategoryAsync, Name: "AsyncNoOutputFromCallback", Err: ErrAsyncNoOutputFromCallback},
+	{Code: 516, Category: ErrorCategoryAsync, Name: "AsyncNoMultiLevel", Err: ErrAsyncNoMultiLevel},
+	{Code: 517, Category: ErrorCategoryAsync, Name: "AsyncNoCallbackForClosure", Err: ErrAsyncNoCallbackForClosure},
+
+	// Storage
+	{Code: 601, Category: ErrorCategoryStorage, Name: "StoreReservedKey", Err: ErrStoreReservedKey},
+	{Code: 602, Category: ErrorCategoryStorage, Name: "CannotWriteProtectedKey", Err: ErrCannotWriteProtectedKey},
+	{Code: 603, Category: ErrorCategoryStorage, Name: "StorageValueOutOfRange", Err: ErrStorageValueOutOfRange},
+	{Code: 604, Category: ErrorCategoryStorage, Name: "EmptyProtectedKeyPrefix", Err: ErrEmptyProtectedKeyPrefix},
+
+	// Transfer
+	{Code: 701, Category: ErrorCategoryTransfer, Name: "FailedTransfer", Err: ErrFailedTransfer},
+	{Code: 702, Category: ErrorCategoryTransfer, Name: "TransferInsufficientFunds", Err: ErrTransferInsufficientFunds},
+	{Code: 703, Category: ErrorCategoryTransfer, Name: "TransferNegativeValue", Err: ErrTransferNegativeValue},
+	{Code: 704, Category: ErrorCategoryTransfer, Name: "NonPayableFunctionEgld", Err: ErrNonPayableFunctionEgld},
+	{Code: 705, Category: ErrorCategoryTransfer, Name: "NonPayableFunctionEsdt", Err: ErrNonPayableFunctionEsdt},
+	{Code: 706, Category: ErrorCategoryTransfer, Name: "AccountNotPayable", Err: ErrAccountNotPayable},
+	{Code: 707, Category: ErrorCategoryTransfer, Name: "TransferValueOnESDTCall", Err: ErrTransferValueOnESDTCall},
+	{Code: 708, Category: ErrorCategoryTransfer, Name: "TooManyESDTTransfers", Err: ErrTooManyESDTTransfers},
+	{Code: 709, Category: ErrorCategoryTransfer, Name: "NilESDTData", Err: ErrNilESDTData},
+	{Code: 710, Category: ErrorCategoryTransfer, Name: "InvalidTokenIndex", Err: ErrInvalidTokenIndex},
+
+	// ManagedTypes
+	{Code: 801, Category: ErrorCategoryManagedTypes, Name: "DivZero", Err: ErrDivZero},
+	{Code: 802, Category: ErrorCategoryManagedTypes, Name: "BigIntCannotBeRepresentedAsInt64", Err: ErrBigIntCannotBeRepresentedAsInt64},
+	{Code: 803, Category: ErrorCategoryManagedTypes, Name: "BytesExceedInt64", Err: ErrBytesExceedInt64},
+	{Code: 804, Category: ErrorCategoryManagedTypes, Name: "BytesExceedUint64", Err: ErrBytesExceedUint64},
+	{Code: 805, Category: ErrorCategoryManagedTypes, Name: "BitwiseNegative", Err: ErrBitwiseNegative},
+	{Code: 806, Category: ErrorCategoryManagedTypes, Name: "ShiftNegative", Err: ErrShiftNegative},
+	{Code: 807, Category: ErrorCategoryManagedTypes, Name: "NoBigIntUnderThisHandle", Err: ErrNoBigIntUnderThisHandle},
+	{Code: 808, Category: ErrorCategoryManagedTypes, Name: "NoBigFloatUnderThisHandle", Err: ErrNoBigFloatUnderThisHandle},
+	{Code: 809, Category: ErrorCategoryManagedTypes, Name: "NoDecimalUnderThisHandle", Err: ErrNoDecimalUnderThisHandle},
+	{Code: 810, Category: ErrorCategoryManagedTypes, Name: "InvalidEncodedDecimal", Err: ErrInvalidEncodedDecimal},
+	{Code: 811, Category: ErrorCategoryManagedTypes, Name: "PositiveExponent", Err: ErrPositiveExponent},
+	{Code: 812, Category: ErrorCategoryManagedTypes, Name: "LengthOfBufferNotCorrect", Err: ErrLengthOfBufferNotCorrect},
+	{Code: 813, Category: ErrorCategoryManagedTypes, Name: "NoEllipticCurveUnderThisHandle", Err: ErrNoEllipticCurveUnderThisHandle},
+	{Code: 814, Category: ErrorCategoryManagedTypes, Name: "NoManagedBufferUnderThisHandle", Err: ErrNoManagedBufferUnderThisHandle},
+	{Code: 815, Category: ErrorCategoryManagedTypes, Name: "NoManagedMapUnderThisHandle", Err: ErrNoManagedMapUnderThisHandle},
+	{Code: 816, Category: ErrorCategoryManagedTypes, Name: "InfinityFloatOperation", Err: ErrInfinityFloatOperation},
+	{Code: 817, Category: ErrorCategoryManagedTypes, Name: "BigFloatWrongPrecision", Err: ErrBigFloatWrongPrecision},
+	{Code: 818, Category: ErrorCategoryManagedTypes, Name: "BigFloatDecode", Err: ErrBigFloatDecode},
+	{Code: 819, Category: ErrorCategoryManagedTypes, Name: "BigFloatEncode", Err: ErrBigFloatEncode},
+	{Code: 820, Category: ErrorCategoryManagedTypes, Name: "AllOperandsAreEqualToZero", Err: ErrAllOperandsAreEqualToZero},
+	{Code: 821, Category: ErrorCategoryManagedTypes, Name: "ExponentTooBigOrTooSmall", Err: ErrExponentTooBigOrTooSmall},
+
+	// Crypto
+	{Code: 901, Category: ErrorCategoryCrypto, Name: "PointNotOnCurve", Err: ErrPointNotOnCurve},
+	{Code: 902, Category: ErrorCategoryCrypto, Name: "Sha256Hash", Err: ErrSha256Hash},
+	{Code: 903, Category: ErrorCategoryCrypto, Name: "Keccak256Hash", Err: ErrKeccak256Hash},
+	{Code: 904, Category: ErrorCategoryCrypto, Name: "Ripemd160Hash", Err: ErrRipemd160Hash},
+	{Code: 905, Category: ErrorCategoryCrypto, Name: "BlsVerify", Err: ErrBlsVerify},
+	{Code: 906, Category: ErrorCategoryCrypto, Name: "Ed25519Verify", Err: ErrEd25519Verify},
+	{Code: 907, Category: ErrorCategoryCrypto, Name: "Secp256k1Verify", Err: ErrSecp256k1Verify},
+
+	// Configuration
+	{Code: 1001, Category: ErrorCategoryConfiguration, Name: "NilVMType", Err: ErrNilVMType},
+	{Code: 1002, Category: ErrorCategoryConfiguration, Name: "NilVMHost", Err: ErrNilVMHost},
+	{Code: 1003, Category: ErrorCategoryConfiguration, Name: "NilExecutor", Err: ErrNilExecutor},
+	{Code: 1004, Category: ErrorCategoryConfiguration, Name: "NilHasher", Err: ErrNilHasher},
+	{Code: 1005, Category: ErrorCategoryConfiguration, Name: "NilHostParameters", Err: ErrNilHostParameters},
+	{Code: 1006, Category: ErrorCategoryConfiguration, Name: "NilESDTTransferParser", Err: ErrNilESDTTransferParser},
+	{Code: 1007, Category: ErrorCategoryConfiguration, Name: "NilCallArgsParser", Err: ErrNilCallArgsParser},
+	{Code: 1008, Category: ErrorCategoryConfiguration, Name: "NilBuiltInFunctionsContainer", Err: ErrNilBuiltInFunctionsContainer},
+	{Code: 1009, Category: ErrorCategoryConfiguration, Name: "NilBlockChainHook", Err: ErrNilBlockChainHook},
+	{Code: 1010, Category: ErrorCategoryConfiguration, Name: "NilEpochNotifier", Err: ErrNilEpochNotifier},
+	{Code: 1011, Category: ErrorCategoryConfiguration, Name: "NilEnableEpochsHandler", Err: ErrNilEnableEpochsHandler},
+	{Code: 1012, Category: ErrorCategoryConfiguration, Name: "NilMapOpcodeAddress", Err: ErrNilMapOpcodeAddress},
+	{Code: 1013, Category: ErrorCategoryConfiguration, Name: "InvalidGasSchedule", Err: ErrInvalidGasSchedule},
+	{Code: 1014, Category: ErrorCategoryConfiguration, Name: "NoPreviousGasSchedule", Err: ErrNoPreviousGasSchedule},
+
+	// Access
+	{Code: 1101, Category: ErrorCategoryAccess, Name: "CallDeniedByPolicy", Err: ErrCallDeniedByPolicy},
+	{Code: 1102, Category: ErrorCategoryAccess, Name: "ReentrancyNotAllowed", Err: ErrReentrancyNotAllowed},
+	{Code: 1103, Category: ErrorCategoryAccess, Name: "ReentrancyProtectionOutsideDeploy", Err: ErrReentrancyProtectionOutsideDeploy},
+}
+
+// ErrorCatalog returns a copy of the catalog of VM errors
+func ErrorCatalog() []ErrorCatalogEntry {
+	catalog := make([]ErrorCatalogEntry, len(errorCatalog))
+	copy(catalog, errorCatalog)
+	return catalog
+}
+
+// LookupError finds the catalog entry of an error, looking through the errors it wraps. Errors missing from the
+// catalog get the UnknownErrorCode and the generic category.
+func LookupError(err error) ErrorCatalogEntry {
+	for current := err; current != nil; current = errors.Unwrap(current) {
+		for _, entry := range errorCatalog {
+			if current == entry.Err {
+				return entry
+			}
+		}
+	}
+
+	return ErrorCatalogEntry{
+		Code:     UnknownErrorCode,
+		Category: ErrorCategoryGeneric,
+		Err:      err,
+	}
+}
+
+// ErrorReportEntry is a single error of an ErrorReport
+type ErrorReportEntry struct {
+	Code     ErrorCode     `json:"code"`
+	Category ErrorCategory `json:"category"`
+	Name     string        `json:"name,omitempty"`
+	Message  string        `json:"message"`
+	Info     []string      `json:"info,omitempty"`
+}
+
+// ErrorReport is the structured description of the errors recorded during a contract call, from the first to the
+// last one, meant for indexers and wallets
+type ErrorReport struct {
+	Version  int                `json:"version"`
+	Contract []byte             `json:"contract"`
+	Function string             `json:"function"`
+	Errors   []ErrorReportEntry `json:"errors"`
+}
+
+// NewErrorReport creates the ErrorReport of the errors recorded for a call of the given contract and function
+func NewErrorReport(contract []byte, function string, err error) *ErrorReport {
+	report := &ErrorReport{
+		Version:  ErrorReportVersion,
+		Contract: contract,
+		Function: function,
+		Errors:   make([]ErrorReportEntry, 0),
+	}
+	if err == nil {
+		return report
+	}
+
+	allErrors := []error{err}
+	allOtherInfo := [][]string{nil}
+	wrappable, ok := err.(WrappableError)
+	if ok {
+		allErrors, allOtherInfo = wrappable.GetAllErrorsWithOtherInfo()
+	}
+
+	for i, currentErr := range allErrors {
+		if currentErr.Error() == "" && len(allOtherInfo[i]) == 0 {
+			// stack trace only wrapping, nothing to report
+			continue
+		}
+		entry := LookupError(currentErr)
+		report.Errors = append(report.Errors, ErrorReportEntry{
+			Code:     entry.Code,
+			Category: entry.Category,
+			Name:     entry.Name,
+			Message:  currentErr.Error(),
+			Info:     allOtherInfo[i],
+		})
+	}
+
+	return report
+}
+
+// Encode serializes the ErrorReport as JSON
+func (report *ErrorReport) Encode() ([]byte, error) {
+	return json.Marshal(report)
+}
+
+// DecodeErrorReport deserializes an ErrorReport produced by Encode
+func DecodeErrorReport(data []byte) (*ErrorReport, error) {
+	report := &ErrorReport{}
+	err := json.Unmarshal(data, report)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
@@ -0,0 +1,160 @@
+package vmhost
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/stretchr/testify/require"
+)
+
+func declaredErrors(t *testing.T, path string) []string {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	require.Nil(t, err)
+
+	names := make([]string, 0)
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			for _, name := range spec.(*ast.ValueSpec).Names {
+				names = append(names, name.Name)
+			}
+		}
+	}
+	return names
+}
+
+func TestErrorCatalog_CoversAllErrors(t *testing.T) {
+	t.Parallel()
+
+	catalogued := make(map[string]struct{})
+	for _, entry := range ErrorCatalog() {
+		catalogued[entry.Name] = struct{}{}
+	}
+
+	for _, name := range declaredErrors(t, "errors.go") {
+		_, found := catalogued[name[len("Err"):]]
+		require.True(t, found, "missing from the error catalog: vmhost.%s", name)
+	}
+	for _, name := range declaredErrors(t, "../executor/executorError.go") {
+		_, found := catalogued["Executor"+name[len("Err"):]]
+		require.True(t, found, "missing from the error catalog: executor.%s", name)
+	}
+}
+
+func TestErrorCatalog_CodesAndNamesAreUnique(t *testing.T) {
+	t.Parallel()
+
+	codes := make(map[ErrorCode]string)
+	names := make(map[string]struct{})
+	errs := make(map[error]struct{})
+	for _, entry := range ErrorCatalog() {
+		require.NotEqual(t, UnknownErrorCode, entry.Code)
+		require.NotEqual(t, ErrorCategoryGeneric, entry.Category)
+		require.NotNil(t, entry.Err)
+
+		reservedName, found := reservedErrorCodes[entry.Code]
+		require.False(t, found, "code %d of %s is reserved for %s", entry.Code, entry.Name, reservedName)
+
+		otherName, found := codes[entry.Code]
+		require.False(t, found, "code %d used by both %s and %s", entry.Code, otherName, entry.Name)
+		codes[entry.Code] = entry.Name
+
+		_, found = names[entry.Name]
+		require.False(t, found, "duplicate name %s", entry.Name)
+		names[entry.Name] = struct{}{}
+
+		_, found = errs[entry.Err]
+		require.False(t, found, "duplicate error %s", entry.Name)
+		errs[entry.Err] = struct{}{}
+	}
+}
+
+func TestErrorCatalog_LookupError(t *testing.T) {
+	t.Parallel()
+
+	entry := LookupError(ErrNotEnoughGas)
+	require.Equal(t, "NotEnoughGas", entry.Name)
+	require.Equal(t, ErrorCategoryGas, entry.Category)
+
+	entry = LookupError(fmt.Errorf("%w: transfer", ErrTransferInsufficientFunds))
+	require.Equal(t, "TransferInsufficientFunds", entry.Name)
+	require.Equal(t, ErrorCategoryTransfer, entry.Category)
+
+	entry = LookupError(executor.ErrFuncNotFound)
+	require.Equal(t, "ExecutorFuncNotFound", entry.Name)
+	require.Equal(t, ErrorCategoryValidation, entry.Category)
+
+	unknownErr := errors.New("unknown")
+	entry = LookupError(unknownErr)
+	require.Equal(t, UnknownErrorCode, entry.Code)
+	require.Equal(t, ErrorCategoryGeneric, entry.Category)
+	require.Equal(t, unknownErr, entry.Err)
+
+	entry = LookupError(nil)
+	require.Equal(t, UnknownErrorCode, entry.Code)
+}
+
+func TestErrorReport_FromWrappableError(t *testing.T) {
+	t.Parallel()
+
+	err := WrapError(ErrNotEnoughGas, "transfer")
+	err = err.WrapWithStackTrace()
+	err = err.WrapWithError(errors.New("custom"), "callee", "extra")
+	err = err.WrapWithError(ErrExecutionFailed, "caller")
+
+	report := NewErrorReport([]byte("contract"), "caller", err)
+	require.Equal(t, ErrorReportVersion, report.Version)
+	require.Equal(t, []byte("contract"), report.Contract)
+	require.Equal(t, "caller", report.Function)
+	require.Equal(t, []ErrorReportEntry{
+		{
+			Code:     LookupError(ErrNotEnoughGas).Code,
+			Category: ErrorCategoryGas,
+			Name:     "NotEnoughGas",
+			Message:  ErrNotEnoughGas.Error(),
+			Info:     []string{"transfer"},
+		},
+		{
+			Code:     UnknownErrorCode,
+			Category: ErrorCategoryGeneric,
+			Message:  "custom",
+			Info:     []string{"callee", "extra"},
+		},
+		{
+			Code:     LookupError(ErrExecutionFailed).Code,
+			Category: ErrorCategoryExecution,
+			Name:     "ExecutionFailed",
+			Message:  ErrExecutionFailed.Error(),
+			Info:     []string{"caller"},
+		},
+	}, report.Errors)
+
+	encoded, encodeErr := report.Encode()
+	require.Nil(t, encodeErr)
+	decoded, decodeErr := DecodeErrorReport(encoded)
+	require.Nil(t, decodeErr)
+	require.Equal(t, report, decoded)
+}
+
+func TestErrorReport_FromPlainError(t *testing.T) {
+	t.Parallel()
+
+	report := NewErrorReport([]byte("contract"), "function", ErrSignalError)
+	require.Len(t, report.Errors, 1)
+	require.Equal(t, "SignalError", report.Errors[0].Name)
+	require.Nil(t, report.Errors[0].Info)
+
+	report = NewErrorReport([]byte("contract"), "function", nil)
+	require.Empty(t, report.Errors)
+
+	_, err := DecodeErrorReport([]byte("not json"))
+	require.NotNil(t, err)
+}
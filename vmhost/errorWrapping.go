@@ -20,6 +20,7 @@ type WrappableError interface {
 	GetLastError() error
 	GetAllErrors() []error
 	GetAllErrorsAndOtherInfo() ([]error, []string)
+	GetAllErrorsWithOtherInfo() ([]error, [][]string)
 
 	Unwrap() error
 	Is(target error) bool
@@ -95,6 +96,18 @@ func (werr *wrappableError) GetAllErrorsAndOtherInfo() ([]error, []string) {
 	return allErrors, allOtherInfo
 }
 
+// GetAllErrorsWithOtherInfo gets all the wrapped errors, each with its own otherInfo
+func (werr *wrappableError) GetAllErrorsWithOtherInfo() ([]error, [][]string) {
+	errs := werr.errsWithLocation
+	allErrors := make([]error, 0, len(errs))
+	allOtherInfo := make([][]string, 0, len(errs))
+	for _, err := range errs {
+		allErrors = append(allErrors, err.err)
+		allOtherInfo = append(allOtherInfo, err.otherInfo)
+	}
+	return allErrors, allOtherInfo
+}
+
 func (werr *wrappableError) wrapWithErrorWithSkipLevels(err error, skipStackLevels int, otherInfo ...string) *wrappableError {
 	newErrs := make([]errorWithLocation, len(werr.errsWithLocation))
 	copy(newErrs, werr.errsWithLocation)
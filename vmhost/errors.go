@@ -91,6 +91,9 @@ var ErrContractNotFound = fmt.Errorf("%w (not found)", ErrContractInvalid)
 // ErrMemoryDeclarationMissing signals that a memory declaration is missing
 var ErrMemoryDeclarationMissing = fmt.Errorf("%w (missing memory declaration)", ErrContractInvalid)
 
+// ErrMalformedBytecode signals that the WASM bytecode of a contract cannot be decoded
+var ErrMalformedBytecode = fmt.Errorf("%w (malformed bytecode)", ErrContractInvalid)
+
 // ErrMaxInstancesReached signals that the max number of Wasmer instances has been reached.
 var ErrMaxInstancesReached = fmt.Errorf("%w (max instances reached)", ErrExecutionFailed)
 
@@ -209,6 +212,12 @@ var ErrNoBigIntUnderThisHandle = errors.New("no bigInt under the given handle")
 // ErrNoBigFloatUnderThisHandle signals that there is no bigInt for the given handle
 var ErrNoBigFloatUnderThisHandle = errors.New("no bigFloat under the given handle")
 
+// ErrNoDecimalUnderThisHandle signals that there is no decimal for the given handle
+var ErrNoDecimalUnderThisHandle = errors.New("no decimal under the given handle")
+
+// ErrInvalidEncodedDecimal signals that a managed buffer does not hold a valid encoded decimal
+var ErrInvalidEncodedDecimal = errors.New("invalid encoded decimal")
+
 // ErrPositiveExponent signals that the exponent is greater or equal to 0
 var ErrPositiveExponent = errors.New("exponent must be negative")
 
@@ -334,3 +343,27 @@ var ErrOpcodeIsNotAllowed = errors.New("opcode is not allowed")
 
 // ErrInvalidSignature signals that a signature verification failed
 var ErrInvalidSignature = errors.New("signature is invalid")
+
+// ErrCallDeniedByPolicy signals that a call, a deployment or a transfer was denied by the CallPolicy of the VM host
+var ErrCallDeniedByPolicy = errors.New("call denied by policy")
+
+// ErrReentrancyNotAllowed signals that a call re-entered a contract protected against reentrancy
+var ErrReentrancyNotAllowed = errors.New("reentrancy not allowed")
+
+// ErrReentrancyProtectionOutsideDeploy signals that the reentrancy protection was requested outside of init or upgrade
+var ErrReentrancyProtectionOutsideDeploy = errors.New("reentrancy protection can only be set during init or upgrade")
+
+// ErrNativeContractWithoutCode signals that code was requested from, or deployed at, the address of a native contract
+var ErrNativeContractWithoutCode = errors.New("native contracts have no code")
+
+// ErrNativeContractWithoutMemory signals that the WASM memory of a native contract was accessed
+var ErrNativeContractWithoutMemory = errors.New("native contracts have no WASM memory")
+
+// ErrInvalidGasSchedule signals that a new gas schedule was rejected, leaving the current one in place
+var ErrInvalidGasSchedule = errors.New("invalid gas schedule")
+
+// ErrNoPreviousGasSchedule signals that there is no previous gas schedule to roll back to
+var ErrNoPreviousGasSchedule = errors.New("no previous gas schedule")
+
+// ErrTooManyLogTopics signals that an Ethereum log was written with more than 4 topics
+var ErrTooManyLogTopics = errors.New("too many log topics")
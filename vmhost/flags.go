@@ -30,5 +30,29 @@ const (
 	// FixGetBalanceFlag defines the flag that activates the fix for get balance from the Barnard release
 	FixGetBalanceFlag core.EnableEpochFlag = "FixGetBalanceFlag"
 
+	// ManagedDecimalOpcodesFlag defines the flag that activates the managed decimal opcodes
+	ManagedDecimalOpcodesFlag core.EnableEpochFlag = "ManagedDecimalOpcodesFlag"
+
+	// PerCallRandomnessFlag defines the flag that activates the per-call DRBG randomness and the random range opcodes
+	PerCallRandomnessFlag core.EnableEpochFlag = "PerCallRandomnessFlag"
+
+	// TransientStorageFlag defines the flag that activates the transaction-scoped transient storage opcodes
+	TransientStorageFlag core.EnableEpochFlag = "TransientStorageFlag"
+
+	// ReentrancyProtectionFlag defines the flag that activates the runtime-enforced reentrancy protection
+	ReentrancyProtectionFlag core.EnableEpochFlag = "ReentrancyProtectionFlag"
+
+	// StructuredVMErrorsFlag defines the flag that adds the structured error report to the internalVMErrors log entry
+	StructuredVMErrorsFlag core.EnableEpochFlag = "StructuredVMErrorsFlag"
+
+	// ErrorReturnOpcodesFlag defines the flag that activates the error-returning deploy, upgrade and transfer opcodes
+	ErrorReturnOpcodesFlag core.EnableEpochFlag = "ErrorReturnOpcodesFlag"
+
+	// BatchedStorageLoadFlag defines the flag that activates the opcodes loading several storage keys in one call
+	BatchedStorageLoadFlag core.EnableEpochFlag = "BatchedStorageLoadFlag"
+
+	// EthereumAPIFlag defines the flag that activates the opcodes of the Ethereum compatibility layer
+	EthereumAPIFlag core.EnableEpochFlag = "EthereumAPIFlag"
+
 	// all new flags must be added to allFlags slice from hostCore/host
 )
@@ -1,6 +1,7 @@
 package vmhost
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math/big"
 	"os"
@@ -20,6 +21,49 @@ func CustomStorageKey(keyType string, associatedKey []byte) []byte {
 	return append([]byte(keyType), associatedKey...)
 }
 
+// AddReentrancyProtection returns the reentrancy protection marks of a contract with the given endpoint added. The
+// marks are the names of the protected endpoints, each preceded by its length on 4 bytes, big endian. An empty
+// endpoint name designates the whole contract.
+func AddReentrancyProtection(marks []byte, endpoint string) []byte {
+	for _, protectedEndpoint := range reentrancyProtectedEndpoints(marks) {
+		if protectedEndpoint == endpoint {
+			return marks
+		}
+	}
+
+	newMarks := make([]byte, len(marks)+reentrancyMarkLengthSize, len(marks)+reentrancyMarkLengthSize+len(endpoint))
+	copy(newMarks, marks)
+	binary.BigEndian.PutUint32(newMarks[len(marks):], uint32(len(endpoint)))
+	return append(newMarks, endpoint...)
+}
+
+// IsProtectedAgainstReentrancy returns true if the reentrancy protection marks of a contract protect the whole
+// contract or the given endpoint.
+func IsProtectedAgainstReentrancy(marks []byte, endpoint string) bool {
+	for _, protectedEndpoint := range reentrancyProtectedEndpoints(marks) {
+		if protectedEndpoint == "" || protectedEndpoint == endpoint {
+			return true
+		}
+	}
+	return false
+}
+
+const reentrancyMarkLengthSize = 4
+
+func reentrancyProtectedEndpoints(marks []byte) []string {
+	endpoints := make([]string, 0)
+	for len(marks) >= reentrancyMarkLengthSize {
+		length := uint64(binary.BigEndian.Uint32(marks))
+		marks = marks[reentrancyMarkLengthSize:]
+		if length > uint64(len(marks)) {
+			break
+		}
+		endpoints = append(endpoints, string(marks[:length]))
+		marks = marks[length:]
+	}
+	return endpoints
+}
+
 // BooleanToInt returns 1 if the given bool is true, 0 otherwise
 func BooleanToInt(b bool) int {
 	if b {
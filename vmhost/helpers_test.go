@@ -41,3 +41,25 @@ func TestInverseBytes(t *testing.T) {
 	result = InverseBytes([]byte("a"))
 	require.Equal(t, []byte("a"), result)
 }
+
+func TestReentrancyProtectionMarks(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, IsProtectedAgainstReentrancy(nil, "endpoint"))
+
+	marks := AddReentrancyProtection(nil, "endpoint")
+	require.True(t, IsProtectedAgainstReentrancy(marks, "endpoint"))
+	require.False(t, IsProtectedAgainstReentrancy(marks, "other"))
+	require.False(t, IsProtectedAgainstReentrancy(marks, "endpointSuffix"))
+
+	// adding a mark twice keeps a single one
+	require.Equal(t, marks, AddReentrancyProtection(marks, "endpoint"))
+
+	marks = AddReentrancyProtection(marks, "")
+	require.Equal(t, []byte("\x00\x00\x00\x08endpoint\x00\x00\x00\x00"), marks)
+	require.True(t, IsProtectedAgainstReentrancy(marks, "other"))
+
+	// truncated marks protect only the complete endpoints
+	require.True(t, IsProtectedAgainstReentrancy(marks[:len(marks)-1], "endpoint"))
+	require.False(t, IsProtectedAgainstReentrancy(marks[:len(marks)-1], "other"))
+}
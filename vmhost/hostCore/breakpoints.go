@@ -39,6 +39,10 @@ func (host *vmHost) handleBreakpoint(breakpointValue vmhost.BreakpointValue) err
 	if breakpointValue == vmhost.BreakpointMemoryLimit {
 		return vmhost.ErrMemoryLimit
 	}
+	if breakpointValue == vmhost.BreakpointFinish {
+		host.Runtime().SetRuntimeBreakpointValue(vmhost.BreakpointNone)
+		return nil
+	}
 
 	return vmhost.ErrUnhandledRuntimeBreakpoint
 }
@@ -0,0 +1,29 @@
+package hostCore
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+func policyCallFromInput(kind vmhost.CallPolicyKind, input *vmcommon.ContractCallInput) *vmhost.PolicyCall {
+	return &vmhost.PolicyCall{
+		Kind:          kind,
+		Caller:        input.CallerAddr,
+		Destination:   input.RecipientAddr,
+		Function:      input.Function,
+		Value:         input.CallValue,
+		ESDTTransfers: input.ESDTTransfers,
+	}
+}
+
+// checkCallPolicyOnDestContext checks the synchronous calls made by contracts. The asynchronous calls were already
+// checked when they were registered, the callbacks are never denied, and the init function of a contract deployed
+// by another contract was checked together with the deployment.
+func (host *vmHost) checkCallPolicyOnDestContext(input *vmcommon.ContractCallInput) error {
+	if input.CallType != vm.DirectCall || input.AllowInitFunction {
+		return nil
+	}
+
+	return host.CheckCallPolicy(policyCallFromInput(vmhost.PolicyExecuteOnDestContext, input))
+}
@@ -31,9 +31,7 @@ func (host *vmHost) doRunSmartContractCreate(input *vmcommon.ContractCreateInput
 
 	var vmOutput *vmcommon.VMOutput
 	defer func() {
-		if vmOutput == nil || vmOutput.ReturnCode == vmcommon.ExecutionFailed {
-			runtime.CleanInstance()
-		}
+		host.cleanFailedInstance(vmOutput, &vmhost.ForensicsBundle{CreateInput: input})
 	}()
 
 	address, err := blockchain.NewAddress(input.CallerAddr)
@@ -51,6 +49,12 @@ func (host *vmHost) doRunSmartContractCreate(input *vmcommon.ContractCreateInput
 	runtime.SetCodeAddress(address)
 	metering.InitStateFromContractCallInput(&input.VMInput)
 
+	err = host.CheckCallPolicy(policyCallFromInput(vmhost.PolicyDeploy, contractCallInput))
+	if err != nil {
+		vmOutput = output.CreateVMOutputInCaseOfError(err)
+		return vmOutput
+	}
+
 	output.AddTxValueToAccount(address, input.CallValue)
 	storage.SetAddress(runtime.GetContextAddress())
 
@@ -129,9 +133,7 @@ func (host *vmHost) doRunSmartContractUpgrade(input *vmcommon.ContractCallInput)
 
 	var vmOutput *vmcommon.VMOutput
 	defer func() {
-		if vmOutput == nil || vmOutput.ReturnCode == vmcommon.ExecutionFailed {
-			runtime.CleanInstance()
-		}
+		host.cleanFailedInstance(vmOutput, &vmhost.ForensicsBundle{CallInput: input})
 	}()
 
 	err := host.checkUpgradePermission(input)
@@ -143,6 +145,13 @@ func (host *vmHost) doRunSmartContractUpgrade(input *vmcommon.ContractCallInput)
 
 	runtime.InitStateFromContractCallInput(input)
 	metering.InitStateFromContractCallInput(&input.VMInput)
+
+	err = host.CheckCallPolicy(policyCallFromInput(vmhost.PolicyDeploy, input))
+	if err != nil {
+		vmOutput = output.CreateVMOutputInCaseOfError(err)
+		return vmOutput
+	}
+
 	output.AddTxValueToAccount(input.RecipientAddr, input.CallValue)
 	storage.SetAddress(runtime.GetContextAddress())
 
@@ -194,6 +203,8 @@ func (host *vmHost) doRunSmartContractDelete(input *vmcommon.ContractCallInput)
 
 func (host *vmHost) doRunSmartContractCall(input *vmcommon.ContractCallInput) *vmcommon.VMOutput {
 	host.InitState()
+	// transient storage lives only as long as the top-level call
+	defer host.TransientStorage().InitState()
 	defer func() {
 		errs := host.GetRuntimeErrors()
 		if errs != nil {
@@ -205,9 +216,7 @@ func (host *vmHost) doRunSmartContractCall(input *vmcommon.ContractCallInput) *v
 
 	var vmOutput *vmcommon.VMOutput
 	defer func() {
-		if vmOutput == nil || vmOutput.ReturnCode == vmcommon.ExecutionFailed {
-			host.Runtime().CleanInstance()
-		}
+		host.cleanFailedInstance(vmOutput, &vmhost.ForensicsBundle{CallInput: input})
 	}()
 
 	runtime.InitStateFromContractCallInput(input)
@@ -219,6 +228,13 @@ func (host *vmHost) doRunSmartContractCall(input *vmcommon.ContractCallInput) *v
 		return vmOutput
 	}
 	metering.InitStateFromContractCallInput(&input.VMInput)
+
+	err = host.CheckCallPolicy(policyCallFromInput(vmhost.PolicyDirectCall, input))
+	if err != nil {
+		vmOutput = output.CreateVMOutputInCaseOfError(err)
+		return vmOutput
+	}
+
 	output.AddTxValueToAccount(input.RecipientAddr, input.CallValue)
 	storage.SetAddress(runtime.GetContextAddress())
 
@@ -292,14 +308,25 @@ func (host *vmHost) ExecuteOnDestContext(input *vmcommon.ContractCallInput) (vmO
 
 	scExecutionInput := input
 
+	err = host.checkCallPolicyOnDestContext(input)
+	if err != nil {
+		host.Runtime().AddError(err, input.Function)
+		vmOutput = host.Output().CreateVMOutputInCaseOfError(err)
+		isChildComplete = true
+		return
+	}
+
 	blockchain := host.Blockchain()
+	transientStorage := host.TransientStorage()
 
 	blockchain.PushState()
+	transientStorage.PushState()
 
 	if host.IsBuiltinFunctionName(input.Function) {
 		scExecutionInput, vmOutput, err = host.handleBuiltinFunctionCall(input)
 		if err != nil {
 			blockchain.PopSetActiveState()
+			transientStorage.PopSetActiveState()
 			host.Runtime().AddError(err, input.Function)
 			vmOutput = host.Output().CreateVMOutputInCaseOfError(err)
 			isChildComplete = true
@@ -314,6 +341,12 @@ func (host *vmHost) ExecuteOnDestContext(input *vmcommon.ContractCallInput) (vmO
 		host.addNewBackTransfersFromVMOutput(vmOutput, scExecutionInput.CallerAddr, scExecutionInput.RecipientAddr)
 	}
 
+	if err != nil || vmOutput == nil || vmOutput.ReturnCode != vmcommon.Ok {
+		transientStorage.PopSetActiveState()
+	} else {
+		transientStorage.PopDiscard()
+	}
+
 	if err != nil {
 		blockchain.PopSetActiveState()
 	} else {
@@ -445,6 +478,13 @@ func (host *vmHost) executeOnDestContextNoBuiltinFunction(input *vmcommon.Contra
 		return vmOutput, true, err
 	}
 
+	err = host.checkReentrancyProtection(input, input.RecipientAddr)
+	if err != nil {
+		runtime.AddError(err, input.Function)
+		vmOutput = output.CreateVMOutputInCaseOfError(err)
+		return vmOutput, true, err
+	}
+
 	managedTypes.PushState()
 	managedTypes.InitState()
 	managedTypes.PopBackTransferIfAsyncCallBack(input)
@@ -579,6 +619,18 @@ func (host *vmHost) ExecuteOnSameContext(input *vmcommon.ContractCallInput) erro
 
 	managedTypes, blockchain, metering, output, runtime, _, _ := host.GetContexts()
 
+	err := host.CheckCallPolicy(policyCallFromInput(vmhost.PolicyExecuteOnSameContext, input))
+	if err != nil {
+		runtime.AddError(err, input.Function)
+		return err
+	}
+
+	err = host.checkReentrancyProtection(input, input.RecipientAddr)
+	if err != nil {
+		runtime.AddError(err, input.Function)
+		return err
+	}
+
 	// Back up the states of the contexts (except Storage and Async, which aren't affected
 	// by ExecuteOnSameContext())
 	managedTypes.PushState()
@@ -598,8 +650,7 @@ func (host *vmHost) ExecuteOnSameContext(input *vmcommon.ContractCallInput) erro
 	metering.InitStateFromContractCallInput(&input.VMInput)
 
 	blockchain.PushState()
-
-	var err error
+	host.TransientStorage().PushState()
 
 	defer host.finishExecuteOnSameContext(err)
 
@@ -631,6 +682,7 @@ func (host *vmHost) finishExecuteOnSameContext(executeErr error) {
 		metering.PopSetActiveState()
 		output.PopSetActiveState()
 		blockchain.PopSetActiveState()
+		host.TransientStorage().PopSetActiveState()
 		runtime.PopSetActiveState()
 		return
 	}
@@ -643,6 +695,7 @@ func (host *vmHost) finishExecuteOnSameContext(executeErr error) {
 	metering.PopMergeActiveState()
 	output.PopDiscard()
 	blockchain.PopDiscard()
+	host.TransientStorage().PopDiscard()
 	managedTypes.PopSetActiveState()
 	runtime.PopSetActiveState()
 	// Restore remaining gas to the caller (parent) Wasmer instance
@@ -669,6 +722,75 @@ func (host *vmHost) IsOutOfVMFunctionExecution(input *vmcommon.ContractCallInput
 	return false
 }
 
+// checkReentrancyProtection rejects a call into a contract whose code is already executing,
+// if the contract, or the called endpoint, was protected against reentrancy at deploy or upgrade.
+// Callbacks are exempt, since they are the expected way for a contract to be called back.
+// Reading the marks is charged to the caller as a storage load.
+func (host *vmHost) checkReentrancyProtection(input *vmcommon.ContractCallInput, codeAddress []byte) error {
+	if !host.enableEpochsHandler.IsFlagEnabled(vmhost.ReentrancyProtectionFlag) {
+		return nil
+	}
+	if input.CallType == vm.AsynchronousCallBack {
+		return nil
+	}
+	if !host.Runtime().IsContractOnTheStack(codeAddress) {
+		return nil
+	}
+
+	storage := host.Storage()
+	key := storage.GetVmProtectedPrefix(vmhost.ReentrancyProtectionKeyPrefix)
+	marks, trieDepth, usedCache, err := storage.GetStorageFromAddressNoChecks(codeAddress, key)
+	if err != nil {
+		return err
+	}
+
+	err = storage.UseGasForStorageLoad(
+		input.Function,
+		int64(trieDepth),
+		host.Metering().GasSchedule().BaseOpsAPICost.StorageLoad,
+		usedCache)
+	if err != nil {
+		return err
+	}
+
+	if vmhost.IsProtectedAgainstReentrancy(marks, input.Function) {
+		log.Trace("reentrancy rejected", "address", codeAddress, "function", input.Function)
+		return vmhost.ErrReentrancyNotAllowed
+	}
+
+	return nil
+}
+
+// clearReentrancyProtection removes the reentrancy protection marks of the contract being upgraded, so that only the
+// marks set again by the upgrade function of the new code remain
+func (host *vmHost) clearReentrancyProtection() error {
+	if !host.enableEpochsHandler.IsFlagEnabled(vmhost.ReentrancyProtectionFlag) {
+		return nil
+	}
+
+	storage := host.Storage()
+	key := storage.GetVmProtectedPrefix(vmhost.ReentrancyProtectionKeyPrefix)
+	marks, trieDepth, usedCache, err := storage.GetStorage(key)
+	if err != nil {
+		return err
+	}
+
+	err = storage.UseGasForStorageLoad(
+		vmhost.ContractsUpgradeFunctionName,
+		int64(trieDepth),
+		host.Metering().GasSchedule().BaseOpsAPICost.StorageLoad,
+		usedCache)
+	if err != nil {
+		return err
+	}
+	if len(marks) == 0 {
+		return nil
+	}
+
+	_, err = storage.SetProtectedStorage(key, nil)
+	return err
+}
+
 // IsBuiltinFunctionName returns true if the given function name is the same as any protocol builtin function
 func (host *vmHost) IsBuiltinFunctionName(functionName string) bool {
 	function, err := host.builtInFuncContainer.Get(functionName)
@@ -686,8 +808,16 @@ func (host *vmHost) IsBuiltinFunctionCall(data []byte) bool {
 }
 
 // CreateNewContract creates a new contract indirectly (from another Smart Contract)
-func (host *vmHost) CreateNewContract(input *vmcommon.ContractCreateInput, createContractCallType int) (newContractAddress []byte, err error) {
+func (host *vmHost) CreateNewContract(input *vmcommon.ContractCreateInput, createContractCallType int) ([]byte, error) {
+	newContractAddress, _, err := host.CreateNewContractWithOutput(input, createContractCallType)
+	return newContractAddress, err
+}
+
+// CreateNewContractWithOutput creates a new contract indirectly, like CreateNewContract, also returning the output of
+// its init function. The output is nil if the deployment failed before calling init.
+func (host *vmHost) CreateNewContractWithOutput(input *vmcommon.ContractCreateInput, createContractCallType int) (newContractAddress []byte, initVmOutput *vmcommon.VMOutput, err error) {
 	newContractAddress = nil
+	initVmOutput = nil
 	err = nil
 
 	defer func() {
@@ -724,6 +854,18 @@ func (host *vmHost) CreateNewContract(input *vmcommon.ContractCreateInput, creat
 		return
 	}
 
+	err = host.CheckCallPolicy(&vmhost.PolicyCall{
+		Kind:          vmhost.PolicyDeploy,
+		Caller:        input.CallerAddr,
+		Destination:   newContractAddress,
+		Function:      vmhost.InitFunctionName,
+		Value:         input.CallValue,
+		ESDTTransfers: input.ESDTTransfers,
+	})
+	if err != nil {
+		return
+	}
+
 	codeDeployInput.ContractAddress = newContractAddress
 	output.DeployCode(codeDeployInput)
 
@@ -744,7 +886,7 @@ func (host *vmHost) CreateNewContract(input *vmcommon.ContractCreateInput, creat
 
 	var isChildComplete bool
 	host.Async().SetAsyncArgumentsForCall(initCallInput)
-	initVmOutput, isChildComplete, err := host.ExecuteOnDestContext(initCallInput)
+	initVmOutput, isChildComplete, err = host.ExecuteOnDestContext(initCallInput)
 	if err != nil {
 		return
 	}
@@ -1155,6 +1297,11 @@ func (host *vmHost) callInitFunction() error {
 }
 
 func (host *vmHost) callUpgradeFunction() error {
+	err := host.clearReentrancyProtection()
+	if err != nil {
+		return err
+	}
+
 	return host.callSCFunction(vmhost.ContractsUpgradeFunctionName)
 }
 
@@ -0,0 +1,54 @@
+package hostCore
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// captureForensics completes the bundle with the state of the failed execution and hands it to the forensics writer,
+// if one is configured. It must run before the active instance is cleaned, otherwise its memory is lost.
+func (host *vmHost) captureForensics(bundle *vmhost.ForensicsBundle) {
+	if check.IfNil(host.forensicsWriter) {
+		return
+	}
+
+	// the state may be inconsistent after a panic, capturing it must never fail the execution
+	defer func() {
+		r := recover()
+		if r != nil {
+			log.Error("cannot capture forensics", "error", r)
+		}
+	}()
+
+	runtime := host.Runtime()
+	metering := host.Metering()
+
+	bundle.CallStack = runtime.GetCallStack()
+	bundle.GasProvided = metering.GetGasProvided()
+	bundle.ManagedTypes = host.ManagedTypes().GetSummary()
+
+	instance := runtime.GetInstance()
+	if !check.IfNil(instance) && !instance.IsAlreadyCleaned() && instance.HasMemory() {
+		bundle.GasLeft = metering.GasLeft()
+		bundle.Memory = instance.MemDump()
+	}
+
+	err := host.forensicsWriter.WriteForensics(bundle)
+	if err != nil {
+		log.Error("cannot write forensics", "error", err)
+	}
+}
+
+// cleanFailedInstance captures the forensics of an execution which ended with ExecutionFailed, then cleans its
+// instance. A nil output means the execution panicked: the recovery in RunSmartContractCreate or RunSmartContractCall
+// captures the forensics and cleans the instance, so it is left untouched here.
+func (host *vmHost) cleanFailedInstance(vmOutput *vmcommon.VMOutput, bundle *vmhost.ForensicsBundle) {
+	if vmOutput == nil || vmOutput.ReturnCode != vmcommon.ExecutionFailed {
+		return
+	}
+
+	bundle.Reason = vmOutput.ReturnMessage
+	host.captureForensics(bundle)
+	host.Runtime().CleanInstance()
+}
@@ -2,6 +2,7 @@ package hostCore
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"runtime/debug"
 	"sync"
@@ -46,6 +47,14 @@ var allFlags = []core.EnableEpochFlag{
 	vmhost.ValidationOnGobDecodeFlag,
 	vmhost.BarnardOpcodesFlag,
 	vmhost.FixGetBalanceFlag,
+	vmhost.ManagedDecimalOpcodesFlag,
+	vmhost.PerCallRandomnessFlag,
+	vmhost.TransientStorageFlag,
+	vmhost.ReentrancyProtectionFlag,
+	vmhost.StructuredVMErrorsFlag,
+	vmhost.ErrorReturnOpcodesFlag,
+	vmhost.BatchedStorageLoadFlag,
+	vmhost.EthereumAPIFlag,
 }
 
 // vmHost implements HostContext interface.
@@ -55,17 +64,17 @@ type vmHost struct {
 	closingInstance  bool
 	executionTimeout time.Duration
 
-	ethInput []byte
-
-	blockchainContext   vmhost.BlockchainContext
-	runtimeContext      vmhost.RuntimeContext
-	asyncContext        vmhost.AsyncContext
-	outputContext       vmhost.OutputContext
-	meteringContext     vmhost.MeteringContext
-	storageContext      vmhost.StorageContext
-	managedTypesContext vmhost.ManagedTypesContext
+	blockchainContext       vmhost.BlockchainContext
+	runtimeContext          vmhost.RuntimeContext
+	asyncContext            vmhost.AsyncContext
+	outputContext           vmhost.OutputContext
+	meteringContext         vmhost.MeteringContext
+	storageContext          vmhost.StorageContext
+	transientStorageContext vmhost.TransientStorageContext
+	managedTypesContext     vmhost.ManagedTypesContext
 
 	gasSchedule          config.GasScheduleMap
+	previousGasSchedule  config.GasScheduleMap
 	builtInFuncContainer vmcommon.BuiltInFunctionContainer
 	esdtTransferParser   vmcommon.ESDTTransferParser
 	callArgsParser       vmhost.CallArgsParser
@@ -74,6 +83,11 @@ type vmHost struct {
 
 	transferLogIdentifiers    map[string]bool
 	mapOpcodeAddressIsAllowed map[string]map[string]struct{}
+	forensicsWriter           vmhost.ForensicsWriter
+	executionProfiler         vmhost.ExecutionProfiler
+	callPolicy                vmhost.CallPolicy
+	nativeContracts           map[string]vmhost.NativeContract
+	hasher                    vmhost.HashComputer
 }
 
 // NewVMHost creates a new VM vmHost
@@ -125,6 +139,7 @@ func NewVMHost(
 		asyncContext:              nil,
 		blockchainContext:         nil,
 		storageContext:            nil,
+		transientStorageContext:   nil,
 		managedTypesContext:       nil,
 		gasSchedule:               hostParameters.GasSchedule,
 		builtInFuncContainer:      hostParameters.BuiltInFuncContainer,
@@ -133,6 +148,11 @@ func NewVMHost(
 		executionTimeout:          minExecutionTimeout,
 		enableEpochsHandler:       hostParameters.EnableEpochsHandler,
 		mapOpcodeAddressIsAllowed: hostParameters.MapOpcodeAddressIsAllowed,
+		forensicsWriter:           hostParameters.ForensicsWriter,
+		executionProfiler:         hostParameters.ExecutionProfiler,
+		callPolicy:                hostParameters.CallPolicy,
+		nativeContracts:           hostParameters.NativeContracts,
+		hasher:                    hostParameters.Hasher,
 	}
 	newExecutionTimeout := time.Duration(hostParameters.TimeOutForSCExecutionInMilliseconds) * time.Millisecond
 	if newExecutionTimeout > minExecutionTimeout {
@@ -179,6 +199,11 @@ func NewVMHost(
 		return nil, err
 	}
 
+	host.transientStorageContext, err = contexts.NewTransientStorageContext(host)
+	if err != nil {
+		return nil, err
+	}
+
 	host.asyncContext, err = contexts.NewAsyncContext(host, host.callArgsParser, host.esdtTransferParser, &marshal.GogoProtoMarshalizer{})
 	if err != nil {
 		return nil, err
@@ -190,6 +215,7 @@ func NewVMHost(
 	}
 
 	host.runtimeContext.SetMaxInstanceStackSize(MaximumRuntimeInstanceStackSize)
+	host.runtimeContext.SetFootprintTrace(!check.IfNil(host.executionProfiler))
 
 	host.initContexts()
 	hostParameters.EpochNotifier.RegisterNotifyHandler(host)
@@ -218,6 +244,12 @@ func (host *vmHost) createExecutor(hostParameters *vmhost.VMHostParameters) (exe
 	} else {
 		vmExecutorFactory = wasmer2.ExecutorFactory()
 	}
+	if !check.IfNil(hostParameters.ForensicsWriter) {
+		vmExecutorFactory = hostParameters.ForensicsWriter.WrapExecutorFactory(vmExecutorFactory)
+	}
+	if !check.IfNil(hostParameters.ExecutionProfiler) {
+		vmExecutorFactory = hostParameters.ExecutionProfiler.WrapExecutorFactory(vmExecutorFactory)
+	}
 	vmExecutorFactoryArgs := executor.ExecutorFactoryArgs{
 		VMHooks:                  vmHooks,
 		OpcodeCosts:              gasCostConfig.WASMOpcodeCost,
@@ -267,6 +299,11 @@ func (host *vmHost) Storage() vmhost.StorageContext {
 	return host.storageContext
 }
 
+// TransientStorage returns the TransientStorageContext instance of the host
+func (host *vmHost) TransientStorage() vmhost.TransientStorageContext {
+	return host.transientStorageContext
+}
+
 // EnableEpochsHandler returns the enableEpochsHandler instance of the host
 func (host *vmHost) EnableEpochsHandler() vmhost.EnableEpochsHandler {
 	return host.enableEpochsHandler
@@ -331,8 +368,8 @@ func (host *vmHost) initContexts() {
 	host.runtimeContext.InitState()
 	host.asyncContext.InitState()
 	host.storageContext.InitState()
+	host.transientStorageContext.InitState()
 	host.blockchainContext.InitState()
-	host.ethInput = nil
 }
 
 // ClearContextStateStack cleans the state stacks of all the contexts of the host
@@ -343,30 +380,85 @@ func (host *vmHost) ClearContextStateStack() {
 	host.runtimeContext.ClearStateStack()
 	host.asyncContext.ClearStateStack()
 	host.storageContext.ClearStateStack()
+	host.transientStorageContext.ClearStateStack()
 	host.blockchainContext.ClearStateStack()
 }
 
-// GasScheduleChange applies a new gas schedule to the host
+// GasScheduleChange applies a new gas schedule to the host. An invalid gas schedule is rejected and logged, the
+// host keeping the current one.
 func (host *vmHost) GasScheduleChange(newGasSchedule config.GasScheduleMap) {
+	diff, err := host.ApplyGasSchedule(newGasSchedule)
+	if err != nil {
+		log.Error("cannot apply new gas config", "err", err)
+		return
+	}
+
+	log.Debug("gas schedule changed", "num changed costs", len(diff))
+}
+
+// ApplyGasSchedule validates the new gas schedule and, only if it is valid, applies it to the executor, to the
+// metering context and to the warm instance cache. It returns the costs which changed. The current gas schedule is
+// kept, so it can be restored with RollbackGasSchedule.
+func (host *vmHost) ApplyGasSchedule(newGasSchedule config.GasScheduleMap) (config.GasScheduleDiff, error) {
 	host.mutExecution.Lock()
 	defer host.mutExecution.Unlock()
 
-	host.gasSchedule = newGasSchedule
+	err := config.ValidateGasSchedule(newGasSchedule, host.enableEpochsHandler)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", vmhost.ErrInvalidGasSchedule, err)
+	}
+
+	previousGasSchedule := host.gasSchedule
+	diff, err := host.setGasSchedule(config.CopyGasSchedule(newGasSchedule))
+	if err != nil {
+		return nil, err
+	}
+
+	host.previousGasSchedule = previousGasSchedule
+	return diff, nil
+}
+
+// RollbackGasSchedule restores the gas schedule which was in place before the last one applied, returning the
+// costs which changed. Only the last change can be rolled back.
+func (host *vmHost) RollbackGasSchedule() (config.GasScheduleDiff, error) {
+	host.mutExecution.Lock()
+	defer host.mutExecution.Unlock()
+
+	if host.previousGasSchedule == nil {
+		return nil, vmhost.ErrNoPreviousGasSchedule
+	}
+
+	diff, err := host.setGasSchedule(host.previousGasSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	host.previousGasSchedule = nil
+	return diff, nil
+}
+
+// setGasSchedule builds the gas costs before touching any of the components of the host, so they either all
+// receive the new gas schedule, or are all left unchanged
+func (host *vmHost) setGasSchedule(newGasSchedule config.GasScheduleMap) (config.GasScheduleDiff, error) {
 	gasCostConfig, err := config.CreateGasConfig(newGasSchedule)
 	if err != nil {
-		log.Error("cannot apply new gas config", "err", err)
-		return
+		return nil, fmt.Errorf("%w: %w", vmhost.ErrInvalidGasSchedule, err)
 	}
 
+	diff := config.DiffGasSchedules(host.gasSchedule, newGasSchedule)
+
 	host.runtimeContext.GetVMExecutor().SetOpcodeCosts(gasCostConfig.WASMOpcodeCost)
 
 	host.meteringContext.SetGasSchedule(newGasSchedule)
 	host.runtimeContext.ClearWarmInstanceCache()
+	host.gasSchedule = newGasSchedule
+
+	return diff, nil
 }
 
-// GetGasScheduleMap returns the currently stored gas schedule
+// GetGasScheduleMap returns a copy of the currently stored gas schedule
 func (host *vmHost) GetGasScheduleMap() config.GasScheduleMap {
-	return host.gasSchedule
+	return config.CopyGasSchedule(host.gasSchedule)
 }
 
 // GetGasTrace returns the curent gas trace, used in scenario tests
@@ -408,8 +500,15 @@ func (host *vmHost) RunSmartContractCreate(input *vmcommon.ContractCreateInput)
 		defer func() {
 			r := recover()
 			if r != nil {
-				log.Error("VM execution panicked", "error", r, "stack", "\n"+string(debug.Stack()))
+				goStack := string(debug.Stack())
+				log.Error("VM execution panicked", "error", r, "stack", "\n"+goStack)
 				err = vmhost.ErrExecutionPanicked
+				host.captureForensics(&vmhost.ForensicsBundle{
+					Reason:      err.Error(),
+					PanicValue:  fmt.Sprint(r),
+					GoStack:     goStack,
+					CreateInput: input,
+				})
 				host.Runtime().CleanInstance()
 			} else {
 				host.Runtime().EndExecution()
@@ -418,6 +517,7 @@ func (host *vmHost) RunSmartContractCreate(input *vmcommon.ContractCreateInput)
 			close(done)
 		}()
 
+		host.beginProfiling()
 		vmOutput = host.doRunSmartContractCreate(input)
 		host.CompleteLogEntriesWithCallType(vmOutput, vmhost.DeploySmartContractString)
 
@@ -431,6 +531,7 @@ func (host *vmHost) RunSmartContractCreate(input *vmcommon.ContractCreateInput)
 			"returnMessage", vmOutput.ReturnMessage,
 			"gasRemaining", vmOutput.GasRemaining)
 		host.logFromGasTracer("init")
+		host.endProfiling(&vmhost.ExecutionProfile{CreateInput: input})
 	}()
 
 	select {
@@ -445,8 +546,28 @@ func (host *vmHost) RunSmartContractCreate(input *vmcommon.ContractCreateInput)
 	return
 }
 
+// RunSmartContractCallWithOverrides executes the call of an existing contract, as if the world was changed by the
+// given overrides. It is meant for read-only queries and gas estimations: the output must not be committed.
+func (host *vmHost) RunSmartContractCallWithOverrides(
+	input *vmcommon.ContractCallInput,
+	overrides *vmhost.StateOverrides,
+) (*vmcommon.VMOutput, error) {
+	// the caller keeps the overrides, so the execution works on its own copy, with code hashes it computed itself
+	overridesCopy := overrides.Clone()
+	overridesCopy.ComputeCodeHashes(host.hasher)
+
+	return host.runSmartContractCall(input, overridesCopy)
+}
+
 // RunSmartContractCall executes the call of an existing contract
-func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmOutput *vmcommon.VMOutput, err error) {
+func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	return host.runSmartContractCall(input, nil)
+}
+
+func (host *vmHost) runSmartContractCall(
+	input *vmcommon.ContractCallInput,
+	overrides *vmhost.StateOverrides,
+) (vmOutput *vmcommon.VMOutput, err error) {
 	err = validateVMInput(&input.VMInput)
 	if err != nil {
 		return nil, err
@@ -459,6 +580,9 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 		return nil, vmhost.ErrVMIsClosing
 	}
 
+	host.Blockchain().SetStateOverrides(overrides)
+	defer host.Blockchain().SetStateOverrides(nil)
+
 	host.setGasTracerEnabledIfLogIsTrace()
 	ctx, cancel := context.WithTimeout(context.Background(), host.executionTimeout)
 	defer cancel()
@@ -473,8 +597,15 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 		defer func() {
 			r := recover()
 			if r != nil {
-				log.Error("VM execution panicked", "error", r, "stack", "\n"+string(debug.Stack()))
+				goStack := string(debug.Stack())
+				log.Error("VM execution panicked", "error", r, "stack", "\n"+goStack)
 				err = vmhost.ErrExecutionPanicked
+				host.captureForensics(&vmhost.ForensicsBundle{
+					Reason:     err.Error(),
+					PanicValue: fmt.Sprint(r),
+					GoStack:    goStack,
+					CallInput:  input,
+				})
 				host.Runtime().CleanInstance()
 			} else {
 				host.Runtime().EndExecution()
@@ -483,6 +614,7 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 			close(done)
 		}()
 
+		host.beginProfiling()
 		switch input.Function {
 		case vmhost.UpgradeFunctionName:
 			vmOutput = host.doRunSmartContractUpgrade(input)
@@ -503,6 +635,7 @@ func (host *vmHost) RunSmartContractCall(input *vmcommon.ContractCallInput) (vmO
 			"returnMessage", vmOutput.ReturnMessage,
 			"gasRemaining", vmOutput.GasRemaining)
 		host.logFromGasTracer(input.Function)
+		host.endProfiling(&vmhost.ExecutionProfile{CallInput: input})
 	}()
 
 	select {
@@ -536,6 +669,15 @@ func (host *vmHost) createLogEntryFromErrors(sndAddress, rcvAddress []byte, func
 		Data:       [][]byte{[]byte(formattedErrors.Error())},
 	}
 
+	if host.enableEpochsHandler.IsFlagEnabled(vmhost.StructuredVMErrorsFlag) {
+		errorReport, err := vmhost.NewErrorReport(rcvAddress, function, formattedErrors).Encode()
+		if err != nil {
+			log.Warn("createLogEntryFromErrors: cannot encode the error report", "error", err)
+		} else {
+			logFromError.Data = append(logFromError.Data, errorReport)
+		}
+	}
+
 	return logFromError
 }
 
@@ -559,6 +701,41 @@ func (host *vmHost) IsAllowedToExecute(opcode string) bool {
 	return ok
 }
 
+// GetNativeContract returns the native contract registered at the given address, if any
+func (host *vmHost) GetNativeContract(address []byte) (vmhost.NativeContract, bool) {
+	contract, ok := host.nativeContracts[string(address)]
+	if !ok || check.IfNil(contract) {
+		return nil, false
+	}
+
+	return contract, true
+}
+
+// HasCallPolicy returns true if a CallPolicy is configured, so that the callers can skip preparing the calls to check
+func (host *vmHost) HasCallPolicy() bool {
+	return !check.IfNil(host.callPolicy)
+}
+
+// CheckCallPolicy checks the operation against the CallPolicy of the host, if one is configured
+func (host *vmHost) CheckCallPolicy(call *vmhost.PolicyCall) error {
+	if check.IfNil(host.callPolicy) {
+		return nil
+	}
+
+	err := host.callPolicy.CheckCall(call)
+	if err != nil {
+		log.Trace("call denied by policy",
+			"kind", call.Kind.String(),
+			"caller", call.Caller,
+			"destination", call.Destination,
+			"function", call.Function,
+			"error", err)
+		return fmt.Errorf("%w: %s", vmhost.ErrCallDeniedByPolicy, err.Error())
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (host *vmHost) IsInterfaceNil() bool {
 	return host == nil
@@ -0,0 +1,35 @@
+package hostCore
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// beginProfiling signals the execution profiler, if one is configured, that a top-level execution starts.
+func (host *vmHost) beginProfiling() {
+	if check.IfNil(host.executionProfiler) {
+		return
+	}
+
+	host.executionProfiler.BeginExecution()
+}
+
+// endProfiling completes the profile with the footprints of the instances ran by the top-level execution, then hands
+// it to the execution profiler, if one is configured.
+func (host *vmHost) endProfiling(profile *vmhost.ExecutionProfile) {
+	if check.IfNil(host.executionProfiler) {
+		return
+	}
+
+	// profiling must never fail the execution
+	defer func() {
+		r := recover()
+		if r != nil {
+			log.Error("cannot profile execution", "error", r)
+		}
+	}()
+
+	profile.Footprints = host.Runtime().GetInstanceFootprints()
+
+	host.executionProfiler.EndExecution(profile)
+}
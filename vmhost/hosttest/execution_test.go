@@ -662,6 +662,121 @@ func TestExecution_ChangeWasmerAPICosts(t *testing.T) {
 	require.NotEqual(t, gasRemainingBeforeChange, gasRemainingAfterChange)
 }
 
+func TestExecution_ApplyGasSchedule_InvalidScheduleIsRejected(t *testing.T) {
+	host := test.NewTestHostBuilder(t).
+		WithBlockchainHook(test.BlockchainHookStubForCall(test.GetTestSCCode("misc", "../../"), big.NewInt(0))).
+		Build()
+	defer func() {
+		host.Reset()
+	}()
+	gasScheduleBeforeChange := host.GetGasScheduleMap()
+
+	gasSchedule := host.GetGasScheduleMap()
+	gasSchedule["WASMOpcodeCost"]["BrIf"] = 0
+	gasSchedule["BaseOpsAPICost"]["Finish"]++
+	diff, err := host.ApplyGasSchedule(gasSchedule)
+	require.ErrorIs(t, err, vmhost.ErrInvalidGasSchedule)
+	require.ErrorIs(t, err, config.ErrZeroGasCost)
+	require.Nil(t, diff)
+
+	require.Equal(t, gasScheduleBeforeChange, host.GetGasScheduleMap())
+	require.Equal(t, gasScheduleBeforeChange["BaseOpsAPICost"]["Finish"], host.Metering().GasSchedule().BaseOpsAPICost.Finish)
+
+	_, err = host.RollbackGasSchedule()
+	require.Equal(t, vmhost.ErrNoPreviousGasSchedule, err)
+}
+
+func TestExecution_ApplyGasSchedule_MissingCostsOfActiveFeature(t *testing.T) {
+	// the section, and the key when the feature only adds a cost to an existing section
+	featureCosts := map[core.EnableEpochFlag][2]string{
+		vmhost.ManagedDecimalOpcodesFlag: {"ManagedDecimalAPICost", ""},
+		vmhost.PerCallRandomnessFlag:     {"BigIntAPICost", "BigIntSetRandomInRange"},
+		vmhost.TransientStorageFlag:      {"TransientStorageAPICost", ""},
+		vmhost.BatchedStorageLoadFlag:    {"ManagedBufferAPICost", "MBufferStorageLoadBatch"},
+		vmhost.EthereumAPIFlag:           {"EthAPICost", ""},
+	}
+	removeCosts := func(gasSchedule config.GasScheduleMap, cost [2]string) {
+		if cost[1] == "" {
+			delete(gasSchedule, cost[0])
+			return
+		}
+		delete(gasSchedule[cost[0]], cost[1])
+	}
+
+	for flag, cost := range featureCosts {
+		activeHost := test.NewTestHostBuilder(t).
+			WithBlockchainHook(test.BlockchainHookStubForCall(test.GetTestSCCode("misc", "../../"), big.NewInt(0))).
+			Build()
+		gasSchedule := activeHost.GetGasScheduleMap()
+		removeCosts(gasSchedule, cost)
+		_, err := activeHost.ApplyGasSchedule(gasSchedule)
+		require.ErrorIs(t, err, vmhost.ErrInvalidGasSchedule, flag)
+		activeHost.Reset()
+
+		inactiveHost := test.NewTestHostBuilder(t).
+			WithBlockchainHook(test.BlockchainHookStubForCall(test.GetTestSCCode("misc", "../../"), big.NewInt(0))).
+			WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(flag)).
+			Build()
+		gasSchedule = inactiveHost.GetGasScheduleMap()
+		removeCosts(gasSchedule, cost)
+		_, err = inactiveHost.ApplyGasSchedule(gasSchedule)
+		require.Nil(t, err, flag)
+		inactiveHost.Reset()
+	}
+}
+
+func TestExecution_ApplyGasSchedule_Rollback(t *testing.T) {
+	host := test.NewTestHostBuilder(t).
+		WithBlockchainHook(test.BlockchainHookStubForCall(test.GetTestSCCode("misc", "../../"), big.NewInt(0))).
+		Build()
+	defer func() {
+		host.Reset()
+	}()
+
+	input := test.CreateTestContractCallInputBuilder().
+		WithGasProvided(10000).
+		WithFunction("iterate_over_byte_array").
+		Build()
+
+	vmOutput, err := host.RunSmartContractCall(input)
+	verify := test.NewVMOutputVerifier(t, vmOutput, err)
+	verify.Ok()
+	gasRemainingBeforeChange := vmOutput.GasRemaining
+
+	gasSchedule := host.GetGasScheduleMap()
+	finishCost := gasSchedule["BaseOpsAPICost"]["Finish"]
+	brIfCost := gasSchedule["WASMOpcodeCost"]["BrIf"]
+	gasSchedule["BaseOpsAPICost"]["Finish"] += 2
+	gasSchedule["WASMOpcodeCost"]["BrIf"] += 20
+
+	diff, err := host.ApplyGasSchedule(gasSchedule)
+	require.Nil(t, err)
+	require.Equal(t, config.GasScheduleDiff{
+		{Section: "BaseOpsAPICost", Key: "Finish", OldValue: finishCost, NewValue: finishCost + 2},
+		{Section: "WASMOpcodeCost", Key: "BrIf", OldValue: brIfCost, NewValue: brIfCost + 20},
+	}, diff)
+
+	vmOutput, err = host.RunSmartContractCall(input)
+	verify = test.NewVMOutputVerifier(t, vmOutput, err)
+	verify.Ok()
+	require.Less(t, vmOutput.GasRemaining, gasRemainingBeforeChange)
+
+	diff, err = host.RollbackGasSchedule()
+	require.Nil(t, err)
+	require.Equal(t, config.GasScheduleDiff{
+		{Section: "BaseOpsAPICost", Key: "Finish", OldValue: finishCost + 2, NewValue: finishCost},
+		{Section: "WASMOpcodeCost", Key: "BrIf", OldValue: brIfCost + 20, NewValue: brIfCost},
+	}, diff)
+
+	vmOutput, err = host.RunSmartContractCall(input)
+	verify = test.NewVMOutputVerifier(t, vmOutput, err)
+	verify.Ok()
+	require.Equal(t, gasRemainingBeforeChange, vmOutput.GasRemaining)
+
+	_, err = host.RollbackGasSchedule()
+	require.Equal(t, vmhost.ErrNoPreviousGasSchedule, err)
+}
+
 func TestExecution_CallSCMethod_Init(t *testing.T) {
 	test.BuildInstanceCallTest(t).
 		WithContracts(
@@ -830,6 +945,7 @@ func TestExecution_ManagedBuffers(t *testing.T) {
 			WithFunction(mBuffer[functionNumber]). // mBufferSetRandomTest
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -867,6 +983,7 @@ func TestExecution_ManagedBuffers(t *testing.T) {
 			WithFunction(mBuffer[functionNumber]). // mBufferGetBytesTest
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -888,6 +1005,7 @@ func TestExecution_ManagedBuffers(t *testing.T) {
 			WithFunction(mBuffer[functionNumber]). // mBufferAppendTest
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -911,6 +1029,7 @@ func TestExecution_ManagedBuffers(t *testing.T) {
 			WithFunction(mBuffer[functionNumber]). // mBufferToBigIntUnsignedTest
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -932,6 +1051,7 @@ func TestExecution_ManagedBuffers(t *testing.T) {
 			WithFunction(mBuffer[functionNumber]). // mBufferToBigIntSignedTest
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -953,6 +1073,7 @@ func TestExecution_ManagedBuffers(t *testing.T) {
 			WithFunction(mBuffer[functionNumber]). // mBufferFromBigIntUnsignedTest
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -974,6 +1095,7 @@ func TestExecution_ManagedBuffers(t *testing.T) {
 			WithFunction(mBuffer[functionNumber]). // mBufferFromBigIntSignedTest
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -995,6 +1117,7 @@ func TestExecution_ManagedBuffers(t *testing.T) {
 			WithFunction(mBuffer[functionNumber]). // mBufferStorageStoreTest
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -1030,6 +1153,7 @@ func TestExecution_ManagedBuffers(t *testing.T) {
 			WithFunction(mBuffer[functionNumber]). // mBufferStorageLoadTest
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -1535,7 +1659,7 @@ func TestExecution_ExecuteOnSameContext_Recursive_Direct(t *testing.T) {
 			verify.Ok().
 				Balance(test.ParentAddress, 1000).
 				BalanceDelta(test.ParentAddress, 0).
-				GasUsed(test.ParentAddress, 41149).
+				GasUsed(test.ParentAddress, 56440).
 				ReturnData(returnData...).
 				Storage(storeEntries...)
 
@@ -1634,7 +1758,7 @@ func TestExecution_ExecuteOnSameContext_Recursive_Mutual_Methods(t *testing.T) {
 			verify.Ok().
 				Balance(test.ParentAddress, 1000).
 				BalanceDelta(test.ParentAddress, (big.NewInt(0).Sub(big.NewInt(1), big.NewInt(1))).Int64()).
-				GasUsed(test.ParentAddress, 45387).
+				GasUsed(test.ParentAddress, 60679).
 				ReturnData(returnData...).
 				Storage(storeEntries...)
 
@@ -1707,7 +1831,7 @@ func TestExecution_ExecuteOnSameContext_Recursive_Mutual_SCs(t *testing.T) {
 				// test.ParentAddress
 				Balance(test.ParentAddress, 1000).
 				BalanceDelta(test.ParentAddress, 0).
-				GasUsed(test.ParentAddress, 24570).
+				GasUsed(test.ParentAddress, 55145).
 				// test.ChildAddress
 				BalanceDelta(test.ChildAddress, 0).
 				GasUsed(test.ChildAddress, 0).
@@ -2353,7 +2477,7 @@ func TestExecution_ExecuteOnDestContext_Recursive_Direct(t *testing.T) {
 			verify.Ok().
 				Balance(test.ParentAddress, 1000).
 				BalanceDelta(test.ParentAddress, big.NewInt(0).Sub(big.NewInt(1), big.NewInt(1)).Int64()).
-				GasUsed(test.ParentAddress, 45468).
+				GasUsed(test.ParentAddress, 60760).
 				ReturnData(returnData...).
 				Storage(storeEntries...)
 
@@ -2412,7 +2536,7 @@ func TestExecution_ExecuteOnDestContext_Recursive_Mutual_Methods(t *testing.T) {
 			verify.Ok().
 				Balance(test.ParentAddress, 1000).
 				BalanceDelta(test.ParentAddress, big.NewInt(0).Sub(big.NewInt(1), big.NewInt(1)).Int64()).
-				GasUsed(test.ParentAddress, 54023).
+				GasUsed(test.ParentAddress, 69317).
 				ReturnData(returnData...).
 				Storage(storeEntries...)
 
@@ -2489,11 +2613,11 @@ func TestExecution_ExecuteOnDestContext_Recursive_Mutual_SCs(t *testing.T) {
 				// test.ParentAddress
 				Balance(test.ParentAddress, 1000).
 				BalanceDelta(test.ParentAddress, -balanceDelta).
-				GasUsed(test.ParentAddress, 22703).
+				GasUsed(test.ParentAddress, 37991).
 				// test.ChildAddress
 				Balance(test.ChildAddress, 1000).
 				BalanceDelta(test.ChildAddress, balanceDelta).
-				GasUsed(test.ChildAddress, 20874).
+				GasUsed(test.ChildAddress, 36163).
 				// others
 				ReturnData(returnData...).
 				Storage(storeEntries...)
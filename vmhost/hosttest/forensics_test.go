@@ -0,0 +1,59 @@
+package hostCoretest
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForensics_FailedExecutionCapturesMemory(t *testing.T) {
+	memoryMarker := []byte("forensics marker")
+	failure := errors.New("forensics failure")
+
+	var bundles []*vmhost.ForensicsBundle
+	forensicsWriter := &contextmock.ForensicsWriterStub{
+		WriteForensicsCalled: func(bundle *vmhost.ForensicsBundle) error {
+			bundles = append(bundles, bundle)
+			return nil
+		},
+	}
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(parentInstance *contextmock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("fail", func() *contextmock.InstanceMock {
+						host := parentInstance.Host
+						instance := contextmock.GetMockInstance(host)
+						_ = instance.MemStore(0, memoryMarker)
+						host.Runtime().FailExecution(failure)
+						return instance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(1000).
+			WithFunction("fail").
+			Build()).
+		WithForensicsWriter(forensicsWriter).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.ExecutionFailed().
+				ReturnMessage(failure.Error())
+		})
+	assert.Nil(t, err)
+
+	require.Len(t, bundles, 1)
+	bundle := bundles[0]
+	require.Equal(t, failure.Error(), bundle.Reason)
+	require.Equal(t, "fail", bundle.CallInput.Function)
+	require.True(t, bytes.HasPrefix(bundle.Memory, memoryMarker))
+}
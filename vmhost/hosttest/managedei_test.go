@@ -1184,11 +1184,19 @@ func Test_ManagedUnmarshalCompressedEC(t *testing.T) {
 }
 
 func Test_ManagedGenerateKeyEC(t *testing.T) {
+	runManagedGenerateKeyECTest(t, false, "00ddb81d205713945e203848e2f5c312067649f9a40727ca26b672b164cd1f9108f564958b20312146bb9750b74757d97cfbbba2aedebaba3a68fe3f2d669a992fab")
+}
+
+func Test_ManagedGenerateKeyEC_PerCallRandomness(t *testing.T) {
+	runManagedGenerateKeyECTest(t, true, "017355dcc0b0e6c9024bdd0d0ad17be4943ddf4650787850d8c8c1389188cf8be4b27be763a395ab41ce48746ba1fd2c56dacd6a4ac602774abd85dee6ac6baf3e34")
+}
+
+func runManagedGenerateKeyECTest(t *testing.T, perCallRandomness bool, expectedResultHex string) {
 	testConfig := baseTestConfig
 
 	pointXBytes, _ := hex.DecodeString("010ba38127b62997b313aa2990a13fce55c46fc3ae751a7a7b91c41341719b57f13b9185edd96a0211acf922adb13aa9d7c64925664a9419ae6f5bc9cc4d25f91f50")
 	pointYBytes, _ := hex.DecodeString("016967055bf964609b6fd853e0aa9b90d6e1e942066278a18e8604f9fcef5b64370412f20836767829ee7e0d3fc8e2e204e2a8ec4f9257a552d66647b2d1b9856223")
-	expectedResultBytes, _ := hex.DecodeString("00ddb81d205713945e203848e2f5c312067649f9a40727ca26b672b164cd1f9108f564958b20312146bb9750b74757d97cfbbba2aedebaba3a68fe3f2d669a992fab")
+	expectedResultBytes, _ := hex.DecodeString(expectedResultHex)
 
 	_, err := test.BuildMockInstanceCallTest(t).
 		WithContracts(
@@ -1230,6 +1238,12 @@ func Test_ManagedGenerateKeyEC(t *testing.T) {
 			WithGasProvided(testConfig.GasProvided).
 			WithFunction("testFunction").
 			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			enableEpochsHandler := host.EnableEpochsHandler().(*worldmock.EnableEpochsHandlerStub)
+			enableEpochsHandler.IsFlagEnabledCalled = func(flag core.EnableEpochFlag) bool {
+				return perCallRandomness || flag != vmhost.PerCallRandomnessFlag
+			}
+		}).
 		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
 			verify.
 				Ok()
@@ -2340,3 +2354,507 @@ func Test_ManagedManagedGetAllTransfersCallValue_OnlyCallValue(t *testing.T) {
 		})
 	assert.Nil(t, err)
 }
+
+type errorReturnHandles struct {
+	errorCode    int32
+	errorMessage int32
+}
+
+func newErrorReturnHandles(managedTypes vmhost.ManagedTypesContext) *errorReturnHandles {
+	return &errorReturnHandles{
+		errorCode:    managedTypes.NewManagedBufferFromBytes([]byte("stale")),
+		errorMessage: managedTypes.NewManagedBufferFromBytes([]byte("stale")),
+	}
+}
+
+func (handles *errorReturnHandles) requireError(t *testing.T, managedTypes vmhost.ManagedTypesContext, expectedErr error, expectedMessage string) {
+	errorCode, err := managedTypes.GetBytes(handles.errorCode)
+	require.Nil(t, err)
+	errorMessage, err := managedTypes.GetBytes(handles.errorMessage)
+	require.Nil(t, err)
+
+	expectedCode := big.NewInt(int64(vmhost.LookupError(expectedErr).Code)).Bytes()
+	require.Equal(t, expectedCode, errorCode)
+	require.Equal(t, expectedMessage, string(errorMessage))
+}
+
+func Test_ManagedTransferValueExecuteWithErrorReturn_Success(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("callChild", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						handles := newErrorReturnHandles(managedTypes)
+
+						returnVal := vmhooks.NewVMHooksImpl(host).ManagedTransferValueExecuteWithErrorReturn(
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							managedTypes.NewBigIntFromInt64(testConfig.TransferFromParentToChild),
+							int64(testConfig.GasProvidedToChild),
+							managedTypes.NewManagedBufferFromBytes([]byte("childFunction")),
+							managedTypes.NewManagedBuffer(),
+							handles.errorCode,
+							handles.errorMessage,
+						)
+
+						require.Equal(t, int32(0), returnVal)
+						handles.requireError(t, managedTypes, nil, "")
+
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("childFunction", func() *mock.InstanceMock {
+						host := childInstance.Host
+						_, _ = host.Storage().SetStorage(test.ChildKey, test.ChildData)
+						return childInstance
+					})
+				}),
+		).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+		}).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("callChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				Storage(test.CreateStoreEntry(test.ChildAddress).WithKey(test.ChildKey).WithValue(test.ChildData))
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedTransferValueExecuteWithErrorReturn_ChildFails(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("callChild", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						handles := newErrorReturnHandles(managedTypes)
+
+						returnVal := vmhooks.NewVMHooksImpl(host).ManagedTransferValueExecuteWithErrorReturn(
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							managedTypes.NewBigIntFromInt64(testConfig.TransferFromParentToChild),
+							int64(testConfig.GasProvidedToChild),
+							managedTypes.NewManagedBufferFromBytes([]byte("childFunction")),
+							managedTypes.NewManagedBuffer(),
+							handles.errorCode,
+							handles.errorMessage,
+						)
+
+						require.Equal(t, int32(1), returnVal)
+						handles.requireError(t, managedTypes, vmhost.ErrSignalError, "child error")
+
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("childFunction", func() *mock.InstanceMock {
+						host := childInstance.Host
+						_, _ = host.Storage().SetStorage(test.ChildKey, test.ChildData)
+						host.Runtime().SignalUserError("child error")
+						return childInstance
+					})
+				}),
+		).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+		}).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("callChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+			// the storage written by the failed child is reverted
+			childAccount := verify.VmOutput.OutputAccounts[string(test.ChildAddress)]
+			if childAccount != nil {
+				require.Empty(t, childAccount.StorageUpdates)
+			}
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedCreateContractWithErrorReturn_InitFails(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("deployChild", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						handles := newErrorReturnHandles(managedTypes)
+						resultAddressHandle := managedTypes.NewManagedBuffer()
+
+						returnVal := vmhooks.NewVMHooksImpl(host).ManagedCreateContractWithErrorReturn(
+							int64(testConfig.GasProvidedToChild),
+							managedTypes.NewBigIntFromInt64(0),
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							managedTypes.NewManagedBufferFromBytes([]byte{0, 0}),
+							managedTypes.NewManagedBuffer(),
+							resultAddressHandle,
+							managedTypes.NewManagedBuffer(),
+							handles.errorCode,
+							handles.errorMessage,
+						)
+
+						require.Equal(t, int32(1), returnVal)
+						handles.requireError(t, managedTypes, vmhost.ErrSignalError, "init error")
+
+						newAddress, err := managedTypes.GetBytes(resultAddressHandle)
+						require.Nil(t, err)
+						require.Empty(t, newAddress)
+
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod(vmhost.InitFunctionName, func() *mock.InstanceMock {
+						childInstance.Host.Runtime().SignalUserError("init error")
+						return childInstance
+					})
+				}),
+		).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+		}).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("deployChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedUpgradeContractWithErrorReturn_UpgradeFails(t *testing.T) {
+	testConfig := makeTestConfig()
+	codeMetadata := []byte{vmcommon.MetadataUpgradeable, 0}
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("upgradeChild", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						handles := newErrorReturnHandles(managedTypes)
+
+						returnVal := vmhooks.NewVMHooksImpl(host).ManagedUpgradeContractWithErrorReturn(
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							int64(testConfig.GasProvidedToChild),
+							managedTypes.NewBigIntFromInt64(0),
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							managedTypes.NewManagedBufferFromBytes(codeMetadata),
+							managedTypes.NewManagedBuffer(),
+							managedTypes.NewManagedBuffer(),
+							handles.errorCode,
+							handles.errorMessage,
+						)
+
+						require.Equal(t, int32(1), returnVal)
+						handles.requireError(t, managedTypes, vmhost.ErrSignalError, "upgrade error")
+
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithOwnerAddress(test.ParentAddress).
+				WithCodeMetadata(codeMetadata).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod(vmhost.ContractsUpgradeFunctionName, func() *mock.InstanceMock {
+						childInstance.Host.Runtime().SignalUserError("upgrade error")
+						return childInstance
+					})
+				}),
+		).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+		}).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("upgradeChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedUpgradeContractWithErrorReturn_OtherShard(t *testing.T) {
+	testConfig := makeTestConfig()
+	codeMetadata := []byte{vmcommon.MetadataUpgradeable, 0}
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("upgradeChild", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						handles := newErrorReturnHandles(managedTypes)
+
+						returnVal := vmhooks.NewVMHooksImpl(host).ManagedUpgradeContractWithErrorReturn(
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							int64(testConfig.GasProvidedToChild),
+							managedTypes.NewBigIntFromInt64(0),
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							managedTypes.NewManagedBufferFromBytes(codeMetadata),
+							managedTypes.NewManagedBuffer(),
+							managedTypes.NewManagedBuffer(),
+							handles.errorCode,
+							handles.errorMessage,
+						)
+
+						require.Equal(t, int32(1), returnVal)
+						handles.requireError(t, managedTypes, vmhost.ErrSyncExecutionNotInSameShard, vmhost.ErrSyncExecutionNotInSameShard.Error())
+
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithShardID(1).
+				WithOwnerAddress(test.ParentAddress).
+				WithCodeMetadata(codeMetadata).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod(vmhost.ContractsUpgradeFunctionName, func() *mock.InstanceMock {
+						require.Fail(t, "the contract of another shard must not be upgraded")
+						return childInstance
+					})
+				}),
+		).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+		}).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("upgradeChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedDeployFromSourceContractWithErrorReturn_InitFails(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("deployChild", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						handles := newErrorReturnHandles(managedTypes)
+						resultAddressHandle := managedTypes.NewManagedBuffer()
+
+						returnVal := vmhooks.NewVMHooksImpl(host).ManagedDeployFromSourceContractWithErrorReturn(
+							int64(testConfig.GasProvidedToChild),
+							managedTypes.NewBigIntFromInt64(0),
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							managedTypes.NewManagedBufferFromBytes([]byte{0, 0}),
+							managedTypes.NewManagedBuffer(),
+							resultAddressHandle,
+							managedTypes.NewManagedBuffer(),
+							handles.errorCode,
+							handles.errorMessage,
+						)
+
+						require.Equal(t, int32(1), returnVal)
+						handles.requireError(t, managedTypes, vmhost.ErrSignalError, "init error")
+
+						newAddress, err := managedTypes.GetBytes(resultAddressHandle)
+						require.Nil(t, err)
+						require.Empty(t, newAddress)
+
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod(vmhost.InitFunctionName, func() *mock.InstanceMock {
+						childInstance.Host.Runtime().SignalUserError("init error")
+						return childInstance
+					})
+				}),
+		).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+		}).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("deployChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedDeployFromSourceContractWithErrorReturn_RejectedBeforeInit(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("deployChild", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						handles := newErrorReturnHandles(managedTypes)
+						resultAddressHandle := managedTypes.NewManagedBuffer()
+
+						// a deployment is refused in read-only mode, before the new code is instantiated
+						host.Runtime().SetReadOnly(true)
+						returnVal := vmhooks.NewVMHooksImpl(host).ManagedDeployFromSourceContractWithErrorReturn(
+							int64(testConfig.GasProvidedToChild),
+							managedTypes.NewBigIntFromInt64(0),
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							managedTypes.NewManagedBufferFromBytes([]byte{0, 0}),
+							managedTypes.NewManagedBuffer(),
+							resultAddressHandle,
+							managedTypes.NewManagedBuffer(),
+							handles.errorCode,
+							handles.errorMessage,
+						)
+						host.Runtime().SetReadOnly(false)
+
+						require.Equal(t, int32(1), returnVal)
+						handles.requireError(t, managedTypes, vmhost.ErrInvalidCallOnReadOnlyMode, vmhost.ErrInvalidCallOnReadOnlyMode.Error())
+
+						newAddress, err := managedTypes.GetBytes(resultAddressHandle)
+						require.Nil(t, err)
+						require.Empty(t, newAddress)
+
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod(vmhost.InitFunctionName, func() *mock.InstanceMock {
+						require.Fail(t, "the rejected deployment must not run init")
+						return childInstance
+					})
+				}),
+		).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+		}).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("deployChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func Test_ManagedUpgradeFromSourceContractWithErrorReturn_UpgradeFails(t *testing.T) {
+	testConfig := makeTestConfig()
+	codeMetadata := []byte{vmcommon.MetadataUpgradeable, 0}
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *mock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("upgradeChild", func() *mock.InstanceMock {
+						host := parentInstance.Host
+						managedTypes := host.ManagedTypes()
+						handles := newErrorReturnHandles(managedTypes)
+
+						returnVal := vmhooks.NewVMHooksImpl(host).ManagedUpgradeFromSourceContractWithErrorReturn(
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							int64(testConfig.GasProvidedToChild),
+							managedTypes.NewBigIntFromInt64(0),
+							managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+							managedTypes.NewManagedBufferFromBytes(codeMetadata),
+							managedTypes.NewManagedBuffer(),
+							managedTypes.NewManagedBuffer(),
+							handles.errorCode,
+							handles.errorMessage,
+						)
+
+						require.Equal(t, int32(1), returnVal)
+						handles.requireError(t, managedTypes, vmhost.ErrSignalError, "upgrade error")
+
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithOwnerAddress(test.ParentAddress).
+				WithCodeMetadata(codeMetadata).
+				WithMethods(func(childInstance *mock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod(vmhost.ContractsUpgradeFunctionName, func() *mock.InstanceMock {
+						childInstance.Host.Runtime().SignalUserError("upgrade error")
+						return childInstance
+					})
+				}),
+		).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+		}).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("upgradeChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	assert.Nil(t, err)
+}
@@ -41,12 +41,17 @@ func Test_RunDEXPairBenchmark(t *testing.T) {
 			mex.ExecuteSwap(mexToWegldESDT, mexToWegldSwap)
 		}
 		elapsedTime := time.Since(start)
+		poolMetrics := host.Runtime().GetInstanceTracker().InstancePoolMetrics()
 		logBenchmark.Trace(
 			"swap batch finished",
 			"numSwapsPerBatch",
 			numSwapsPerBatch,
 			"duration",
 			elapsedTime,
+			"pooled instances reused",
+			poolMetrics.Hits,
+			"pooled instances missed",
+			poolMetrics.Misses,
 		)
 	}
 
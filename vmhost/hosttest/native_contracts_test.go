@@ -0,0 +1,181 @@
+package hostCoretest
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	"github.com/multiversx/mx-chain-vm-go/mock/contracts"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/stretchr/testify/require"
+)
+
+var nativeAddress = test.MakeTestSCAddressWithDefaultVM("nativeSC")
+
+var nativeCounterKey = []byte("counter")
+
+const nativeGasPerIncrement = uint64(50)
+
+// counterNativeContract keeps a counter in storage and can forward calls to other contracts
+type counterNativeContract struct {
+	gasForChild uint64
+}
+
+func (contract *counterNativeContract) FunctionNames() []string {
+	return []string{"increment", "callChild", "fail"}
+}
+
+func (contract *counterNativeContract) Call(ctx vmhost.NativeContext, function string) error {
+	switch function {
+	case "increment":
+		err := ctx.UseGas(nativeGasPerIncrement)
+		if err != nil {
+			return err
+		}
+		value, err := ctx.StorageLoad(nativeCounterKey)
+		if err != nil {
+			return err
+		}
+		counter := big.NewInt(0).SetBytes(value)
+		counter.Add(counter, big.NewInt(1))
+		err = ctx.StorageStore(nativeCounterKey, counter.Bytes())
+		if err != nil {
+			return err
+		}
+		return ctx.Finish(counter.Bytes())
+	case "callChild":
+		_, err := ctx.ExecuteOnDestContext(test.ChildAddress, "wasteGas", big.NewInt(0), nil, contract.gasForChild)
+		return err
+	case "fail":
+		return errors.New("native contract failed")
+	}
+
+	return errors.New("unknown function")
+}
+
+func (contract *counterNativeContract) IsInterfaceNil() bool {
+	return contract == nil
+}
+
+func nativeContracts(testConfig *test.TestConfig) map[string]vmhost.NativeContract {
+	return map[string]vmhost.NativeContract{
+		string(nativeAddress): &counterNativeContract{gasForChild: testConfig.GasProvidedToChild},
+	}
+}
+
+func createNativeAccount(world *worldmock.MockWorld) {
+	world.AcctMap.CreateAccount(nativeAddress, world)
+}
+
+func setZeroNativeCosts(host vmhost.VMHost) {
+	setZeroCodeCosts(host)
+	host.Metering().GasSchedule().BaseOpsAPICost.Finish = 0
+	host.Metering().GasSchedule().BaseOpsAPICost.CachedStorageLoad = 0
+}
+
+func TestNativeContract_DirectCall(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts().
+		WithNativeContracts(nativeContracts(testConfig)).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(nativeAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("increment").
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			createNativeAccount(world)
+			setZeroNativeCosts(host)
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData([]byte{1}).
+				GasRemaining(testConfig.GasProvided - nativeGasPerIncrement).
+				Storage(
+					test.CreateStoreEntry(nativeAddress).WithKey(nativeCounterKey).WithValue([]byte{1}),
+				)
+		})
+	require.Nil(t, err)
+}
+
+func TestNativeContract_UserError(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts().
+		WithNativeContracts(nativeContracts(testConfig)).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(nativeAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("fail").
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			createNativeAccount(world)
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.UserError().
+				ReturnMessage("native contract failed")
+		})
+	require.Nil(t, err)
+}
+
+func TestNativeContract_CalledThroughExecuteOnDestContext(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(contracts.ExecOnDestCtxParentMock)).
+		WithNativeContracts(nativeContracts(testConfig)).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("execOnDestCtx").
+			WithArguments(nativeAddress, []byte("increment"), big.NewInt(2).Bytes()).
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			createNativeAccount(world)
+			setZeroNativeCosts(host)
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				GasUsed(test.ParentAddress, testConfig.GasUsedByParent).
+				GasUsed(nativeAddress, 2*nativeGasPerIncrement).
+				Storage(
+					test.CreateStoreEntry(nativeAddress).WithKey(nativeCounterKey).WithValue([]byte{2}),
+				)
+		})
+	require.Nil(t, err)
+}
+
+func TestNativeContract_CallsWASMContract(t *testing.T) {
+	testConfig := makeTestConfig()
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithMethods(contracts.WasteGasChildMock)).
+		WithNativeContracts(nativeContracts(testConfig)).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(nativeAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("callChild").
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			createNativeAccount(world)
+			setZeroNativeCosts(host)
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				GasUsed(test.ChildAddress, testConfig.GasUsedByChild).
+				GasRemaining(testConfig.GasProvided - testConfig.GasUsedByChild)
+		})
+	require.Nil(t, err)
+}
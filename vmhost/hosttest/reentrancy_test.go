@@ -0,0 +1,329 @@
+package hostCoretest
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/txDataBuilder"
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var reentrancyRejected = []byte("reentrancy rejected")
+var reentered = []byte("reentered")
+
+func TestReentrancy_ExecuteOnDestContextRejected(t *testing.T) {
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			createReentrancyParentMock(t),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(1000).
+				WithMethods(func(childInstance *contextmock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("callParent", func() *contextmock.InstanceMock {
+						host := childInstance.Host
+						vmOutput, _, err := host.ExecuteOnDestContext(createReentrantInput("doSomething"))
+						require.True(t, errors.Is(err, vmhost.ErrReentrancyNotAllowed))
+						require.Equal(t, vmcommon.UserError, vmOutput.ReturnCode)
+						require.Equal(t, vmhost.ErrReentrancyNotAllowed.Error(), vmOutput.ReturnMessage)
+						host.Output().Finish(reentrancyRejected)
+						return childInstance
+					})
+				}),
+		).
+		WithInput(createReentrancyTestInput()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			protectAgainstReentrancy(host, world, test.ParentAddress, "")
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(reentrancyRejected).
+				HasRuntimeErrors(vmhost.ErrReentrancyNotAllowed.Error())
+		})
+	assert.Nil(t, err)
+}
+
+func TestReentrancy_ExecuteOnSameContextRejected(t *testing.T) {
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			createReentrancyParentMock(t),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(1000).
+				WithMethods(func(childInstance *contextmock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("callParent", func() *contextmock.InstanceMock {
+						host := childInstance.Host
+						err := host.ExecuteOnSameContext(createReentrantInput("doSomething"))
+						require.True(t, errors.Is(err, vmhost.ErrReentrancyNotAllowed))
+						host.Output().Finish(reentrancyRejected)
+						return childInstance
+					})
+				}),
+		).
+		WithInput(createReentrancyTestInput()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			protectAgainstReentrancy(host, world, test.ParentAddress, "doSomething")
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(reentrancyRejected).
+				HasRuntimeErrors(vmhost.ErrReentrancyNotAllowed.Error())
+		})
+	assert.Nil(t, err)
+}
+
+func TestReentrancy_UnprotectedEndpointAllowed(t *testing.T) {
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			createReentrancyParentMock(t),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(1000).
+				WithMethods(func(childInstance *contextmock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("callParent", func() *contextmock.InstanceMock {
+						host := childInstance.Host
+						vmOutput, _, err := host.ExecuteOnDestContext(createReentrantInput("doSomething"))
+						require.Nil(t, err)
+						require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+						return childInstance
+					})
+				}),
+		).
+		WithInput(createReentrancyTestInput()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			protectAgainstReentrancy(host, world, test.ParentAddress, "otherEndpoint")
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(reentered)
+		})
+	assert.Nil(t, err)
+}
+
+func TestReentrancy_AsyncCallRejectedCallbackAllowed(t *testing.T) {
+	testConfig := makeTestConfig()
+	testConfig.GasProvided = 1000
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *contextmock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("performAsyncCall", func() *contextmock.InstanceMock {
+						host := parentInstance.Host
+						callData := txDataBuilder.NewBuilder()
+						callData.Func("callParent")
+
+						err := host.Async().RegisterAsyncCall("testGroup", &vmhost.AsyncCall{
+							Status:          vmhost.AsyncCallPending,
+							Destination:     test.ChildAddress,
+							Data:            callData.ToBytes(),
+							ValueBytes:      big.NewInt(0).Bytes(),
+							SuccessCallback: testConfig.SuccessCallback,
+							ErrorCallback:   testConfig.ErrorCallback,
+							GasLimit:        testConfig.GasProvidedToChild,
+							GasLocked:       testConfig.GasToLock,
+						})
+						require.Nil(t, err)
+						return parentInstance
+					})
+					parentInstance.AddMockMethod("doSomething", func() *contextmock.InstanceMock {
+						parentInstance.Host.Output().Finish(reentered)
+						return parentInstance
+					})
+					parentInstance.AddMockMethod(testConfig.SuccessCallback, func() *contextmock.InstanceMock {
+						parentInstance.Host.Output().Finish([]byte("callback"))
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithMethods(func(childInstance *contextmock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("callParent", func() *contextmock.InstanceMock {
+						// the rejected reentrancy fails the async call, whose callback is still run on the parent
+						host := childInstance.Host
+						vmhooks.ExecuteOnDestContextWithTypedArgs(host, 100, big.NewInt(0), []byte("doSomething"), test.ParentAddress, nil, true)
+						return childInstance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("performAsyncCall").
+			WithCurrentTxHash([]byte("txhash")).
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+			setAsyncCosts(host, testConfig.GasLockCost)
+			protectAgainstReentrancy(host, world, test.ParentAddress, "")
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnDataContains([]byte("callback")).
+				ReturnDataDoesNotContain(reentered).
+				HasRuntimeErrors(vmhost.ErrReentrancyNotAllowed.Error())
+		})
+	assert.Nil(t, err)
+}
+
+func TestReentrancy_CheckChargedAsStorageLoad(t *testing.T) {
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			createReentrancyParentMock(t),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(1000).
+				WithMethods(func(childInstance *contextmock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("callParent", func() *contextmock.InstanceMock {
+						host := childInstance.Host
+						metering := host.Metering()
+						expectedCost, err := host.Storage().GetStorageLoadCost(0, metering.GasSchedule().BaseOpsAPICost.StorageLoad)
+						require.Nil(t, err)
+
+						gasLeft := metering.GasLeft()
+						vmOutput, _, err := host.ExecuteOnDestContext(createReentrantInput("doSomething"))
+						require.Nil(t, err)
+						require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+						require.Equal(t, expectedCost, gasLeft-metering.GasLeft())
+						return childInstance
+					})
+				}),
+		).
+		WithInput(createReentrancyTestInput()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			setZeroCodeCosts(host)
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok().
+				ReturnData(reentered)
+		})
+	assert.Nil(t, err)
+}
+
+func TestReentrancy_UpgradeClearsProtection(t *testing.T) {
+	codeMetadata := []byte{vmcommon.MetadataUpgradeable, 0}
+	var key []byte
+
+	runUpgrade := func(protectAtUpgrade bool) *vmcommon.VMOutput {
+		vmOutput, err := test.BuildMockInstanceCallTest(t).
+			WithContracts(
+				test.CreateMockContract(test.ParentAddress).
+					WithBalance(1000).
+					WithMethods(func(parentInstance *contextmock.InstanceMock, config interface{}) {
+						parentInstance.AddMockMethod("upgradeChild", func() *contextmock.InstanceMock {
+							host := parentInstance.Host
+							managedTypes := host.ManagedTypes()
+							returnVal := vmhooks.NewVMHooksImpl(host).ManagedUpgradeContractWithErrorReturn(
+								managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+								50_000,
+								managedTypes.NewBigIntFromInt64(0),
+								managedTypes.NewManagedBufferFromBytes(test.ChildAddress),
+								managedTypes.NewManagedBufferFromBytes(codeMetadata),
+								managedTypes.NewManagedBuffer(),
+								managedTypes.NewManagedBuffer(),
+								managedTypes.NewManagedBuffer(),
+								managedTypes.NewManagedBuffer(),
+							)
+							require.Equal(t, int32(0), returnVal)
+							return parentInstance
+						})
+					}),
+				test.CreateMockContract(test.ChildAddress).
+					WithBalance(1000).
+					WithOwnerAddress(test.ParentAddress).
+					WithCodeMetadata(codeMetadata).
+					WithMethods(func(childInstance *contextmock.InstanceMock, config interface{}) {
+						childInstance.AddMockMethod(vmhost.ContractsUpgradeFunctionName, func() *contextmock.InstanceMock {
+							if protectAtUpgrade {
+								host := childInstance.Host
+								endpointHandle := host.ManagedTypes().NewManagedBufferFromBytes([]byte("otherEndpoint"))
+								vmhooks.NewVMHooksImpl(host).ManagedProtectEndpointAgainstReentrancy(endpointHandle)
+							}
+							return childInstance
+						})
+					}),
+			).
+			WithInput(test.CreateTestContractCallInputBuilder().
+				WithRecipientAddr(test.ParentAddress).
+				WithGasProvided(100_000).
+				WithFunction("upgradeChild").
+				Build()).
+			WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+				setZeroCodeCosts(host)
+				protectAgainstReentrancy(host, world, test.ChildAddress, "")
+				key = host.Storage().GetVmProtectedPrefix(vmhost.ReentrancyProtectionKeyPrefix)
+			}).
+			AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+				verify.Ok()
+			})
+		assert.Nil(t, err)
+		return vmOutput
+	}
+
+	// the marks of the previous code are removed
+	vmOutput := runUpgrade(false)
+	storageUpdate := vmOutput.OutputAccounts[string(test.ChildAddress)].StorageUpdates[string(key)]
+	require.NotNil(t, storageUpdate)
+	require.Empty(t, storageUpdate.Data)
+
+	// only the marks set by the new code remain
+	vmOutput = runUpgrade(true)
+	storageUpdate = vmOutput.OutputAccounts[string(test.ChildAddress)].StorageUpdates[string(key)]
+	require.NotNil(t, storageUpdate)
+	require.Equal(t, vmhost.AddReentrancyProtection(nil, "otherEndpoint"), storageUpdate.Data)
+}
+
+// createReentrancyParentMock creates a parent contract which calls the child contract, while the child attempts to
+// call back the "doSomething" endpoint of the parent
+func createReentrancyParentMock(t *testing.T) test.MockTestSmartContract {
+	return test.CreateMockContract(test.ParentAddress).
+		WithBalance(1000).
+		WithMethods(func(parentInstance *contextmock.InstanceMock, config interface{}) {
+			parentInstance.AddMockMethod("callChild", func() *contextmock.InstanceMock {
+				host := parentInstance.Host
+				result := vmhooks.ExecuteOnDestContextWithTypedArgs(host, 50_000, big.NewInt(0), []byte("callParent"), test.ChildAddress, nil, true)
+				require.Equal(t, int32(0), result)
+				return parentInstance
+			})
+			parentInstance.AddMockMethod("doSomething", func() *contextmock.InstanceMock {
+				parentInstance.Host.Output().Finish(reentered)
+				return parentInstance
+			})
+		})
+}
+
+func createReentrancyTestInput() *vmcommon.ContractCallInput {
+	return test.CreateTestContractCallInputBuilder().
+		WithRecipientAddr(test.ParentAddress).
+		WithGasProvided(100_000).
+		WithFunction("callChild").
+		Build()
+}
+
+func createReentrantInput(function string) *vmcommon.ContractCallInput {
+	input := test.DefaultTestContractCallInput()
+	input.CallerAddr = test.ChildAddress
+	input.RecipientAddr = test.ParentAddress
+	input.CallValue = big.NewInt(0)
+	input.Function = function
+	input.GasProvided = 100
+	input.AsyncArguments = &vmcommon.AsyncArguments{
+		CallID:       []byte{},
+		CallerCallID: []byte{},
+	}
+	return input
+}
+
+// protectAgainstReentrancy marks a contract, or one of its endpoints, as protected, the way the reentrancy
+// protection hooks do during deployment
+func protectAgainstReentrancy(host vmhost.VMHost, world *worldmock.MockWorld, address []byte, endpoint string) {
+	key := host.Storage().GetVmProtectedPrefix(vmhost.ReentrancyProtectionKeyPrefix)
+	accountHandler, _ := world.GetUserAccount(address)
+	(accountHandler.(*worldmock.Account)).Storage[string(key)] = vmhost.AddReentrancyProtection(nil, endpoint)
+}
@@ -0,0 +1,113 @@
+package hostCoretest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructuredVMErrors_SyncCallFallible(t *testing.T) {
+	logEntry := runSyncCallFallibleWithFailingChild(t, true)
+	require.Len(t, logEntry.Data, 2)
+
+	report, err := vmhost.DecodeErrorReport(logEntry.Data[1])
+	require.Nil(t, err)
+	require.Equal(t, vmhost.ErrorReportVersion, report.Version)
+	require.Equal(t, test.ParentAddress, report.Contract)
+	require.Equal(t, "callChild", report.Function)
+
+	childError := findErrorReportEntry(report, vmhost.LookupError(vmhost.ErrArgOutOfRange).Code)
+	require.NotNil(t, childError)
+	require.Equal(t, vmhost.ErrorCategoryExecution, childError.Category)
+	require.Equal(t, "ArgOutOfRange", childError.Name)
+	require.Equal(t, vmhost.ErrArgOutOfRange.Error(), childError.Message)
+}
+
+func TestStructuredVMErrors_SyncCallFallibleFlagDisabled(t *testing.T) {
+	logEntry := runSyncCallFallibleWithFailingChild(t, false)
+	require.Len(t, logEntry.Data, 1)
+	require.Contains(t, string(logEntry.Data[0]), vmhost.ErrArgOutOfRange.Error())
+}
+
+// runSyncCallFallibleWithFailingChild runs a parent contract whose synchronous call of a failing child is fallible,
+// as in the fallible composability scenarios, and returns the internalVMErrors log entry of the call
+func runSyncCallFallibleWithFailingChild(t *testing.T, structuredErrors bool) *vmcommon.LogEntry {
+	testConfig := makeTestConfig()
+
+	var logEntry *vmcommon.LogEntry
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(testConfig.ParentBalance).
+				WithConfig(testConfig).
+				WithMethods(func(parentInstance *contextmock.InstanceMock, config interface{}) {
+					parentInstance.AddMockMethod("callChild", func() *contextmock.InstanceMock {
+						returnVal := vmhooks.ManagedExecuteOnDestContextWithErrorReturnWithHost(
+							parentInstance.Host,
+							int64(testConfig.GasProvidedToChild),
+							big.NewInt(0),
+							"childFunction",
+							test.ChildAddress,
+							[][]byte{},
+							0,
+						)
+						require.Equal(t, int32(1), returnVal)
+						return parentInstance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithBalance(testConfig.ChildBalance).
+				WithConfig(testConfig).
+				WithMethods(func(childInstance *contextmock.InstanceMock, config interface{}) {
+					childInstance.AddMockMethod("childFunction", func() *contextmock.InstanceMock {
+						childInstance.Host.Runtime().FailExecution(vmhost.ErrArgOutOfRange)
+						return childInstance
+					})
+				}),
+		).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			createMockBuiltinFunctions(t, host, world)
+			setZeroCodeCosts(host)
+			enableEpochsHandler := host.EnableEpochsHandler().(*worldmock.EnableEpochsHandlerStub)
+			enableEpochsHandler.IsFlagEnabledCalled = func(flag core.EnableEpochFlag) bool {
+				return structuredErrors || flag != vmhost.StructuredVMErrorsFlag
+			}
+		}).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(testConfig.GasProvided).
+			WithFunction("callChild").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+			for _, currentLog := range verify.VmOutput.Logs {
+				if string(currentLog.Identifier) == "internalVMErrors" {
+					logEntry = currentLog
+				}
+			}
+		})
+	assert.Nil(t, err)
+	require.NotNil(t, logEntry)
+
+	require.Equal(t, [][]byte{test.ParentAddress, []byte("callChild")}, logEntry.Topics)
+	return logEntry
+}
+
+func findErrorReportEntry(report *vmhost.ErrorReport, code vmhost.ErrorCode) *vmhost.ErrorReportEntry {
+	for i := range report.Errors {
+		if report.Errors[i].Code == code {
+			return &report.Errors[i]
+		}
+	}
+
+	return nil
+}
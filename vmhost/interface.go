@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-vm-go/config"
 	"github.com/multiversx/mx-chain-vm-go/crypto"
 	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/math"
 )
 
 // StateStack defines the functionality for working with a state stack
@@ -32,6 +33,7 @@ type CallArgsParser interface {
 // VMHost defines the functionality for working with the VM
 type VMHost interface {
 	vmcommon.VMExecutionHandler
+	RunSmartContractCallWithOverrides(input *vmcommon.ContractCallInput, overrides *StateOverrides) (*vmcommon.VMOutput, error)
 	Crypto() crypto.VMCrypto
 	Blockchain() BlockchainContext
 	Runtime() RuntimeContext
@@ -40,18 +42,25 @@ type VMHost interface {
 	Output() OutputContext
 	Metering() MeteringContext
 	Storage() StorageContext
+	TransientStorage() TransientStorageContext
 	EnableEpochsHandler() EnableEpochsHandler
 
 	ExecuteESDTTransfer(transfersArgs *ESDTTransfersArgs, callType vm.CallType) (*vmcommon.VMOutput, uint64, error)
 	CreateNewContract(input *vmcommon.ContractCreateInput, createContractCallType int) ([]byte, error)
+	CreateNewContractWithOutput(input *vmcommon.ContractCreateInput, createContractCallType int) ([]byte, *vmcommon.VMOutput, error)
 	ExecuteOnSameContext(input *vmcommon.ContractCallInput) error
 	ExecuteOnDestContext(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, bool, error)
 	IsBuiltinFunctionName(functionName string) bool
 	IsBuiltinFunctionCall(data []byte) bool
 	AreInSameShard(leftAddress []byte, rightAddress []byte) bool
 	IsAllowedToExecute(opcode string) bool
+	HasCallPolicy() bool
+	CheckCallPolicy(call *PolicyCall) error
+	GetNativeContract(address []byte) (NativeContract, bool)
 
 	GetGasScheduleMap() config.GasScheduleMap
+	ApplyGasSchedule(newGasSchedule config.GasScheduleMap) (config.GasScheduleDiff, error)
+	RollbackGasSchedule() (config.GasScheduleDiff, error)
 	GetContexts() (ManagedTypesContext, BlockchainContext, MeteringContext, OutputContext, RuntimeContext, AsyncContext, StorageContext)
 	SetRuntimeContext(runtime RuntimeContext)
 
@@ -111,6 +120,8 @@ type BlockchainContext interface {
 	RevertToSnapshot(snapshot int)
 	ClearCompiledCodes()
 	ExecuteSmartContractCallOnOtherVM(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error)
+	SetStateOverrides(overrides *StateOverrides)
+	GetStateOverrides() *StateOverrides
 }
 
 // RuntimeContext defines the functionality needed for interacting with the runtime context
@@ -123,6 +134,8 @@ type RuntimeContext interface {
 	SetCustomCallFunction(callFunction string)
 	GetVMInput() *vmcommon.ContractCallInput
 	SetVMInput(vmInput *vmcommon.ContractCallInput)
+	GetEthCallData() []byte
+	SetEthCallData(callData []byte)
 	GetContextAddress() []byte
 	GetOriginalCallerAddress() []byte
 	SetCodeAddress(scAddress []byte)
@@ -141,6 +154,8 @@ type RuntimeContext interface {
 	GetRuntimeBreakpointValue() BreakpointValue
 	GetInstanceStackSize() uint64
 	CountSameContractInstancesOnStack(address []byte) uint64
+	IsContractOnTheStack(address []byte) bool
+	GetCallStack() []CallFrame
 	IsFunctionImported(name string) bool
 	ReadOnly() bool
 	SetReadOnly(readOnly bool)
@@ -152,6 +167,8 @@ type RuntimeContext interface {
 	GetInstanceTracker() InstanceTracker
 	FunctionNameChecked() (string, error)
 	CallSCFunction(functionName string) error
+	SetFootprintTrace(footprintTrace bool)
+	GetInstanceFootprints() []*InstanceFootprint
 	GetPointsUsed() uint64
 	SetPointsUsed(gasPoints uint64)
 	UseGasBoundedShouldFailExecution() bool
@@ -170,11 +187,64 @@ type RuntimeContext interface {
 	ValidateInstances() error
 }
 
+// ForensicsWriter persists the state of the VM captured when an execution panicked or failed unexpectedly
+type ForensicsWriter interface {
+	WrapExecutorFactory(factory executor.ExecutorAbstractFactory) executor.ExecutorAbstractFactory
+	WriteForensics(bundle *ForensicsBundle) error
+	IsInterfaceNil() bool
+}
+
+// CallPolicy decides whether a call, a deployment or a transfer is allowed to happen. A non-nil error denies it,
+// and its message explains why to the caller.
+type CallPolicy interface {
+	CheckCall(call *PolicyCall) error
+	IsInterfaceNil() bool
+}
+
+// NativeContract is a contract implemented in Go and registered at a fixed address. The host runs it in place of
+// a WASM instance, so it can be called by transactions, through ExecuteOnDestContext and through async calls.
+type NativeContract interface {
+	FunctionNames() []string
+	Call(ctx NativeContext, function string) error
+	IsInterfaceNil() bool
+}
+
+// NativeContext gives a NativeContract metered access to the contexts of the host. Every operation consumes gas as
+// the equivalent VM hook would; once the execution has failed, e.g. by running out of gas, the remaining
+// operations are refused and the call ends with the failure, whatever the contract returns.
+type NativeContext interface {
+	SCAddress() []byte
+	Caller() []byte
+	Function() string
+	Arguments() [][]byte
+	CallValue() *big.Int
+	ESDTTransfers() []*vmcommon.ESDTTransfer
+	GasLeft() uint64
+	UseGas(gas uint64) error
+	StorageLoad(key []byte) ([]byte, error)
+	StorageStore(key []byte, value []byte) error
+	Finish(data []byte) error
+	WriteLog(topics [][]byte, data []byte) error
+	TransferValue(destination []byte, value *big.Int) error
+	TransferESDT(destination []byte, transfers []*vmcommon.ESDTTransfer) error
+	ExecuteOnDestContext(destination []byte, function string, value *big.Int, arguments [][]byte, gasLimit uint64) ([][]byte, error)
+	RegisterAsyncCall(call *NativeAsyncCall) error
+}
+
+// ExecutionProfiler collects the VM hooks and the instance footprints of each top-level execution
+type ExecutionProfiler interface {
+	WrapExecutorFactory(factory executor.ExecutorAbstractFactory) executor.ExecutorAbstractFactory
+	BeginExecution()
+	EndExecution(profile *ExecutionProfile)
+	IsInterfaceNil() bool
+}
+
 // InstanceTracker defines the functionality needed for interacting with the instance tracker
 type InstanceTracker interface {
 	StateStack
 
 	TrackedInstances() map[string]executor.Instance
+	InstancePoolMetrics() InstancePoolMetrics
 }
 
 // ManagedTypesContext defines the functionality needed for interacting with the big int context
@@ -182,6 +252,7 @@ type ManagedTypesContext interface {
 	StateStack
 
 	GetRandReader() io.Reader
+	GetSummary() ManagedTypesSummary
 	ConsumeGasForThisBigIntNumberOfBytes(byteLen *big.Int) error
 	ConsumeGasForThisIntNumberOfBytes(byteLen int) error
 	ConsumeGasForBytes(bytes []byte) error
@@ -199,6 +270,10 @@ type ManagedTypesContext interface {
 	GetBigFloatOrCreate(handle int32) (*big.Float, error)
 	GetBigFloat(handle int32) (*big.Float, error)
 	GetTwoBigFloats(handle1 int32, handle2 int32) (*big.Float, *big.Float, error)
+	GetDecimal(handle int32) (*math.Decimal, error)
+	GetTwoDecimals(handle1 int32, handle2 int32) (*math.Decimal, *math.Decimal, error)
+	SetDecimal(handle int32, value *math.Decimal) error
+	PutDecimal(value *math.Decimal) (int32, error)
 	PutEllipticCurve(ec *elliptic.CurveParams) int32
 	GetEllipticCurve(handle int32) (*elliptic.CurveParams, error)
 	GetEllipticCurveSizeOfField(ecHandle int32) int32
@@ -222,6 +297,7 @@ type ManagedTypesContext interface {
 	ManagedMapGet(mMapHandle int32, keyHandle int32, outValueHandle int32) error
 	ManagedMapRemove(mMapHandle int32, keyHandle int32, outValueHandle int32) error
 	ManagedMapContains(mMapHandle int32, keyHandle int32) (bool, error)
+	GetManagedMapLength(mMapHandle int32) (int, error)
 	GetBackTransfers() ([]*vmcommon.ESDTTransfer, *big.Int)
 	AddBackTransfers(value *big.Int, transfers []*vmcommon.ESDTTransfer, index uint32)
 	PopBackTransferIfAsyncCallBack(vmInput *vmcommon.ContractCallInput)
@@ -334,9 +410,18 @@ type StorageContext interface {
 	SetProtectedStorageToAddress(address []byte, key []byte, value []byte) (StorageStatus, error)
 	SetProtectedStorageToAddressUnmetered(address []byte, key []byte, value []byte) (StorageStatus, error)
 	UseGasForStorageLoad(tracedFunctionName string, trieDepth int64, blockchainLoadCost uint64, usedCache bool) error
+	GetStorageLoadCost(trieDepth int64, staticGasCost uint64) (uint64, error)
 	GetVmProtectedPrefix(prefix string) []byte
 }
 
+// TransientStorageContext defines the functionality needed for interacting with the transaction-scoped storage of contracts
+type TransientStorageContext interface {
+	StateStack
+
+	GetTransientStorage(address []byte, key []byte) []byte
+	SetTransientStorage(address []byte, key []byte, value []byte) error
+}
+
 // AsyncCallInfoHandler defines the functionality for working with AsyncCallInfo
 type AsyncCallInfoHandler interface {
 	GetDestination() []byte
@@ -240,3 +240,12 @@ func (b *BlockchainContextMock) ClearCompiledCodes() {
 func (b *BlockchainContextMock) ExecuteSmartContractCallOnOtherVM(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
 	return nil, nil
 }
+
+// SetStateOverrides -
+func (b *BlockchainContextMock) SetStateOverrides(_ *vmhost.StateOverrides) {
+}
+
+// GetStateOverrides -
+func (b *BlockchainContextMock) GetStateOverrides() *vmhost.StateOverrides {
+	return nil
+}
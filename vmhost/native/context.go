@@ -0,0 +1,275 @@
+package native
+
+import (
+	"fmt"
+	"math/big"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/txDataBuilder"
+	"github.com/multiversx/mx-chain-vm-go/config"
+	"github.com/multiversx/mx-chain-vm-go/math"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+)
+
+var _ vmhost.NativeContext = (*nativeContext)(nil)
+
+// nativeContext gives the native contracts access to the contexts of the host, charging the same gas as the
+// VM hooks used by the WASM contracts for the same operations
+type nativeContext struct {
+	host vmhost.VMHost
+}
+
+// SCAddress returns the address of the native contract
+func (context *nativeContext) SCAddress() []byte {
+	err := context.useGas("getSCAddress", context.baseOpsCost().GetSCAddress)
+	if err != nil {
+		return nil
+	}
+
+	return context.host.Runtime().GetContextAddress()
+}
+
+// Caller returns the address of the caller
+func (context *nativeContext) Caller() []byte {
+	err := context.useGas("getCaller", context.baseOpsCost().GetCaller)
+	if err != nil {
+		return nil
+	}
+
+	return context.host.Runtime().GetVMInput().CallerAddr
+}
+
+// Function returns the name of the called function
+func (context *nativeContext) Function() string {
+	err := context.useGas("getFunction", context.baseOpsCost().GetFunction)
+	if err != nil {
+		return ""
+	}
+
+	return context.host.Runtime().FunctionName()
+}
+
+// Arguments returns the arguments of the call
+func (context *nativeContext) Arguments() [][]byte {
+	arguments := context.host.Runtime().Arguments()
+	gasToUse := math.AddUint64(
+		context.baseOpsCost().GetNumArguments,
+		math.MulUint64(context.baseOpsCost().GetArgument, uint64(len(arguments))))
+	err := context.useGas("getArgument", gasToUse)
+	if err != nil {
+		return nil
+	}
+
+	return arguments
+}
+
+// CallValue returns the EGLD value transferred with the call
+func (context *nativeContext) CallValue() *big.Int {
+	err := context.useGas("getCallValue", context.baseOpsCost().GetCallValue)
+	if err != nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(context.host.Runtime().GetVMInput().CallValue)
+}
+
+// ESDTTransfers returns the tokens transferred with the call
+func (context *nativeContext) ESDTTransfers() []*vmcommon.ESDTTransfer {
+	transfers := context.host.Runtime().GetVMInput().ESDTTransfers
+	gasToUse := math.MulUint64(context.baseOpsCost().GetCallValue, uint64(len(transfers)+1))
+	err := context.useGas("getNumESDTTransfers", gasToUse)
+	if err != nil {
+		return nil
+	}
+
+	return transfers
+}
+
+// GasLeft returns the gas still available to the native contract
+func (context *nativeContext) GasLeft() uint64 {
+	return context.host.Metering().GasLeft()
+}
+
+// UseGas consumes gas for the computations done by the native contract itself
+func (context *nativeContext) UseGas(gas uint64) error {
+	return context.useGas("nativeUseGas", gas)
+}
+
+// StorageLoad reads a key from the storage of the native contract
+func (context *nativeContext) StorageLoad(key []byte) ([]byte, error) {
+	if context.hasFailed() {
+		return nil, context.failure()
+	}
+
+	value, err := vmhooks.StorageLoadWithWithTypedArgs(context.host, key)
+	if err != nil {
+		vmhooks.FailExecution(context.host, err)
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// StorageStore writes a key in the storage of the native contract
+func (context *nativeContext) StorageStore(key []byte, value []byte) error {
+	if context.hasFailed() {
+		return context.failure()
+	}
+
+	vmhooks.StorageStoreWithTypedArgs(context.host, key, value)
+	return context.checkFailure()
+}
+
+// Finish appends the given data to the results of the call
+func (context *nativeContext) Finish(data []byte) error {
+	gasToUse := math.AddUint64(
+		context.baseOpsCost().Finish,
+		math.MulUint64(context.host.Metering().GasSchedule().BaseOperationCost.PersistPerByte, uint64(len(data))))
+	err := context.useGas("finish", gasToUse)
+	if err != nil {
+		return err
+	}
+
+	context.host.Output().Finish(data)
+	return nil
+}
+
+// WriteLog saves an event with the given topics and data, emitted by the native contract
+func (context *nativeContext) WriteLog(topics [][]byte, data []byte) error {
+	dataLength := len(data)
+	for _, topic := range topics {
+		dataLength += len(topic)
+	}
+	gasToUse := math.AddUint64(
+		context.baseOpsCost().Log,
+		math.MulUint64(context.host.Metering().GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(dataLength)))
+	err := context.useGas("writeEventLog", gasToUse)
+	if err != nil {
+		return err
+	}
+
+	context.host.Output().WriteLog(context.host.Runtime().GetContextAddress(), topics, [][]byte{data})
+	return nil
+}
+
+// TransferValue sends EGLD from the native contract to the given destination
+func (context *nativeContext) TransferValue(destination []byte, value *big.Int) error {
+	if context.hasFailed() {
+		return context.failure()
+	}
+
+	vmhooks.TransferValueExecuteWithTypedArgs(context.host, destination, value, 0, nil, nil)
+	return context.checkFailure()
+}
+
+// TransferESDT sends tokens from the native contract to the given destination
+func (context *nativeContext) TransferESDT(destination []byte, transfers []*vmcommon.ESDTTransfer) error {
+	if context.hasFailed() {
+		return context.failure()
+	}
+
+	vmhooks.TransferESDTNFTExecuteWithTypedArgs(context.host, destination, transfers, 0, nil, nil)
+	return context.checkFailure()
+}
+
+// ExecuteOnDestContext calls another contract synchronously and returns its results. As for the WASM contracts,
+// a failure of the called contract also fails the native contract.
+func (context *nativeContext) ExecuteOnDestContext(
+	destination []byte,
+	function string,
+	value *big.Int,
+	arguments [][]byte,
+	gasLimit uint64,
+) ([][]byte, error) {
+	if context.hasFailed() {
+		return nil, context.failure()
+	}
+
+	numReturnDataBefore := len(context.host.Output().ReturnData())
+	vmhooks.ExecuteOnDestContextWithTypedArgs(
+		context.host,
+		int64(gasLimit),
+		value,
+		[]byte(function),
+		destination,
+		arguments,
+		true,
+	)
+	err := context.checkFailure()
+	if err != nil {
+		return nil, err
+	}
+
+	returnData := context.host.Output().ReturnData()
+	if len(returnData) < numReturnDataBefore {
+		return nil, nil
+	}
+
+	return returnData[numReturnDataBefore:], nil
+}
+
+// RegisterAsyncCall registers an async call, executed after the native contract returns
+func (context *nativeContext) RegisterAsyncCall(call *vmhost.NativeAsyncCall) error {
+	if context.hasFailed() {
+		return context.failure()
+	}
+
+	callData := txDataBuilder.NewBuilder()
+	callData.Func(call.Function)
+	for _, argument := range call.Arguments {
+		callData.Bytes(argument)
+	}
+
+	value := big.NewInt(0)
+	if call.Value != nil {
+		value = call.Value
+	}
+
+	vmhooks.CreateAsyncCallWithTypedArgs(
+		context.host,
+		call.Destination,
+		value.Bytes(),
+		callData.ToBytes(),
+		[]byte(call.SuccessCallback),
+		[]byte(call.ErrorCallback),
+		int64(call.GasLimit),
+		int64(call.GasForCallback),
+		nil,
+	)
+	return context.checkFailure()
+}
+
+func (context *nativeContext) baseOpsCost() *config.BaseOpsAPICost {
+	return &context.host.Metering().GasSchedule().BaseOpsAPICost
+}
+
+func (context *nativeContext) useGas(name string, gas uint64) error {
+	if context.hasFailed() {
+		return context.failure()
+	}
+
+	err := context.host.Metering().UseGasBoundedAndAddTracedGas(name, gas)
+	if err != nil {
+		vmhooks.FailExecution(context.host, err)
+		return err
+	}
+
+	return nil
+}
+
+func (context *nativeContext) hasFailed() bool {
+	return context.host.Runtime().GetRuntimeBreakpointValue() != vmhost.BreakpointNone
+}
+
+func (context *nativeContext) checkFailure() error {
+	if context.hasFailed() {
+		return context.failure()
+	}
+
+	return nil
+}
+
+func (context *nativeContext) failure() error {
+	return fmt.Errorf("%w: %s", vmhost.ErrExecutionFailed, context.host.Output().ReturnMessage())
+}
@@ -0,0 +1,172 @@
+package native
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+var _ executor.Instance = (*instance)(nil)
+
+// instance runs a NativeContract in place of a WASM instance; it has no memory and its gas is consumed
+// explicitly, through the NativeContext given to the contract
+type instance struct {
+	host       vmhost.VMHost
+	contract   vmhost.NativeContract
+	functions  map[string]struct{}
+	pointsUsed uint64
+	gasLimit   uint64
+	breakpoint uint64
+	vmHooksPtr uintptr
+	cleaned    bool
+}
+
+// NewInstance creates the instance executing the given native contract on the given host
+func NewInstance(host vmhost.VMHost, contract vmhost.NativeContract) *instance {
+	functions := make(map[string]struct{})
+	for _, function := range contract.FunctionNames() {
+		functions[function] = struct{}{}
+	}
+
+	return &instance{
+		host:      host,
+		contract:  contract,
+		functions: functions,
+	}
+}
+
+// CallFunction runs the given function of the native contract. An error returned by the contract is signalled as a
+// user error, while a failure caused by one of its operations stops the execution like a runtime breakpoint.
+func (instance *instance) CallFunction(functionName string) error {
+	context := &nativeContext{host: instance.host}
+	err := instance.contract.Call(context, functionName)
+	if vmhost.BreakpointValue(instance.breakpoint) != vmhost.BreakpointNone {
+		return fmt.Errorf("%w: native contract stopped at breakpoint %d", vmhost.ErrExecutionFailed, instance.breakpoint)
+	}
+	if err != nil {
+		instance.host.Runtime().SignalUserError(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// HasFunction returns true if the native contract exposes the given function
+func (instance *instance) HasFunction(functionName string) bool {
+	_, ok := instance.functions[functionName]
+	return ok
+}
+
+// GetFunctionNames returns the functions exposed by the native contract
+func (instance *instance) GetFunctionNames() []string {
+	return instance.contract.FunctionNames()
+}
+
+// ValidateFunctionArities does nothing, the native contracts read their arguments through the NativeContext
+func (instance *instance) ValidateFunctionArities() error {
+	return nil
+}
+
+// GetPointsUsed returns the gas used by the native contract
+func (instance *instance) GetPointsUsed() uint64 {
+	return instance.pointsUsed
+}
+
+// SetPointsUsed sets the gas used by the native contract
+func (instance *instance) SetPointsUsed(points uint64) {
+	instance.pointsUsed = points
+}
+
+// SetGasLimit sets the gas limit of the native contract
+func (instance *instance) SetGasLimit(gasLimit uint64) {
+	instance.gasLimit = gasLimit
+}
+
+// SetBreakpointValue sets the breakpoint which stops the native contract
+func (instance *instance) SetBreakpointValue(value uint64) {
+	instance.breakpoint = value
+}
+
+// GetBreakpointValue returns the breakpoint which stopped the native contract
+func (instance *instance) GetBreakpointValue() uint64 {
+	return instance.breakpoint
+}
+
+// Cache returns an error, the native contracts have no compiled code
+func (instance *instance) Cache() ([]byte, error) {
+	return nil, vmhost.ErrNativeContractWithoutCode
+}
+
+// Clean marks the instance as cleaned
+func (instance *instance) Clean() bool {
+	instance.cleaned = true
+	return true
+}
+
+// IsAlreadyCleaned returns true if the instance was cleaned
+func (instance *instance) IsAlreadyCleaned() bool {
+	return instance.cleaned
+}
+
+// Reset clears the gas used and the breakpoint of the instance
+func (instance *instance) Reset() bool {
+	instance.pointsUsed = 0
+	instance.breakpoint = uint64(vmhost.BreakpointNone)
+	return true
+}
+
+// HasMemory returns false, the native contracts have no WASM memory
+func (instance *instance) HasMemory() bool {
+	return false
+}
+
+// MemLoad returns an error, the native contracts have no WASM memory
+func (instance *instance) MemLoad(_ executor.MemPtr, _ executor.MemLength) ([]byte, error) {
+	return nil, vmhost.ErrNativeContractWithoutMemory
+}
+
+// MemStore returns an error, the native contracts have no WASM memory
+func (instance *instance) MemStore(_ executor.MemPtr, _ []byte) error {
+	return vmhost.ErrNativeContractWithoutMemory
+}
+
+// MemLength returns 0, the native contracts have no WASM memory
+func (instance *instance) MemLength() uint32 {
+	return 0
+}
+
+// MemGrow returns an error, the native contracts have no WASM memory
+func (instance *instance) MemGrow(_ uint32) error {
+	return vmhost.ErrNativeContractWithoutMemory
+}
+
+// MemDump returns nil, the native contracts have no WASM memory
+func (instance *instance) MemDump() []byte {
+	return nil
+}
+
+// IsFunctionImported returns false, the native contracts import no VM hooks
+func (instance *instance) IsFunctionImported(_ string) bool {
+	return false
+}
+
+// SetVMHooksPtr stores the VM hooks pointer, which is not used by the native contracts
+func (instance *instance) SetVMHooksPtr(vmHooksPtr uintptr) {
+	instance.vmHooksPtr = vmHooksPtr
+}
+
+// GetVMHooksPtr returns the stored VM hooks pointer
+func (instance *instance) GetVMHooksPtr() uintptr {
+	return instance.vmHooksPtr
+}
+
+// ID returns an identifier for the instance, unique at runtime
+func (instance *instance) ID() string {
+	return fmt.Sprintf("native-%p", instance)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (instance *instance) IsInterfaceNil() bool {
+	return instance == nil
+}
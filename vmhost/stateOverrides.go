@@ -0,0 +1,230 @@
+package vmhost
+
+import (
+	"math/big"
+)
+
+// StateOverrides replaces parts of the world for a simulated execution, such as a what-if query or a gas estimation.
+// The BlockchainContext consults the overrides before delegating to the BlockchainHook. Built-in functions run
+// by the BlockchainHook, such as the ESDT transfers, still see the real state.
+type StateOverrides struct {
+	// Accounts holds the account overrides, by address.
+	Accounts map[string]*AccountOverride
+
+	// CurrentBlock overrides the information about the current block.
+	CurrentBlock *BlockOverride
+}
+
+// AccountOverride replaces parts of an account. Nil fields are not overridden.
+type AccountOverride struct {
+	Balance      *big.Int
+	Code         []byte
+	OwnerAddress []byte
+
+	// CodeHash is the hash of the overridden code. The VM host always computes it from Code, ignoring the value set
+	// by the caller, so that the overridden code is never mistaken for another one by the compiled code caches.
+	CodeHash []byte
+
+	// Storage holds the overridden storage values, by key. An empty value overrides the key as missing.
+	Storage map[string][]byte
+
+	ESDTBalances []*ESDTBalanceOverride
+}
+
+// ESDTBalanceOverride replaces the balance of a token held by an account.
+type ESDTBalanceOverride struct {
+	TokenID []byte
+	Nonce   uint64
+	Balance *big.Int
+}
+
+// BlockOverride replaces parts of the block information. Nil fields are not overridden.
+type BlockOverride struct {
+	Nonce      *uint64
+	Round      *uint64
+	Epoch      *uint32
+	TimeStamp  *uint64
+	RandomSeed []byte
+}
+
+// Account returns the override of the given account, or nil if it is not overridden.
+func (overrides *StateOverrides) Account(address []byte) *AccountOverride {
+	if overrides == nil {
+		return nil
+	}
+
+	return overrides.Accounts[string(address)]
+}
+
+// Block returns the override of the current block, or nil if it is not overridden.
+func (overrides *StateOverrides) Block() *BlockOverride {
+	if overrides == nil {
+		return nil
+	}
+
+	return overrides.CurrentBlock
+}
+
+// Balance returns the overridden balance of the given account, if any.
+func (overrides *StateOverrides) Balance(address []byte) (*big.Int, bool) {
+	account := overrides.Account(address)
+	if account == nil || account.Balance == nil {
+		return nil, false
+	}
+
+	return big.NewInt(0).Set(account.Balance), true
+}
+
+// Code returns the overridden code of the given account, if any.
+func (overrides *StateOverrides) Code(address []byte) ([]byte, bool) {
+	account := overrides.Account(address)
+	if account == nil || account.Code == nil {
+		return nil, false
+	}
+
+	return account.Code, true
+}
+
+// OwnerAddress returns the overridden owner of the given account, if any.
+func (overrides *StateOverrides) OwnerAddress(address []byte) ([]byte, bool) {
+	account := overrides.Account(address)
+	if account == nil || account.OwnerAddress == nil {
+		return nil, false
+	}
+
+	return account.OwnerAddress, true
+}
+
+// Storage returns the overridden storage value of the given account and key, if any.
+func (overrides *StateOverrides) Storage(address []byte, key []byte) ([]byte, bool) {
+	account := overrides.Account(address)
+	if account == nil {
+		return nil, false
+	}
+
+	value, ok := account.Storage[string(key)]
+	return value, ok
+}
+
+// ESDTBalance returns the overridden balance of the given token held by the given account, if any.
+func (overrides *StateOverrides) ESDTBalance(address []byte, tokenID []byte, nonce uint64) (*big.Int, bool) {
+	account := overrides.Account(address)
+	if account == nil {
+		return nil, false
+	}
+
+	for _, esdtBalance := range account.ESDTBalances {
+		if esdtBalance.Nonce == nonce && string(esdtBalance.TokenID) == string(tokenID) && esdtBalance.Balance != nil {
+			return big.NewInt(0).Set(esdtBalance.Balance), true
+		}
+	}
+
+	return nil, false
+}
+
+// Clone creates a deep clone of the overrides, which the caller may change afterwards without affecting the clone.
+func (overrides *StateOverrides) Clone() *StateOverrides {
+	if overrides == nil {
+		return nil
+	}
+
+	clone := &StateOverrides{
+		Accounts: make(map[string]*AccountOverride, len(overrides.Accounts)),
+	}
+	for address, account := range overrides.Accounts {
+		clone.Accounts[address] = account.clone()
+	}
+	if overrides.CurrentBlock != nil {
+		clone.CurrentBlock = overrides.CurrentBlock.clone()
+	}
+
+	return clone
+}
+
+// ComputeCodeHashes sets the hash of every overridden code, replacing any hash already set.
+func (overrides *StateOverrides) ComputeCodeHashes(hasher HashComputer) {
+	if overrides == nil {
+		return
+	}
+
+	for _, account := range overrides.Accounts {
+		if account == nil {
+			continue
+		}
+
+		account.CodeHash = nil
+		if account.Code != nil {
+			account.CodeHash = hasher.Compute(string(account.Code))
+		}
+	}
+}
+
+func (account *AccountOverride) clone() *AccountOverride {
+	if account == nil {
+		return nil
+	}
+
+	clone := &AccountOverride{
+		Code:         cloneBytes(account.Code),
+		OwnerAddress: cloneBytes(account.OwnerAddress),
+		CodeHash:     cloneBytes(account.CodeHash),
+	}
+	if account.Balance != nil {
+		clone.Balance = big.NewInt(0).Set(account.Balance)
+	}
+	if account.Storage != nil {
+		clone.Storage = make(map[string][]byte, len(account.Storage))
+		for key, value := range account.Storage {
+			clone.Storage[key] = cloneBytes(value)
+		}
+	}
+	for _, esdtBalance := range account.ESDTBalances {
+		if esdtBalance == nil {
+			continue
+		}
+
+		esdtBalanceClone := &ESDTBalanceOverride{
+			TokenID: cloneBytes(esdtBalance.TokenID),
+			Nonce:   esdtBalance.Nonce,
+		}
+		if esdtBalance.Balance != nil {
+			esdtBalanceClone.Balance = big.NewInt(0).Set(esdtBalance.Balance)
+		}
+		clone.ESDTBalances = append(clone.ESDTBalances, esdtBalanceClone)
+	}
+
+	return clone
+}
+
+func (block *BlockOverride) clone() *BlockOverride {
+	clone := &BlockOverride{
+		RandomSeed: cloneBytes(block.RandomSeed),
+	}
+	if block.Nonce != nil {
+		nonce := *block.Nonce
+		clone.Nonce = &nonce
+	}
+	if block.Round != nil {
+		round := *block.Round
+		clone.Round = &round
+	}
+	if block.Epoch != nil {
+		epoch := *block.Epoch
+		clone.Epoch = &epoch
+	}
+	if block.TimeStamp != nil {
+		timeStamp := *block.TimeStamp
+		clone.TimeStamp = &timeStamp
+	}
+
+	return clone
+}
+
+// cloneBytes copies the given bytes, keeping nil apart from empty, since a nil field is not overridden
+func cloneBytes(data []byte) []byte {
+	if data == nil {
+		return nil
+	}
+
+	return append(make([]byte, 0, len(data)), data...)
+}
@@ -0,0 +1,147 @@
+package vmhost
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateOverrides_NilOverridesOverrideNothing(t *testing.T) {
+	t.Parallel()
+
+	var overrides *StateOverrides
+	require.Nil(t, overrides.Account([]byte("address")))
+	require.Nil(t, overrides.Block())
+
+	_, isOverridden := overrides.Balance([]byte("address"))
+	require.False(t, isOverridden)
+	_, isOverridden = overrides.Storage([]byte("address"), []byte("key"))
+	require.False(t, isOverridden)
+	_, isOverridden = overrides.ESDTBalance([]byte("address"), []byte("TOKEN-abcdef"), 0)
+	require.False(t, isOverridden)
+
+	overrides.ComputeCodeHashes(blake2b.NewBlake2b())
+}
+
+func TestStateOverrides_Lookups(t *testing.T) {
+	t.Parallel()
+
+	hasher := blake2b.NewBlake2b()
+	overrides := &StateOverrides{
+		Accounts: map[string]*AccountOverride{
+			"address": {
+				Balance:      big.NewInt(10),
+				Code:         []byte("code"),
+				OwnerAddress: []byte("owner"),
+				Storage: map[string][]byte{
+					"key":     []byte("value"),
+					"deleted": {},
+				},
+				ESDTBalances: []*ESDTBalanceOverride{
+					{TokenID: []byte("TOKEN-abcdef"), Nonce: 3, Balance: big.NewInt(5)},
+				},
+			},
+		},
+	}
+
+	balance, isOverridden := overrides.Balance([]byte("address"))
+	require.True(t, isOverridden)
+	require.Equal(t, big.NewInt(10), balance)
+	balance.SetInt64(11)
+	balance, _ = overrides.Balance([]byte("address"))
+	require.Equal(t, big.NewInt(10), balance)
+
+	_, isOverridden = overrides.Balance([]byte("other"))
+	require.False(t, isOverridden)
+
+	owner, isOverridden := overrides.OwnerAddress([]byte("address"))
+	require.True(t, isOverridden)
+	require.Equal(t, []byte("owner"), owner)
+
+	value, isOverridden := overrides.Storage([]byte("address"), []byte("key"))
+	require.True(t, isOverridden)
+	require.Equal(t, []byte("value"), value)
+	value, isOverridden = overrides.Storage([]byte("address"), []byte("deleted"))
+	require.True(t, isOverridden)
+	require.Empty(t, value)
+	_, isOverridden = overrides.Storage([]byte("address"), []byte("other"))
+	require.False(t, isOverridden)
+
+	esdtBalance, isOverridden := overrides.ESDTBalance([]byte("address"), []byte("TOKEN-abcdef"), 3)
+	require.True(t, isOverridden)
+	require.Equal(t, big.NewInt(5), esdtBalance)
+	_, isOverridden = overrides.ESDTBalance([]byte("address"), []byte("TOKEN-abcdef"), 0)
+	require.False(t, isOverridden)
+
+	overrides.ComputeCodeHashes(hasher)
+	require.Equal(t, hasher.Compute("code"), overrides.Account([]byte("address")).CodeHash)
+}
+
+func TestStateOverrides_ComputeCodeHashesIgnoresSuppliedHash(t *testing.T) {
+	t.Parallel()
+
+	hasher := blake2b.NewBlake2b()
+	overrides := &StateOverrides{
+		Accounts: map[string]*AccountOverride{
+			"withCode":    {Code: []byte("code"), CodeHash: []byte("real contract hash")},
+			"withoutCode": {CodeHash: []byte("real contract hash")},
+			"missing":     nil,
+		},
+	}
+
+	overrides.ComputeCodeHashes(hasher)
+	require.Equal(t, hasher.Compute("code"), overrides.Account([]byte("withCode")).CodeHash)
+	require.Nil(t, overrides.Account([]byte("withoutCode")).CodeHash)
+}
+
+func TestStateOverrides_Clone(t *testing.T) {
+	t.Parallel()
+
+	var nilOverrides *StateOverrides
+	require.Nil(t, nilOverrides.Clone())
+
+	nonce := uint64(7)
+	overrides := &StateOverrides{
+		Accounts: map[string]*AccountOverride{
+			"address": {
+				Balance:      big.NewInt(10),
+				Code:         []byte("code"),
+				OwnerAddress: []byte("owner"),
+				Storage:      map[string][]byte{"key": []byte("value")},
+				ESDTBalances: []*ESDTBalanceOverride{
+					{TokenID: []byte("TOKEN-abcdef"), Nonce: 3, Balance: big.NewInt(5)},
+				},
+			},
+			"empty": {Code: []byte{}},
+		},
+		CurrentBlock: &BlockOverride{Nonce: &nonce, RandomSeed: []byte("seed")},
+	}
+
+	clone := overrides.Clone()
+	require.Equal(t, overrides, clone)
+
+	account := overrides.Accounts["address"]
+	account.Balance.SetInt64(11)
+	account.Code[0] = 'C'
+	account.Storage["key"] = []byte("changed")
+	account.ESDTBalances[0].Balance.SetInt64(6)
+	overrides.Accounts["other"] = &AccountOverride{Balance: big.NewInt(1)}
+	nonce = 8
+
+	balance, _ := clone.Balance([]byte("address"))
+	require.Equal(t, big.NewInt(10), balance)
+	code, _ := clone.Code([]byte("address"))
+	require.Equal(t, []byte("code"), code)
+	value, _ := clone.Storage([]byte("address"), []byte("key"))
+	require.Equal(t, []byte("value"), value)
+	esdtBalance, _ := clone.ESDTBalance([]byte("address"), []byte("TOKEN-abcdef"), 3)
+	require.Equal(t, big.NewInt(5), esdtBalance)
+	require.Nil(t, clone.Account([]byte("other")))
+	require.Equal(t, uint64(7), *clone.Block().Nonce)
+
+	code, isOverridden := clone.Code([]byte("empty"))
+	require.True(t, isOverridden)
+	require.Empty(t, code)
+}
@@ -842,6 +842,25 @@ func TransferValueExecuteWithTypedArgs(
 	function []byte,
 	args [][]byte,
 ) int32 {
+	_, _, err := transferValueExecute(host, dest, value, gasLimit, function, args)
+	if err != nil {
+		FailExecution(host, err)
+		return 1
+	}
+
+	return 0
+}
+
+// transferValueExecute performs the transfer and the execution, without failing the current execution. On failure,
+// it also tells whether the state was left untouched, so that the error can be returned to the calling contract.
+func transferValueExecute(
+	host vmhost.VMHost,
+	dest []byte,
+	value *big.Int,
+	gasLimit int64,
+	function []byte,
+	args [][]byte,
+) (*vmcommon.VMOutput, bool, error) {
 	runtime := host.Runtime()
 	metering := host.Metering()
 	output := host.Output()
@@ -850,8 +869,7 @@ func TransferValueExecuteWithTypedArgs(
 	err := metering.UseGasBounded(gasToUse)
 
 	if err != nil && runtime.UseGasBoundedShouldFailExecution() {
-		FailExecution(host, err)
-		return 1
+		return nil, false, err
 	}
 
 	sender := runtime.GetContextAddress()
@@ -870,15 +888,13 @@ func TransferValueExecuteWithTypedArgs(
 			false,
 		)
 		if err != nil {
-			FailExecution(host, err)
-			return 1
+			return nil, false, err
 		}
 	}
 
 	if contractCallInput != nil {
 		if host.IsBuiltinFunctionName(contractCallInput.Function) {
-			FailExecution(host, vmhost.ErrNilESDTData)
-			return 1
+			return nil, false, vmhost.ErrNilESDTData
 		}
 	}
 
@@ -890,8 +906,7 @@ func TransferValueExecuteWithTypedArgs(
 	lastRound := host.Blockchain().LastRound()
 	if host.IsBuiltinFunctionCall([]byte(data)) &&
 		lastRound >= uint64(host.EnableEpochsHandler().GetActivationEpoch(vmhost.CheckBuiltInCallOnTransferValueAndFailExecutionFlag)) {
-		FailExecution(host, vmhost.ErrTransferValueOnESDTCall)
-		return 1
+		return nil, false, vmhost.ErrTransferValueOnESDTCall
 	}
 
 	if host.AreInSameShard(sender, dest) && contractCallInput != nil && host.Blockchain().IsSmartContract(dest) {
@@ -899,27 +914,34 @@ func TransferValueExecuteWithTypedArgs(
 		vmOutput, err := executeOnDestContextFromAPI(host, contractCallInput)
 		if err != nil {
 			logEEI.Trace("eGLD pre-transfer execution failed", "error", err)
-			FailExecution(host, err)
-			return 1
+			return vmOutput, isRevertedExecution(vmOutput), err
 		}
 		host.CompleteLogEntriesWithCallType(vmOutput, vmhost.TransferAndExecuteString)
 
-		return 0
+		return vmOutput, false, nil
 	}
 
 	err = metering.UseGasBounded(uint64(gasLimit))
 	if err != nil && runtime.UseGasBoundedShouldFailExecution() {
-		FailExecution(host, err)
-		return 1
+		return nil, false, err
 	}
+	isGasLimitUsed := err == nil
 
 	err = output.Transfer(dest, sender, uint64(gasLimit), 0, value, nil, []byte(data), vm.DirectCall)
 	if err != nil {
-		FailExecution(host, err)
-		return 1
+		// the transfer is checked before changing any balance
+		if isGasLimitUsed {
+			metering.RestoreGas(uint64(gasLimit))
+		}
+		return nil, true, err
 	}
 
-	return 0
+	return nil, false, nil
+}
+
+// isRevertedExecution is true when an indirect call was executed and failed, so all its changes were reverted
+func isRevertedExecution(vmOutput *vmcommon.VMOutput) bool {
+	return vmOutput != nil && vmOutput.ReturnCode != vmcommon.Ok
 }
 
 func makeCrossShardCallFromInput(function string, arguments [][]byte) string {
@@ -1731,6 +1753,45 @@ func upgradeContract(
 	}
 }
 
+// upgradeContractWithOutput upgrades a contract of the same shard synchronously, on its own context, so a failed
+// upgrade is reverted and returned instead of being handled by a callback. The upgrade of a contract of another shard
+// cannot be awaited, so it is returned as ErrSyncExecutionNotInSameShard.
+func upgradeContractWithOutput(
+	host vmhost.VMHost,
+	destContractAddress []byte,
+	code []byte,
+	codeMetadata []byte,
+	value *big.Int,
+	data [][]byte,
+	gasLimit int64,
+) (*vmcommon.VMOutput, error) {
+	sender := host.Runtime().GetContextAddress()
+	upgradeArguments := append([][]byte{code, codeMetadata}, data...)
+
+	contractCallInput, err := prepareIndirectContractCallInput(
+		host,
+		sender,
+		value,
+		gasLimit,
+		destContractAddress,
+		[]byte(vmhost.UpgradeFunctionName),
+		upgradeArguments,
+		0,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput, err := executeOnDestContextFromAPI(host, contractCallInput)
+	if err != nil {
+		return vmOutput, err
+	}
+	host.CompleteLogEntriesWithCallType(vmOutput, vmhost.UpgradeFromSourceString)
+
+	return vmOutput, nil
+}
+
 // DeleteContract VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) DeleteContract(
@@ -3614,6 +3675,33 @@ func createContract(
 	host vmhost.VMHost,
 	createContractCallType CreateContractCallType,
 ) ([]byte, error) {
+	contractCreate := newContractCreateInput(sender, data, value, gasLimit, code, codeMetadata, host)
+	return host.CreateNewContract(contractCreate, int(createContractCallType))
+}
+
+func createContractWithOutput(
+	sender []byte,
+	data [][]byte,
+	value *big.Int,
+	gasLimit int64,
+	code []byte,
+	codeMetadata []byte,
+	host vmhost.VMHost,
+	createContractCallType CreateContractCallType,
+) ([]byte, *vmcommon.VMOutput, error) {
+	contractCreate := newContractCreateInput(sender, data, value, gasLimit, code, codeMetadata, host)
+	return host.CreateNewContractWithOutput(contractCreate, int(createContractCallType))
+}
+
+func newContractCreateInput(
+	sender []byte,
+	data [][]byte,
+	value *big.Int,
+	gasLimit int64,
+	code []byte,
+	codeMetadata []byte,
+	host vmhost.VMHost,
+) *vmcommon.ContractCreateInput {
 	originalCaller := host.Runtime().GetOriginalCallerAddress()
 	metering := host.Metering()
 	contractCreate := &vmcommon.ContractCreateInput{
@@ -3635,7 +3723,7 @@ func createContract(
 		copy(contractCreate.RelayerAddr, currentVMInput.RelayerAddr)
 	}
 
-	return host.CreateNewContract(contractCreate, int(createContractCallType))
+	return contractCreate
 }
 
 // GetNumReturnData VMHooks implementation.
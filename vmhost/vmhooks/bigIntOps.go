@@ -52,6 +52,7 @@ const (
 	bigIntGetESDTExternalBalanceName  = "bigIntGetESDTExternalBalance"
 	bigIntGetExternalBalanceName      = "bigIntGetExternalBalance"
 	bigIntToStringName                = "bigIntToString"
+	bigIntSetRandomInRangeName        = "bigIntSetRandomInRange"
 )
 
 // BigIntGetUnsignedArgument VMHooks implementation.
@@ -1336,3 +1337,40 @@ func BigIntToStringWithHost(host vmhost.VMHost, bigIntHandle int32, destinationH
 
 	managedType.SetBytes(destinationHandle, []byte(resultStr))
 }
+
+// BigIntSetRandomInRange VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) BigIntSetRandomInRange(destinationHandle, minHandle, maxHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().BigIntAPICost.BigIntSetRandomInRange
+	err := metering.UseGasBoundedAndAddTracedGas(bigIntSetRandomInRangeName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	min, max, err := managedType.GetTwoBigInt(minHandle, maxHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = managedType.ConsumeGasForBigIntCopy(min, max)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	value, err := math.RandomBigIntInRange(managedType.GetRandReader(), min, max)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	dest := managedType.GetBigIntOrCreate(destinationHandle)
+	dest.Set(value)
+
+	return 0
+}
@@ -0,0 +1,455 @@
+package vmhooks
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/math"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+const (
+	ethGetCallDataSizeName   = "ethGetCallDataSize"
+	ethCallDataCopyName      = "ethCallDataCopy"
+	ethStorageStoreName      = "ethStorageStore"
+	ethStorageLoadName       = "ethStorageLoad"
+	ethGetCallValueName      = "ethGetCallValue"
+	ethGetCallerName         = "ethGetCaller"
+	ethGetAddressName        = "ethGetAddress"
+	ethGetTxOriginName       = "ethGetTxOrigin"
+	ethGetGasLeftName        = "ethGetGasLeft"
+	ethGetBlockNumberName    = "ethGetBlockNumber"
+	ethGetBlockTimestampName = "ethGetBlockTimestamp"
+	ethLogName               = "ethLog"
+	ethFinishName            = "ethFinish"
+	ethRevertName            = "ethRevert"
+)
+
+const (
+	ethWordLength    = 32
+	ethAddressLength = 20
+	ethMaxLogTopics  = 4
+)
+
+// The Ethereum compatibility layer exposes the functions of the Ethereum environment interface (EEI) used by
+// contracts compiled to WASM from Solidity. They share the import module of the other VM hooks, hence the "eth"
+// prefix of their names. Values and storage slots are 32-byte big-endian words, and addresses are written as the
+// 20 bytes reserved by the EEI, keeping the last 20 bytes of the native 32-byte addresses.
+//
+// Such contracts export only their "main" function, which dispatches on the call data itself. They are therefore
+// called through "main", with the call data given by the arguments: the 4-byte selector of "name(types)" followed
+// by the ABI encoded arguments, as built by the Ethereum tooling, either in a single argument or split across
+// several, which are concatenated unchanged.
+
+// EthGetCallDataSize VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthGetCallDataSize() int32 {
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().EthAPICost.GetCallDataSize
+	err := metering.UseGasBoundedAndAddTracedGas(ethGetCallDataSizeName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	callData, err := context.ethCallData(ethGetCallDataSizeName)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return int32(len(callData))
+}
+
+// EthCallDataCopy VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthCallDataCopy(resultOffset executor.MemPtr, dataOffset int32, length executor.MemLength) {
+	metering := context.GetMeteringContext()
+
+	if dataOffset < 0 || length < 0 {
+		context.FailExecution(vmhost.ErrNegativeLength)
+		return
+	}
+
+	copyGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(length))
+	gasToUse := math.AddUint64(metering.GasSchedule().EthAPICost.CallDataCopy, copyGas)
+	err := metering.UseGasBoundedAndAddTracedGas(ethCallDataCopyName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	callData, err := context.ethCallData(ethCallDataCopyName)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	// as in the EVM, the bytes past the end of the call data are read as zeros
+	result := make([]byte, length)
+	if int(dataOffset) < len(callData) {
+		copy(result, callData[dataOffset:])
+	}
+
+	err = context.MemStore(resultOffset, result)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+}
+
+// EthStorageStore VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthStorageStore(pathOffset executor.MemPtr, valueOffset executor.MemPtr) {
+	storage := context.GetStorageContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().EthAPICost.StorageStore
+	err := metering.UseGasBoundedAndAddTracedGas(ethStorageStoreName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	key, err := context.ethStorageKey(pathOffset)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	value, err := context.MemLoad(valueOffset, ethWordLength)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	// a slot set to zero is empty, as in the EVM, so it is removed from the storage
+	if isZeroWord(value) {
+		value = nil
+	}
+
+	_, err = storage.SetStorage(key, value)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+}
+
+// EthStorageLoad VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthStorageLoad(pathOffset executor.MemPtr, resultOffset executor.MemPtr) {
+	storage := context.GetStorageContext()
+	metering := context.GetMeteringContext()
+
+	key, err := context.ethStorageKey(pathOffset)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	value, trieDepth, usedCache, err := storage.GetStorage(key)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	err = storage.UseGasForStorageLoad(
+		ethStorageLoadName,
+		int64(trieDepth),
+		metering.GasSchedule().EthAPICost.StorageLoad,
+		usedCache)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	err = context.MemStore(resultOffset, toEthWord(value))
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+}
+
+// EthGetCallValue VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthGetCallValue(resultOffset executor.MemPtr) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().EthAPICost.GetCallValue
+	err := metering.UseGasBoundedAndAddTracedGas(ethGetCallValueName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	err = context.MemStore(resultOffset, toEthWord(runtime.GetVMInput().CallValue.Bytes()))
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+}
+
+// EthGetCaller VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthGetCaller(resultOffset executor.MemPtr) {
+	runtime := context.GetRuntimeContext()
+	gasToUse := context.GetMeteringContext().GasSchedule().EthAPICost.GetCaller
+	context.ethStoreAddress(ethGetCallerName, gasToUse, resultOffset, runtime.GetVMInput().CallerAddr)
+}
+
+// EthGetAddress VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthGetAddress(resultOffset executor.MemPtr) {
+	runtime := context.GetRuntimeContext()
+	gasToUse := context.GetMeteringContext().GasSchedule().EthAPICost.GetAddress
+	context.ethStoreAddress(ethGetAddressName, gasToUse, resultOffset, runtime.GetContextAddress())
+}
+
+// EthGetTxOrigin VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthGetTxOrigin(resultOffset executor.MemPtr) {
+	runtime := context.GetRuntimeContext()
+	gasToUse := context.GetMeteringContext().GasSchedule().EthAPICost.GetTxOrigin
+	context.ethStoreAddress(ethGetTxOriginName, gasToUse, resultOffset, runtime.GetVMInput().OriginalCallerAddr)
+}
+
+// EthGetGasLeft VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthGetGasLeft() int64 {
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().EthAPICost.GetGasLeft
+	err := metering.UseGasBoundedAndAddTracedGas(ethGetGasLeftName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return int64(metering.GasLeft())
+}
+
+// EthGetBlockNumber VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthGetBlockNumber() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().EthAPICost.GetBlockNumber
+	err := metering.UseGasBoundedAndAddTracedGas(ethGetBlockNumberName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return int64(blockchain.CurrentNonce())
+}
+
+// EthGetBlockTimestamp VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthGetBlockTimestamp() int64 {
+	blockchain := context.GetBlockchainContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().EthAPICost.GetBlockTimeStamp
+	err := metering.UseGasBoundedAndAddTracedGas(ethGetBlockTimestampName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return int64(blockchain.CurrentTimeStamp())
+}
+
+// EthLog VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthLog(
+	dataOffset executor.MemPtr,
+	dataLength executor.MemLength,
+	numberOfTopics int32,
+	topic1Offset executor.MemPtr,
+	topic2Offset executor.MemPtr,
+	topic3Offset executor.MemPtr,
+	topic4Offset executor.MemPtr,
+) {
+	runtime := context.GetRuntimeContext()
+	output := context.GetOutputContext()
+	metering := context.GetMeteringContext()
+
+	if numberOfTopics < 0 || dataLength < 0 {
+		context.FailExecution(vmhost.ErrNegativeLength)
+		return
+	}
+	if numberOfTopics > ethMaxLogTopics {
+		context.FailExecution(vmhost.ErrTooManyLogTopics)
+		return
+	}
+
+	persistGas := math.MulUint64(
+		metering.GasSchedule().BaseOperationCost.PersistPerByte,
+		uint64(numberOfTopics*ethWordLength+dataLength))
+	gasToUse := math.AddUint64(metering.GasSchedule().EthAPICost.Log, persistGas)
+	err := metering.UseGasBoundedAndAddTracedGas(ethLogName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	data, err := context.MemLoad(dataOffset, dataLength)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	topicOffsets := []executor.MemPtr{topic1Offset, topic2Offset, topic3Offset, topic4Offset}
+	topics := make([][]byte, numberOfTopics)
+	for i := range topics {
+		topics[i], err = context.MemLoad(topicOffsets[i], ethWordLength)
+		if err != nil {
+			context.FailExecution(err)
+			return
+		}
+	}
+
+	output.WriteLog(runtime.GetContextAddress(), topics, [][]byte{data})
+}
+
+// EthFinish VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthFinish(dataOffset executor.MemPtr, dataLength executor.MemLength) {
+	runtime := context.GetRuntimeContext()
+	output := context.GetOutputContext()
+	metering := context.GetMeteringContext()
+
+	persistGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.PersistPerByte, uint64(dataLength))
+	gasToUse := math.AddUint64(metering.GasSchedule().EthAPICost.Finish, persistGas)
+	err := metering.UseGasBoundedAndAddTracedGas(ethFinishName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	data, err := context.MemLoad(dataOffset, dataLength)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	// unlike finish, the Ethereum finish ends the execution
+	output.Finish(data)
+	runtime.SetRuntimeBreakpointValue(vmhost.BreakpointFinish)
+}
+
+// EthRevert VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) EthRevert(dataOffset executor.MemPtr, dataLength executor.MemLength) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+
+	persistGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.PersistPerByte, uint64(dataLength))
+	gasToUse := math.AddUint64(metering.GasSchedule().EthAPICost.Revert, persistGas)
+	err := metering.UseGasBoundedAndAddTracedGas(ethRevertName, gasToUse)
+	if err != nil && runtime.UseGasBoundedShouldFailExecution() {
+		context.FailExecution(err)
+		return
+	}
+
+	data, err := context.MemLoad(dataOffset, dataLength)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	// the revert data, usually an ABI encoded error, becomes the message of the user error
+	runtime.SignalUserError(string(data))
+}
+
+// ethCallData returns the Ethereum call data of the current call, the concatenation of its arguments, which already
+// hold the selector and the ABI encoded arguments. The call data is built only once per call, which then pays for
+// each of its bytes.
+func (context *VMHooksImpl) ethCallData(tracedFunctionName string) ([]byte, error) {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+
+	callData := runtime.GetEthCallData()
+	if callData != nil {
+		return callData, nil
+	}
+
+	vmInput := runtime.GetVMInput()
+	callDataLength := 0
+	for _, argument := range vmInput.Arguments {
+		callDataLength += len(argument)
+	}
+
+	gasToUse := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(callDataLength))
+	err := metering.UseGasBoundedAndAddTracedGas(tracedFunctionName, gasToUse)
+	if err != nil {
+		return nil, err
+	}
+
+	// never nil, so that empty call data is also built only once
+	callData = make([]byte, 0, callDataLength)
+	for _, argument := range vmInput.Arguments {
+		callData = append(callData, argument...)
+	}
+
+	runtime.SetEthCallData(callData)
+	return callData, nil
+}
+
+// ethStorageKey returns the storage key of the slot found at the given offset, the keccak256 hash of the slot,
+// which keeps the Ethereum storage apart from the keys written by the other storage functions
+func (context *VMHooksImpl) ethStorageKey(pathOffset executor.MemPtr) ([]byte, error) {
+	path, err := context.MemLoad(pathOffset, ethWordLength)
+	if err != nil {
+		return nil, err
+	}
+
+	return context.GetCryptoContext().Keccak256(path)
+}
+
+// ethStoreAddress writes the address in the 20 bytes reserved for it by the EEI, keeping its last 20 bytes
+func (context *VMHooksImpl) ethStoreAddress(tracedName string, gasToUse uint64, resultOffset executor.MemPtr, address []byte) {
+	metering := context.GetMeteringContext()
+
+	err := metering.UseGasBoundedAndAddTracedGas(tracedName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+
+	err = context.MemStore(resultOffset, toEthAddress(address))
+	if err != nil {
+		context.FailExecution(err)
+		return
+	}
+}
+
+// toEthAddress returns the address on 20 bytes, keeping the last 20 bytes of longer addresses
+func toEthAddress(address []byte) []byte {
+	ethAddress := make([]byte, ethAddressLength)
+	if len(address) > ethAddressLength {
+		address = address[len(address)-ethAddressLength:]
+	}
+	copy(ethAddress[ethAddressLength-len(address):], address)
+	return ethAddress
+}
+
+// toEthWord returns the value as a 32-byte big-endian word, keeping only the last 32 bytes of longer values
+func toEthWord(value []byte) []byte {
+	word := make([]byte, ethWordLength)
+	if len(value) > ethWordLength {
+		value = value[len(value)-ethWordLength:]
+	}
+	copy(word[ethWordLength-len(value):], value)
+	return word
+}
+
+func isZeroWord(word []byte) bool {
+	for _, b := range word {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
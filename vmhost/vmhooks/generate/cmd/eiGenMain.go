@@ -14,6 +14,8 @@ import (
 )
 
 const pathToApiPackage = "./"
+const pathToValidator = "../contexts/validator.go"
+const pathToFlags = "../flags.go"
 const pathToRustRepoConfigFile = "wasm-vm-executor-rs-path.txt"
 
 func initEIMetadata() *eapigen.EIMetadata {
@@ -25,6 +27,10 @@ func initEIMetadata() *eapigen.EIMetadata {
 			{SourcePath: "bigIntOps.go", Name: "BigInt"},
 			{SourcePath: "manBufOps.go", Name: "ManagedBuffer"},
 			{SourcePath: "manMapOps.go", Name: "ManagedMap"},
+			{SourcePath: "managedDecimalOps.go", Name: "ManagedDecimal"},
+			{SourcePath: "transientStorageOps.go", Name: "TransientStorage"},
+			{SourcePath: "reentrancyOps.go", Name: "Reentrancy"},
+			{SourcePath: "ethOps.go", Name: "Ethereum"},
 			{SourcePath: "smallIntOps.go", Name: "SmallInt"},
 			{SourcePath: "cryptoei.go", Name: "Crypto"},
 		},
@@ -41,9 +47,22 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	err = eapigen.ReadAndParseEIGasCosts(fset, pathToApiPackage, eiMetadata)
+	if err != nil {
+		panic(err)
+	}
+	err = eapigen.ReadAndParseEIKinds(fset, pathToApiPackage, eiMetadata)
+	if err != nil {
+		panic(err)
+	}
+	err = eapigen.ReadAndParseEIActivationFlags(fset, pathToApiPackage+pathToValidator, pathToApiPackage+pathToFlags, eiMetadata)
+	if err != nil {
+		panic(err)
+	}
 
 	writeVMHooks(eiMetadata)
 	writeVMHooksWrapper(eiMetadata)
+	writeVMHooksFuzz(eiMetadata)
 	writeWasmer2ImportsCgo(eiMetadata)
 	writeWasmer2Names(eiMetadata)
 
@@ -61,6 +80,8 @@ func main() {
 	writeRustWasmerExperimentalImports(eiMetadata)
 	writeRustVHDispatcherLegacy(eiMetadata)
 
+	writeManifest(eiMetadata)
+
 	fmt.Printf("Generated code for %d executor callback methods.\n", len(eiMetadata.AllFunctions))
 
 	writeExecutorOpcodeCosts()
@@ -88,6 +109,12 @@ func writeVMHooksWrapper(eiMetadata *eapigen.EIMetadata) {
 	eapigen.WriteVMHooksWrapper(out, eiMetadata)
 }
 
+func writeVMHooksFuzz(eiMetadata *eapigen.EIMetadata) {
+	out := eapigen.NewEIGenWriter(pathToApiPackage, "../vmhookstest/vmHooksFuzz_test.go")
+	defer out.Close()
+	eapigen.WriteVMHooksFuzz(out, eiMetadata)
+}
+
 func writeWasmer2ImportsCgo(eiMetadata *eapigen.EIMetadata) {
 	out := eapigen.NewEIGenWriter(pathToApiPackage, "../../wasmer2/wasmer2ImportsCgo.go")
 	defer out.Close()
@@ -173,6 +200,12 @@ func writeRustVHDispatcherLegacy(eiMetadata *eapigen.EIMetadata) {
 	eapigen.WriteRustVHDispatcherLegacy(out, eiMetadata)
 }
 
+func writeManifest(eiMetadata *eapigen.EIMetadata) {
+	out := eapigen.NewEIGenWriter(pathToApiPackage, "../../executor/vmHooksManifest.json")
+	defer out.Close()
+	eapigen.WriteManifest(out, eiMetadata)
+}
+
 func writeExecutorOpcodeCosts() {
 	out := eapigen.NewEIGenWriter(pathToApiPackage, "../../executor/gasCostWASM.go")
 	defer out.Close()
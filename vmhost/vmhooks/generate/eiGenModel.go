@@ -10,15 +10,57 @@ const (
 	EITypeInvalid
 )
 
+// EIKind is the semantic kind of an EI function argument, on top of its low-level type.
+type EIKind string
+
+const (
+	EIKindPlain                EIKind = "plain"
+	EIKindBigIntHandle         EIKind = "bigInt"
+	EIKindBigFloatHandle       EIKind = "bigFloat"
+	EIKindManagedBufferHandle  EIKind = "managedBuffer"
+	EIKindManagedMapHandle     EIKind = "managedMap"
+	EIKindManagedDecimalHandle EIKind = "managedDecimal"
+	EIKindEllipticCurveHandle  EIKind = "ellipticCurve"
+	EIKindAddress              EIKind = "address"
+	EIKindTokenID              EIKind = "tokenID"
+)
+
+// IsHandle returns true for the kinds referring to a managed type handle.
+func (kind EIKind) IsHandle() bool {
+	switch kind {
+	case EIKindBigIntHandle, EIKindBigFloatHandle, EIKindManagedBufferHandle,
+		EIKindManagedMapHandle, EIKindManagedDecimalHandle, EIKindEllipticCurveHandle:
+		return true
+	}
+	return false
+}
+
+// IsMemory returns true for the kinds referring to data in the WASM memory.
+func (kind EIKind) IsMemory() bool {
+	return kind == EIKindAddress || kind == EIKindTokenID
+}
+
 // EIFunctionArg models an executor callback method arg.
 type EIFunctionArg struct {
 	Name string
 	Type EIType
+
+	// Kind is the semantic kind of the argument, EIKindPlain if it could not be inferred
+	Kind EIKind
+
+	// Input is set for handles which are read by the function, so they must exist before the call
+	Input bool
+
+	// LengthArgument is the name of the argument holding the length of a memory kind, if it has one
+	LengthArgument string
 }
 
 // EIFunctionResult models the executor callback method result.
 type EIFunctionResult struct {
 	Type EIType
+
+	// Kind is the semantic kind of the result, EIKindPlain if it could not be inferred
+	Kind EIKind
 }
 
 // EIFunction holds data about one function in the VM EI.
@@ -26,6 +68,12 @@ type EIFunction struct {
 	Name      string
 	Arguments []*EIFunctionArg
 	Result    *EIFunctionResult
+
+	// GasCosts lists the config.GasCost fields charged by the function, as "Section.Field"
+	GasCosts []string
+
+	// ActivationFlag is the epoch flag which enables the function, empty if it is always active
+	ActivationFlag string
 }
 
 // EIGroup groups EI functions into bundles.
@@ -43,6 +43,7 @@ func extractEIFunctionArguments(decl *ast.FuncDecl) ([]*EIFunctionArg, error) {
 			arguments = append(arguments, &EIFunctionArg{
 				Name: name.String(),
 				Type: eiType,
+				Kind: EIKindPlain,
 			})
 		}
 
@@ -64,6 +65,7 @@ func extractEIFunctionResult(decl *ast.FuncDecl) (*EIFunctionResult, error) {
 		}
 		return &EIFunctionResult{
 			Type: eiType,
+			Kind: EIKindPlain,
 		}, nil
 	default:
 		return nil, fmt.Errorf("too many results in function %s, no more than 1 accepted", decl.Name.Name)
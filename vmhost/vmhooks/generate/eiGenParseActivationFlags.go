@@ -0,0 +1,125 @@
+package vmhooksgenerate
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+)
+
+const activationFlagsMapName = "reservedFunctionsActivationFlag"
+
+// ReadAndParseEIActivationFlags sets the activation flag of the EI functions listed in the
+// reservedFunctionsActivationFlag map of the validator, resolving the flag constants from the flags file.
+func ReadAndParseEIActivationFlags(fset *token.FileSet, pathToValidator string, pathToFlags string, eiMetadata *EIMetadata) error {
+	flagValues, err := parseFlagConstants(fset, pathToFlags)
+	if err != nil {
+		return err
+	}
+
+	f, err := parser.ParseFile(fset, pathToValidator, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	activationFlags, err := extractActivationFlags(f, flagValues)
+	if err != nil {
+		return err
+	}
+
+	for _, eiFunction := range eiMetadata.AllFunctions {
+		eiFunction.ActivationFlag = activationFlags[lowerInitial(eiFunction.Name)]
+	}
+
+	return nil
+}
+
+func parseFlagConstants(fset *token.FileSet, pathToFlags string) (map[string]string, error) {
+	f, err := parser.ParseFile(fset, pathToFlags, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	flagValues := make(map[string]string)
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for i, name := range valueSpec.Names {
+				if i >= len(valueSpec.Values) {
+					continue
+				}
+				literal, ok := valueSpec.Values[i].(*ast.BasicLit)
+				if !ok || literal.Kind != token.STRING {
+					continue
+				}
+				value, err := strconv.Unquote(literal.Value)
+				if err != nil {
+					return nil, err
+				}
+				flagValues[name.Name] = value
+			}
+		}
+	}
+
+	return flagValues, nil
+}
+
+func extractActivationFlags(f *ast.File, flagValues map[string]string) (map[string]string, error) {
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			if len(valueSpec.Names) != 1 || valueSpec.Names[0].Name != activationFlagsMapName || len(valueSpec.Values) != 1 {
+				continue
+			}
+			literal, ok := valueSpec.Values[0].(*ast.CompositeLit)
+			if !ok {
+				return nil, fmt.Errorf("%s is not a map literal", activationFlagsMapName)
+			}
+			return extractActivationFlagEntries(literal, flagValues)
+		}
+	}
+
+	return nil, fmt.Errorf("%s not found", activationFlagsMapName)
+}
+
+func extractActivationFlagEntries(literal *ast.CompositeLit, flagValues map[string]string) (map[string]string, error) {
+	activationFlags := make(map[string]string)
+	for _, element := range literal.Elts {
+		entry, ok := element.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, fmt.Errorf("invalid entry in %s", activationFlagsMapName)
+		}
+		key, ok := entry.Key.(*ast.BasicLit)
+		if !ok || key.Kind != token.STRING {
+			return nil, fmt.Errorf("invalid key in %s", activationFlagsMapName)
+		}
+		functionName, err := strconv.Unquote(key.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		flagName := ""
+		switch value := entry.Value.(type) {
+		case *ast.SelectorExpr:
+			flagName = value.Sel.Name
+		case *ast.Ident:
+			flagName = value.Name
+		}
+		flagValue, ok := flagValues[flagName]
+		if !ok {
+			return nil, fmt.Errorf("unknown activation flag for %s", functionName)
+		}
+		activationFlags[functionName] = flagValue
+	}
+
+	return activationFlags, nil
+}
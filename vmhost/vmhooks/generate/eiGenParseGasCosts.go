@@ -0,0 +1,193 @@
+package vmhooksgenerate
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-vm-go/config"
+)
+
+// gasCostFields holds the field names of each section of config.GasCost
+func gasCostFields() map[string]map[string]struct{} {
+	sections := make(map[string]map[string]struct{})
+	gasCostType := reflect.TypeOf(config.GasCost{})
+	for i := 0; i < gasCostType.NumField(); i++ {
+		section := gasCostType.Field(i)
+		if section.Type.Kind() != reflect.Struct {
+			continue
+		}
+
+		fields := make(map[string]struct{})
+		for j := 0; j < section.Type.NumField(); j++ {
+			fields[section.Type.Field(j).Name] = struct{}{}
+		}
+		sections[section.Name] = fields
+	}
+
+	return sections
+}
+
+// funcRef identifies a function of the package, or a method when it has a receiver type
+type funcRef struct {
+	receiver string
+	name     string
+}
+
+// ReadAndParseEIGasCosts finds the gas costs charged by each EI function, by looking for the config.GasCost fields
+// read in its body and, transitively, in the bodies of the functions of the package it calls. A call is followed
+// only when it resolves to a function of the package, or to a method of the same receiver, so that calling a method
+// of another package with the name of an EI function does not add the costs of that EI function.
+func ReadAndParseEIGasCosts(fset *token.FileSet, pathToSources string, eiMetadata *EIMetadata) error {
+	declarations, err := parsePackageFuncDecls(fset, pathToSources)
+	if err != nil {
+		return err
+	}
+
+	sections := gasCostFields()
+	for _, eiFunction := range eiMetadata.AllFunctions {
+		costs := make(map[string]struct{})
+		visited := make(map[funcRef]struct{})
+		collectGasCosts(eiFunctionRef(eiFunction.Name, declarations), declarations, sections, costs, visited)
+
+		eiFunction.GasCosts = make([]string, 0, len(costs))
+		for cost := range costs {
+			eiFunction.GasCosts = append(eiFunction.GasCosts, cost)
+		}
+		sort.Strings(eiFunction.GasCosts)
+	}
+
+	return nil
+}
+
+// eiFunctionRef returns the method implementing the EI function
+func eiFunctionRef(name string, declarations map[string][]*ast.FuncDecl) funcRef {
+	for _, decl := range declarations[name] {
+		receiver := receiverTypeName(decl)
+		if len(receiver) > 0 {
+			return funcRef{receiver: receiver, name: name}
+		}
+	}
+
+	return funcRef{name: name}
+}
+
+func parsePackageFuncDecls(fset *token.FileSet, pathToSources string) (map[string][]*ast.FuncDecl, error) {
+	entries, err := os.ReadDir(pathToSources)
+	if err != nil {
+		return nil, err
+	}
+
+	declarations := make(map[string][]*ast.FuncDecl)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, filepath.Join(pathToSources, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if ok && funcDecl.Body != nil {
+				declarations[funcDecl.Name.Name] = append(declarations[funcDecl.Name.Name], funcDecl)
+			}
+		}
+	}
+
+	return declarations, nil
+}
+
+func collectGasCosts(
+	ref funcRef,
+	declarations map[string][]*ast.FuncDecl,
+	sections map[string]map[string]struct{},
+	costs map[string]struct{},
+	visited map[funcRef]struct{},
+) {
+	if _, ok := visited[ref]; ok {
+		return
+	}
+	visited[ref] = struct{}{}
+
+	for _, decl := range declarations[ref.name] {
+		if receiverTypeName(decl) != ref.receiver {
+			continue
+		}
+
+		receiverName := receiverVariableName(decl)
+		ast.Inspect(decl.Body, func(node ast.Node) bool {
+			switch expr := node.(type) {
+			case *ast.SelectorExpr:
+				// matches gasSchedule.Section.Field and GasSchedule().Section.Field
+				inner, ok := expr.X.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				fields, isSection := sections[inner.Sel.Name]
+				if !isSection {
+					return true
+				}
+				if _, isField := fields[expr.Sel.Name]; isField {
+					costs[inner.Sel.Name+"."+expr.Sel.Name] = struct{}{}
+				}
+			case *ast.CallExpr:
+				callee, ok := resolveCallee(expr, ref.receiver, receiverName)
+				if ok {
+					collectGasCosts(callee, declarations, sections, costs, visited)
+				}
+			}
+			return true
+		})
+	}
+}
+
+// resolveCallee returns the function of the package called, if any: a plain call is a function of the package, while
+// a call through the receiver of the caller is a method of the same type. The calls through any other value are
+// made to other packages, whose gas costs are charged by their callers.
+func resolveCallee(call *ast.CallExpr, receiver string, receiverName string) (funcRef, bool) {
+	switch callee := call.Fun.(type) {
+	case *ast.Ident:
+		return funcRef{name: callee.Name}, true
+	case *ast.SelectorExpr:
+		if len(receiverName) > 0 && isIdentNamed(callee.X, receiverName) {
+			return funcRef{receiver: receiver, name: callee.Sel.Name}, true
+		}
+	}
+
+	return funcRef{}, false
+}
+
+// receiverTypeName returns the type of the receiver of a method, without the pointer, or an empty string for a function
+func receiverTypeName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) != 1 {
+		return ""
+	}
+
+	receiverType := decl.Recv.List[0].Type
+	star, ok := receiverType.(*ast.StarExpr)
+	if ok {
+		receiverType = star.X
+	}
+	ident, ok := receiverType.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+
+	return ident.Name
+}
+
+func receiverVariableName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) != 1 || len(decl.Recv.List[0].Names) != 1 {
+		return ""
+	}
+
+	return decl.Recv.List[0].Names[0].Name
+}
@@ -0,0 +1,88 @@
+package vmhooksgenerate
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const gasCostsTestSource = `package vmhooks
+
+type VMHooksImpl struct{}
+
+func (context *VMHooksImpl) Keccak256() {
+	_ = context.GetMeteringContext().GasSchedule().CryptoAPICost.Keccak256
+}
+
+func (context *VMHooksImpl) EthCallDataCopy() {
+	metering := context.GetMeteringContext()
+	_ = metering.GasSchedule().EthAPICost.CallDataCopy
+	_ = crypto.Keccak256(nil)
+	context.copyData()
+	persist()
+}
+
+func (context *VMHooksImpl) copyData() {
+	_ = context.GetMeteringContext().GasSchedule().BaseOperationCost.DataCopyPerByte
+	context.copyData()
+}
+
+func (context *VMHooksImpl) WriteLog() {
+	_ = context.GetMeteringContext().GasSchedule().BaseOpsAPICost.Log
+}
+
+func (context *VMHooksImpl) WriteEventLog() {
+	output := context.GetOutputContext()
+	output.WriteLog()
+}
+
+func persist() {
+	_ = gasSchedule.BaseOperationCost.PersistPerByte
+}
+
+func Keccak256() {
+	_ = gasSchedule.BaseOperationCost.CompilePerByte
+}
+`
+
+func parseTestGasCosts(t *testing.T, names ...string) map[string][]string {
+	pathToSources := t.TempDir()
+	err := os.WriteFile(filepath.Join(pathToSources, "hooks.go"), []byte(gasCostsTestSource), 0644)
+	require.Nil(t, err)
+
+	eiMetadata := &EIMetadata{}
+	for _, name := range names {
+		eiMetadata.AllFunctions = append(eiMetadata.AllFunctions, &EIFunction{Name: name})
+	}
+	err = ReadAndParseEIGasCosts(token.NewFileSet(), pathToSources, eiMetadata)
+	require.Nil(t, err)
+
+	gasCosts := make(map[string][]string)
+	for _, eiFunction := range eiMetadata.AllFunctions {
+		gasCosts[eiFunction.Name] = eiFunction.GasCosts
+	}
+	return gasCosts
+}
+
+func TestReadAndParseEIGasCosts_FollowsReceiverAndPackageCalls(t *testing.T) {
+	gasCosts := parseTestGasCosts(t, "EthCallDataCopy")
+	require.Equal(t, []string{
+		"BaseOperationCost.DataCopyPerByte",
+		"BaseOperationCost.PersistPerByte",
+		"EthAPICost.CallDataCopy",
+	}, gasCosts["EthCallDataCopy"])
+}
+
+func TestReadAndParseEIGasCosts_MethodNotConfusedWithFunction(t *testing.T) {
+	gasCosts := parseTestGasCosts(t, "Keccak256")
+	require.Equal(t, []string{"CryptoAPICost.Keccak256"}, gasCosts["Keccak256"])
+}
+
+func TestReadAndParseEIGasCosts_CallsOnOtherValuesNotFollowed(t *testing.T) {
+	gasCosts := parseTestGasCosts(t, "WriteLog", "WriteEventLog")
+	require.Equal(t, []string{"BaseOpsAPICost.Log"}, gasCosts["WriteLog"])
+	require.Empty(t, gasCosts["WriteEventLog"])
+}
@@ -0,0 +1,300 @@
+package vmhooksgenerate
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// handleUse describes how a managed types method uses one of its handle arguments
+type handleUse struct {
+	kind  EIKind
+	input bool
+}
+
+// managedTypesHandleUses lists, for the ManagedTypesContext methods receiving handles, the kind of each handle argument
+// by position and whether the method requires the handle to exist
+var managedTypesHandleUses = map[string]map[int]handleUse{
+	"GetBigIntOrCreate":   {0: {EIKindBigIntHandle, false}},
+	"GetBigInt":           {0: {EIKindBigIntHandle, true}},
+	"GetTwoBigInt":        {0: {EIKindBigIntHandle, true}, 1: {EIKindBigIntHandle, true}},
+	"GetBigFloatOrCreate": {0: {EIKindBigFloatHandle, false}},
+	"GetBigFloat":         {0: {EIKindBigFloatHandle, true}},
+	"GetTwoBigFloats":     {0: {EIKindBigFloatHandle, true}, 1: {EIKindBigFloatHandle, true}},
+	"GetDecimal":          {0: {EIKindManagedDecimalHandle, true}},
+	"GetTwoDecimals":      {0: {EIKindManagedDecimalHandle, true}, 1: {EIKindManagedDecimalHandle, true}},
+	"SetDecimal":          {0: {EIKindManagedDecimalHandle, false}},
+
+	"GetEllipticCurve":                         {0: {EIKindEllipticCurveHandle, true}},
+	"GetEllipticCurveSizeOfField":              {0: {EIKindEllipticCurveHandle, true}},
+	"Get100xCurveGasCostMultiplier":            {0: {EIKindEllipticCurveHandle, true}},
+	"GetScalarMult100xCurveGasCostMultiplier":  {0: {EIKindEllipticCurveHandle, true}},
+	"GetUCompressed100xCurveGasCostMultiplier": {0: {EIKindEllipticCurveHandle, true}},
+	"GetPrivateKeyByteLengthEC":                {0: {EIKindEllipticCurveHandle, true}},
+
+	"SetBytes":                        {0: {EIKindManagedBufferHandle, false}},
+	"GetBytes":                        {0: {EIKindManagedBufferHandle, true}},
+	"AppendBytes":                     {0: {EIKindManagedBufferHandle, true}},
+	"GetLength":                       {0: {EIKindManagedBufferHandle, true}},
+	"GetSlice":                        {0: {EIKindManagedBufferHandle, true}},
+	"DeleteSlice":                     {0: {EIKindManagedBufferHandle, true}},
+	"InsertSlice":                     {0: {EIKindManagedBufferHandle, true}},
+	"ReadManagedVecOfManagedBuffers":  {0: {EIKindManagedBufferHandle, true}},
+	"WriteManagedVecOfManagedBuffers": {1: {EIKindManagedBufferHandle, false}},
+
+	"ManagedMapPut":      {0: {EIKindManagedMapHandle, true}, 1: {EIKindManagedBufferHandle, true}, 2: {EIKindManagedBufferHandle, true}},
+	"ManagedMapGet":      {0: {EIKindManagedMapHandle, true}, 1: {EIKindManagedBufferHandle, true}, 2: {EIKindManagedBufferHandle, false}},
+	"ManagedMapRemove":   {0: {EIKindManagedMapHandle, true}, 1: {EIKindManagedBufferHandle, true}, 2: {EIKindManagedBufferHandle, false}},
+	"ManagedMapContains": {0: {EIKindManagedMapHandle, true}, 1: {EIKindManagedBufferHandle, true}},
+}
+
+// managedTypesHandleCreators lists the ManagedTypesContext methods returning a new handle, with its kind
+var managedTypesHandleCreators = map[string]EIKind{
+	"NewBigInt":                 EIKindBigIntHandle,
+	"NewBigIntFromInt64":        EIKindBigIntHandle,
+	"PutBigFloat":               EIKindBigFloatHandle,
+	"PutDecimal":                EIKindManagedDecimalHandle,
+	"PutEllipticCurve":          EIKindEllipticCurveHandle,
+	"NewManagedBuffer":          EIKindManagedBufferHandle,
+	"NewManagedBufferFromBytes": EIKindManagedBufferHandle,
+	"NewManagedMap":             EIKindManagedMapHandle,
+}
+
+// ReadAndParseEIKinds infers the semantic kind of the arguments and results of each EI function, by looking at how
+// its body uses them, following the calls to the functions of the package:
+//   - handles passed to the managed types context get the kind of the managed type, and are inputs if the method
+//     requires them to exist;
+//   - pointers loaded with vmhost.AddressLen are addresses, pointers to token identifiers are token IDs;
+//   - results returned straight from the creation of a managed type are handles of that type.
+func ReadAndParseEIKinds(fset *token.FileSet, pathToSources string, eiMetadata *EIMetadata) error {
+	declarations, err := parsePackageFuncDecls(fset, pathToSources)
+	if err != nil {
+		return err
+	}
+
+	for _, eiFunction := range eiMetadata.AllFunctions {
+		for argIndex, arg := range eiFunction.Arguments {
+			switch arg.Type {
+			case EITypeInt32, EITypeInt64:
+				use, found := findHandleUse(eiFunction.Name, argIndex, declarations, make(map[string]struct{}))
+				if found {
+					arg.Kind = use.kind
+					arg.Input = use.input
+				}
+			case EITypeMemPtr:
+				kind, lengthIndex := findMemoryKind(eiFunction.Name, argIndex, declarations, make(map[string]struct{}))
+				arg.Kind = kind
+				if lengthIndex >= 0 && lengthIndex < len(eiFunction.Arguments) {
+					arg.LengthArgument = eiFunction.Arguments[lengthIndex].Name
+				}
+			}
+		}
+
+		if eiFunction.Result != nil {
+			eiFunction.Result.Kind = findResultKind(eiFunction.Name, declarations)
+		}
+	}
+
+	return nil
+}
+
+func paramNames(decl *ast.FuncDecl) []string {
+	var names []string
+	for _, param := range decl.Type.Params.List {
+		for _, name := range param.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return names
+}
+
+func calleeName(call *ast.CallExpr) string {
+	switch callee := call.Fun.(type) {
+	case *ast.Ident:
+		return callee.Name
+	case *ast.SelectorExpr:
+		return callee.Sel.Name
+	}
+	return ""
+}
+
+func isIdentNamed(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// findHandleUse looks for the first use of the parameter at the given position of the function as a handle. Once
+// a kind is found, the parameter is an input if any of its uses requires the handle to exist.
+func findHandleUse(
+	funcName string,
+	paramIndex int,
+	declarations map[string][]*ast.FuncDecl,
+	visited map[string]struct{},
+) (handleUse, bool) {
+	visitKey := fmt.Sprintf("%s/%d", funcName, paramIndex)
+	if _, ok := visited[visitKey]; ok {
+		return handleUse{}, false
+	}
+	visited[visitKey] = struct{}{}
+
+	result := handleUse{}
+	found := false
+	for _, decl := range declarations[funcName] {
+		names := paramNames(decl)
+		if paramIndex >= len(names) {
+			continue
+		}
+		paramName := names[paramIndex]
+
+		ast.Inspect(decl.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			name := calleeName(call)
+			for argIndex, arg := range call.Args {
+				if !isIdentNamed(arg, paramName) {
+					continue
+				}
+
+				use, isHandle := managedTypesHandleUses[name][argIndex]
+				if !isHandle {
+					use, isHandle = findHandleUse(name, argIndex, declarations, visited)
+				}
+				if !isHandle {
+					continue
+				}
+				if !found {
+					result = use
+					found = true
+				}
+				result.input = result.input || (use.input && use.kind == result.kind)
+			}
+			return true
+		})
+	}
+
+	return result, found
+}
+
+// findMemoryKind recognizes the pointers to addresses and to token identifiers loaded by the function, or by the
+// functions of the package it passes them to. For token IDs, it also returns the position of the argument holding
+// their length, or -1 if it is not an argument of the function.
+func findMemoryKind(
+	funcName string,
+	paramIndex int,
+	declarations map[string][]*ast.FuncDecl,
+	visited map[string]struct{},
+) (EIKind, int) {
+	visitKey := fmt.Sprintf("%s/%d", funcName, paramIndex)
+	if _, ok := visited[visitKey]; ok {
+		return EIKindPlain, -1
+	}
+	visited[visitKey] = struct{}{}
+
+	kind := EIKindPlain
+	lengthIndex := -1
+	for _, decl := range declarations[funcName] {
+		names := paramNames(decl)
+		if paramIndex >= len(names) {
+			continue
+		}
+		paramName := names[paramIndex]
+
+		ast.Inspect(decl.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || kind != EIKindPlain {
+				return true
+			}
+			name := calleeName(call)
+			if name == "MemLoad" && len(call.Args) == 2 && isIdentNamed(call.Args[0], paramName) {
+				kind, lengthIndex = memoryKindOfLoad(paramName, call.Args[1], names)
+				return true
+			}
+			for argIndex, arg := range call.Args {
+				if !isIdentNamed(arg, paramName) {
+					continue
+				}
+				calleeKind, calleeLengthIndex := findMemoryKind(name, argIndex, declarations, visited)
+				if calleeKind == EIKindPlain {
+					continue
+				}
+				kind = calleeKind
+				if calleeLengthIndex >= 0 && calleeLengthIndex < len(call.Args) {
+					lengthIndex = paramIndexOf(call.Args[calleeLengthIndex], names)
+				}
+				break
+			}
+			return true
+		})
+	}
+
+	return kind, lengthIndex
+}
+
+func memoryKindOfLoad(paramName string, length ast.Expr, names []string) (EIKind, int) {
+	switch length := length.(type) {
+	case *ast.SelectorExpr:
+		if length.Sel.Name == "AddressLen" {
+			return EIKindAddress, -1
+		}
+	case *ast.Ident:
+		if strings.Contains(strings.ToLower(paramName), "tokenid") {
+			return EIKindTokenID, paramIndexOf(length, names)
+		}
+	}
+	return EIKindPlain, -1
+}
+
+func paramIndexOf(expr ast.Expr, names []string) int {
+	for index, name := range names {
+		if isIdentNamed(expr, name) {
+			return index
+		}
+	}
+	return -1
+}
+
+// findResultKind recognizes the functions returning a newly created managed type handle, either directly or through
+// a local variable
+func findResultKind(funcName string, declarations map[string][]*ast.FuncDecl) EIKind {
+	kind := EIKindPlain
+	for _, decl := range declarations[funcName] {
+		createdHandles := make(map[string]EIKind)
+		ast.Inspect(decl.Body, func(node ast.Node) bool {
+			switch stmt := node.(type) {
+			case *ast.AssignStmt:
+				if len(stmt.Rhs) != 1 || len(stmt.Lhs) == 0 {
+					return true
+				}
+				call, ok := stmt.Rhs[0].(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				createdKind, isCreator := managedTypesHandleCreators[calleeName(call)]
+				handle, isIdent := stmt.Lhs[0].(*ast.Ident)
+				if isCreator && isIdent {
+					createdHandles[handle.Name] = createdKind
+				}
+			case *ast.ReturnStmt:
+				if len(stmt.Results) != 1 {
+					return true
+				}
+				switch result := stmt.Results[0].(type) {
+				case *ast.CallExpr:
+					if createdKind, isCreator := managedTypesHandleCreators[calleeName(result)]; isCreator {
+						kind = createdKind
+					}
+				case *ast.Ident:
+					if createdKind, isCreated := createdHandles[result.Name]; isCreated {
+						kind = createdKind
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	return kind
+}
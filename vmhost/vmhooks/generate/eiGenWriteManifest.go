@@ -0,0 +1,119 @@
+package vmhooksgenerate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// ManifestSchemaVersion is incremented whenever the layout of the VM hooks manifest changes
+const ManifestSchemaVersion = 2
+
+type manifestArgument struct {
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	WasmType       string `json:"wasmType"`
+	Kind           string `json:"kind"`
+	Input          bool   `json:"input,omitempty"`
+	LengthArgument string `json:"lengthArgument,omitempty"`
+}
+
+type manifestResult struct {
+	Type     string `json:"type"`
+	WasmType string `json:"wasmType"`
+	Kind     string `json:"kind"`
+}
+
+type manifestHook struct {
+	Name           string              `json:"name"`
+	Group          string              `json:"group"`
+	Arguments      []*manifestArgument `json:"arguments"`
+	Result         *manifestResult     `json:"result"`
+	GasCosts       []string            `json:"gasCosts"`
+	ActivationFlag string              `json:"activationFlag,omitempty"`
+}
+
+type manifest struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	InterfaceHash string          `json:"interfaceHash"`
+	Hooks         []*manifestHook `json:"hooks"`
+}
+
+// WriteManifest writes the JSON manifest of the VM hooks, meant for external tools. The interface hash only
+// covers the names and signatures of the hooks, it changes when the executor interface changes.
+func WriteManifest(out *eiGenWriter, eiMetadata *EIMetadata) {
+	hooks := make([]*manifestHook, 0, len(eiMetadata.AllFunctions))
+	for _, group := range eiMetadata.Groups {
+		for _, funcMetadata := range group.Functions {
+			hooks = append(hooks, makeManifestHook(group.Name, funcMetadata))
+		}
+	}
+
+	data, err := json.MarshalIndent(&manifest{
+		SchemaVersion: ManifestSchemaVersion,
+		InterfaceHash: interfaceHash(eiMetadata),
+		Hooks:         hooks,
+	}, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	out.WriteString(string(data))
+	out.WriteString("\n")
+}
+
+func makeManifestHook(groupName string, funcMetadata *EIFunction) *manifestHook {
+	hook := &manifestHook{
+		Name:           lowerInitial(funcMetadata.Name),
+		Group:          groupName,
+		Arguments:      make([]*manifestArgument, 0, len(funcMetadata.Arguments)),
+		GasCosts:       funcMetadata.GasCosts,
+		ActivationFlag: funcMetadata.ActivationFlag,
+	}
+	if hook.GasCosts == nil {
+		hook.GasCosts = make([]string, 0)
+	}
+
+	for _, arg := range funcMetadata.Arguments {
+		hook.Arguments = append(hook.Arguments, &manifestArgument{
+			Name:           arg.Name,
+			Type:           vmHooksType(arg.Type),
+			WasmType:       rustCapiType(arg.Type),
+			Kind:           string(arg.Kind),
+			Input:          arg.Input,
+			LengthArgument: arg.LengthArgument,
+		})
+	}
+	if funcMetadata.Result != nil {
+		hook.Result = &manifestResult{
+			Type:     vmHooksType(funcMetadata.Result.Type),
+			WasmType: rustCapiType(funcMetadata.Result.Type),
+			Kind:     string(funcMetadata.Result.Kind),
+		}
+	}
+
+	return hook
+}
+
+func interfaceHash(eiMetadata *EIMetadata) string {
+	var sb strings.Builder
+	for _, funcMetadata := range eiMetadata.AllFunctions {
+		sb.WriteString(lowerInitial(funcMetadata.Name))
+		sb.WriteString("(")
+		for i, arg := range funcMetadata.Arguments {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			sb.WriteString(vmHooksType(arg.Type))
+		}
+		sb.WriteString(")")
+		if funcMetadata.Result != nil {
+			sb.WriteString(vmHooksType(funcMetadata.Result.Type))
+		}
+		sb.WriteString("\n")
+	}
+
+	hash := sha256.Sum256([]byte(sb.String()))
+	return hex.EncodeToString(hash[:])
+}
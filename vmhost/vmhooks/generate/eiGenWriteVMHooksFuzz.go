@@ -0,0 +1,78 @@
+package vmhooksgenerate
+
+import (
+	"fmt"
+)
+
+// isFuzzable is true for the functions taking at least one handle and only numbers, so they can be called with
+// fuzzed arguments without preparing the WASM memory
+func isFuzzable(funcMetadata *EIFunction) bool {
+	hasHandle := false
+	for _, arg := range funcMetadata.Arguments {
+		switch arg.Type {
+		case EITypeInt32, EITypeInt64:
+			hasHandle = hasHandle || isDecodedHandle(arg.Type, arg.Kind)
+		default:
+			return false
+		}
+	}
+	return hasHandle
+}
+
+// WriteVMHooksFuzz writes a Go fuzz target for each VM hook which only takes handles and numbers. Handle arguments
+// are resolved through the fuzzHandles helper of the test package, so the fuzzer reaches both existing and missing
+// handles of the right kind.
+func WriteVMHooksFuzz(out *eiGenWriter, eiMetadata *EIMetadata) {
+	autoGeneratedGoHeader(out, "vmhookstest")
+	out.WriteString(`
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+)
+`)
+
+	for _, funcMetadata := range eiMetadata.AllFunctions {
+		if !isFuzzable(funcMetadata) {
+			continue
+		}
+
+		name := upperInitial(funcMetadata.Name)
+		out.WriteString(fmt.Sprintf("\nfunc Fuzz%s(f *testing.F) {", name))
+		writeFuzzSeed(out, funcMetadata, "0")
+		writeFuzzSeed(out, funcMetadata, "-1")
+		out.WriteString("\n\tf.Fuzz(func(t *testing.T")
+		for _, arg := range funcMetadata.Arguments {
+			out.WriteString(fmt.Sprintf(", %s %s", arg.Name, vmHooksType(arg.Type)))
+		}
+		out.WriteString(") {")
+		out.WriteString("\n\t\trunVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {")
+		out.WriteString(fmt.Sprintf("\n\t\t\thooks.%s(", name))
+		for argIndex, arg := range funcMetadata.Arguments {
+			if argIndex > 0 {
+				out.WriteString(", ")
+			}
+			if isDecodedHandle(arg.Type, arg.Kind) {
+				out.WriteString(fmt.Sprintf("handles.handle(%s, %s)", executorArgKind(arg.Kind), arg.Name))
+			} else {
+				out.WriteString(arg.Name)
+			}
+		}
+		out.WriteString(")")
+		out.WriteString("\n\t\t})")
+		out.WriteString("\n\t})")
+		out.WriteString("\n}\n")
+	}
+}
+
+func writeFuzzSeed(out *eiGenWriter, funcMetadata *EIFunction, value string) {
+	out.WriteString("\n\tf.Add(")
+	for argIndex, arg := range funcMetadata.Arguments {
+		if argIndex > 0 {
+			out.WriteString(", ")
+		}
+		out.WriteString(fmt.Sprintf("%s(%s)", vmHooksType(arg.Type), value))
+	}
+	out.WriteString(")")
+}
@@ -20,9 +20,11 @@ import (
 )
 
 // WrapperVMHooks wraps a VMHooks instance and optionally performs some logging.
+// When a decoder is set, handles and memory pointers are logged together with the values they refer to.
 type WrapperVMHooks struct {
 	logger         ExecutorLogger
 	wrappedVMHooks executor.VMHooks
+	decoder        executor.VMHookValueDecoder
 }
 `)
 
@@ -49,7 +51,11 @@ type WrapperVMHooks struct {
 		out.WriteString(fmt.Sprintf("w.wrappedVMHooks.%s(", upperInitial(funcMetadata.Name)))
 		writeCommaSeparatedArgumentNames(out, funcMetadata.Arguments)
 		out.WriteString(")")
-		out.WriteString("\n\tw.logger.LogVMHookCallAfter(callInfo)")
+		if funcMetadata.Result != nil && isDecodedHandle(funcMetadata.Result.Type, funcMetadata.Result.Kind) {
+			out.WriteString(fmt.Sprintf("\n\tw.logger.LogVMHookCallAfter(callInfo + w.handleResult(%s, result))", executorArgKind(funcMetadata.Result.Kind)))
+		} else {
+			out.WriteString("\n\tw.logger.LogVMHookCallAfter(callInfo)")
+		}
 		if funcMetadata.Result != nil {
 			out.WriteString("\n\treturn result")
 		}
@@ -62,18 +68,76 @@ func writeCallInfo(out *eiGenWriter, funcMetadata *EIFunction) {
 		out.WriteString(fmt.Sprintf("\n\tcallInfo := \"%s()\"", upperInitial(funcMetadata.Name)))
 	} else {
 		out.WriteString(fmt.Sprintf("\n\tcallInfo := fmt.Sprintf(\"%s(", upperInitial(funcMetadata.Name)))
-		for argIndex := range funcMetadata.Arguments {
+		for argIndex, arg := range funcMetadata.Arguments {
 			if argIndex > 0 {
 				out.WriteString(", ")
 			}
-			out.WriteString("%d")
+			if isDecodedArgument(arg) {
+				out.WriteString("%s")
+			} else {
+				out.WriteString("%d")
+			}
 		}
 		out.WriteString(")\", ")
-		writeCommaSeparatedArgumentNames(out, funcMetadata.Arguments)
+		writeCommaSeparatedLoggedArguments(out, funcMetadata)
 		out.WriteString(")")
 	}
 }
 
+func isDecodedHandle(eiType EIType, kind EIKind) bool {
+	return eiType == EITypeInt32 && kind.IsHandle()
+}
+
+func isDecodedArgument(arg *EIFunctionArg) bool {
+	return isDecodedHandle(arg.Type, arg.Kind) || (arg.Type == EITypeMemPtr && arg.Kind.IsMemory())
+}
+
+// writeCommaSeparatedLoggedArguments writes the arguments of the call info, decoding handles and memory pointers
+func writeCommaSeparatedLoggedArguments(out *eiGenWriter, funcMetadata *EIFunction) {
+	for argIndex, arg := range funcMetadata.Arguments {
+		if argIndex > 0 {
+			out.WriteString(", ")
+		}
+		switch {
+		case isDecodedHandle(arg.Type, arg.Kind):
+			out.WriteString(fmt.Sprintf("w.handleArg(\"%s\", \"%s\", %s, %s, %t)",
+				upperInitial(funcMetadata.Name), arg.Name, executorArgKind(arg.Kind), arg.Name, arg.Input))
+		case isDecodedArgument(arg):
+			length := "0"
+			if arg.LengthArgument != "" {
+				length = arg.LengthArgument
+			}
+			out.WriteString(fmt.Sprintf("w.memoryArg(%s, %s, %s)", executorArgKind(arg.Kind), arg.Name, length))
+		default:
+			out.WriteString(arg.Name)
+		}
+	}
+}
+
+// executorArgKind gives the executor.VMHookArgKind constant of a kind
+func executorArgKind(kind EIKind) string {
+	switch kind {
+	case EIKindBigIntHandle:
+		return "executor.BigIntHandleArg"
+	case EIKindBigFloatHandle:
+		return "executor.BigFloatHandleArg"
+	case EIKindManagedBufferHandle:
+		return "executor.ManagedBufferHandleArg"
+	case EIKindManagedMapHandle:
+		return "executor.ManagedMapHandleArg"
+	case EIKindManagedDecimalHandle:
+		return "executor.ManagedDecimalHandleArg"
+	case EIKindEllipticCurveHandle:
+		return "executor.EllipticCurveHandleArg"
+	case EIKindAddress:
+		return "executor.AddressArg"
+	case EIKindTokenID:
+		return "executor.TokenIDArg"
+	default:
+		return "executor.PlainArg"
+	}
+}
+
 func writeCommaSeparatedArgumentNames(out *eiGenWriter, arguments []*EIFunctionArg) {
 	for argIndex, arg := range arguments {
 		if argIndex > 0 {
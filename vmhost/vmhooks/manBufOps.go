@@ -32,6 +32,8 @@ const (
 	mBufferFromSmallIntSignedName   = "mBufferFromSmallIntSigned"
 	mBufferStorageStoreName         = "mBufferStorageStore"
 	mBufferStorageLoadName          = "mBufferStorageLoad"
+	mBufferStorageLoadBatchName     = "mBufferStorageLoadBatch"
+	mBufferStorageLoadBatchFromName = "mBufferStorageLoadBatchFromAddress"
 	mBufferGetArgumentName          = "mBufferGetArgument"
 	mBufferFinishName               = "mBufferFinish"
 	mBufferSetRandomName            = "mBufferSetRandom"
@@ -884,6 +886,104 @@ func (context *VMHooksImpl) MBufferStorageLoadFromAddress(addressHandle, keyHand
 	managedType.SetBytes(destinationHandle, storageBytes)
 }
 
+// MBufferStorageLoadBatch VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MBufferStorageLoadBatch(keysHandle int32, destinationHandle int32) int32 {
+	host := context.GetVMHost()
+	runtime := context.GetRuntimeContext()
+
+	err := storageLoadBatch(host, mBufferStorageLoadBatchName, runtime.GetContextAddress(), keysHandle, destinationHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return 0
+}
+
+// MBufferStorageLoadBatchFromAddress VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MBufferStorageLoadBatchFromAddress(addressHandle, keysHandle, destinationHandle int32) {
+	host := context.GetVMHost()
+	managedType := context.GetManagedTypesContext()
+
+	address, err := managedType.GetBytes(addressHandle)
+	if err != nil {
+		context.FailExecution(vmhost.ErrArgOutOfRange)
+		return
+	}
+
+	err = storageLoadBatch(host, mBufferStorageLoadBatchFromName, address, keysHandle, destinationHandle)
+	if err != nil {
+		context.FailExecution(err)
+	}
+}
+
+// storageLoadBatch reads the values under a managed vec of keys from the storage of the given address into a managed
+// vec of values. The per-call overhead is charged once, then each key pays the reduced per-key cost, plus the part of
+// the storage load cost which depends on its trie depth, or only the cached storage load cost.
+func storageLoadBatch(host vmhost.VMHost, tracedFunctionName string, address []byte, keysHandle int32, destinationHandle int32) error {
+	managedType := host.ManagedTypes()
+	storage := host.Storage()
+	metering := host.Metering()
+
+	gasToUse := metering.GasSchedule().ManagedBufferAPICost.MBufferStorageLoadBatch
+	err := metering.UseGasBoundedAndAddTracedGas(tracedFunctionName, gasToUse)
+	if err != nil {
+		return err
+	}
+
+	keys, _, err := managedType.ReadManagedVecOfManagedBuffers(keysHandle)
+	if err != nil {
+		return err
+	}
+
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, trieDepth, usedCache, err := storage.GetStorageFromAddress(address, key)
+		if err != nil {
+			return err
+		}
+
+		gasToUse, err = batchStorageLoadKeyCost(host, int64(trieDepth), usedCache)
+		if err != nil {
+			return err
+		}
+
+		err = metering.UseGasBoundedAndAddTracedGas(tracedFunctionName, gasToUse)
+		if err != nil {
+			return err
+		}
+
+		values = append(values, value)
+	}
+
+	return managedType.WriteManagedVecOfManagedBuffers(values, destinationHandle)
+}
+
+// batchStorageLoadKeyCost returns the cost of a single key of a batch storage load. The constant term of the dynamic
+// storage load cost is the overhead of a single load, which the batch replaces by its own overhead, so a key only pays
+// the terms given by its trie depth on top of the reduced per-key cost.
+func batchStorageLoadKeyCost(host vmhost.VMHost, trieDepth int64, usedCache bool) (uint64, error) {
+	gasSchedule := host.Metering().GasSchedule()
+	if usedCache {
+		return gasSchedule.BaseOpsAPICost.CachedStorageLoad, nil
+	}
+
+	loadCost, err := host.Storage().GetStorageLoadCost(trieDepth, gasSchedule.ManagedBufferAPICost.MBufferStorageLoad)
+	if err != nil {
+		return 0, err
+	}
+
+	constantCost := uint64(gasSchedule.DynamicStorageLoad.Constant)
+	trieDepthCost := uint64(0)
+	if loadCost > constantCost {
+		trieDepthCost = loadCost - constantCost
+	}
+
+	return math.AddUint64(trieDepthCost, gasSchedule.ManagedBufferAPICost.MBufferStorageLoadBatchPerKey), nil
+}
+
 // MBufferGetArgument VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) MBufferGetArgument(id int32, destinationHandle int32) int32 {
@@ -0,0 +1,432 @@
+package vmhooks
+
+import (
+	"encoding/binary"
+
+	vmMath "github.com/multiversx/mx-chain-vm-go/math"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	twos "github.com/multiversx/mx-components-big-int/twos-complement"
+)
+
+const (
+	mDecimalFromBigIntName        = "mDecimalFromBigInt"
+	mDecimalToBigIntName          = "mDecimalToBigInt"
+	mDecimalGetMantissaName       = "mDecimalGetMantissa"
+	mDecimalGetScaleName          = "mDecimalGetScale"
+	mDecimalFromManagedBufferName = "mDecimalFromManagedBuffer"
+	mDecimalToManagedBufferName   = "mDecimalToManagedBuffer"
+	mDecimalAddName               = "mDecimalAdd"
+	mDecimalSubName               = "mDecimalSub"
+	mDecimalMulName               = "mDecimalMul"
+	mDecimalDivName               = "mDecimalDiv"
+	mDecimalRescaleName           = "mDecimalRescale"
+	mDecimalCmpName               = "mDecimalCmp"
+	mDecimalLnName                = "mDecimalLn"
+	mDecimalExpName               = "mDecimalExp"
+)
+
+// encodedDecimalScaleLength is the length of the big endian scale prefix of an encoded decimal,
+// the rest of the encoding being the two's complement mantissa
+const encodedDecimalScaleLength = 4
+
+func (context *VMHooksImpl) useGasForDecimal(name string, gasToUse uint64, decimals ...*vmMath.Decimal) error {
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+
+	err := metering.UseGasBoundedAndAddTracedGas(name, gasToUse)
+	if err != nil {
+		return err
+	}
+
+	for _, decimal := range decimals {
+		err = managedType.ConsumeGasForBigIntCopy(decimal.Mantissa)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (context *VMHooksImpl) mDecimalBinaryOperation(
+	name string,
+	gasToUse uint64,
+	destinationHandle, op1Handle, op2Handle int32,
+	operation func(a, b *vmMath.Decimal) (*vmMath.Decimal, error),
+) int32 {
+	managedType := context.GetManagedTypesContext()
+
+	a, b, err := managedType.GetTwoDecimals(op1Handle, op2Handle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = context.useGasForDecimal(name, gasToUse, a, b)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	result, err := operation(a, b)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = managedType.SetDecimal(destinationHandle, result)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return 0
+}
+
+// MDecimalFromBigInt VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalFromBigInt(destinationHandle, bigIntHandle, scale int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().ManagedDecimalAPICost.MDecimalFromBigInt
+	err := metering.UseGasBoundedAndAddTracedGas(mDecimalFromBigIntName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	mantissa, err := managedType.GetBigInt(bigIntHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = managedType.ConsumeGasForBigIntCopy(mantissa)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	if scale < 0 {
+		context.FailExecution(vmMath.ErrDecimalScaleTooLarge)
+		return -1
+	}
+
+	decimal, err := vmMath.NewDecimal(mantissa, uint32(scale))
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = managedType.SetDecimal(destinationHandle, decimal)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return 0
+}
+
+// MDecimalToBigInt VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalToBigInt(destinationHandle, decimalHandle, roundingMode int32) int32 {
+	managedType := context.GetManagedTypesContext()
+
+	decimal, err := managedType.GetDecimal(decimalHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalToBigInt
+	err = context.useGasForDecimal(mDecimalToBigIntName, gasToUse, decimal)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	value, err := vmMath.DecimalToBigInt(decimal, vmMath.RoundingMode(roundingMode))
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	dest := managedType.GetBigIntOrCreate(destinationHandle)
+	dest.Set(value)
+
+	return 0
+}
+
+// MDecimalGetMantissa VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalGetMantissa(destinationHandle, decimalHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+
+	decimal, err := managedType.GetDecimal(decimalHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalGetMantissa
+	err = context.useGasForDecimal(mDecimalGetMantissaName, gasToUse, decimal)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	dest := managedType.GetBigIntOrCreate(destinationHandle)
+	dest.Set(decimal.Mantissa)
+
+	return 0
+}
+
+// MDecimalGetScale VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalGetScale(decimalHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().ManagedDecimalAPICost.MDecimalGetScale
+	err := metering.UseGasBoundedAndAddTracedGas(mDecimalGetScaleName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	decimal, err := managedType.GetDecimal(decimalHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return int32(decimal.Scale)
+}
+
+// MDecimalFromManagedBuffer VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalFromManagedBuffer(destinationHandle, mBufferHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().ManagedDecimalAPICost.MDecimalFromManagedBuffer
+	err := metering.UseGasBoundedAndAddTracedGas(mDecimalFromManagedBufferName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	encoded, err := managedType.GetBytes(mBufferHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = managedType.ConsumeGasForBytes(encoded)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	if len(encoded) < encodedDecimalScaleLength {
+		context.FailExecution(vmhost.ErrInvalidEncodedDecimal)
+		return -1
+	}
+
+	scale := binary.BigEndian.Uint32(encoded[:encodedDecimalScaleLength])
+	mantissa := twos.FromBytes(encoded[encodedDecimalScaleLength:])
+	decimal, err := vmMath.NewDecimal(mantissa, scale)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = managedType.SetDecimal(destinationHandle, decimal)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return 0
+}
+
+// MDecimalToManagedBuffer VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalToManagedBuffer(decimalHandle, destinationHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+
+	decimal, err := managedType.GetDecimal(decimalHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalToManagedBuffer
+	err = context.useGasForDecimal(mDecimalToManagedBufferName, gasToUse, decimal)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	encoded := make([]byte, encodedDecimalScaleLength)
+	binary.BigEndian.PutUint32(encoded, decimal.Scale)
+	encoded = append(encoded, twos.ToBytes(decimal.Mantissa)...)
+	managedType.SetBytes(destinationHandle, encoded)
+
+	return 0
+}
+
+// MDecimalAdd VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalAdd(destinationHandle, op1Handle, op2Handle int32) int32 {
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalAdd
+	return context.mDecimalBinaryOperation(mDecimalAddName, gasToUse, destinationHandle, op1Handle, op2Handle,
+		func(a, b *vmMath.Decimal) (*vmMath.Decimal, error) {
+			return vmMath.AddDecimal(a, b), nil
+		})
+}
+
+// MDecimalSub VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalSub(destinationHandle, op1Handle, op2Handle int32) int32 {
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalSub
+	return context.mDecimalBinaryOperation(mDecimalSubName, gasToUse, destinationHandle, op1Handle, op2Handle,
+		func(a, b *vmMath.Decimal) (*vmMath.Decimal, error) {
+			return vmMath.SubDecimal(a, b), nil
+		})
+}
+
+// MDecimalMul VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalMul(destinationHandle, op1Handle, op2Handle, roundingMode int32) int32 {
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalMul
+	return context.mDecimalBinaryOperation(mDecimalMulName, gasToUse, destinationHandle, op1Handle, op2Handle,
+		func(a, b *vmMath.Decimal) (*vmMath.Decimal, error) {
+			return vmMath.MulDecimal(a, b, vmMath.RoundingMode(roundingMode))
+		})
+}
+
+// MDecimalDiv VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalDiv(destinationHandle, op1Handle, op2Handle, roundingMode int32) int32 {
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalDiv
+	return context.mDecimalBinaryOperation(mDecimalDivName, gasToUse, destinationHandle, op1Handle, op2Handle,
+		func(a, b *vmMath.Decimal) (*vmMath.Decimal, error) {
+			return vmMath.DivDecimal(a, b, vmMath.RoundingMode(roundingMode))
+		})
+}
+
+// MDecimalRescale VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalRescale(destinationHandle, opHandle, scale, roundingMode int32) int32 {
+	managedType := context.GetManagedTypesContext()
+
+	decimal, err := managedType.GetDecimal(opHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalRescale
+	err = context.useGasForDecimal(mDecimalRescaleName, gasToUse, decimal)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	if scale < 0 {
+		context.FailExecution(vmMath.ErrDecimalScaleTooLarge)
+		return -1
+	}
+
+	result, err := vmMath.RescaleDecimal(decimal, uint32(scale), vmMath.RoundingMode(roundingMode))
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = managedType.SetDecimal(destinationHandle, result)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return 0
+}
+
+// MDecimalCmp VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalCmp(op1Handle, op2Handle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+
+	a, b, err := managedType.GetTwoDecimals(op1Handle, op2Handle)
+	if err != nil {
+		context.FailExecution(err)
+		return -2
+	}
+
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalCmp
+	err = context.useGasForDecimal(mDecimalCmpName, gasToUse, a, b)
+	if err != nil {
+		context.FailExecution(err)
+		return -2
+	}
+
+	return int32(vmMath.CmpDecimal(a, b))
+}
+
+// MDecimalLn VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalLn(destinationHandle, opHandle int32) int32 {
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalLn
+	return context.mDecimalUnaryApproximation(mDecimalLnName, gasToUse, destinationHandle, opHandle, vmMath.LnDecimal, vmMath.LnDecimalPrecision)
+}
+
+// MDecimalExp VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MDecimalExp(destinationHandle, opHandle int32) int32 {
+	gasToUse := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalExp
+	return context.mDecimalUnaryApproximation(mDecimalExpName, gasToUse, destinationHandle, opHandle, vmMath.ExpDecimal, vmMath.ExpDecimalPrecision)
+}
+
+func (context *VMHooksImpl) mDecimalUnaryApproximation(
+	name string,
+	gasToUse uint64,
+	destinationHandle, opHandle int32,
+	approximation func(d *vmMath.Decimal) (*vmMath.Decimal, error),
+	precision func(d *vmMath.Decimal) uint64,
+) int32 {
+	managedType := context.GetManagedTypesContext()
+
+	decimal, err := managedType.GetDecimal(opHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	// the series need about one iteration per digit of precision, and the argument is rescaled to that precision
+	perDigit := context.GetMeteringContext().GasSchedule().ManagedDecimalAPICost.MDecimalApproximationPerDigit
+	digits := vmMath.AddUint64(precision(decimal), vmMath.MantissaDigits(decimal))
+	gasToUse = vmMath.AddUint64(gasToUse, vmMath.MulUint64(perDigit, digits))
+
+	err = context.useGasForDecimal(name, gasToUse, decimal)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	result, err := approximation(decimal)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = managedType.SetDecimal(destinationHandle, result)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return 0
+}
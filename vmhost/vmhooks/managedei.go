@@ -54,6 +54,12 @@ const (
 	managedIsBuiltinFunction                     = "managedIsBuiltinFunction"
 	managedMultiTransferESDTNFTExecuteByUser     = "managedMultiTransferESDTNFTExecuteByUser"
 	managedMultiTransferESDTNFTExecuteWithReturn = "managedMultiTransferESDTNFTExecuteByWithReturn"
+
+	managedCreateContractWithErrorReturnName            = "managedCreateContractWithErrorReturn"
+	managedDeployFromSourceContractWithErrorReturnName  = "managedDeployFromSourceContractWithErrorReturn"
+	managedUpgradeContractWithErrorReturnName           = "managedUpgradeContractWithErrorReturn"
+	managedUpgradeFromSourceContractWithErrorReturnName = "managedUpgradeFromSourceContractWithErrorReturn"
+	managedTransferValueExecuteWithErrorReturnName      = "managedTransferValueExecuteWithErrorReturn"
 )
 
 const EGLDTokenName = "EGLD-000000" // TODO: maybe move to core?
@@ -1421,6 +1427,335 @@ func (context *VMHooksImpl) ManagedTransferValueExecute(
 	)
 }
 
+// ManagedCreateContractWithErrorReturn VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedCreateContractWithErrorReturn(
+	gas int64,
+	valueHandle int32,
+	codeHandle int32,
+	codeMetadataHandle int32,
+	argumentsHandle int32,
+	resultAddressHandle int32,
+	resultHandle int32,
+	errorCodeHandle int32,
+	errorMessageHandle int32,
+) int32 {
+	host := context.GetVMHost()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(managedCreateContractWithErrorReturnName)
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.CreateContract
+	err := metering.UseGasBounded(gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	sender := runtime.GetContextAddress()
+	value, err := managedType.GetBigInt(valueHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	data, actualLen, err := managedType.ReadManagedVecOfManagedBuffers(argumentsHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	gasToUse = math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, actualLen)
+	err = metering.UseGasBounded(gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	codeMetadata, err := managedType.GetBytes(codeMetadataHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	code, err := managedType.GetBytes(codeHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	lenReturnData := len(host.Output().ReturnData())
+	newAddress, vmOutput, err := createContractWithOutput(sender, data, value, gas, code, codeMetadata, host, CreateContract)
+
+	return finishCreateContractWithErrorReturn(host, newAddress, vmOutput, err, lenReturnData, resultAddressHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+}
+
+// ManagedDeployFromSourceContractWithErrorReturn VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedDeployFromSourceContractWithErrorReturn(
+	gas int64,
+	valueHandle int32,
+	addressHandle int32,
+	codeMetadataHandle int32,
+	argumentsHandle int32,
+	resultAddressHandle int32,
+	resultHandle int32,
+	errorCodeHandle int32,
+	errorMessageHandle int32,
+) int32 {
+	host := context.GetVMHost()
+	runtime := host.Runtime()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(managedDeployFromSourceContractWithErrorReturnName)
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.CreateContract
+	err := metering.UseGasBounded(gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	vmInput, err := readDestinationValueArguments(host, addressHandle, valueHandle, argumentsHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	codeMetadata, err := managedType.GetBytes(codeMetadataHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	code, err := host.Blockchain().GetCode(vmInput.destination)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	lenReturnData := len(host.Output().ReturnData())
+	sender := runtime.GetContextAddress()
+	newAddress, vmOutput, err := createContractWithOutput(sender, vmInput.arguments, vmInput.value, gas, code, codeMetadata, host, DeployContract)
+
+	return finishCreateContractWithErrorReturn(host, newAddress, vmOutput, err, lenReturnData, resultAddressHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+}
+
+// ManagedUpgradeContractWithErrorReturn VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedUpgradeContractWithErrorReturn(
+	destHandle int32,
+	gas int64,
+	valueHandle int32,
+	codeHandle int32,
+	codeMetadataHandle int32,
+	argumentsHandle int32,
+	resultHandle int32,
+	errorCodeHandle int32,
+	errorMessageHandle int32,
+) int32 {
+	host := context.GetVMHost()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(managedUpgradeContractWithErrorReturnName)
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.CreateContract
+	err := metering.UseGasBounded(gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	vmInput, err := readDestinationValueArguments(host, destHandle, valueHandle, argumentsHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	codeMetadata, err := managedType.GetBytes(codeMetadataHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	code, err := managedType.GetBytes(codeHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	lenReturnData := len(host.Output().ReturnData())
+	vmOutput, err := upgradeContractWithOutput(host, vmInput.destination, code, codeMetadata, vmInput.value, vmInput.arguments, gas)
+
+	return finishWithErrorReturn(host, vmOutput, err, lenReturnData, resultHandle, errorCodeHandle, errorMessageHandle)
+}
+
+// ManagedUpgradeFromSourceContractWithErrorReturn VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedUpgradeFromSourceContractWithErrorReturn(
+	destHandle int32,
+	gas int64,
+	valueHandle int32,
+	addressHandle int32,
+	codeMetadataHandle int32,
+	argumentsHandle int32,
+	resultHandle int32,
+	errorCodeHandle int32,
+	errorMessageHandle int32,
+) int32 {
+	host := context.GetVMHost()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(managedUpgradeFromSourceContractWithErrorReturnName)
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.CreateContract
+	err := metering.UseGasBounded(gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	vmInput, err := readDestinationValueArguments(host, destHandle, valueHandle, argumentsHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	sourceContractAddress, err := managedType.GetBytes(addressHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	codeMetadata, err := managedType.GetBytes(codeMetadataHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	code, err := host.Blockchain().GetCode(sourceContractAddress)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	lenReturnData := len(host.Output().ReturnData())
+	vmOutput, err := upgradeContractWithOutput(host, vmInput.destination, code, codeMetadata, vmInput.value, vmInput.arguments, gas)
+
+	return finishWithErrorReturn(host, vmOutput, err, lenReturnData, resultHandle, errorCodeHandle, errorMessageHandle)
+}
+
+// ManagedTransferValueExecuteWithErrorReturn VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedTransferValueExecuteWithErrorReturn(
+	dstHandle int32,
+	valueHandle int32,
+	gasLimit int64,
+	functionHandle int32,
+	argumentsHandle int32,
+	errorCodeHandle int32,
+	errorMessageHandle int32,
+) int32 {
+	host := context.GetVMHost()
+	metering := host.Metering()
+	managedType := host.ManagedTypes()
+	metering.StartGasTracing(managedTransferValueExecuteWithErrorReturnName)
+
+	vmInput, err := readDestinationValueFunctionArguments(host, dstHandle, valueHandle, functionHandle, argumentsHandle)
+	if err != nil {
+		FailExecution(host, err)
+		return -1
+	}
+
+	vmOutput, isReverted, err := transferValueExecute(
+		host,
+		vmInput.destination,
+		vmInput.value,
+		gasLimit,
+		[]byte(vmInput.function),
+		vmInput.arguments,
+	)
+	if err != nil && !isReverted {
+		FailExecution(host, err)
+		return -1
+	}
+
+	setErrorReturn(managedType, vmOutput, err, errorCodeHandle, errorMessageHandle)
+	if err != nil {
+		return 1
+	}
+
+	return 0
+}
+
+// finishCreateContractWithErrorReturn sets the address of the new contract and its return data, or the error of a
+// failed deployment
+func finishCreateContractWithErrorReturn(
+	host vmhost.VMHost,
+	newAddress []byte,
+	vmOutput *vmcommon.VMOutput,
+	err error,
+	lenReturnData int,
+	resultAddressHandle int32,
+	resultHandle int32,
+	errorCodeHandle int32,
+	errorMessageHandle int32,
+) int32 {
+	host.ManagedTypes().SetBytes(resultAddressHandle, newAddress)
+	return finishWithErrorReturn(host, vmOutput, err, lenReturnData, resultHandle, errorCodeHandle, errorMessageHandle)
+}
+
+// finishWithErrorReturn returns the error of a failed deployment or upgrade to the calling contract, both when the
+// new code failed and when the call was rejected before running it, which leaves no state to revert
+func finishWithErrorReturn(
+	host vmhost.VMHost,
+	vmOutput *vmcommon.VMOutput,
+	err error,
+	lenReturnData int,
+	resultHandle int32,
+	errorCodeHandle int32,
+	errorMessageHandle int32,
+) int32 {
+	returnDataErr := setReturnDataIfExists(host, lenReturnData, resultHandle)
+	if returnDataErr != nil {
+		FailExecution(host, returnDataErr)
+		return -1
+	}
+
+	setErrorReturn(host.ManagedTypes(), vmOutput, err, errorCodeHandle, errorMessageHandle)
+	if err != nil {
+		return 1
+	}
+
+	return 0
+}
+
+// setErrorReturn writes the catalog code and the message of the error into the given managed buffers, or empties
+// them when there is no error. The code is encoded big endian, without leading zeros.
+func setErrorReturn(
+	managedType vmhost.ManagedTypesContext,
+	vmOutput *vmcommon.VMOutput,
+	err error,
+	errorCodeHandle int32,
+	errorMessageHandle int32,
+) {
+	if err == nil {
+		managedType.SetBytes(errorCodeHandle, make([]byte, 0))
+		managedType.SetBytes(errorMessageHandle, make([]byte, 0))
+		return
+	}
+
+	errorCode := big.NewInt(int64(vmhost.LookupError(err).Code))
+	errorMessage := err.Error()
+	if vmOutput != nil && len(vmOutput.ReturnMessage) > 0 {
+		errorMessage = vmOutput.ReturnMessage
+	}
+
+	managedType.SetBytes(errorCodeHandle, errorCode.Bytes())
+	managedType.SetBytes(errorMessageHandle, []byte(errorMessage))
+}
+
 // ManagedIsESDTFrozen VMHooks implementation.
 // @autogenerate(VMHooks)
 func (context *VMHooksImpl) ManagedIsESDTFrozen(
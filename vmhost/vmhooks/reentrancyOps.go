@@ -0,0 +1,86 @@
+package vmhooks
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+const (
+	protectContractAgainstReentrancyName        = "protectContractAgainstReentrancy"
+	managedProtectEndpointAgainstReentrancyName = "managedProtectEndpointAgainstReentrancy"
+)
+
+// ProtectContractAgainstReentrancy VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ProtectContractAgainstReentrancy() int32 {
+	return context.setReentrancyProtection(protectContractAgainstReentrancyName, "")
+}
+
+// ManagedProtectEndpointAgainstReentrancy VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) ManagedProtectEndpointAgainstReentrancy(endpointHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+
+	endpoint, err := managedType.GetBytes(endpointHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+	if len(endpoint) == 0 {
+		context.FailExecution(vmhost.ErrInvalidFunctionName)
+		return -1
+	}
+
+	return context.setReentrancyProtection(managedProtectEndpointAgainstReentrancyName, string(endpoint))
+}
+
+// setReentrancyProtection marks the current contract, or one of its endpoints, as protected against reentrancy.
+// The marks are kept under a single VM-protected key, so they can only be set by the contract itself, while it is
+// deployed or upgraded, and an upgrade clears all of them at once.
+func (context *VMHooksImpl) setReentrancyProtection(hookName string, endpoint string) int32 {
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+	storage := context.GetStorageContext()
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.Int64StorageStore
+	err := metering.UseGasBoundedAndAddTracedGas(hookName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	if !isDeployOrUpgradeFunction(runtime.FunctionName()) {
+		context.FailExecution(vmhost.ErrReentrancyProtectionOutsideDeploy)
+		return -1
+	}
+
+	key := storage.GetVmProtectedPrefix(vmhost.ReentrancyProtectionKeyPrefix)
+	marks, trieDepth, usedCache, err := storage.GetStorage(key)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = storage.UseGasForStorageLoad(
+		hookName,
+		int64(trieDepth),
+		metering.GasSchedule().BaseOpsAPICost.StorageLoad,
+		usedCache)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	storageStatus, err := storage.SetProtectedStorage(key, vmhost.AddReentrancyProtection(marks, endpoint))
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return int32(storageStatus)
+}
+
+func isDeployOrUpgradeFunction(functionName string) bool {
+	return functionName == vmhost.InitFunctionName ||
+		functionName == vmhost.UpgradeFunctionName ||
+		functionName == vmhost.ContractsUpgradeFunctionName
+}
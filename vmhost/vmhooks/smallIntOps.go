@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/math"
 	"github.com/multiversx/mx-chain-vm-go/vmhost"
 	twos "github.com/multiversx/mx-components-big-int/twos-complement"
 )
@@ -17,6 +18,7 @@ const (
 	smallIntStorageStoreSignedName   = "smallIntStorageStoreSigned"
 	smallIntStorageLoadUnsignedName  = "smallIntStorageLoadUnsigned"
 	smallIntStorageLoadSignedName    = "smallIntStorageLoadSigned"
+	smallIntGetRandomInRangeName     = "smallIntGetRandomInRange"
 	int64getArgumentName             = "int64getArgument"
 	int64storageStoreName            = "int64storageStore"
 	int64storageLoadName             = "int64storageLoad"
@@ -272,3 +274,25 @@ func (context *VMHooksImpl) Int64storageLoad(keyOffset executor.MemPtr, keyLengt
 	// backwards compatibility
 	return context.SmallIntStorageLoadUnsigned(keyOffset, keyLength)
 }
+
+// SmallIntGetRandomInRange VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) SmallIntGetRandomInRange(min int64, max int64) int64 {
+	managedType := context.GetManagedTypesContext()
+	metering := context.GetMeteringContext()
+
+	gasToUse := metering.GasSchedule().BaseOpsAPICost.Int64GetRandomInRange
+	err := metering.UseGasBoundedAndAddTracedGas(smallIntGetRandomInRangeName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return 0
+	}
+
+	value, err := math.RandomInt64InRange(managedType.GetRandReader(), min, max)
+	if err != nil {
+		context.FailExecution(err)
+		return 0
+	}
+
+	return value
+}
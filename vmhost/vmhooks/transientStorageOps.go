@@ -0,0 +1,76 @@
+package vmhooks
+
+import (
+	"github.com/multiversx/mx-chain-vm-go/math"
+)
+
+const (
+	mBufferTransientStorageStoreName = "mBufferTransientStorageStore"
+	mBufferTransientStorageLoadName  = "mBufferTransientStorageLoad"
+)
+
+// MBufferTransientStorageStore VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MBufferTransientStorageStore(keyHandle int32, sourceHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	transientStorage := context.GetTransientStorageContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+
+	key, err := managedType.GetBytes(keyHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	sourceBytes, err := managedType.GetBytes(sourceHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	copyGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(len(key)+len(sourceBytes)))
+	gasToUse := math.AddUint64(metering.GasSchedule().TransientStorageAPICost.TransientStorageStore, copyGas)
+	err = metering.UseGasBoundedAndAddTracedGas(mBufferTransientStorageStoreName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	err = transientStorage.SetTransientStorage(runtime.GetContextAddress(), key, sourceBytes)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	return 0
+}
+
+// MBufferTransientStorageLoad VMHooks implementation.
+// @autogenerate(VMHooks)
+func (context *VMHooksImpl) MBufferTransientStorageLoad(keyHandle int32, destinationHandle int32) int32 {
+	managedType := context.GetManagedTypesContext()
+	transientStorage := context.GetTransientStorageContext()
+	runtime := context.GetRuntimeContext()
+	metering := context.GetMeteringContext()
+
+	key, err := managedType.GetBytes(keyHandle)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	value := transientStorage.GetTransientStorage(runtime.GetContextAddress(), key)
+
+	copyGas := math.MulUint64(metering.GasSchedule().BaseOperationCost.DataCopyPerByte, uint64(len(key)+len(value)))
+	gasToUse := math.AddUint64(metering.GasSchedule().TransientStorageAPICost.TransientStorageLoad, copyGas)
+	err = metering.UseGasBoundedAndAddTracedGas(mBufferTransientStorageLoadName, gasToUse)
+	if err != nil {
+		context.FailExecution(err)
+		return -1
+	}
+
+	managedType.SetBytes(destinationHandle, value)
+
+	return 0
+}
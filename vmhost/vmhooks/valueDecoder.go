@@ -0,0 +1,104 @@
+package vmhooks
+
+import (
+	"encoding/hex"
+	"fmt"
+	"unicode"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+)
+
+// maxDecodedBytes limits how much of a buffer is shown when decoding it
+const maxDecodedBytes = 64
+
+var _ executor.VMHookValueDecoder = (*VMHooksImpl)(nil)
+
+// DecodeHandle describes the value under a handle, without consuming gas.
+func (context *VMHooksImpl) DecodeHandle(kind executor.VMHookArgKind, handle int32) (string, bool) {
+	managedType := context.GetManagedTypesContext()
+	switch kind {
+	case executor.BigIntHandleArg:
+		value, err := managedType.GetBigInt(handle)
+		if err != nil {
+			return "", false
+		}
+		return value.String(), true
+	case executor.BigFloatHandleArg:
+		value, err := managedType.GetBigFloat(handle)
+		if err != nil {
+			return "", false
+		}
+		return value.String(), true
+	case executor.ManagedBufferHandleArg:
+		value, err := managedType.GetBytes(handle)
+		if err != nil {
+			return "", false
+		}
+		return describeBytes(value), true
+	case executor.ManagedMapHandleArg:
+		length, err := managedType.GetManagedMapLength(handle)
+		if err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("map[%d entries]", length), true
+	case executor.ManagedDecimalHandleArg:
+		value, err := managedType.GetDecimal(handle)
+		if err != nil {
+			return "", false
+		}
+		return fmt.Sprintf("%se-%d", value.Mantissa.String(), value.Scale), true
+	case executor.EllipticCurveHandleArg:
+		value, err := managedType.GetEllipticCurve(handle)
+		if err != nil {
+			return "", false
+		}
+		return value.Name, true
+	}
+
+	return "", false
+}
+
+// DecodeMemory describes the address or token identifier found in WASM memory, without consuming gas.
+func (context *VMHooksImpl) DecodeMemory(kind executor.VMHookArgKind, offset executor.MemPtr, length executor.MemLength) (string, bool) {
+	instance := context.GetRuntimeContext().GetInstance()
+	if instance == nil || !instance.HasMemory() {
+		return "", false
+	}
+
+	switch kind {
+	case executor.AddressArg:
+		data, err := instance.MemLoad(offset, vmhost.AddressLen)
+		if err != nil {
+			return "", false
+		}
+		return hex.EncodeToString(data), true
+	case executor.TokenIDArg:
+		if length < 0 || length > maxDecodedBytes {
+			return "", false
+		}
+		data, err := instance.MemLoad(offset, length)
+		if err != nil {
+			return "", false
+		}
+		return describeBytes(data), true
+	}
+
+	return "", false
+}
+
+// describeBytes shows printable data as a quoted string and anything else as hex, truncated to maxDecodedBytes
+func describeBytes(data []byte) string {
+	suffix := ""
+	if len(data) > maxDecodedBytes {
+		suffix = fmt.Sprintf("...(%d bytes)", len(data))
+		data = data[:maxDecodedBytes]
+	}
+
+	for _, b := range data {
+		if b >= unicode.MaxASCII || !unicode.IsPrint(rune(b)) {
+			return "0x" + hex.EncodeToString(data) + suffix
+		}
+	}
+	return fmt.Sprintf("%q", data) + suffix
+}
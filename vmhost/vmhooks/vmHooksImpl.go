@@ -92,6 +92,11 @@ func (context *VMHooksImpl) GetStorageContext() vmhost.StorageContext {
 	return context.host.Storage()
 }
 
+// GetTransientStorageContext returns the transient storage context
+func (context *VMHooksImpl) GetTransientStorageContext() vmhost.TransientStorageContext {
+	return context.host.TransientStorage()
+}
+
 // FailExecution fails the execution with the provided error
 func (context *VMHooksImpl) FailExecution(err error) {
 	FailExecution(context.host, err)
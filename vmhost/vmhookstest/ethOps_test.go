@@ -0,0 +1,265 @@
+package vmhookstest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-go/crypto/hashing"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	ethWordLength    = 32
+	ethAddressLength = 20
+)
+
+func ethWord(value ...byte) []byte {
+	word := make([]byte, ethWordLength)
+	copy(word[ethWordLength-len(value):], value)
+	return word
+}
+
+func runEthOpsTest(t *testing.T, method func(*contextmock.InstanceMock, *vmhooks.VMHooksImpl), arguments [][]byte) *test.VMOutputVerifier {
+	var verifier *test.VMOutputVerifier
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(instanceMock *contextmock.InstanceMock, config interface{}) {
+					instanceMock.AddMockMethod("test", func() *contextmock.InstanceMock {
+						host := instanceMock.Host
+						instance := contextmock.GetMockInstance(host)
+						method(instance, vmhooks.NewVMHooksImpl(host))
+						return instance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(1_000_000).
+			WithFunction("test").
+			WithArguments(arguments...).
+			WithCallValue(7).
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verifier = verify
+		})
+	assert.Nil(t, err)
+
+	return verifier
+}
+
+func ethSelector(signature string) []byte {
+	hash, _ := hashing.NewHasher().Keccak256([]byte(signature))
+	return hash[:4]
+}
+
+func TestEthOps_CallData(t *testing.T) {
+	selector := ethSelector("transfer(address,uint256)")
+	encodedArguments := append(ethWord(0x01, 0x02), ethWord(0x03)...)
+	expectedCallData := append(append([]byte{}, selector...), encodedArguments...)
+
+	verify := runEthOpsTest(t, func(instance *contextmock.InstanceMock, hooks *vmhooks.VMHooksImpl) {
+		size := hooks.EthGetCallDataSize()
+		assert.Equal(t, int32(len(expectedCallData)), size)
+
+		_ = instance.MemStore(0, bytes.Repeat([]byte{0xff}, 200))
+		hooks.EthCallDataCopy(0, 0, executor.MemLength(size+10))
+		callData, _ := instance.MemLoad(0, executor.MemLength(size+10))
+		assert.Equal(t, expectedCallData, callData[:size])
+		assert.Equal(t, make([]byte, 10), callData[size:])
+	}, [][]byte{selector, encodedArguments})
+	verify.Ok()
+}
+
+func TestEthOps_CallDataBuiltOnce(t *testing.T) {
+	callData := append(ethSelector("transfer(address,uint256)"), bytes.Repeat([]byte{0xab}, 64)...)
+	callDataLength := uint64(len(callData))
+
+	verify := runEthOpsTest(t, func(instance *contextmock.InstanceMock, hooks *vmhooks.VMHooksImpl) {
+		metering := hooks.GetMeteringContext()
+		gasSchedule := metering.GasSchedule()
+
+		// the first call builds the call data and pays for each of its bytes
+		gasLeft := metering.GasLeft()
+		size := hooks.EthGetCallDataSize()
+		assert.Equal(t, int32(callDataLength), size)
+		expectedGas := gasSchedule.EthAPICost.GetCallDataSize + callDataLength*gasSchedule.BaseOperationCost.DataCopyPerByte
+		assert.Equal(t, expectedGas, gasLeft-metering.GasLeft())
+
+		// the next calls reuse it
+		gasLeft = metering.GasLeft()
+		size = hooks.EthGetCallDataSize()
+		assert.Equal(t, int32(callDataLength), size)
+		assert.Equal(t, gasSchedule.EthAPICost.GetCallDataSize, gasLeft-metering.GasLeft())
+
+		gasLeft = metering.GasLeft()
+		hooks.EthCallDataCopy(0, 0, executor.MemLength(size))
+		expectedGas = gasSchedule.EthAPICost.CallDataCopy + callDataLength*gasSchedule.BaseOperationCost.DataCopyPerByte
+		assert.Equal(t, expectedGas, gasLeft-metering.GasLeft())
+	}, [][]byte{callData})
+	verify.Ok()
+}
+
+func TestEthOps_AddressesWrittenOn20Bytes(t *testing.T) {
+	guard := bytes.Repeat([]byte{0xff}, ethWordLength)
+
+	verify := runEthOpsTest(t, func(instance *contextmock.InstanceMock, hooks *vmhooks.VMHooksImpl) {
+		_ = instance.MemStore(0, guard)
+		hooks.EthGetAddress(0)
+		memory, _ := instance.MemLoad(0, ethWordLength)
+		assert.Equal(t, test.ParentAddress[len(test.ParentAddress)-ethAddressLength:], memory[:ethAddressLength])
+		assert.Equal(t, guard[ethAddressLength:], memory[ethAddressLength:])
+	}, nil)
+	verify.Ok()
+}
+
+// ewasmTokenMock behaves as a Solidity contract compiled to ewasm: it exports only "main", which reads the call
+// data, dispatches on the selector and keeps the caller in the 20 bytes reserved for an address
+func ewasmTokenMock(instanceMock *contextmock.InstanceMock, _ interface{}) {
+	instanceMock.AddMockMethod("main", func() *contextmock.InstanceMock {
+		host := instanceMock.Host
+		instance := contextmock.GetMockInstance(host)
+		hooks := vmhooks.NewVMHooksImpl(host)
+
+		size := hooks.EthGetCallDataSize()
+		hooks.EthCallDataCopy(0, 0, executor.MemLength(size))
+		callData, _ := instance.MemLoad(0, executor.MemLength(size))
+
+		// the slot of the balance of an address is the word of the address, right-aligned as in Solidity
+		const callerOffset = 512
+		_ = instance.MemStore(callerOffset, make([]byte, ethWordLength-ethAddressLength))
+		hooks.EthGetCaller(callerOffset + ethWordLength - ethAddressLength)
+
+		switch {
+		case bytes.Equal(callData[:4], ethSelector("mint(uint256)")):
+			hooks.EthStorageStore(callerOffset, 4)
+			hooks.EthFinish(0, 0)
+		case bytes.Equal(callData[:4], ethSelector("balanceOf(address)")):
+			hooks.EthStorageLoad(4, 256)
+			hooks.EthFinish(256, ethWordLength)
+		default:
+			message := []byte("unknown selector")
+			_ = instance.MemStore(256, message)
+			hooks.EthRevert(256, executor.MemLength(len(message)))
+		}
+		return instance
+	})
+}
+
+func runEwasmTokenCall(t *testing.T, callData []byte, storage map[string][]byte) *test.VMOutputVerifier {
+	var verifier *test.VMOutputVerifier
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(ewasmTokenMock),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithCallerAddr(test.UserAddress).
+			WithGasProvided(1_000_000).
+			WithFunction("main").
+			WithArguments(callData).
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			accountHandler, _ := world.GetUserAccount(test.ParentAddress)
+			for key, value := range storage {
+				(accountHandler.(*worldmock.Account)).Storage[key] = value
+			}
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verifier = verify
+		})
+	assert.Nil(t, err)
+
+	return verifier
+}
+
+func TestEthOps_EwasmStyleContract(t *testing.T) {
+	callerWord := ethWord(test.UserAddress[len(test.UserAddress)-ethAddressLength:]...)
+	callerSlot, _ := hashing.NewHasher().Keccak256(callerWord)
+	amount := ethWord(0x2a)
+
+	verify := runEwasmTokenCall(t, append(ethSelector("mint(uint256)"), amount...), nil)
+	verify.Ok().
+		Storage(test.CreateStoreEntry(test.ParentAddress).WithKey(callerSlot).WithValue(amount))
+
+	verify = runEwasmTokenCall(t, append(ethSelector("balanceOf(address)"), callerWord...), map[string][]byte{
+		string(callerSlot): amount,
+	})
+	verify.Ok().
+		ReturnData(amount)
+
+	verify = runEwasmTokenCall(t, ethSelector("burn(uint256)"), nil)
+	verify.UserError().
+		ReturnMessage("unknown selector")
+}
+
+func TestEthOps_StorageAndCallValue(t *testing.T) {
+	slot := ethWord(0x05)
+	value := ethWord(0x2a)
+	storageKey, _ := hashing.NewHasher().Keccak256(slot)
+
+	verify := runEthOpsTest(t, func(instance *contextmock.InstanceMock, hooks *vmhooks.VMHooksImpl) {
+		_ = instance.MemStore(0, slot)
+		_ = instance.MemStore(ethWordLength, value)
+		hooks.EthStorageStore(0, ethWordLength)
+
+		hooks.EthStorageLoad(0, 2*ethWordLength)
+		loaded, _ := instance.MemLoad(2*ethWordLength, ethWordLength)
+		assert.Equal(t, value, loaded)
+
+		hooks.EthGetCallValue(3 * ethWordLength)
+		callValue, _ := instance.MemLoad(3*ethWordLength, ethWordLength)
+		assert.Equal(t, ethWord(7), callValue)
+	}, nil)
+	verify.Ok().
+		Storage(test.CreateStoreEntry(test.ParentAddress).WithKey(storageKey).WithValue(value))
+}
+
+func TestEthOps_Log(t *testing.T) {
+	topic1 := ethWord(0x01)
+	topic2 := ethWord(0x02)
+	data := []byte("log data")
+
+	verify := runEthOpsTest(t, func(instance *contextmock.InstanceMock, hooks *vmhooks.VMHooksImpl) {
+		_ = instance.MemStore(0, topic1)
+		_ = instance.MemStore(ethWordLength, topic2)
+		_ = instance.MemStore(2*ethWordLength, data)
+		hooks.EthLog(2*ethWordLength, executor.MemLength(len(data)), 2, 0, ethWordLength, 0, 0)
+	}, nil)
+	verify.Ok().
+		Logs(vmcommon.LogEntry{
+			Address:    test.ParentAddress,
+			Identifier: []byte("test"),
+			Topics:     [][]byte{topic1, topic2},
+			Data:       [][]byte{data},
+		})
+}
+
+func TestEthOps_LogTooManyTopics(t *testing.T) {
+	verify := runEthOpsTest(t, func(instance *contextmock.InstanceMock, hooks *vmhooks.VMHooksImpl) {
+		hooks.EthLog(0, 0, 5, 0, 0, 0, 0)
+	}, nil)
+	verify.ExecutionFailed().
+		HasRuntimeErrors(vmhost.ErrTooManyLogTopics.Error())
+}
+
+func TestEthOps_Revert(t *testing.T) {
+	message := []byte("reverted")
+
+	verify := runEthOpsTest(t, func(instance *contextmock.InstanceMock, hooks *vmhooks.VMHooksImpl) {
+		_ = instance.MemStore(0, message)
+		hooks.EthRevert(0, executor.MemLength(len(message)))
+	}, nil)
+	verify.UserError().
+		ReturnMessage(string(message))
+}
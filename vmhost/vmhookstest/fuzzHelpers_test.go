@@ -0,0 +1,82 @@
+package vmhookstest
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/math"
+	mock "github.com/multiversx/mx-chain-vm-go/mock/context"
+	test "github.com/multiversx/mx-chain-vm-go/testcommon"
+	"github.com/multiversx/mx-chain-vm-go/vmhost"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+	"github.com/stretchr/testify/require"
+)
+
+const fuzzGasProvided = uint64(1_000_000)
+
+// fuzzHandles holds a few handles of each kind, created before calling the fuzzed VM hook
+type fuzzHandles struct {
+	handles map[executor.VMHookArgKind][]int32
+}
+
+func newFuzzHandles(managedTypes vmhost.ManagedTypesContext) *fuzzHandles {
+	decimal, _ := math.NewDecimal(big.NewInt(12345), 2)
+	decimalHandle, _ := managedTypes.PutDecimal(decimal)
+	bigFloatHandle, _ := managedTypes.PutBigFloat(big.NewFloat(1.5))
+
+	return &fuzzHandles{
+		handles: map[executor.VMHookArgKind][]int32{
+			executor.BigIntHandleArg: {
+				managedTypes.NewBigIntFromInt64(0),
+				managedTypes.NewBigIntFromInt64(-1),
+				managedTypes.NewBigIntFromInt64(1 << 62),
+			},
+			executor.BigFloatHandleArg: {bigFloatHandle},
+			executor.ManagedBufferHandleArg: {
+				managedTypes.NewManagedBuffer(),
+				managedTypes.NewManagedBufferFromBytes([]byte("TOKEN-123456")),
+				managedTypes.NewManagedBufferFromBytes(test.ParentAddress),
+			},
+			executor.ManagedMapHandleArg:     {managedTypes.NewManagedMap()},
+			executor.ManagedDecimalHandleArg: {decimalHandle},
+			executor.EllipticCurveHandleArg:  {managedTypes.PutEllipticCurve(elliptic.P256().Params())},
+		},
+	}
+}
+
+// handle maps a fuzzed value to a handle of the given kind: non-negative values select one of the prepared handles,
+// negative values are used as they are, so they may be missing
+func (fuzzHandles *fuzzHandles) handle(kind executor.VMHookArgKind, value int32) int32 {
+	handles := fuzzHandles.handles[kind]
+	if value < 0 || len(handles) == 0 {
+		return value
+	}
+	return handles[value%int32(len(handles))]
+}
+
+// runVMHookFuzzTarget calls the target from a mock contract and fails if the VM hook made the execution panic,
+// any other failure being an acceptable outcome for fuzzed arguments
+func runVMHookFuzzTarget(t *testing.T, target func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles)) {
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(instance *mock.InstanceMock, config interface{}) {
+					instance.AddMockMethod("fuzz", func() *mock.InstanceMock {
+						host := instance.Host
+						target(vmhooks.NewVMHooksImpl(host), newFuzzHandles(host.ManagedTypes()))
+						return mock.GetMockInstance(host)
+					})
+				})).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(fuzzGasProvided).
+			WithFunction("fuzz").
+			Build()).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+		})
+	require.NotEqual(t, vmhost.ErrExecutionPanicked, err)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-scenario-go/worldmock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	vmMath "github.com/multiversx/mx-chain-vm-go/math"
 	contextmock "github.com/multiversx/mx-chain-vm-go/mock/context"
 	test "github.com/multiversx/mx-chain-vm-go/testcommon"
@@ -42,6 +43,20 @@ func buildRandomizer(host vmhost.VMHost) io.Reader {
 	return randReader
 }
 
+func buildPerCallRandomizer(host vmhost.VMHost) io.Reader {
+	// building the randomizer of a direct call to the parent contract, at depth 0
+	blockchainContext := host.Blockchain()
+	previousRandomSeed := blockchainContext.LastRandomSeed()
+	currentRandomSeed := blockchainContext.CurrentRandomSeed()
+	txHash := host.Runtime().GetCurrentTxHash()
+
+	randomSeed := append(append([]byte{}, previousRandomSeed...), currentRandomSeed...)
+	depth := make([]byte, 8)
+	callType := make([]byte, 4)
+	randReader := vmMath.NewHMACDRBG(randomSeed, txHash, test.ParentAddress, depth, callType)
+	return randReader
+}
+
 func TestManBuffers_MixedFunctions(t *testing.T) {
 	test.BuildInstanceCallTest(t).
 		WithContracts(
@@ -101,6 +116,7 @@ func TestManBuffers_SetRandom(t *testing.T) {
 			WithFunction("mBufferSetRandomTest").
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -113,6 +129,32 @@ func TestManBuffers_SetRandom(t *testing.T) {
 		})
 }
 
+func TestManBuffers_SetRandom_PerCallRandomness(t *testing.T) {
+	test.BuildInstanceCallTest(t).
+		WithContracts(
+			test.CreateInstanceContract(test.ParentAddress).
+				WithCode(test.GetTestSCCode("managed-buffers", "../../"))).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithGasProvided(100000).
+			WithFunction("mBufferSetRandomTest").
+			WithArguments([]byte{byte(numberOfReps)}).
+			Build()).
+		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
+			randReader := buildPerCallRandomizer(host)
+			legacyRandReader := buildRandomizer(host)
+
+			randomBuffer := make([]byte, numberOfReps)
+			legacyRandomBuffer := make([]byte, numberOfReps)
+			for i := 0; i < numberOfReps; i++ {
+				_, _ = randReader.Read(randomBuffer)
+				_, _ = legacyRandReader.Read(legacyRandomBuffer)
+			}
+			assert.NotEqual(t, legacyRandomBuffer, randomBuffer)
+			verify.Ok().
+				ReturnData(randomBuffer)
+		})
+}
+
 func TestManBuffers_GetLength(t *testing.T) {
 	test.BuildInstanceCallTest(t).
 		WithContracts(
@@ -139,6 +181,7 @@ func TestManBuffers_GetBytes(t *testing.T) {
 			WithFunction("mBufferGetBytesTest").
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -161,6 +204,7 @@ func TestManBuffers_AppendBytes(t *testing.T) {
 			WithFunction("mBufferAppendTest").
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -185,6 +229,7 @@ func TestManBuffers_mBufferToBigIntUnsigned(t *testing.T) {
 			WithFunction("mBufferToBigIntUnsignedTest").
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -207,6 +252,7 @@ func TestManBuffers_mBufferToBigIntSigned(t *testing.T) {
 			WithFunction("mBufferToBigIntSignedTest").
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -230,6 +276,7 @@ func TestManBuffers_mBufferFromBigIntUnsigned(t *testing.T) {
 			WithFunction("mBufferFromBigIntUnsignedTest").
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -252,6 +299,7 @@ func TestManBuffers_mBufferFromBigIntSigned(t *testing.T) {
 			WithFunction("mBufferFromBigIntSignedTest").
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -275,6 +323,7 @@ func TestManBuffers_StorageStore(t *testing.T) {
 			WithFunction("mBufferStorageStoreTest").
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -309,6 +358,7 @@ func TestManBuffers_StorageLoad(t *testing.T) {
 			WithFunction("mBufferStorageLoadTest").
 			WithArguments([]byte{byte(numberOfReps)}).
 			Build()).
+		WithEnableEpochsHandler(test.EnableEpochsHandlerStubWithoutFlags(vmhost.PerCallRandomnessFlag)).
 		AndAssertResults(func(host vmhost.VMHost, stubBlockchainHook *contextmock.BlockchainHookStub, verify *test.VMOutputVerifier) {
 			randReader := buildRandomizer(host)
 
@@ -446,3 +496,135 @@ func TestManBuffers_Ints_WithBarnardOpcodesFlag(t *testing.T) {
 		})
 	assert.Nil(t, err)
 }
+
+func TestManBuffers_StorageLoadBatch(t *testing.T) {
+	keys := [][]byte{[]byte("keyA"), []byte("keyB"), []byte("missing")}
+	parentValues := [][]byte{[]byte("parent value A"), []byte("parent value B"), {}}
+	childValues := [][]byte{[]byte("child value A"), []byte("child value B"), {}}
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(instanceMock *contextmock.InstanceMock, config interface{}) {
+					instanceMock.AddMockMethod("test", func() *contextmock.InstanceMock {
+						host := instanceMock.Host
+						instance := contextmock.GetMockInstance(host)
+						managedTypes := host.ManagedTypes()
+						hooks := vmhooks.NewVMHooksImpl(host)
+
+						keysHandle := managedTypes.NewManagedBuffer()
+						err := managedTypes.WriteManagedVecOfManagedBuffers(keys, keysHandle)
+						assert.Nil(t, err)
+
+						destinationHandle := managedTypes.NewManagedBuffer()
+						result := hooks.MBufferStorageLoadBatch(keysHandle, destinationHandle)
+						assert.Equal(t, int32(0), result)
+						values, _, err := managedTypes.ReadManagedVecOfManagedBuffers(destinationHandle)
+						assert.Nil(t, err)
+						assert.Equal(t, parentValues, values)
+
+						addressHandle := managedTypes.NewManagedBufferFromBytes(test.ChildAddress)
+						hooks.MBufferStorageLoadBatchFromAddress(addressHandle, keysHandle, destinationHandle)
+						values, _, err = managedTypes.ReadManagedVecOfManagedBuffers(destinationHandle)
+						assert.Nil(t, err)
+						assert.Equal(t, childValues, values)
+
+						return instance
+					})
+				}),
+			test.CreateMockContract(test.ChildAddress).
+				WithCodeMetadata((&vmcommon.CodeMetadata{Readable: true}).ToBytes()).
+				WithMethods(func(instanceMock *contextmock.InstanceMock, config interface{}) {}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(1_000_000).
+			WithFunction("test").
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			for i := 0; i < 2; i++ {
+				world.AcctMap.GetAccount(test.ParentAddress).Storage[string(keys[i])] = parentValues[i]
+				world.AcctMap.GetAccount(test.ChildAddress).Storage[string(keys[i])] = childValues[i]
+			}
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	assert.Nil(t, err)
+}
+
+func TestManBuffers_StorageLoadBatchGas(t *testing.T) {
+	batchKeys := [][]byte{[]byte("keyA"), []byte("keyB"), []byte("keyC")}
+	singleKeys := [][]byte{[]byte("keyD"), []byte("keyE"), []byte("keyF")}
+	value := []byte("value")
+
+	_, err := test.BuildMockInstanceCallTest(t).
+		WithContracts(
+			test.CreateMockContract(test.ParentAddress).
+				WithBalance(1000).
+				WithMethods(func(instanceMock *contextmock.InstanceMock, config interface{}) {
+					instanceMock.AddMockMethod("test", func() *contextmock.InstanceMock {
+						host := instanceMock.Host
+						instance := contextmock.GetMockInstance(host)
+						managedTypes := host.ManagedTypes()
+						metering := host.Metering()
+						gasSchedule := metering.GasSchedule()
+						hooks := vmhooks.NewVMHooksImpl(host)
+
+						gasLeft := metering.GasLeft()
+						for _, key := range singleKeys {
+							keyHandle := managedTypes.NewManagedBufferFromBytes(key)
+							result := hooks.MBufferStorageLoad(keyHandle, managedTypes.NewManagedBuffer())
+							assert.Equal(t, int32(0), result)
+						}
+						singlesGas := gasLeft - metering.GasLeft()
+
+						keysHandle := managedTypes.NewManagedBuffer()
+						err := managedTypes.WriteManagedVecOfManagedBuffers(batchKeys, keysHandle)
+						assert.Nil(t, err)
+
+						gasLeft = metering.GasLeft()
+						result := hooks.MBufferStorageLoadBatch(keysHandle, managedTypes.NewManagedBuffer())
+						assert.Equal(t, int32(0), result)
+						batchGas := gasLeft - metering.GasLeft()
+
+						// the keys are stored in a trie of depth 0, so a single load only pays the constant term of the
+						// dynamic storage load cost, which the batch replaces by its overhead and the per-key cost; the
+						// batch also copies the vector of keys in and the vector of values out
+						numKeys := uint64(len(batchKeys))
+						singleLoadCost := uint64(gasSchedule.DynamicStorageLoad.Constant)
+						keysCopyLength := numKeys * 4
+						valuesCopyLength := uint64(0)
+						for _, key := range batchKeys {
+							keysCopyLength += uint64(len(key))
+							valuesCopyLength += uint64(len(value))
+						}
+						copyCost := (keysCopyLength + valuesCopyLength) * gasSchedule.BaseOperationCost.DataCopyPerByte
+						expectedBatchGas := singlesGas - numKeys*singleLoadCost +
+							gasSchedule.ManagedBufferAPICost.MBufferStorageLoadBatch +
+							numKeys*gasSchedule.ManagedBufferAPICost.MBufferStorageLoadBatchPerKey +
+							copyCost
+						assert.Equal(t, expectedBatchGas, batchGas)
+						assert.Less(t, batchGas, singlesGas)
+
+						return instance
+					})
+				}),
+		).
+		WithInput(test.CreateTestContractCallInputBuilder().
+			WithRecipientAddr(test.ParentAddress).
+			WithGasProvided(1_000_000).
+			WithFunction("test").
+			Build()).
+		WithSetup(func(host vmhost.VMHost, world *worldmock.MockWorld) {
+			for i := range batchKeys {
+				world.AcctMap.GetAccount(test.ParentAddress).Storage[string(batchKeys[i])] = value
+				world.AcctMap.GetAccount(test.ParentAddress).Storage[string(singleKeys[i])] = value
+			}
+		}).
+		AndAssertResults(func(world *worldmock.MockWorld, verify *test.VMOutputVerifier) {
+			verify.Ok()
+		})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,1704 @@
+package vmhookstest
+
+// Code generated by vmhooks generator. DO NOT EDIT.
+
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+// !!!!!!!!!!!!!!!!!!!!!! AUTO-GENERATED FILE !!!!!!!!!!!!!!!!!!!!!!
+// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-vm-go/executor"
+	"github.com/multiversx/mx-chain-vm-go/vmhost/vmhooks"
+)
+
+func FuzzGetESDTLocalRoles(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, tokenIdHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.GetESDTLocalRoles(handles.handle(executor.ManagedBufferHandleArg, tokenIdHandle))
+		})
+	})
+}
+
+func FuzzValidateTokenIdentifier(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, tokenIdHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ValidateTokenIdentifier(handles.handle(executor.ManagedBufferHandleArg, tokenIdHandle))
+		})
+	})
+}
+
+func FuzzIsReservedFunctionName(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, nameHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.IsReservedFunctionName(handles.handle(executor.ManagedBufferHandleArg, nameHandle))
+		})
+	})
+}
+
+func FuzzManagedSCAddress(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedSCAddress(handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzManagedOwnerAddress(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedOwnerAddress(handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzManagedCaller(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedCaller(handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzManagedGetOriginalCallerAddr(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetOriginalCallerAddr(handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzManagedGetRelayerAddr(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetRelayerAddr(handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzManagedSignalError(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, errHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedSignalError(handles.handle(executor.ManagedBufferHandleArg, errHandle))
+		})
+	})
+}
+
+func FuzzManagedWriteLog(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, topicsHandle int32, dataHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedWriteLog(handles.handle(executor.ManagedBufferHandleArg, topicsHandle), handles.handle(executor.ManagedBufferHandleArg, dataHandle))
+		})
+	})
+}
+
+func FuzzManagedGetOriginalTxHash(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetOriginalTxHash(handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedGetStateRootHash(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetStateRootHash(handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedGetBlockRandomSeed(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetBlockRandomSeed(handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedGetPrevBlockRandomSeed(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetPrevBlockRandomSeed(handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedGetReturnData(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, resultID int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetReturnData(resultID, handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedGetMultiESDTCallValue(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, multiCallValueHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetMultiESDTCallValue(handles.handle(executor.ManagedBufferHandleArg, multiCallValueHandle))
+		})
+	})
+}
+
+func FuzzManagedGetAllTransfersCallValue(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, transferCallValuesListHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetAllTransfersCallValue(handles.handle(executor.ManagedBufferHandleArg, transferCallValuesListHandle))
+		})
+	})
+}
+
+func FuzzManagedGetBackTransfers(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, esdtTransfersValueHandle int32, egldValueHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetBackTransfers(handles.handle(executor.ManagedBufferHandleArg, esdtTransfersValueHandle), handles.handle(executor.BigIntHandleArg, egldValueHandle))
+		})
+	})
+}
+
+func FuzzManagedGetESDTBalance(f *testing.F) {
+	f.Add(int32(0), int32(0), int64(0), int32(0))
+	f.Add(int32(-1), int32(-1), int64(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetESDTBalance(handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, tokenIDHandle), nonce, handles.handle(executor.BigIntHandleArg, valueHandle))
+		})
+	})
+}
+
+func FuzzManagedGetESDTTokenData(f *testing.F) {
+	f.Add(int32(0), int32(0), int64(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, addressHandle int32, tokenIDHandle int32, nonce int64, valueHandle int32, propertiesHandle int32, hashHandle int32, nameHandle int32, attributesHandle int32, creatorHandle int32, royaltiesHandle int32, urisHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetESDTTokenData(handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, tokenIDHandle), nonce, handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, propertiesHandle), handles.handle(executor.ManagedBufferHandleArg, hashHandle), handles.handle(executor.ManagedBufferHandleArg, nameHandle), handles.handle(executor.ManagedBufferHandleArg, attributesHandle), handles.handle(executor.ManagedBufferHandleArg, creatorHandle), handles.handle(executor.BigIntHandleArg, royaltiesHandle), handles.handle(executor.ManagedBufferHandleArg, urisHandle))
+		})
+	})
+}
+
+func FuzzManagedGetESDTTokenType(f *testing.F) {
+	f.Add(int32(0), int32(0), int64(0), int32(0))
+	f.Add(int32(-1), int32(-1), int64(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, addressHandle int32, tokenIDHandle int32, nonce int64, typeHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetESDTTokenType(handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, tokenIDHandle), nonce, handles.handle(executor.BigIntHandleArg, typeHandle))
+		})
+	})
+}
+
+func FuzzManagedAsyncCall(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedAsyncCall(handles.handle(executor.ManagedBufferHandleArg, destHandle), handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle))
+		})
+	})
+}
+
+func FuzzManagedGetCallbackClosure(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, callbackClosureHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetCallbackClosure(handles.handle(executor.ManagedBufferHandleArg, callbackClosureHandle))
+		})
+	})
+}
+
+func FuzzManagedUpgradeFromSourceContract(f *testing.F) {
+	f.Add(int32(0), int64(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedUpgradeFromSourceContract(handles.handle(executor.ManagedBufferHandleArg, destHandle), gas, handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, codeMetadataHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedUpgradeContract(f *testing.F) {
+	f.Add(int32(0), int64(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedUpgradeContract(handles.handle(executor.ManagedBufferHandleArg, destHandle), gas, handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, codeHandle), handles.handle(executor.ManagedBufferHandleArg, codeMetadataHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedDeleteContract(f *testing.F) {
+	f.Add(int32(0), int64(0), int32(0))
+	f.Add(int32(-1), int64(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destHandle int32, gasLimit int64, argumentsHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedDeleteContract(handles.handle(executor.ManagedBufferHandleArg, destHandle), gasLimit, handles.handle(executor.ManagedBufferHandleArg, argumentsHandle))
+		})
+	})
+}
+
+func FuzzManagedDeployFromSourceContract(f *testing.F) {
+	f.Add(int64(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedDeployFromSourceContract(gas, handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, codeMetadataHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultAddressHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedCreateContract(f *testing.F) {
+	f.Add(int64(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedCreateContract(gas, handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, codeHandle), handles.handle(executor.ManagedBufferHandleArg, codeMetadataHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultAddressHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedExecuteReadOnly(f *testing.F) {
+	f.Add(int64(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int64(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, gas int64, addressHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedExecuteReadOnly(gas, handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedExecuteOnSameContext(f *testing.F) {
+	f.Add(int64(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedExecuteOnSameContext(gas, handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedExecuteOnDestContext(f *testing.F) {
+	f.Add(int64(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedExecuteOnDestContext(gas, handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedExecuteOnDestContextWithErrorReturn(f *testing.F) {
+	f.Add(int64(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, gas int64, addressHandle int32, valueHandle int32, functionHandle int32, argumentsHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedExecuteOnDestContextWithErrorReturn(gas, handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedMultiTransferESDTNFTExecute(f *testing.F) {
+	f.Add(int32(0), int32(0), int64(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int64(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedMultiTransferESDTNFTExecute(handles.handle(executor.ManagedBufferHandleArg, dstHandle), handles.handle(executor.ManagedBufferHandleArg, tokenTransfersHandle), gasLimit, handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle))
+		})
+	})
+}
+
+func FuzzManagedMultiTransferESDTNFTExecuteWithReturn(f *testing.F) {
+	f.Add(int32(0), int32(0), int64(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int64(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedMultiTransferESDTNFTExecuteWithReturn(handles.handle(executor.ManagedBufferHandleArg, dstHandle), handles.handle(executor.ManagedBufferHandleArg, tokenTransfersHandle), gasLimit, handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle))
+		})
+	})
+}
+
+func FuzzManagedMultiTransferESDTNFTExecuteByUser(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int64(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int64(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, userHandle int32, dstHandle int32, tokenTransfersHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedMultiTransferESDTNFTExecuteByUser(handles.handle(executor.ManagedBufferHandleArg, userHandle), handles.handle(executor.ManagedBufferHandleArg, dstHandle), handles.handle(executor.ManagedBufferHandleArg, tokenTransfersHandle), gasLimit, handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle))
+		})
+	})
+}
+
+func FuzzManagedTransferValueExecute(f *testing.F) {
+	f.Add(int32(0), int32(0), int64(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int64(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedTransferValueExecute(handles.handle(executor.ManagedBufferHandleArg, dstHandle), handles.handle(executor.BigIntHandleArg, valueHandle), gasLimit, handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle))
+		})
+	})
+}
+
+func FuzzManagedCreateContractWithErrorReturn(f *testing.F) {
+	f.Add(int64(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedCreateContractWithErrorReturn(gas, handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, codeHandle), handles.handle(executor.ManagedBufferHandleArg, codeMetadataHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultAddressHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle), handles.handle(executor.ManagedBufferHandleArg, errorCodeHandle), handles.handle(executor.ManagedBufferHandleArg, errorMessageHandle))
+		})
+	})
+}
+
+func FuzzManagedDeployFromSourceContractWithErrorReturn(f *testing.F) {
+	f.Add(int64(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedDeployFromSourceContractWithErrorReturn(gas, handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, codeMetadataHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultAddressHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle), handles.handle(executor.ManagedBufferHandleArg, errorCodeHandle), handles.handle(executor.ManagedBufferHandleArg, errorMessageHandle))
+		})
+	})
+}
+
+func FuzzManagedUpgradeContractWithErrorReturn(f *testing.F) {
+	f.Add(int32(0), int64(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedUpgradeContractWithErrorReturn(handles.handle(executor.ManagedBufferHandleArg, destHandle), gas, handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, codeHandle), handles.handle(executor.ManagedBufferHandleArg, codeMetadataHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle), handles.handle(executor.ManagedBufferHandleArg, errorCodeHandle), handles.handle(executor.ManagedBufferHandleArg, errorMessageHandle))
+		})
+	})
+}
+
+func FuzzManagedUpgradeFromSourceContractWithErrorReturn(f *testing.F) {
+	f.Add(int32(0), int64(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int64(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedUpgradeFromSourceContractWithErrorReturn(handles.handle(executor.ManagedBufferHandleArg, destHandle), gas, handles.handle(executor.BigIntHandleArg, valueHandle), handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, codeMetadataHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle), handles.handle(executor.ManagedBufferHandleArg, errorCodeHandle), handles.handle(executor.ManagedBufferHandleArg, errorMessageHandle))
+		})
+	})
+}
+
+func FuzzManagedTransferValueExecuteWithErrorReturn(f *testing.F) {
+	f.Add(int32(0), int32(0), int64(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int64(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32, errorCodeHandle int32, errorMessageHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedTransferValueExecuteWithErrorReturn(handles.handle(executor.ManagedBufferHandleArg, dstHandle), handles.handle(executor.BigIntHandleArg, valueHandle), gasLimit, handles.handle(executor.ManagedBufferHandleArg, functionHandle), handles.handle(executor.ManagedBufferHandleArg, argumentsHandle), handles.handle(executor.ManagedBufferHandleArg, errorCodeHandle), handles.handle(executor.ManagedBufferHandleArg, errorMessageHandle))
+		})
+	})
+}
+
+func FuzzManagedIsESDTFrozen(f *testing.F) {
+	f.Add(int32(0), int32(0), int64(0))
+	f.Add(int32(-1), int32(-1), int64(-1))
+	f.Fuzz(func(t *testing.T, addressHandle int32, tokenIDHandle int32, nonce int64) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedIsESDTFrozen(handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, tokenIDHandle), nonce)
+		})
+	})
+}
+
+func FuzzManagedIsESDTLimitedTransfer(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, tokenIDHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedIsESDTLimitedTransfer(handles.handle(executor.ManagedBufferHandleArg, tokenIDHandle))
+		})
+	})
+}
+
+func FuzzManagedIsESDTPaused(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, tokenIDHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedIsESDTPaused(handles.handle(executor.ManagedBufferHandleArg, tokenIDHandle))
+		})
+	})
+}
+
+func FuzzManagedBufferToHex(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, sourceHandle int32, destHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedBufferToHex(handles.handle(executor.ManagedBufferHandleArg, sourceHandle), handles.handle(executor.ManagedBufferHandleArg, destHandle))
+		})
+	})
+}
+
+func FuzzManagedGetCodeMetadata(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, addressHandle int32, responseHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetCodeMetadata(handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, responseHandle))
+		})
+	})
+}
+
+func FuzzManagedGetCodeHash(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, addressHandle int32, codeHashHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGetCodeHash(handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, codeHashHandle))
+		})
+	})
+}
+
+func FuzzManagedIsBuiltinFunction(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, functionNameHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedIsBuiltinFunction(handles.handle(executor.ManagedBufferHandleArg, functionNameHandle))
+		})
+	})
+}
+
+func FuzzBigFloatAdd(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatAdd(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigFloatHandleArg, op1Handle), handles.handle(executor.BigFloatHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigFloatSub(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatSub(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigFloatHandleArg, op1Handle), handles.handle(executor.BigFloatHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigFloatMul(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatMul(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigFloatHandleArg, op1Handle), handles.handle(executor.BigFloatHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigFloatDiv(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatDiv(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigFloatHandleArg, op1Handle), handles.handle(executor.BigFloatHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigFloatNeg(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatNeg(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigFloatHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigFloatClone(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatClone(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigFloatHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigFloatCmp(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatCmp(handles.handle(executor.BigFloatHandleArg, op1Handle), handles.handle(executor.BigFloatHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigFloatAbs(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatAbs(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigFloatHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigFloatSign(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatSign(handles.handle(executor.BigFloatHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigFloatSqrt(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatSqrt(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigFloatHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigFloatPow(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32, exponent int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatPow(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigFloatHandleArg, opHandle), exponent)
+		})
+	})
+}
+
+func FuzzBigFloatFloor(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destBigIntHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatFloor(handles.handle(executor.BigIntHandleArg, destBigIntHandle), handles.handle(executor.BigFloatHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigFloatCeil(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destBigIntHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatCeil(handles.handle(executor.BigIntHandleArg, destBigIntHandle), handles.handle(executor.BigFloatHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigFloatTruncate(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destBigIntHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatTruncate(handles.handle(executor.BigIntHandleArg, destBigIntHandle), handles.handle(executor.BigFloatHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigFloatSetInt64(f *testing.F) {
+	f.Add(int32(0), int64(0))
+	f.Add(int32(-1), int64(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, value int64) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatSetInt64(handles.handle(executor.BigFloatHandleArg, destinationHandle), value)
+		})
+	})
+}
+
+func FuzzBigFloatIsInt(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatIsInt(handles.handle(executor.BigFloatHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigFloatSetBigInt(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, bigIntHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatSetBigInt(handles.handle(executor.BigFloatHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, bigIntHandle))
+		})
+	})
+}
+
+func FuzzBigFloatGetConstPi(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatGetConstPi(handles.handle(executor.BigFloatHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzBigFloatGetConstE(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigFloatGetConstE(handles.handle(executor.BigFloatHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzBigIntGetUnsignedArgument(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, id int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntGetUnsignedArgument(id, handles.handle(executor.BigIntHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzBigIntGetSignedArgument(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, id int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntGetSignedArgument(id, handles.handle(executor.BigIntHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzBigIntGetCallValue(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntGetCallValue(handles.handle(executor.BigIntHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzBigIntGetESDTCallValue(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destination int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntGetESDTCallValue(handles.handle(executor.BigIntHandleArg, destination))
+		})
+	})
+}
+
+func FuzzBigIntGetESDTCallValueByIndex(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, index int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntGetESDTCallValueByIndex(handles.handle(executor.BigIntHandleArg, destinationHandle), index)
+		})
+	})
+}
+
+func FuzzBigIntUnsignedByteLength(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, referenceHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntUnsignedByteLength(handles.handle(executor.BigIntHandleArg, referenceHandle))
+		})
+	})
+}
+
+func FuzzBigIntSignedByteLength(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, referenceHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntSignedByteLength(handles.handle(executor.BigIntHandleArg, referenceHandle))
+		})
+	})
+}
+
+func FuzzBigIntIsInt64(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntIsInt64(handles.handle(executor.BigIntHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzBigIntGetInt64(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntGetInt64(handles.handle(executor.BigIntHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzBigIntSetInt64(f *testing.F) {
+	f.Add(int32(0), int64(0))
+	f.Add(int32(-1), int64(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, value int64) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntSetInt64(handles.handle(executor.BigIntHandleArg, destinationHandle), value)
+		})
+	})
+}
+
+func FuzzBigIntAdd(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntAdd(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntSub(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntSub(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntMul(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntMul(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntTDiv(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntTDiv(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntTMod(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntTMod(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntEDiv(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntEDiv(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntEMod(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntEMod(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntSqrt(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntSqrt(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigIntPow(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntPow(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntLog2(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, op1Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntLog2(handles.handle(executor.BigIntHandleArg, op1Handle))
+		})
+	})
+}
+
+func FuzzBigIntAbs(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntAbs(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigIntNeg(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntNeg(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigIntSign(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntSign(handles.handle(executor.BigIntHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigIntCmp(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntCmp(handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntNot(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntNot(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzBigIntAnd(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntAnd(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntOr(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntOr(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntXor(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntXor(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, op1Handle), handles.handle(executor.BigIntHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzBigIntShr(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32, bits int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntShr(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, opHandle), bits)
+		})
+	})
+}
+
+func FuzzBigIntShl(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32, bits int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntShl(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, opHandle), bits)
+		})
+	})
+}
+
+func FuzzBigIntFinishUnsigned(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, referenceHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntFinishUnsigned(handles.handle(executor.BigIntHandleArg, referenceHandle))
+		})
+	})
+}
+
+func FuzzBigIntFinishSigned(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, referenceHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntFinishSigned(handles.handle(executor.BigIntHandleArg, referenceHandle))
+		})
+	})
+}
+
+func FuzzBigIntToString(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, bigIntHandle int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntToString(handles.handle(executor.BigIntHandleArg, bigIntHandle), handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzBigIntSetRandomInRange(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, minHandle int32, maxHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.BigIntSetRandomInRange(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, minHandle), handles.handle(executor.BigIntHandleArg, maxHandle))
+		})
+	})
+}
+
+func FuzzMBufferGetLength(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferGetLength(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle))
+		})
+	})
+}
+
+func FuzzMBufferCopyByteSlice(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, sourceHandle int32, startingPosition int32, sliceLength int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferCopyByteSlice(handles.handle(executor.ManagedBufferHandleArg, sourceHandle), startingPosition, sliceLength, handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzMBufferEq(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle1 int32, mBufferHandle2 int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferEq(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle1), handles.handle(executor.ManagedBufferHandleArg, mBufferHandle2))
+		})
+	})
+}
+
+func FuzzMBufferAppend(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, accumulatorHandle int32, dataHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferAppend(handles.handle(executor.ManagedBufferHandleArg, accumulatorHandle), handles.handle(executor.ManagedBufferHandleArg, dataHandle))
+		})
+	})
+}
+
+func FuzzMBufferToBigIntUnsigned(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32, bigIntHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferToBigIntUnsigned(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle), handles.handle(executor.BigIntHandleArg, bigIntHandle))
+		})
+	})
+}
+
+func FuzzMBufferToBigIntSigned(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32, bigIntHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferToBigIntSigned(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle), handles.handle(executor.BigIntHandleArg, bigIntHandle))
+		})
+	})
+}
+
+func FuzzMBufferFromBigIntUnsigned(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32, bigIntHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferFromBigIntUnsigned(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle), handles.handle(executor.BigIntHandleArg, bigIntHandle))
+		})
+	})
+}
+
+func FuzzMBufferFromBigIntSigned(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32, bigIntHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferFromBigIntSigned(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle), handles.handle(executor.BigIntHandleArg, bigIntHandle))
+		})
+	})
+}
+
+func FuzzMBufferToSmallIntUnsigned(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferToSmallIntUnsigned(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle))
+		})
+	})
+}
+
+func FuzzMBufferToSmallIntSigned(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferToSmallIntSigned(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle))
+		})
+	})
+}
+
+func FuzzMBufferFromSmallIntUnsigned(f *testing.F) {
+	f.Add(int32(0), int64(0))
+	f.Add(int32(-1), int64(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32, value int64) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferFromSmallIntUnsigned(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle), value)
+		})
+	})
+}
+
+func FuzzMBufferFromSmallIntSigned(f *testing.F) {
+	f.Add(int32(0), int64(0))
+	f.Add(int32(-1), int64(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32, value int64) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferFromSmallIntSigned(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle), value)
+		})
+	})
+}
+
+func FuzzMBufferToBigFloat(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32, bigFloatHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferToBigFloat(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle), handles.handle(executor.BigFloatHandleArg, bigFloatHandle))
+		})
+	})
+}
+
+func FuzzMBufferFromBigFloat(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mBufferHandle int32, bigFloatHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferFromBigFloat(handles.handle(executor.ManagedBufferHandleArg, mBufferHandle), handles.handle(executor.BigFloatHandleArg, bigFloatHandle))
+		})
+	})
+}
+
+func FuzzMBufferStorageStore(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, sourceHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferStorageStore(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, sourceHandle))
+		})
+	})
+}
+
+func FuzzMBufferStorageLoad(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferStorageLoad(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzMBufferStorageLoadFromAddress(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, addressHandle int32, keyHandle int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferStorageLoadFromAddress(handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzMBufferStorageLoadBatch(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keysHandle int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferStorageLoadBatch(handles.handle(executor.ManagedBufferHandleArg, keysHandle), handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzMBufferStorageLoadBatchFromAddress(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, addressHandle int32, keysHandle int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferStorageLoadBatchFromAddress(handles.handle(executor.ManagedBufferHandleArg, addressHandle), handles.handle(executor.ManagedBufferHandleArg, keysHandle), handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzMBufferGetArgument(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, id int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferGetArgument(id, handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzMBufferFinish(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, sourceHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferFinish(handles.handle(executor.ManagedBufferHandleArg, sourceHandle))
+		})
+	})
+}
+
+func FuzzMBufferSetRandom(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, length int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferSetRandom(handles.handle(executor.ManagedBufferHandleArg, destinationHandle), length)
+		})
+	})
+}
+
+func FuzzManagedMapPut(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mMapHandle int32, keyHandle int32, valueHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedMapPut(handles.handle(executor.ManagedMapHandleArg, mMapHandle), handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, valueHandle))
+		})
+	})
+}
+
+func FuzzManagedMapGet(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mMapHandle int32, keyHandle int32, outValueHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedMapGet(handles.handle(executor.ManagedMapHandleArg, mMapHandle), handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, outValueHandle))
+		})
+	})
+}
+
+func FuzzManagedMapRemove(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mMapHandle int32, keyHandle int32, outValueHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedMapRemove(handles.handle(executor.ManagedMapHandleArg, mMapHandle), handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, outValueHandle))
+		})
+	})
+}
+
+func FuzzManagedMapContains(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, mMapHandle int32, keyHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedMapContains(handles.handle(executor.ManagedMapHandleArg, mMapHandle), handles.handle(executor.ManagedBufferHandleArg, keyHandle))
+		})
+	})
+}
+
+func FuzzMDecimalFromBigInt(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, bigIntHandle int32, scale int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalFromBigInt(handles.handle(executor.ManagedDecimalHandleArg, destinationHandle), handles.handle(executor.BigIntHandleArg, bigIntHandle), scale)
+		})
+	})
+}
+
+func FuzzMDecimalToBigInt(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, decimalHandle int32, roundingMode int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalToBigInt(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.ManagedDecimalHandleArg, decimalHandle), roundingMode)
+		})
+	})
+}
+
+func FuzzMDecimalGetMantissa(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, decimalHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalGetMantissa(handles.handle(executor.BigIntHandleArg, destinationHandle), handles.handle(executor.ManagedDecimalHandleArg, decimalHandle))
+		})
+	})
+}
+
+func FuzzMDecimalGetScale(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, decimalHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalGetScale(handles.handle(executor.ManagedDecimalHandleArg, decimalHandle))
+		})
+	})
+}
+
+func FuzzMDecimalFromManagedBuffer(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, mBufferHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalFromManagedBuffer(handles.handle(executor.ManagedDecimalHandleArg, destinationHandle), handles.handle(executor.ManagedBufferHandleArg, mBufferHandle))
+		})
+	})
+}
+
+func FuzzMDecimalToManagedBuffer(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, decimalHandle int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalToManagedBuffer(handles.handle(executor.ManagedDecimalHandleArg, decimalHandle), handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzMDecimalAdd(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalAdd(handles.handle(executor.ManagedDecimalHandleArg, destinationHandle), handles.handle(executor.ManagedDecimalHandleArg, op1Handle), handles.handle(executor.ManagedDecimalHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzMDecimalSub(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalSub(handles.handle(executor.ManagedDecimalHandleArg, destinationHandle), handles.handle(executor.ManagedDecimalHandleArg, op1Handle), handles.handle(executor.ManagedDecimalHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzMDecimalMul(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32, roundingMode int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalMul(handles.handle(executor.ManagedDecimalHandleArg, destinationHandle), handles.handle(executor.ManagedDecimalHandleArg, op1Handle), handles.handle(executor.ManagedDecimalHandleArg, op2Handle), roundingMode)
+		})
+	})
+}
+
+func FuzzMDecimalDiv(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, op1Handle int32, op2Handle int32, roundingMode int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalDiv(handles.handle(executor.ManagedDecimalHandleArg, destinationHandle), handles.handle(executor.ManagedDecimalHandleArg, op1Handle), handles.handle(executor.ManagedDecimalHandleArg, op2Handle), roundingMode)
+		})
+	})
+}
+
+func FuzzMDecimalRescale(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32, scale int32, roundingMode int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalRescale(handles.handle(executor.ManagedDecimalHandleArg, destinationHandle), handles.handle(executor.ManagedDecimalHandleArg, opHandle), scale, roundingMode)
+		})
+	})
+}
+
+func FuzzMDecimalCmp(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, op1Handle int32, op2Handle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalCmp(handles.handle(executor.ManagedDecimalHandleArg, op1Handle), handles.handle(executor.ManagedDecimalHandleArg, op2Handle))
+		})
+	})
+}
+
+func FuzzMDecimalLn(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalLn(handles.handle(executor.ManagedDecimalHandleArg, destinationHandle), handles.handle(executor.ManagedDecimalHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzMDecimalExp(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, destinationHandle int32, opHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MDecimalExp(handles.handle(executor.ManagedDecimalHandleArg, destinationHandle), handles.handle(executor.ManagedDecimalHandleArg, opHandle))
+		})
+	})
+}
+
+func FuzzMBufferTransientStorageStore(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, sourceHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferTransientStorageStore(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, sourceHandle))
+		})
+	})
+}
+
+func FuzzMBufferTransientStorageLoad(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, destinationHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.MBufferTransientStorageLoad(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, destinationHandle))
+		})
+	})
+}
+
+func FuzzManagedProtectEndpointAgainstReentrancy(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, endpointHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedProtectEndpointAgainstReentrancy(handles.handle(executor.ManagedBufferHandleArg, endpointHandle))
+		})
+	})
+}
+
+func FuzzManagedSha256(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, inputHandle int32, outputHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedSha256(handles.handle(executor.ManagedBufferHandleArg, inputHandle), handles.handle(executor.ManagedBufferHandleArg, outputHandle))
+		})
+	})
+}
+
+func FuzzManagedKeccak256(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, inputHandle int32, outputHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedKeccak256(handles.handle(executor.ManagedBufferHandleArg, inputHandle), handles.handle(executor.ManagedBufferHandleArg, outputHandle))
+		})
+	})
+}
+
+func FuzzManagedRipemd160(f *testing.F) {
+	f.Add(int32(0), int32(0))
+	f.Add(int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, inputHandle int32, outputHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedRipemd160(handles.handle(executor.ManagedBufferHandleArg, inputHandle), handles.handle(executor.ManagedBufferHandleArg, outputHandle))
+		})
+	})
+}
+
+func FuzzManagedVerifyBLS(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, messageHandle int32, sigHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedVerifyBLS(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, messageHandle), handles.handle(executor.ManagedBufferHandleArg, sigHandle))
+		})
+	})
+}
+
+func FuzzManagedVerifyEd25519(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, messageHandle int32, sigHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedVerifyEd25519(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, messageHandle), handles.handle(executor.ManagedBufferHandleArg, sigHandle))
+		})
+	})
+}
+
+func FuzzManagedVerifyCustomSecp256k1(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, messageHandle int32, sigHandle int32, hashType int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedVerifyCustomSecp256k1(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, messageHandle), handles.handle(executor.ManagedBufferHandleArg, sigHandle), hashType)
+		})
+	})
+}
+
+func FuzzManagedVerifySecp256k1(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, messageHandle int32, sigHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedVerifySecp256k1(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, messageHandle), handles.handle(executor.ManagedBufferHandleArg, sigHandle))
+		})
+	})
+}
+
+func FuzzManagedEncodeSecp256k1DerSignature(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, rHandle int32, sHandle int32, sigHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedEncodeSecp256k1DerSignature(handles.handle(executor.ManagedBufferHandleArg, rHandle), handles.handle(executor.ManagedBufferHandleArg, sHandle), handles.handle(executor.ManagedBufferHandleArg, sigHandle))
+		})
+	})
+}
+
+func FuzzAddEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, xResultHandle int32, yResultHandle int32, ecHandle int32, fstPointXHandle int32, fstPointYHandle int32, sndPointXHandle int32, sndPointYHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.AddEC(handles.handle(executor.BigIntHandleArg, xResultHandle), handles.handle(executor.BigIntHandleArg, yResultHandle), handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.BigIntHandleArg, fstPointXHandle), handles.handle(executor.BigIntHandleArg, fstPointYHandle), handles.handle(executor.BigIntHandleArg, sndPointXHandle), handles.handle(executor.BigIntHandleArg, sndPointYHandle))
+		})
+	})
+}
+
+func FuzzDoubleEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.DoubleEC(handles.handle(executor.BigIntHandleArg, xResultHandle), handles.handle(executor.BigIntHandleArg, yResultHandle), handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.BigIntHandleArg, pointXHandle), handles.handle(executor.BigIntHandleArg, pointYHandle))
+		})
+	})
+}
+
+func FuzzIsOnCurveEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, ecHandle int32, pointXHandle int32, pointYHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.IsOnCurveEC(handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.BigIntHandleArg, pointXHandle), handles.handle(executor.BigIntHandleArg, pointYHandle))
+		})
+	})
+}
+
+func FuzzManagedScalarBaseMultEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, xResultHandle int32, yResultHandle int32, ecHandle int32, dataHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedScalarBaseMultEC(handles.handle(executor.BigIntHandleArg, xResultHandle), handles.handle(executor.BigIntHandleArg, yResultHandle), handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.ManagedBufferHandleArg, dataHandle))
+		})
+	})
+}
+
+func FuzzManagedScalarMultEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, xResultHandle int32, yResultHandle int32, ecHandle int32, pointXHandle int32, pointYHandle int32, dataHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedScalarMultEC(handles.handle(executor.BigIntHandleArg, xResultHandle), handles.handle(executor.BigIntHandleArg, yResultHandle), handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.BigIntHandleArg, pointXHandle), handles.handle(executor.BigIntHandleArg, pointYHandle), handles.handle(executor.ManagedBufferHandleArg, dataHandle))
+		})
+	})
+}
+
+func FuzzManagedMarshalEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, xPairHandle int32, yPairHandle int32, ecHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedMarshalEC(handles.handle(executor.BigIntHandleArg, xPairHandle), handles.handle(executor.BigIntHandleArg, yPairHandle), handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedMarshalCompressedEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, xPairHandle int32, yPairHandle int32, ecHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedMarshalCompressedEC(handles.handle(executor.BigIntHandleArg, xPairHandle), handles.handle(executor.BigIntHandleArg, yPairHandle), handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedUnmarshalEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, xResultHandle int32, yResultHandle int32, ecHandle int32, dataHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedUnmarshalEC(handles.handle(executor.BigIntHandleArg, xResultHandle), handles.handle(executor.BigIntHandleArg, yResultHandle), handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.ManagedBufferHandleArg, dataHandle))
+		})
+	})
+}
+
+func FuzzManagedUnmarshalCompressedEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, xResultHandle int32, yResultHandle int32, ecHandle int32, dataHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedUnmarshalCompressedEC(handles.handle(executor.BigIntHandleArg, xResultHandle), handles.handle(executor.BigIntHandleArg, yResultHandle), handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.ManagedBufferHandleArg, dataHandle))
+		})
+	})
+}
+
+func FuzzManagedGenerateKeyEC(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, xPubKeyHandle int32, yPubKeyHandle int32, ecHandle int32, resultHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedGenerateKeyEC(handles.handle(executor.BigIntHandleArg, xPubKeyHandle), handles.handle(executor.BigIntHandleArg, yPubKeyHandle), handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.ManagedBufferHandleArg, resultHandle))
+		})
+	})
+}
+
+func FuzzManagedCreateEC(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, dataHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedCreateEC(handles.handle(executor.ManagedBufferHandleArg, dataHandle))
+		})
+	})
+}
+
+func FuzzGetCurveLengthEC(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, ecHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.GetCurveLengthEC(handles.handle(executor.EllipticCurveHandleArg, ecHandle))
+		})
+	})
+}
+
+func FuzzGetPrivKeyByteLengthEC(f *testing.F) {
+	f.Add(int32(0))
+	f.Add(int32(-1))
+	f.Fuzz(func(t *testing.T, ecHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.GetPrivKeyByteLengthEC(handles.handle(executor.EllipticCurveHandleArg, ecHandle))
+		})
+	})
+}
+
+func FuzzEllipticCurveGetValues(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0), int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1), int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, ecHandle int32, fieldOrderHandle int32, basePointOrderHandle int32, eqConstantHandle int32, xBasePointHandle int32, yBasePointHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.EllipticCurveGetValues(handles.handle(executor.EllipticCurveHandleArg, ecHandle), handles.handle(executor.BigIntHandleArg, fieldOrderHandle), handles.handle(executor.BigIntHandleArg, basePointOrderHandle), handles.handle(executor.BigIntHandleArg, eqConstantHandle), handles.handle(executor.BigIntHandleArg, xBasePointHandle), handles.handle(executor.BigIntHandleArg, yBasePointHandle))
+		})
+	})
+}
+
+func FuzzManagedVerifySecp256r1(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, messageHandle int32, sigHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedVerifySecp256r1(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, messageHandle), handles.handle(executor.ManagedBufferHandleArg, sigHandle))
+		})
+	})
+}
+
+func FuzzManagedVerifyBLSSignatureShare(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, messageHandle int32, sigHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedVerifyBLSSignatureShare(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, messageHandle), handles.handle(executor.ManagedBufferHandleArg, sigHandle))
+		})
+	})
+}
+
+func FuzzManagedVerifyBLSAggregatedSignature(f *testing.F) {
+	f.Add(int32(0), int32(0), int32(0))
+	f.Add(int32(-1), int32(-1), int32(-1))
+	f.Fuzz(func(t *testing.T, keyHandle int32, messageHandle int32, sigHandle int32) {
+		runVMHookFuzzTarget(t, func(hooks *vmhooks.VMHooksImpl, handles *fuzzHandles) {
+			hooks.ManagedVerifyBLSAggregatedSignature(handles.handle(executor.ManagedBufferHandleArg, keyHandle), handles.handle(executor.ManagedBufferHandleArg, messageHandle), handles.handle(executor.ManagedBufferHandleArg, sigHandle))
+		})
+	})
+}
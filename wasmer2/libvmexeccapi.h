@@ -315,6 +315,47 @@ typedef struct {
   int32_t (*managed_verify_secp256r1_func_ptr)(void *context, int32_t key_handle, int32_t message_handle, int32_t sig_handle);
   int32_t (*managed_verify_blssignature_share_func_ptr)(void *context, int32_t key_handle, int32_t message_handle, int32_t sig_handle);
   int32_t (*managed_verify_blsaggregated_signature_func_ptr)(void *context, int32_t key_handle, int32_t message_handle, int32_t sig_handle);
+  int32_t (*mdecimal_from_big_int_func_ptr)(void *context, int32_t destination_handle, int32_t big_int_handle, int32_t scale);
+  int32_t (*mdecimal_to_big_int_func_ptr)(void *context, int32_t destination_handle, int32_t decimal_handle, int32_t rounding_mode);
+  int32_t (*mdecimal_get_mantissa_func_ptr)(void *context, int32_t destination_handle, int32_t decimal_handle);
+  int32_t (*mdecimal_get_scale_func_ptr)(void *context, int32_t decimal_handle);
+  int32_t (*mdecimal_from_managed_buffer_func_ptr)(void *context, int32_t destination_handle, int32_t m_buffer_handle);
+  int32_t (*mdecimal_to_managed_buffer_func_ptr)(void *context, int32_t decimal_handle, int32_t destination_handle);
+  int32_t (*mdecimal_add_func_ptr)(void *context, int32_t destination_handle, int32_t op1_handle, int32_t op2_handle);
+  int32_t (*mdecimal_sub_func_ptr)(void *context, int32_t destination_handle, int32_t op1_handle, int32_t op2_handle);
+  int32_t (*mdecimal_mul_func_ptr)(void *context, int32_t destination_handle, int32_t op1_handle, int32_t op2_handle, int32_t rounding_mode);
+  int32_t (*mdecimal_div_func_ptr)(void *context, int32_t destination_handle, int32_t op1_handle, int32_t op2_handle, int32_t rounding_mode);
+  int32_t (*mdecimal_rescale_func_ptr)(void *context, int32_t destination_handle, int32_t op_handle, int32_t scale, int32_t rounding_mode);
+  int32_t (*mdecimal_cmp_func_ptr)(void *context, int32_t op1_handle, int32_t op2_handle);
+  int32_t (*mdecimal_ln_func_ptr)(void *context, int32_t destination_handle, int32_t op_handle);
+  int32_t (*mdecimal_exp_func_ptr)(void *context, int32_t destination_handle, int32_t op_handle);
+  int32_t (*big_int_set_random_in_range_func_ptr)(void *context, int32_t destination_handle, int32_t min_handle, int32_t max_handle);
+  int64_t (*small_int_get_random_in_range_func_ptr)(void *context, int64_t min, int64_t max);
+  int32_t (*mbuffer_transient_storage_store_func_ptr)(void *context, int32_t key_handle, int32_t source_handle);
+  int32_t (*mbuffer_transient_storage_load_func_ptr)(void *context, int32_t key_handle, int32_t destination_handle);
+  int32_t (*protect_contract_against_reentrancy_func_ptr)(void *context);
+  int32_t (*managed_protect_endpoint_against_reentrancy_func_ptr)(void *context, int32_t endpoint_handle);
+  int32_t (*managed_create_contract_with_error_return_func_ptr)(void *context, int64_t gas, int32_t value_handle, int32_t code_handle, int32_t code_metadata_handle, int32_t arguments_handle, int32_t result_address_handle, int32_t result_handle, int32_t error_code_handle, int32_t error_message_handle);
+  int32_t (*managed_deploy_from_source_contract_with_error_return_func_ptr)(void *context, int64_t gas, int32_t value_handle, int32_t address_handle, int32_t code_metadata_handle, int32_t arguments_handle, int32_t result_address_handle, int32_t result_handle, int32_t error_code_handle, int32_t error_message_handle);
+  int32_t (*managed_upgrade_contract_with_error_return_func_ptr)(void *context, int32_t dest_handle, int64_t gas, int32_t value_handle, int32_t code_handle, int32_t code_metadata_handle, int32_t arguments_handle, int32_t result_handle, int32_t error_code_handle, int32_t error_message_handle);
+  int32_t (*managed_upgrade_from_source_contract_with_error_return_func_ptr)(void *context, int32_t dest_handle, int64_t gas, int32_t value_handle, int32_t address_handle, int32_t code_metadata_handle, int32_t arguments_handle, int32_t result_handle, int32_t error_code_handle, int32_t error_message_handle);
+  int32_t (*managed_transfer_value_execute_with_error_return_func_ptr)(void *context, int32_t dst_handle, int32_t value_handle, int64_t gas_limit, int32_t function_handle, int32_t arguments_handle, int32_t error_code_handle, int32_t error_message_handle);
+  int32_t (*mbuffer_storage_load_batch_func_ptr)(void *context, int32_t keys_handle, int32_t destination_handle);
+  void (*mbuffer_storage_load_batch_from_address_func_ptr)(void *context, int32_t address_handle, int32_t keys_handle, int32_t destination_handle);
+  int32_t (*eth_get_call_data_size_func_ptr)(void *context);
+  void (*eth_call_data_copy_func_ptr)(void *context, int32_t result_offset, int32_t data_offset, int32_t length);
+  void (*eth_storage_store_func_ptr)(void *context, int32_t path_offset, int32_t value_offset);
+  void (*eth_storage_load_func_ptr)(void *context, int32_t path_offset, int32_t result_offset);
+  void (*eth_get_call_value_func_ptr)(void *context, int32_t result_offset);
+  void (*eth_get_caller_func_ptr)(void *context, int32_t result_offset);
+  void (*eth_get_address_func_ptr)(void *context, int32_t result_offset);
+  void (*eth_get_tx_origin_func_ptr)(void *context, int32_t result_offset);
+  int64_t (*eth_get_gas_left_func_ptr)(void *context);
+  int64_t (*eth_get_block_number_func_ptr)(void *context);
+  int64_t (*eth_get_block_timestamp_func_ptr)(void *context);
+  void (*eth_log_func_ptr)(void *context, int32_t data_offset, int32_t data_length, int32_t number_of_topics, int32_t topic1_offset, int32_t topic2_offset, int32_t topic3_offset, int32_t topic4_offset);
+  void (*eth_finish_func_ptr)(void *context, int32_t data_offset, int32_t data_length);
+  void (*eth_revert_func_ptr)(void *context, int32_t data_offset, int32_t data_length);
 } vm_exec_vm_hook_c_func_pointers;
 
 typedef struct {
@@ -132,6 +132,11 @@ package wasmer2
 // extern int32_t   w2_managedMultiTransferESDTNFTExecuteWithReturn(void* context, int32_t dstHandle, int32_t tokenTransfersHandle, long long gasLimit, int32_t functionHandle, int32_t argumentsHandle);
 // extern int32_t   w2_managedMultiTransferESDTNFTExecuteByUser(void* context, int32_t userHandle, int32_t dstHandle, int32_t tokenTransfersHandle, long long gasLimit, int32_t functionHandle, int32_t argumentsHandle);
 // extern int32_t   w2_managedTransferValueExecute(void* context, int32_t dstHandle, int32_t valueHandle, long long gasLimit, int32_t functionHandle, int32_t argumentsHandle);
+// extern int32_t   w2_managedCreateContractWithErrorReturn(void* context, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultAddressHandle, int32_t resultHandle, int32_t errorCodeHandle, int32_t errorMessageHandle);
+// extern int32_t   w2_managedDeployFromSourceContractWithErrorReturn(void* context, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultAddressHandle, int32_t resultHandle, int32_t errorCodeHandle, int32_t errorMessageHandle);
+// extern int32_t   w2_managedUpgradeContractWithErrorReturn(void* context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t codeHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle, int32_t errorCodeHandle, int32_t errorMessageHandle);
+// extern int32_t   w2_managedUpgradeFromSourceContractWithErrorReturn(void* context, int32_t destHandle, long long gas, int32_t valueHandle, int32_t addressHandle, int32_t codeMetadataHandle, int32_t argumentsHandle, int32_t resultHandle, int32_t errorCodeHandle, int32_t errorMessageHandle);
+// extern int32_t   w2_managedTransferValueExecuteWithErrorReturn(void* context, int32_t dstHandle, int32_t valueHandle, long long gasLimit, int32_t functionHandle, int32_t argumentsHandle, int32_t errorCodeHandle, int32_t errorMessageHandle);
 // extern int32_t   w2_managedIsESDTFrozen(void* context, int32_t addressHandle, int32_t tokenIDHandle, long long nonce);
 // extern int32_t   w2_managedIsESDTLimitedTransfer(void* context, int32_t tokenIDHandle);
 // extern int32_t   w2_managedIsESDTPaused(void* context, int32_t tokenIDHandle);
@@ -203,6 +208,7 @@ package wasmer2
 // extern void      w2_bigIntFinishUnsigned(void* context, int32_t referenceHandle);
 // extern void      w2_bigIntFinishSigned(void* context, int32_t referenceHandle);
 // extern void      w2_bigIntToString(void* context, int32_t bigIntHandle, int32_t destinationHandle);
+// extern int32_t   w2_bigIntSetRandomInRange(void* context, int32_t destinationHandle, int32_t minHandle, int32_t maxHandle);
 // extern int32_t   w2_mBufferNew(void* context);
 // extern int32_t   w2_mBufferNewFromBytes(void* context, int32_t dataOffset, int32_t dataLength);
 // extern int32_t   w2_mBufferGetLength(void* context, int32_t mBufferHandle);
@@ -227,6 +233,8 @@ package wasmer2
 // extern int32_t   w2_mBufferStorageStore(void* context, int32_t keyHandle, int32_t sourceHandle);
 // extern int32_t   w2_mBufferStorageLoad(void* context, int32_t keyHandle, int32_t destinationHandle);
 // extern void      w2_mBufferStorageLoadFromAddress(void* context, int32_t addressHandle, int32_t keyHandle, int32_t destinationHandle);
+// extern int32_t   w2_mBufferStorageLoadBatch(void* context, int32_t keysHandle, int32_t destinationHandle);
+// extern void      w2_mBufferStorageLoadBatchFromAddress(void* context, int32_t addressHandle, int32_t keysHandle, int32_t destinationHandle);
 // extern int32_t   w2_mBufferGetArgument(void* context, int32_t id, int32_t destinationHandle);
 // extern int32_t   w2_mBufferFinish(void* context, int32_t sourceHandle);
 // extern int32_t   w2_mBufferSetRandom(void* context, int32_t destinationHandle, int32_t length);
@@ -235,6 +243,38 @@ package wasmer2
 // extern int32_t   w2_managedMapGet(void* context, int32_t mMapHandle, int32_t keyHandle, int32_t outValueHandle);
 // extern int32_t   w2_managedMapRemove(void* context, int32_t mMapHandle, int32_t keyHandle, int32_t outValueHandle);
 // extern int32_t   w2_managedMapContains(void* context, int32_t mMapHandle, int32_t keyHandle);
+// extern int32_t   w2_mDecimalFromBigInt(void* context, int32_t destinationHandle, int32_t bigIntHandle, int32_t scale);
+// extern int32_t   w2_mDecimalToBigInt(void* context, int32_t destinationHandle, int32_t decimalHandle, int32_t roundingMode);
+// extern int32_t   w2_mDecimalGetMantissa(void* context, int32_t destinationHandle, int32_t decimalHandle);
+// extern int32_t   w2_mDecimalGetScale(void* context, int32_t decimalHandle);
+// extern int32_t   w2_mDecimalFromManagedBuffer(void* context, int32_t destinationHandle, int32_t mBufferHandle);
+// extern int32_t   w2_mDecimalToManagedBuffer(void* context, int32_t decimalHandle, int32_t destinationHandle);
+// extern int32_t   w2_mDecimalAdd(void* context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern int32_t   w2_mDecimalSub(void* context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle);
+// extern int32_t   w2_mDecimalMul(void* context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle, int32_t roundingMode);
+// extern int32_t   w2_mDecimalDiv(void* context, int32_t destinationHandle, int32_t op1Handle, int32_t op2Handle, int32_t roundingMode);
+// extern int32_t   w2_mDecimalRescale(void* context, int32_t destinationHandle, int32_t opHandle, int32_t scale, int32_t roundingMode);
+// extern int32_t   w2_mDecimalCmp(void* context, int32_t op1Handle, int32_t op2Handle);
+// extern int32_t   w2_mDecimalLn(void* context, int32_t destinationHandle, int32_t opHandle);
+// extern int32_t   w2_mDecimalExp(void* context, int32_t destinationHandle, int32_t opHandle);
+// extern int32_t   w2_mBufferTransientStorageStore(void* context, int32_t keyHandle, int32_t sourceHandle);
+// extern int32_t   w2_mBufferTransientStorageLoad(void* context, int32_t keyHandle, int32_t destinationHandle);
+// extern int32_t   w2_protectContractAgainstReentrancy(void* context);
+// extern int32_t   w2_managedProtectEndpointAgainstReentrancy(void* context, int32_t endpointHandle);
+// extern int32_t   w2_ethGetCallDataSize(void* context);
+// extern void      w2_ethCallDataCopy(void* context, int32_t resultOffset, int32_t dataOffset, int32_t length);
+// extern void      w2_ethStorageStore(void* context, int32_t pathOffset, int32_t valueOffset);
+// extern void      w2_ethStorageLoad(void* context, int32_t pathOffset, int32_t resultOffset);
+// extern void      w2_ethGetCallValue(void* context, int32_t resultOffset);
+// extern void      w2_ethGetCaller(void* context, int32_t resultOffset);
+// extern void      w2_ethGetAddress(void* context, int32_t resultOffset);
+// extern void      w2_ethGetTxOrigin(void* context, int32_t resultOffset);
+// extern long long w2_ethGetGasLeft(void* context);
+// extern long long w2_ethGetBlockNumber(void* context);
+// extern long long w2_ethGetBlockTimestamp(void* context);
+// extern void      w2_ethLog(void* context, int32_t dataOffset, int32_t dataLength, int32_t numberOfTopics, int32_t topic1Offset, int32_t topic2Offset, int32_t topic3Offset, int32_t topic4Offset);
+// extern void      w2_ethFinish(void* context, int32_t dataOffset, int32_t dataLength);
+// extern void      w2_ethRevert(void* context, int32_t dataOffset, int32_t dataLength);
 // extern long long w2_smallIntGetUnsignedArgument(void* context, int32_t id);
 // extern long long w2_smallIntGetSignedArgument(void* context, int32_t id);
 // extern void      w2_smallIntFinishUnsigned(void* context, long long value);
@@ -247,6 +287,7 @@ package wasmer2
 // extern void      w2_int64finish(void* context, long long value);
 // extern int32_t   w2_int64storageStore(void* context, int32_t keyOffset, int32_t keyLength, long long value);
 // extern long long w2_int64storageLoad(void* context, int32_t keyOffset, int32_t keyLength);
+// extern long long w2_smallIntGetRandomInRange(void* context, long long min, long long max);
 // extern int32_t   w2_sha256(void* context, int32_t dataOffset, int32_t length, int32_t resultOffset);
 // extern int32_t   w2_managedSha256(void* context, int32_t inputHandle, int32_t outputHandle);
 // extern int32_t   w2_keccak256(void* context, int32_t dataOffset, int32_t length, int32_t resultOffset);
@@ -299,283 +340,324 @@ import (
 // populateCgoFunctionPointers populates imports with the BaseOpsAPI API methods
 func populateCgoFunctionPointers() *cWasmerVmHookPointers {
 	return &cWasmerVmHookPointers{
-		get_gas_left_func_ptr:                                        funcPointer(C.w2_getGasLeft),
-		get_sc_address_func_ptr:                                      funcPointer(C.w2_getSCAddress),
-		get_owner_address_func_ptr:                                   funcPointer(C.w2_getOwnerAddress),
-		get_shard_of_address_func_ptr:                                funcPointer(C.w2_getShardOfAddress),
-		is_smart_contract_func_ptr:                                   funcPointer(C.w2_isSmartContract),
-		signal_error_func_ptr:                                        funcPointer(C.w2_signalError),
-		get_external_balance_func_ptr:                                funcPointer(C.w2_getExternalBalance),
-		get_block_hash_func_ptr:                                      funcPointer(C.w2_getBlockHash),
-		get_esdt_balance_func_ptr:                                    funcPointer(C.w2_getESDTBalance),
-		get_esdt_nft_name_length_func_ptr:                            funcPointer(C.w2_getESDTNFTNameLength),
-		get_esdt_nft_attribute_length_func_ptr:                       funcPointer(C.w2_getESDTNFTAttributeLength),
-		get_esdt_nft_uri_length_func_ptr:                             funcPointer(C.w2_getESDTNFTURILength),
-		get_esdt_token_data_func_ptr:                                 funcPointer(C.w2_getESDTTokenData),
-		get_esdt_local_roles_func_ptr:                                funcPointer(C.w2_getESDTLocalRoles),
-		validate_token_identifier_func_ptr:                           funcPointer(C.w2_validateTokenIdentifier),
-		transfer_value_func_ptr:                                      funcPointer(C.w2_transferValue),
-		transfer_value_execute_func_ptr:                              funcPointer(C.w2_transferValueExecute),
-		transfer_esdt_execute_func_ptr:                               funcPointer(C.w2_transferESDTExecute),
-		transfer_esdt_nft_execute_func_ptr:                           funcPointer(C.w2_transferESDTNFTExecute),
-		multi_transfer_esdt_nft_execute_func_ptr:                     funcPointer(C.w2_multiTransferESDTNFTExecute),
-		create_async_call_func_ptr:                                   funcPointer(C.w2_createAsyncCall),
-		set_async_context_callback_func_ptr:                          funcPointer(C.w2_setAsyncContextCallback),
-		upgrade_contract_func_ptr:                                    funcPointer(C.w2_upgradeContract),
-		upgrade_from_source_contract_func_ptr:                        funcPointer(C.w2_upgradeFromSourceContract),
-		delete_contract_func_ptr:                                     funcPointer(C.w2_deleteContract),
-		async_call_func_ptr:                                          funcPointer(C.w2_asyncCall),
-		get_argument_length_func_ptr:                                 funcPointer(C.w2_getArgumentLength),
-		get_argument_func_ptr:                                        funcPointer(C.w2_getArgument),
-		get_function_func_ptr:                                        funcPointer(C.w2_getFunction),
-		get_num_arguments_func_ptr:                                   funcPointer(C.w2_getNumArguments),
-		storage_store_func_ptr:                                       funcPointer(C.w2_storageStore),
-		storage_load_length_func_ptr:                                 funcPointer(C.w2_storageLoadLength),
-		storage_load_from_address_func_ptr:                           funcPointer(C.w2_storageLoadFromAddress),
-		storage_load_func_ptr:                                        funcPointer(C.w2_storageLoad),
-		set_storage_lock_func_ptr:                                    funcPointer(C.w2_setStorageLock),
-		get_storage_lock_func_ptr:                                    funcPointer(C.w2_getStorageLock),
-		is_storage_locked_func_ptr:                                   funcPointer(C.w2_isStorageLocked),
-		clear_storage_lock_func_ptr:                                  funcPointer(C.w2_clearStorageLock),
-		get_caller_func_ptr:                                          funcPointer(C.w2_getCaller),
-		check_no_payment_func_ptr:                                    funcPointer(C.w2_checkNoPayment),
-		get_call_value_func_ptr:                                      funcPointer(C.w2_getCallValue),
-		get_esdt_value_func_ptr:                                      funcPointer(C.w2_getESDTValue),
-		get_esdt_value_by_index_func_ptr:                             funcPointer(C.w2_getESDTValueByIndex),
-		get_esdt_token_name_func_ptr:                                 funcPointer(C.w2_getESDTTokenName),
-		get_esdt_token_name_by_index_func_ptr:                        funcPointer(C.w2_getESDTTokenNameByIndex),
-		get_esdt_token_nonce_func_ptr:                                funcPointer(C.w2_getESDTTokenNonce),
-		get_esdt_token_nonce_by_index_func_ptr:                       funcPointer(C.w2_getESDTTokenNonceByIndex),
-		get_current_esdt_nft_nonce_func_ptr:                          funcPointer(C.w2_getCurrentESDTNFTNonce),
-		get_esdt_token_type_func_ptr:                                 funcPointer(C.w2_getESDTTokenType),
-		get_esdt_token_type_by_index_func_ptr:                        funcPointer(C.w2_getESDTTokenTypeByIndex),
-		get_num_esdt_transfers_func_ptr:                              funcPointer(C.w2_getNumESDTTransfers),
-		get_call_value_token_name_func_ptr:                           funcPointer(C.w2_getCallValueTokenName),
-		get_call_value_token_name_by_index_func_ptr:                  funcPointer(C.w2_getCallValueTokenNameByIndex),
-		is_reserved_function_name_func_ptr:                           funcPointer(C.w2_isReservedFunctionName),
-		write_log_func_ptr:                                           funcPointer(C.w2_writeLog),
-		write_event_log_func_ptr:                                     funcPointer(C.w2_writeEventLog),
-		get_block_timestamp_func_ptr:                                 funcPointer(C.w2_getBlockTimestamp),
-		get_block_timestamp_ms_func_ptr:                              funcPointer(C.w2_getBlockTimestampMs),
-		get_block_nonce_func_ptr:                                     funcPointer(C.w2_getBlockNonce),
-		get_block_round_func_ptr:                                     funcPointer(C.w2_getBlockRound),
-		get_block_epoch_func_ptr:                                     funcPointer(C.w2_getBlockEpoch),
-		get_block_random_seed_func_ptr:                               funcPointer(C.w2_getBlockRandomSeed),
-		get_state_root_hash_func_ptr:                                 funcPointer(C.w2_getStateRootHash),
-		get_prev_block_timestamp_func_ptr:                            funcPointer(C.w2_getPrevBlockTimestamp),
-		get_prev_block_timestamp_ms_func_ptr:                         funcPointer(C.w2_getPrevBlockTimestampMs),
-		get_prev_block_nonce_func_ptr:                                funcPointer(C.w2_getPrevBlockNonce),
-		get_prev_block_round_func_ptr:                                funcPointer(C.w2_getPrevBlockRound),
-		get_prev_block_epoch_func_ptr:                                funcPointer(C.w2_getPrevBlockEpoch),
-		get_prev_block_random_seed_func_ptr:                          funcPointer(C.w2_getPrevBlockRandomSeed),
-		get_block_round_time_ms_func_ptr:                             funcPointer(C.w2_getBlockRoundTimeMs),
-		epoch_start_block_timestamp_ms_func_ptr:                      funcPointer(C.w2_epochStartBlockTimestampMs),
-		epoch_start_block_nonce_func_ptr:                             funcPointer(C.w2_epochStartBlockNonce),
-		epoch_start_block_round_func_ptr:                             funcPointer(C.w2_epochStartBlockRound),
-		finish_func_ptr:                                              funcPointer(C.w2_finish),
-		execute_on_same_context_func_ptr:                             funcPointer(C.w2_executeOnSameContext),
-		execute_on_dest_context_func_ptr:                             funcPointer(C.w2_executeOnDestContext),
-		execute_read_only_func_ptr:                                   funcPointer(C.w2_executeReadOnly),
-		create_contract_func_ptr:                                     funcPointer(C.w2_createContract),
-		deploy_from_source_contract_func_ptr:                         funcPointer(C.w2_deployFromSourceContract),
-		get_num_return_data_func_ptr:                                 funcPointer(C.w2_getNumReturnData),
-		get_return_data_size_func_ptr:                                funcPointer(C.w2_getReturnDataSize),
-		get_return_data_func_ptr:                                     funcPointer(C.w2_getReturnData),
-		clean_return_data_func_ptr:                                   funcPointer(C.w2_cleanReturnData),
-		delete_from_return_data_func_ptr:                             funcPointer(C.w2_deleteFromReturnData),
-		get_original_tx_hash_func_ptr:                                funcPointer(C.w2_getOriginalTxHash),
-		get_current_tx_hash_func_ptr:                                 funcPointer(C.w2_getCurrentTxHash),
-		get_prev_tx_hash_func_ptr:                                    funcPointer(C.w2_getPrevTxHash),
-		managed_sc_address_func_ptr:                                  funcPointer(C.w2_managedSCAddress),
-		managed_owner_address_func_ptr:                               funcPointer(C.w2_managedOwnerAddress),
-		managed_caller_func_ptr:                                      funcPointer(C.w2_managedCaller),
-		managed_get_original_caller_addr_func_ptr:                    funcPointer(C.w2_managedGetOriginalCallerAddr),
-		managed_get_relayer_addr_func_ptr:                            funcPointer(C.w2_managedGetRelayerAddr),
-		managed_signal_error_func_ptr:                                funcPointer(C.w2_managedSignalError),
-		managed_write_log_func_ptr:                                   funcPointer(C.w2_managedWriteLog),
-		managed_get_original_tx_hash_func_ptr:                        funcPointer(C.w2_managedGetOriginalTxHash),
-		managed_get_state_root_hash_func_ptr:                         funcPointer(C.w2_managedGetStateRootHash),
-		managed_get_block_random_seed_func_ptr:                       funcPointer(C.w2_managedGetBlockRandomSeed),
-		managed_get_prev_block_random_seed_func_ptr:                  funcPointer(C.w2_managedGetPrevBlockRandomSeed),
-		managed_get_return_data_func_ptr:                             funcPointer(C.w2_managedGetReturnData),
-		managed_get_multi_esdt_call_value_func_ptr:                   funcPointer(C.w2_managedGetMultiESDTCallValue),
-		managed_get_all_transfers_call_value_func_ptr:                funcPointer(C.w2_managedGetAllTransfersCallValue),
-		managed_get_back_transfers_func_ptr:                          funcPointer(C.w2_managedGetBackTransfers),
-		managed_get_esdt_balance_func_ptr:                            funcPointer(C.w2_managedGetESDTBalance),
-		managed_get_esdt_token_data_func_ptr:                         funcPointer(C.w2_managedGetESDTTokenData),
-		managed_get_esdt_token_type_func_ptr:                         funcPointer(C.w2_managedGetESDTTokenType),
-		managed_async_call_func_ptr:                                  funcPointer(C.w2_managedAsyncCall),
-		managed_create_async_call_func_ptr:                           funcPointer(C.w2_managedCreateAsyncCall),
-		managed_get_callback_closure_func_ptr:                        funcPointer(C.w2_managedGetCallbackClosure),
-		managed_upgrade_from_source_contract_func_ptr:                funcPointer(C.w2_managedUpgradeFromSourceContract),
-		managed_upgrade_contract_func_ptr:                            funcPointer(C.w2_managedUpgradeContract),
-		managed_delete_contract_func_ptr:                             funcPointer(C.w2_managedDeleteContract),
-		managed_deploy_from_source_contract_func_ptr:                 funcPointer(C.w2_managedDeployFromSourceContract),
-		managed_create_contract_func_ptr:                             funcPointer(C.w2_managedCreateContract),
-		managed_execute_read_only_func_ptr:                           funcPointer(C.w2_managedExecuteReadOnly),
-		managed_execute_on_same_context_func_ptr:                     funcPointer(C.w2_managedExecuteOnSameContext),
-		managed_execute_on_dest_context_func_ptr:                     funcPointer(C.w2_managedExecuteOnDestContext),
-		managed_execute_on_dest_context_with_error_return_func_ptr:   funcPointer(C.w2_managedExecuteOnDestContextWithErrorReturn),
-		managed_multi_transfer_esdt_nft_execute_func_ptr:             funcPointer(C.w2_managedMultiTransferESDTNFTExecute),
-		managed_multi_transfer_esdt_nft_execute_with_return_func_ptr: funcPointer(C.w2_managedMultiTransferESDTNFTExecuteWithReturn),
-		managed_multi_transfer_esdt_nft_execute_by_user_func_ptr:     funcPointer(C.w2_managedMultiTransferESDTNFTExecuteByUser),
-		managed_transfer_value_execute_func_ptr:                      funcPointer(C.w2_managedTransferValueExecute),
-		managed_is_esdt_frozen_func_ptr:                              funcPointer(C.w2_managedIsESDTFrozen),
-		managed_is_esdt_limited_transfer_func_ptr:                    funcPointer(C.w2_managedIsESDTLimitedTransfer),
-		managed_is_esdt_paused_func_ptr:                              funcPointer(C.w2_managedIsESDTPaused),
-		managed_buffer_to_hex_func_ptr:                               funcPointer(C.w2_managedBufferToHex),
-		managed_get_code_metadata_func_ptr:                           funcPointer(C.w2_managedGetCodeMetadata),
-		managed_get_code_hash_func_ptr:                               funcPointer(C.w2_managedGetCodeHash),
-		managed_is_builtin_function_func_ptr:                         funcPointer(C.w2_managedIsBuiltinFunction),
-		big_float_new_from_parts_func_ptr:                            funcPointer(C.w2_bigFloatNewFromParts),
-		big_float_new_from_frac_func_ptr:                             funcPointer(C.w2_bigFloatNewFromFrac),
-		big_float_new_from_sci_func_ptr:                              funcPointer(C.w2_bigFloatNewFromSci),
-		big_float_add_func_ptr:                                       funcPointer(C.w2_bigFloatAdd),
-		big_float_sub_func_ptr:                                       funcPointer(C.w2_bigFloatSub),
-		big_float_mul_func_ptr:                                       funcPointer(C.w2_bigFloatMul),
-		big_float_div_func_ptr:                                       funcPointer(C.w2_bigFloatDiv),
-		big_float_neg_func_ptr:                                       funcPointer(C.w2_bigFloatNeg),
-		big_float_clone_func_ptr:                                     funcPointer(C.w2_bigFloatClone),
-		big_float_cmp_func_ptr:                                       funcPointer(C.w2_bigFloatCmp),
-		big_float_abs_func_ptr:                                       funcPointer(C.w2_bigFloatAbs),
-		big_float_sign_func_ptr:                                      funcPointer(C.w2_bigFloatSign),
-		big_float_sqrt_func_ptr:                                      funcPointer(C.w2_bigFloatSqrt),
-		big_float_pow_func_ptr:                                       funcPointer(C.w2_bigFloatPow),
-		big_float_floor_func_ptr:                                     funcPointer(C.w2_bigFloatFloor),
-		big_float_ceil_func_ptr:                                      funcPointer(C.w2_bigFloatCeil),
-		big_float_truncate_func_ptr:                                  funcPointer(C.w2_bigFloatTruncate),
-		big_float_set_int64_func_ptr:                                 funcPointer(C.w2_bigFloatSetInt64),
-		big_float_is_int_func_ptr:                                    funcPointer(C.w2_bigFloatIsInt),
-		big_float_set_big_int_func_ptr:                               funcPointer(C.w2_bigFloatSetBigInt),
-		big_float_get_const_pi_func_ptr:                              funcPointer(C.w2_bigFloatGetConstPi),
-		big_float_get_const_e_func_ptr:                               funcPointer(C.w2_bigFloatGetConstE),
-		big_int_get_unsigned_argument_func_ptr:                       funcPointer(C.w2_bigIntGetUnsignedArgument),
-		big_int_get_signed_argument_func_ptr:                         funcPointer(C.w2_bigIntGetSignedArgument),
-		big_int_storage_store_unsigned_func_ptr:                      funcPointer(C.w2_bigIntStorageStoreUnsigned),
-		big_int_storage_load_unsigned_func_ptr:                       funcPointer(C.w2_bigIntStorageLoadUnsigned),
-		big_int_get_call_value_func_ptr:                              funcPointer(C.w2_bigIntGetCallValue),
-		big_int_get_esdt_call_value_func_ptr:                         funcPointer(C.w2_bigIntGetESDTCallValue),
-		big_int_get_esdt_call_value_by_index_func_ptr:                funcPointer(C.w2_bigIntGetESDTCallValueByIndex),
-		big_int_get_external_balance_func_ptr:                        funcPointer(C.w2_bigIntGetExternalBalance),
-		big_int_get_esdt_external_balance_func_ptr:                   funcPointer(C.w2_bigIntGetESDTExternalBalance),
-		big_int_new_func_ptr:                                         funcPointer(C.w2_bigIntNew),
-		big_int_unsigned_byte_length_func_ptr:                        funcPointer(C.w2_bigIntUnsignedByteLength),
-		big_int_signed_byte_length_func_ptr:                          funcPointer(C.w2_bigIntSignedByteLength),
-		big_int_get_unsigned_bytes_func_ptr:                          funcPointer(C.w2_bigIntGetUnsignedBytes),
-		big_int_get_signed_bytes_func_ptr:                            funcPointer(C.w2_bigIntGetSignedBytes),
-		big_int_set_unsigned_bytes_func_ptr:                          funcPointer(C.w2_bigIntSetUnsignedBytes),
-		big_int_set_signed_bytes_func_ptr:                            funcPointer(C.w2_bigIntSetSignedBytes),
-		big_int_is_int64_func_ptr:                                    funcPointer(C.w2_bigIntIsInt64),
-		big_int_get_int64_func_ptr:                                   funcPointer(C.w2_bigIntGetInt64),
-		big_int_set_int64_func_ptr:                                   funcPointer(C.w2_bigIntSetInt64),
-		big_int_add_func_ptr:                                         funcPointer(C.w2_bigIntAdd),
-		big_int_sub_func_ptr:                                         funcPointer(C.w2_bigIntSub),
-		big_int_mul_func_ptr:                                         funcPointer(C.w2_bigIntMul),
-		big_int_tdiv_func_ptr:                                        funcPointer(C.w2_bigIntTDiv),
-		big_int_tmod_func_ptr:                                        funcPointer(C.w2_bigIntTMod),
-		big_int_ediv_func_ptr:                                        funcPointer(C.w2_bigIntEDiv),
-		big_int_emod_func_ptr:                                        funcPointer(C.w2_bigIntEMod),
-		big_int_sqrt_func_ptr:                                        funcPointer(C.w2_bigIntSqrt),
-		big_int_pow_func_ptr:                                         funcPointer(C.w2_bigIntPow),
-		big_int_log2_func_ptr:                                        funcPointer(C.w2_bigIntLog2),
-		big_int_abs_func_ptr:                                         funcPointer(C.w2_bigIntAbs),
-		big_int_neg_func_ptr:                                         funcPointer(C.w2_bigIntNeg),
-		big_int_sign_func_ptr:                                        funcPointer(C.w2_bigIntSign),
-		big_int_cmp_func_ptr:                                         funcPointer(C.w2_bigIntCmp),
-		big_int_not_func_ptr:                                         funcPointer(C.w2_bigIntNot),
-		big_int_and_func_ptr:                                         funcPointer(C.w2_bigIntAnd),
-		big_int_or_func_ptr:                                          funcPointer(C.w2_bigIntOr),
-		big_int_xor_func_ptr:                                         funcPointer(C.w2_bigIntXor),
-		big_int_shr_func_ptr:                                         funcPointer(C.w2_bigIntShr),
-		big_int_shl_func_ptr:                                         funcPointer(C.w2_bigIntShl),
-		big_int_finish_unsigned_func_ptr:                             funcPointer(C.w2_bigIntFinishUnsigned),
-		big_int_finish_signed_func_ptr:                               funcPointer(C.w2_bigIntFinishSigned),
-		big_int_to_string_func_ptr:                                   funcPointer(C.w2_bigIntToString),
-		mbuffer_new_func_ptr:                                         funcPointer(C.w2_mBufferNew),
-		mbuffer_new_from_bytes_func_ptr:                              funcPointer(C.w2_mBufferNewFromBytes),
-		mbuffer_get_length_func_ptr:                                  funcPointer(C.w2_mBufferGetLength),
-		mbuffer_get_bytes_func_ptr:                                   funcPointer(C.w2_mBufferGetBytes),
-		mbuffer_get_byte_slice_func_ptr:                              funcPointer(C.w2_mBufferGetByteSlice),
-		mbuffer_copy_byte_slice_func_ptr:                             funcPointer(C.w2_mBufferCopyByteSlice),
-		mbuffer_eq_func_ptr:                                          funcPointer(C.w2_mBufferEq),
-		mbuffer_set_bytes_func_ptr:                                   funcPointer(C.w2_mBufferSetBytes),
-		mbuffer_set_byte_slice_func_ptr:                              funcPointer(C.w2_mBufferSetByteSlice),
-		mbuffer_append_func_ptr:                                      funcPointer(C.w2_mBufferAppend),
-		mbuffer_append_bytes_func_ptr:                                funcPointer(C.w2_mBufferAppendBytes),
-		mbuffer_to_big_int_unsigned_func_ptr:                         funcPointer(C.w2_mBufferToBigIntUnsigned),
-		mbuffer_to_big_int_signed_func_ptr:                           funcPointer(C.w2_mBufferToBigIntSigned),
-		mbuffer_from_big_int_unsigned_func_ptr:                       funcPointer(C.w2_mBufferFromBigIntUnsigned),
-		mbuffer_from_big_int_signed_func_ptr:                         funcPointer(C.w2_mBufferFromBigIntSigned),
-		mbuffer_to_small_int_unsigned_func_ptr:                       funcPointer(C.w2_mBufferToSmallIntUnsigned),
-		mbuffer_to_small_int_signed_func_ptr:                         funcPointer(C.w2_mBufferToSmallIntSigned),
-		mbuffer_from_small_int_unsigned_func_ptr:                     funcPointer(C.w2_mBufferFromSmallIntUnsigned),
-		mbuffer_from_small_int_signed_func_ptr:                       funcPointer(C.w2_mBufferFromSmallIntSigned),
-		mbuffer_to_big_float_func_ptr:                                funcPointer(C.w2_mBufferToBigFloat),
-		mbuffer_from_big_float_func_ptr:                              funcPointer(C.w2_mBufferFromBigFloat),
-		mbuffer_storage_store_func_ptr:                               funcPointer(C.w2_mBufferStorageStore),
-		mbuffer_storage_load_func_ptr:                                funcPointer(C.w2_mBufferStorageLoad),
-		mbuffer_storage_load_from_address_func_ptr:                   funcPointer(C.w2_mBufferStorageLoadFromAddress),
-		mbuffer_get_argument_func_ptr:                                funcPointer(C.w2_mBufferGetArgument),
-		mbuffer_finish_func_ptr:                                      funcPointer(C.w2_mBufferFinish),
-		mbuffer_set_random_func_ptr:                                  funcPointer(C.w2_mBufferSetRandom),
-		managed_map_new_func_ptr:                                     funcPointer(C.w2_managedMapNew),
-		managed_map_put_func_ptr:                                     funcPointer(C.w2_managedMapPut),
-		managed_map_get_func_ptr:                                     funcPointer(C.w2_managedMapGet),
-		managed_map_remove_func_ptr:                                  funcPointer(C.w2_managedMapRemove),
-		managed_map_contains_func_ptr:                                funcPointer(C.w2_managedMapContains),
-		small_int_get_unsigned_argument_func_ptr:                     funcPointer(C.w2_smallIntGetUnsignedArgument),
-		small_int_get_signed_argument_func_ptr:                       funcPointer(C.w2_smallIntGetSignedArgument),
-		small_int_finish_unsigned_func_ptr:                           funcPointer(C.w2_smallIntFinishUnsigned),
-		small_int_finish_signed_func_ptr:                             funcPointer(C.w2_smallIntFinishSigned),
-		small_int_storage_store_unsigned_func_ptr:                    funcPointer(C.w2_smallIntStorageStoreUnsigned),
-		small_int_storage_store_signed_func_ptr:                      funcPointer(C.w2_smallIntStorageStoreSigned),
-		small_int_storage_load_unsigned_func_ptr:                     funcPointer(C.w2_smallIntStorageLoadUnsigned),
-		small_int_storage_load_signed_func_ptr:                       funcPointer(C.w2_smallIntStorageLoadSigned),
-		int64get_argument_func_ptr:                                   funcPointer(C.w2_int64getArgument),
-		int64finish_func_ptr:                                         funcPointer(C.w2_int64finish),
-		int64storage_store_func_ptr:                                  funcPointer(C.w2_int64storageStore),
-		int64storage_load_func_ptr:                                   funcPointer(C.w2_int64storageLoad),
-		sha256_func_ptr:                                              funcPointer(C.w2_sha256),
-		managed_sha256_func_ptr:                                      funcPointer(C.w2_managedSha256),
-		keccak256_func_ptr:                                           funcPointer(C.w2_keccak256),
-		managed_keccak256_func_ptr:                                   funcPointer(C.w2_managedKeccak256),
-		ripemd160_func_ptr:                                           funcPointer(C.w2_ripemd160),
-		managed_ripemd160_func_ptr:                                   funcPointer(C.w2_managedRipemd160),
-		verify_bls_func_ptr:                                          funcPointer(C.w2_verifyBLS),
-		managed_verify_bls_func_ptr:                                  funcPointer(C.w2_managedVerifyBLS),
-		verify_ed25519_func_ptr:                                      funcPointer(C.w2_verifyEd25519),
-		managed_verify_ed25519_func_ptr:                              funcPointer(C.w2_managedVerifyEd25519),
-		verify_custom_secp256k1_func_ptr:                             funcPointer(C.w2_verifyCustomSecp256k1),
-		managed_verify_custom_secp256k1_func_ptr:                     funcPointer(C.w2_managedVerifyCustomSecp256k1),
-		verify_secp256k1_func_ptr:                                    funcPointer(C.w2_verifySecp256k1),
-		managed_verify_secp256k1_func_ptr:                            funcPointer(C.w2_managedVerifySecp256k1),
-		encode_secp256k1_der_signature_func_ptr:                      funcPointer(C.w2_encodeSecp256k1DerSignature),
-		managed_encode_secp256k1_der_signature_func_ptr:              funcPointer(C.w2_managedEncodeSecp256k1DerSignature),
-		add_ec_func_ptr:                                              funcPointer(C.w2_addEC),
-		double_ec_func_ptr:                                           funcPointer(C.w2_doubleEC),
-		is_on_curve_ec_func_ptr:                                      funcPointer(C.w2_isOnCurveEC),
-		scalar_base_mult_ec_func_ptr:                                 funcPointer(C.w2_scalarBaseMultEC),
-		managed_scalar_base_mult_ec_func_ptr:                         funcPointer(C.w2_managedScalarBaseMultEC),
-		scalar_mult_ec_func_ptr:                                      funcPointer(C.w2_scalarMultEC),
-		managed_scalar_mult_ec_func_ptr:                              funcPointer(C.w2_managedScalarMultEC),
-		marshal_ec_func_ptr:                                          funcPointer(C.w2_marshalEC),
-		managed_marshal_ec_func_ptr:                                  funcPointer(C.w2_managedMarshalEC),
-		marshal_compressed_ec_func_ptr:                               funcPointer(C.w2_marshalCompressedEC),
-		managed_marshal_compressed_ec_func_ptr:                       funcPointer(C.w2_managedMarshalCompressedEC),
-		unmarshal_ec_func_ptr:                                        funcPointer(C.w2_unmarshalEC),
-		managed_unmarshal_ec_func_ptr:                                funcPointer(C.w2_managedUnmarshalEC),
-		unmarshal_compressed_ec_func_ptr:                             funcPointer(C.w2_unmarshalCompressedEC),
-		managed_unmarshal_compressed_ec_func_ptr:                     funcPointer(C.w2_managedUnmarshalCompressedEC),
-		generate_key_ec_func_ptr:                                     funcPointer(C.w2_generateKeyEC),
-		managed_generate_key_ec_func_ptr:                             funcPointer(C.w2_managedGenerateKeyEC),
-		create_ec_func_ptr:                                           funcPointer(C.w2_createEC),
-		managed_create_ec_func_ptr:                                   funcPointer(C.w2_managedCreateEC),
-		get_curve_length_ec_func_ptr:                                 funcPointer(C.w2_getCurveLengthEC),
-		get_priv_key_byte_length_ec_func_ptr:                         funcPointer(C.w2_getPrivKeyByteLengthEC),
-		elliptic_curve_get_values_func_ptr:                           funcPointer(C.w2_ellipticCurveGetValues),
-		managed_verify_secp256r1_func_ptr:                            funcPointer(C.w2_managedVerifySecp256r1),
-		managed_verify_blssignature_share_func_ptr:                   funcPointer(C.w2_managedVerifyBLSSignatureShare),
-		managed_verify_blsaggregated_signature_func_ptr:              funcPointer(C.w2_managedVerifyBLSAggregatedSignature),
+		get_gas_left_func_ptr:                                           funcPointer(C.w2_getGasLeft),
+		get_sc_address_func_ptr:                                         funcPointer(C.w2_getSCAddress),
+		get_owner_address_func_ptr:                                      funcPointer(C.w2_getOwnerAddress),
+		get_shard_of_address_func_ptr:                                   funcPointer(C.w2_getShardOfAddress),
+		is_smart_contract_func_ptr:                                      funcPointer(C.w2_isSmartContract),
+		signal_error_func_ptr:                                           funcPointer(C.w2_signalError),
+		get_external_balance_func_ptr:                                   funcPointer(C.w2_getExternalBalance),
+		get_block_hash_func_ptr:                                         funcPointer(C.w2_getBlockHash),
+		get_esdt_balance_func_ptr:                                       funcPointer(C.w2_getESDTBalance),
+		get_esdt_nft_name_length_func_ptr:                               funcPointer(C.w2_getESDTNFTNameLength),
+		get_esdt_nft_attribute_length_func_ptr:                          funcPointer(C.w2_getESDTNFTAttributeLength),
+		get_esdt_nft_uri_length_func_ptr:                                funcPointer(C.w2_getESDTNFTURILength),
+		get_esdt_token_data_func_ptr:                                    funcPointer(C.w2_getESDTTokenData),
+		get_esdt_local_roles_func_ptr:                                   funcPointer(C.w2_getESDTLocalRoles),
+		validate_token_identifier_func_ptr:                              funcPointer(C.w2_validateTokenIdentifier),
+		transfer_value_func_ptr:                                         funcPointer(C.w2_transferValue),
+		transfer_value_execute_func_ptr:                                 funcPointer(C.w2_transferValueExecute),
+		transfer_esdt_execute_func_ptr:                                  funcPointer(C.w2_transferESDTExecute),
+		transfer_esdt_nft_execute_func_ptr:                              funcPointer(C.w2_transferESDTNFTExecute),
+		multi_transfer_esdt_nft_execute_func_ptr:                        funcPointer(C.w2_multiTransferESDTNFTExecute),
+		create_async_call_func_ptr:                                      funcPointer(C.w2_createAsyncCall),
+		set_async_context_callback_func_ptr:                             funcPointer(C.w2_setAsyncContextCallback),
+		upgrade_contract_func_ptr:                                       funcPointer(C.w2_upgradeContract),
+		upgrade_from_source_contract_func_ptr:                           funcPointer(C.w2_upgradeFromSourceContract),
+		delete_contract_func_ptr:                                        funcPointer(C.w2_deleteContract),
+		async_call_func_ptr:                                             funcPointer(C.w2_asyncCall),
+		get_argument_length_func_ptr:                                    funcPointer(C.w2_getArgumentLength),
+		get_argument_func_ptr:                                           funcPointer(C.w2_getArgument),
+		get_function_func_ptr:                                           funcPointer(C.w2_getFunction),
+		get_num_arguments_func_ptr:                                      funcPointer(C.w2_getNumArguments),
+		storage_store_func_ptr:                                          funcPointer(C.w2_storageStore),
+		storage_load_length_func_ptr:                                    funcPointer(C.w2_storageLoadLength),
+		storage_load_from_address_func_ptr:                              funcPointer(C.w2_storageLoadFromAddress),
+		storage_load_func_ptr:                                           funcPointer(C.w2_storageLoad),
+		set_storage_lock_func_ptr:                                       funcPointer(C.w2_setStorageLock),
+		get_storage_lock_func_ptr:                                       funcPointer(C.w2_getStorageLock),
+		is_storage_locked_func_ptr:                                      funcPointer(C.w2_isStorageLocked),
+		clear_storage_lock_func_ptr:                                     funcPointer(C.w2_clearStorageLock),
+		get_caller_func_ptr:                                             funcPointer(C.w2_getCaller),
+		check_no_payment_func_ptr:                                       funcPointer(C.w2_checkNoPayment),
+		get_call_value_func_ptr:                                         funcPointer(C.w2_getCallValue),
+		get_esdt_value_func_ptr:                                         funcPointer(C.w2_getESDTValue),
+		get_esdt_value_by_index_func_ptr:                                funcPointer(C.w2_getESDTValueByIndex),
+		get_esdt_token_name_func_ptr:                                    funcPointer(C.w2_getESDTTokenName),
+		get_esdt_token_name_by_index_func_ptr:                           funcPointer(C.w2_getESDTTokenNameByIndex),
+		get_esdt_token_nonce_func_ptr:                                   funcPointer(C.w2_getESDTTokenNonce),
+		get_esdt_token_nonce_by_index_func_ptr:                          funcPointer(C.w2_getESDTTokenNonceByIndex),
+		get_current_esdt_nft_nonce_func_ptr:                             funcPointer(C.w2_getCurrentESDTNFTNonce),
+		get_esdt_token_type_func_ptr:                                    funcPointer(C.w2_getESDTTokenType),
+		get_esdt_token_type_by_index_func_ptr:                           funcPointer(C.w2_getESDTTokenTypeByIndex),
+		get_num_esdt_transfers_func_ptr:                                 funcPointer(C.w2_getNumESDTTransfers),
+		get_call_value_token_name_func_ptr:                              funcPointer(C.w2_getCallValueTokenName),
+		get_call_value_token_name_by_index_func_ptr:                     funcPointer(C.w2_getCallValueTokenNameByIndex),
+		is_reserved_function_name_func_ptr:                              funcPointer(C.w2_isReservedFunctionName),
+		write_log_func_ptr:                                              funcPointer(C.w2_writeLog),
+		write_event_log_func_ptr:                                        funcPointer(C.w2_writeEventLog),
+		get_block_timestamp_func_ptr:                                    funcPointer(C.w2_getBlockTimestamp),
+		get_block_timestamp_ms_func_ptr:                                 funcPointer(C.w2_getBlockTimestampMs),
+		get_block_nonce_func_ptr:                                        funcPointer(C.w2_getBlockNonce),
+		get_block_round_func_ptr:                                        funcPointer(C.w2_getBlockRound),
+		get_block_epoch_func_ptr:                                        funcPointer(C.w2_getBlockEpoch),
+		get_block_random_seed_func_ptr:                                  funcPointer(C.w2_getBlockRandomSeed),
+		get_state_root_hash_func_ptr:                                    funcPointer(C.w2_getStateRootHash),
+		get_prev_block_timestamp_func_ptr:                               funcPointer(C.w2_getPrevBlockTimestamp),
+		get_prev_block_timestamp_ms_func_ptr:                            funcPointer(C.w2_getPrevBlockTimestampMs),
+		get_prev_block_nonce_func_ptr:                                   funcPointer(C.w2_getPrevBlockNonce),
+		get_prev_block_round_func_ptr:                                   funcPointer(C.w2_getPrevBlockRound),
+		get_prev_block_epoch_func_ptr:                                   funcPointer(C.w2_getPrevBlockEpoch),
+		get_prev_block_random_seed_func_ptr:                             funcPointer(C.w2_getPrevBlockRandomSeed),
+		get_block_round_time_ms_func_ptr:                                funcPointer(C.w2_getBlockRoundTimeMs),
+		epoch_start_block_timestamp_ms_func_ptr:                         funcPointer(C.w2_epochStartBlockTimestampMs),
+		epoch_start_block_nonce_func_ptr:                                funcPointer(C.w2_epochStartBlockNonce),
+		epoch_start_block_round_func_ptr:                                funcPointer(C.w2_epochStartBlockRound),
+		finish_func_ptr:                                                 funcPointer(C.w2_finish),
+		execute_on_same_context_func_ptr:                                funcPointer(C.w2_executeOnSameContext),
+		execute_on_dest_context_func_ptr:                                funcPointer(C.w2_executeOnDestContext),
+		execute_read_only_func_ptr:                                      funcPointer(C.w2_executeReadOnly),
+		create_contract_func_ptr:                                        funcPointer(C.w2_createContract),
+		deploy_from_source_contract_func_ptr:                            funcPointer(C.w2_deployFromSourceContract),
+		get_num_return_data_func_ptr:                                    funcPointer(C.w2_getNumReturnData),
+		get_return_data_size_func_ptr:                                   funcPointer(C.w2_getReturnDataSize),
+		get_return_data_func_ptr:                                        funcPointer(C.w2_getReturnData),
+		clean_return_data_func_ptr:                                      funcPointer(C.w2_cleanReturnData),
+		delete_from_return_data_func_ptr:                                funcPointer(C.w2_deleteFromReturnData),
+		get_original_tx_hash_func_ptr:                                   funcPointer(C.w2_getOriginalTxHash),
+		get_current_tx_hash_func_ptr:                                    funcPointer(C.w2_getCurrentTxHash),
+		get_prev_tx_hash_func_ptr:                                       funcPointer(C.w2_getPrevTxHash),
+		managed_sc_address_func_ptr:                                     funcPointer(C.w2_managedSCAddress),
+		managed_owner_address_func_ptr:                                  funcPointer(C.w2_managedOwnerAddress),
+		managed_caller_func_ptr:                                         funcPointer(C.w2_managedCaller),
+		managed_get_original_caller_addr_func_ptr:                       funcPointer(C.w2_managedGetOriginalCallerAddr),
+		managed_get_relayer_addr_func_ptr:                               funcPointer(C.w2_managedGetRelayerAddr),
+		managed_signal_error_func_ptr:                                   funcPointer(C.w2_managedSignalError),
+		managed_write_log_func_ptr:                                      funcPointer(C.w2_managedWriteLog),
+		managed_get_original_tx_hash_func_ptr:                           funcPointer(C.w2_managedGetOriginalTxHash),
+		managed_get_state_root_hash_func_ptr:                            funcPointer(C.w2_managedGetStateRootHash),
+		managed_get_block_random_seed_func_ptr:                          funcPointer(C.w2_managedGetBlockRandomSeed),
+		managed_get_prev_block_random_seed_func_ptr:                     funcPointer(C.w2_managedGetPrevBlockRandomSeed),
+		managed_get_return_data_func_ptr:                                funcPointer(C.w2_managedGetReturnData),
+		managed_get_multi_esdt_call_value_func_ptr:                      funcPointer(C.w2_managedGetMultiESDTCallValue),
+		managed_get_all_transfers_call_value_func_ptr:                   funcPointer(C.w2_managedGetAllTransfersCallValue),
+		managed_get_back_transfers_func_ptr:                             funcPointer(C.w2_managedGetBackTransfers),
+		managed_get_esdt_balance_func_ptr:                               funcPointer(C.w2_managedGetESDTBalance),
+		managed_get_esdt_token_data_func_ptr:                            funcPointer(C.w2_managedGetESDTTokenData),
+		managed_get_esdt_token_type_func_ptr:                            funcPointer(C.w2_managedGetESDTTokenType),
+		managed_async_call_func_ptr:                                     funcPointer(C.w2_managedAsyncCall),
+		managed_create_async_call_func_ptr:                              funcPointer(C.w2_managedCreateAsyncCall),
+		managed_get_callback_closure_func_ptr:                           funcPointer(C.w2_managedGetCallbackClosure),
+		managed_upgrade_from_source_contract_func_ptr:                   funcPointer(C.w2_managedUpgradeFromSourceContract),
+		managed_upgrade_contract_func_ptr:                               funcPointer(C.w2_managedUpgradeContract),
+		managed_delete_contract_func_ptr:                                funcPointer(C.w2_managedDeleteContract),
+		managed_deploy_from_source_contract_func_ptr:                    funcPointer(C.w2_managedDeployFromSourceContract),
+		managed_create_contract_func_ptr:                                funcPointer(C.w2_managedCreateContract),
+		managed_execute_read_only_func_ptr:                              funcPointer(C.w2_managedExecuteReadOnly),
+		managed_execute_on_same_context_func_ptr:                        funcPointer(C.w2_managedExecuteOnSameContext),
+		managed_execute_on_dest_context_func_ptr:                        funcPointer(C.w2_managedExecuteOnDestContext),
+		managed_execute_on_dest_context_with_error_return_func_ptr:      funcPointer(C.w2_managedExecuteOnDestContextWithErrorReturn),
+		managed_multi_transfer_esdt_nft_execute_func_ptr:                funcPointer(C.w2_managedMultiTransferESDTNFTExecute),
+		managed_multi_transfer_esdt_nft_execute_with_return_func_ptr:    funcPointer(C.w2_managedMultiTransferESDTNFTExecuteWithReturn),
+		managed_multi_transfer_esdt_nft_execute_by_user_func_ptr:        funcPointer(C.w2_managedMultiTransferESDTNFTExecuteByUser),
+		managed_transfer_value_execute_func_ptr:                         funcPointer(C.w2_managedTransferValueExecute),
+		managed_create_contract_with_error_return_func_ptr:              funcPointer(C.w2_managedCreateContractWithErrorReturn),
+		managed_deploy_from_source_contract_with_error_return_func_ptr:  funcPointer(C.w2_managedDeployFromSourceContractWithErrorReturn),
+		managed_upgrade_contract_with_error_return_func_ptr:             funcPointer(C.w2_managedUpgradeContractWithErrorReturn),
+		managed_upgrade_from_source_contract_with_error_return_func_ptr: funcPointer(C.w2_managedUpgradeFromSourceContractWithErrorReturn),
+		managed_transfer_value_execute_with_error_return_func_ptr:       funcPointer(C.w2_managedTransferValueExecuteWithErrorReturn),
+		managed_is_esdt_frozen_func_ptr:                                 funcPointer(C.w2_managedIsESDTFrozen),
+		managed_is_esdt_limited_transfer_func_ptr:                       funcPointer(C.w2_managedIsESDTLimitedTransfer),
+		managed_is_esdt_paused_func_ptr:                                 funcPointer(C.w2_managedIsESDTPaused),
+		managed_buffer_to_hex_func_ptr:                                  funcPointer(C.w2_managedBufferToHex),
+		managed_get_code_metadata_func_ptr:                              funcPointer(C.w2_managedGetCodeMetadata),
+		managed_get_code_hash_func_ptr:                                  funcPointer(C.w2_managedGetCodeHash),
+		managed_is_builtin_function_func_ptr:                            funcPointer(C.w2_managedIsBuiltinFunction),
+		big_float_new_from_parts_func_ptr:                               funcPointer(C.w2_bigFloatNewFromParts),
+		big_float_new_from_frac_func_ptr:                                funcPointer(C.w2_bigFloatNewFromFrac),
+		big_float_new_from_sci_func_ptr:                                 funcPointer(C.w2_bigFloatNewFromSci),
+		big_float_add_func_ptr:                                          funcPointer(C.w2_bigFloatAdd),
+		big_float_sub_func_ptr:                                          funcPointer(C.w2_bigFloatSub),
+		big_float_mul_func_ptr:                                          funcPointer(C.w2_bigFloatMul),
+		big_float_div_func_ptr:                                          funcPointer(C.w2_bigFloatDiv),
+		big_float_neg_func_ptr:                                          funcPointer(C.w2_bigFloatNeg),
+		big_float_clone_func_ptr:                                        funcPointer(C.w2_bigFloatClone),
+		big_float_cmp_func_ptr:                                          funcPointer(C.w2_bigFloatCmp),
+		big_float_abs_func_ptr:                                          funcPointer(C.w2_bigFloatAbs),
+		big_float_sign_func_ptr:                                         funcPointer(C.w2_bigFloatSign),
+		big_float_sqrt_func_ptr:                                         funcPointer(C.w2_bigFloatSqrt),
+		big_float_pow_func_ptr:                                          funcPointer(C.w2_bigFloatPow),
+		big_float_floor_func_ptr:                                        funcPointer(C.w2_bigFloatFloor),
+		big_float_ceil_func_ptr:                                         funcPointer(C.w2_bigFloatCeil),
+		big_float_truncate_func_ptr:                                     funcPointer(C.w2_bigFloatTruncate),
+		big_float_set_int64_func_ptr:                                    funcPointer(C.w2_bigFloatSetInt64),
+		big_float_is_int_func_ptr:                                       funcPointer(C.w2_bigFloatIsInt),
+		big_float_set_big_int_func_ptr:                                  funcPointer(C.w2_bigFloatSetBigInt),
+		big_float_get_const_pi_func_ptr:                                 funcPointer(C.w2_bigFloatGetConstPi),
+		big_float_get_const_e_func_ptr:                                  funcPointer(C.w2_bigFloatGetConstE),
+		big_int_get_unsigned_argument_func_ptr:                          funcPointer(C.w2_bigIntGetUnsignedArgument),
+		big_int_get_signed_argument_func_ptr:                            funcPointer(C.w2_bigIntGetSignedArgument),
+		big_int_storage_store_unsigned_func_ptr:                         funcPointer(C.w2_bigIntStorageStoreUnsigned),
+		big_int_storage_load_unsigned_func_ptr:                          funcPointer(C.w2_bigIntStorageLoadUnsigned),
+		big_int_get_call_value_func_ptr:                                 funcPointer(C.w2_bigIntGetCallValue),
+		big_int_get_esdt_call_value_func_ptr:                            funcPointer(C.w2_bigIntGetESDTCallValue),
+		big_int_get_esdt_call_value_by_index_func_ptr:                   funcPointer(C.w2_bigIntGetESDTCallValueByIndex),
+		big_int_get_external_balance_func_ptr:                           funcPointer(C.w2_bigIntGetExternalBalance),
+		big_int_get_esdt_external_balance_func_ptr:                      funcPointer(C.w2_bigIntGetESDTExternalBalance),
+		big_int_new_func_ptr:                                            funcPointer(C.w2_bigIntNew),
+		big_int_unsigned_byte_length_func_ptr:                           funcPointer(C.w2_bigIntUnsignedByteLength),
+		big_int_signed_byte_length_func_ptr:                             funcPointer(C.w2_bigIntSignedByteLength),
+		big_int_get_unsigned_bytes_func_ptr:                             funcPointer(C.w2_bigIntGetUnsignedBytes),
+		big_int_get_signed_bytes_func_ptr:                               funcPointer(C.w2_bigIntGetSignedBytes),
+		big_int_set_unsigned_bytes_func_ptr:                             funcPointer(C.w2_bigIntSetUnsignedBytes),
+		big_int_set_signed_bytes_func_ptr:                               funcPointer(C.w2_bigIntSetSignedBytes),
+		big_int_is_int64_func_ptr:                                       funcPointer(C.w2_bigIntIsInt64),
+		big_int_get_int64_func_ptr:                                      funcPointer(C.w2_bigIntGetInt64),
+		big_int_set_int64_func_ptr:                                      funcPointer(C.w2_bigIntSetInt64),
+		big_int_add_func_ptr:                                            funcPointer(C.w2_bigIntAdd),
+		big_int_sub_func_ptr:                                            funcPointer(C.w2_bigIntSub),
+		big_int_mul_func_ptr:                                            funcPointer(C.w2_bigIntMul),
+		big_int_tdiv_func_ptr:                                           funcPointer(C.w2_bigIntTDiv),
+		big_int_tmod_func_ptr:                                           funcPointer(C.w2_bigIntTMod),
+		big_int_ediv_func_ptr:                                           funcPointer(C.w2_bigIntEDiv),
+		big_int_emod_func_ptr:                                           funcPointer(C.w2_bigIntEMod),
+		big_int_sqrt_func_ptr:                                           funcPointer(C.w2_bigIntSqrt),
+		big_int_pow_func_ptr:                                            funcPointer(C.w2_bigIntPow),
+		big_int_log2_func_ptr:                                           funcPointer(C.w2_bigIntLog2),
+		big_int_abs_func_ptr:                                            funcPointer(C.w2_bigIntAbs),
+		big_int_neg_func_ptr:                                            funcPointer(C.w2_bigIntNeg),
+		big_int_sign_func_ptr:                                           funcPointer(C.w2_bigIntSign),
+		big_int_cmp_func_ptr:                                            funcPointer(C.w2_bigIntCmp),
+		big_int_not_func_ptr:                                            funcPointer(C.w2_bigIntNot),
+		big_int_and_func_ptr:                                            funcPointer(C.w2_bigIntAnd),
+		big_int_or_func_ptr:                                             funcPointer(C.w2_bigIntOr),
+		big_int_xor_func_ptr:                                            funcPointer(C.w2_bigIntXor),
+		big_int_shr_func_ptr:                                            funcPointer(C.w2_bigIntShr),
+		big_int_shl_func_ptr:                                            funcPointer(C.w2_bigIntShl),
+		big_int_finish_unsigned_func_ptr:                                funcPointer(C.w2_bigIntFinishUnsigned),
+		big_int_finish_signed_func_ptr:                                  funcPointer(C.w2_bigIntFinishSigned),
+		big_int_to_string_func_ptr:                                      funcPointer(C.w2_bigIntToString),
+		big_int_set_random_in_range_func_ptr:                            funcPointer(C.w2_bigIntSetRandomInRange),
+		mbuffer_new_func_ptr:                                            funcPointer(C.w2_mBufferNew),
+		mbuffer_new_from_bytes_func_ptr:                                 funcPointer(C.w2_mBufferNewFromBytes),
+		mbuffer_get_length_func_ptr:                                     funcPointer(C.w2_mBufferGetLength),
+		mbuffer_get_bytes_func_ptr:                                      funcPointer(C.w2_mBufferGetBytes),
+		mbuffer_get_byte_slice_func_ptr:                                 funcPointer(C.w2_mBufferGetByteSlice),
+		mbuffer_copy_byte_slice_func_ptr:                                funcPointer(C.w2_mBufferCopyByteSlice),
+		mbuffer_eq_func_ptr:                                             funcPointer(C.w2_mBufferEq),
+		mbuffer_set_bytes_func_ptr:                                      funcPointer(C.w2_mBufferSetBytes),
+		mbuffer_set_byte_slice_func_ptr:                                 funcPointer(C.w2_mBufferSetByteSlice),
+		mbuffer_append_func_ptr:                                         funcPointer(C.w2_mBufferAppend),
+		mbuffer_append_bytes_func_ptr:                                   funcPointer(C.w2_mBufferAppendBytes),
+		mbuffer_to_big_int_unsigned_func_ptr:                            funcPointer(C.w2_mBufferToBigIntUnsigned),
+		mbuffer_to_big_int_signed_func_ptr:                              funcPointer(C.w2_mBufferToBigIntSigned),
+		mbuffer_from_big_int_unsigned_func_ptr:                          funcPointer(C.w2_mBufferFromBigIntUnsigned),
+		mbuffer_from_big_int_signed_func_ptr:                            funcPointer(C.w2_mBufferFromBigIntSigned),
+		mbuffer_to_small_int_unsigned_func_ptr:                          funcPointer(C.w2_mBufferToSmallIntUnsigned),
+		mbuffer_to_small_int_signed_func_ptr:                            funcPointer(C.w2_mBufferToSmallIntSigned),
+		mbuffer_from_small_int_unsigned_func_ptr:                        funcPointer(C.w2_mBufferFromSmallIntUnsigned),
+		mbuffer_from_small_int_signed_func_ptr:                          funcPointer(C.w2_mBufferFromSmallIntSigned),
+		mbuffer_to_big_float_func_ptr:                                   funcPointer(C.w2_mBufferToBigFloat),
+		mbuffer_from_big_float_func_ptr:                                 funcPointer(C.w2_mBufferFromBigFloat),
+		mbuffer_storage_store_func_ptr:                                  funcPointer(C.w2_mBufferStorageStore),
+		mbuffer_storage_load_func_ptr:                                   funcPointer(C.w2_mBufferStorageLoad),
+		mbuffer_storage_load_from_address_func_ptr:                      funcPointer(C.w2_mBufferStorageLoadFromAddress),
+		mbuffer_storage_load_batch_func_ptr:                             funcPointer(C.w2_mBufferStorageLoadBatch),
+		mbuffer_storage_load_batch_from_address_func_ptr:                funcPointer(C.w2_mBufferStorageLoadBatchFromAddress),
+		mbuffer_get_argument_func_ptr:                                   funcPointer(C.w2_mBufferGetArgument),
+		mbuffer_finish_func_ptr:                                         funcPointer(C.w2_mBufferFinish),
+		mbuffer_set_random_func_ptr:                                     funcPointer(C.w2_mBufferSetRandom),
+		managed_map_new_func_ptr:                                        funcPointer(C.w2_managedMapNew),
+		managed_map_put_func_ptr:                                        funcPointer(C.w2_managedMapPut),
+		managed_map_get_func_ptr:                                        funcPointer(C.w2_managedMapGet),
+		managed_map_remove_func_ptr:                                     funcPointer(C.w2_managedMapRemove),
+		managed_map_contains_func_ptr:                                   funcPointer(C.w2_managedMapContains),
+		mdecimal_from_big_int_func_ptr:                                  funcPointer(C.w2_mDecimalFromBigInt),
+		mdecimal_to_big_int_func_ptr:                                    funcPointer(C.w2_mDecimalToBigInt),
+		mdecimal_get_mantissa_func_ptr:                                  funcPointer(C.w2_mDecimalGetMantissa),
+		mdecimal_get_scale_func_ptr:                                     funcPointer(C.w2_mDecimalGetScale),
+		mdecimal_from_managed_buffer_func_ptr:                           funcPointer(C.w2_mDecimalFromManagedBuffer),
+		mdecimal_to_managed_buffer_func_ptr:                             funcPointer(C.w2_mDecimalToManagedBuffer),
+		mdecimal_add_func_ptr:                                           funcPointer(C.w2_mDecimalAdd),
+		mdecimal_sub_func_ptr:                                           funcPointer(C.w2_mDecimalSub),
+		mdecimal_mul_func_ptr:                                           funcPointer(C.w2_mDecimalMul),
+		mdecimal_div_func_ptr:                                           funcPointer(C.w2_mDecimalDiv),
+		mdecimal_rescale_func_ptr:                                       funcPointer(C.w2_mDecimalRescale),
+		mdecimal_cmp_func_ptr:                                           funcPointer(C.w2_mDecimalCmp),
+		mdecimal_ln_func_ptr:                                            funcPointer(C.w2_mDecimalLn),
+		mdecimal_exp_func_ptr:                                           funcPointer(C.w2_mDecimalExp),
+		mbuffer_transient_storage_store_func_ptr:                        funcPointer(C.w2_mBufferTransientStorageStore),
+		mbuffer_transient_storage_load_func_ptr:                         funcPointer(C.w2_mBufferTransientStorageLoad),
+		protect_contract_against_reentrancy_func_ptr:                    funcPointer(C.w2_protectContractAgainstReentrancy),
+		managed_protect_endpoint_against_reentrancy_func_ptr:            funcPointer(C.w2_managedProtectEndpointAgainstReentrancy),
+		eth_get_call_data_size_func_ptr:                                 funcPointer(C.w2_ethGetCallDataSize),
+		eth_call_data_copy_func_ptr:                                     funcPointer(C.w2_ethCallDataCopy),
+		eth_storage_store_func_ptr:                                      funcPointer(C.w2_ethStorageStore),
+		eth_storage_load_func_ptr:                                       funcPointer(C.w2_ethStorageLoad),
+		eth_get_call_value_func_ptr:                                     funcPointer(C.w2_ethGetCallValue),
+		eth_get_caller_func_ptr:                                         funcPointer(C.w2_ethGetCaller),
+		eth_get_address_func_ptr:                                        funcPointer(C.w2_ethGetAddress),
+		eth_get_tx_origin_func_ptr:                                      funcPointer(C.w2_ethGetTxOrigin),
+		eth_get_gas_left_func_ptr:                                       funcPointer(C.w2_ethGetGasLeft),
+		eth_get_block_number_func_ptr:                                   funcPointer(C.w2_ethGetBlockNumber),
+		eth_get_block_timestamp_func_ptr:                                funcPointer(C.w2_ethGetBlockTimestamp),
+		eth_log_func_ptr:                                                funcPointer(C.w2_ethLog),
+		eth_finish_func_ptr:                                             funcPointer(C.w2_ethFinish),
+		eth_revert_func_ptr:                                             funcPointer(C.w2_ethRevert),
+		small_int_get_unsigned_argument_func_ptr:                        funcPointer(C.w2_smallIntGetUnsignedArgument),
+		small_int_get_signed_argument_func_ptr:                          funcPointer(C.w2_smallIntGetSignedArgument),
+		small_int_finish_unsigned_func_ptr:                              funcPointer(C.w2_smallIntFinishUnsigned),
+		small_int_finish_signed_func_ptr:                                funcPointer(C.w2_smallIntFinishSigned),
+		small_int_storage_store_unsigned_func_ptr:                       funcPointer(C.w2_smallIntStorageStoreUnsigned),
+		small_int_storage_store_signed_func_ptr:                         funcPointer(C.w2_smallIntStorageStoreSigned),
+		small_int_storage_load_unsigned_func_ptr:                        funcPointer(C.w2_smallIntStorageLoadUnsigned),
+		small_int_storage_load_signed_func_ptr:                          funcPointer(C.w2_smallIntStorageLoadSigned),
+		int64get_argument_func_ptr:                                      funcPointer(C.w2_int64getArgument),
+		int64finish_func_ptr:                                            funcPointer(C.w2_int64finish),
+		int64storage_store_func_ptr:                                     funcPointer(C.w2_int64storageStore),
+		int64storage_load_func_ptr:                                      funcPointer(C.w2_int64storageLoad),
+		small_int_get_random_in_range_func_ptr:                          funcPointer(C.w2_smallIntGetRandomInRange),
+		sha256_func_ptr:                                                 funcPointer(C.w2_sha256),
+		managed_sha256_func_ptr:                                         funcPointer(C.w2_managedSha256),
+		keccak256_func_ptr:                                              funcPointer(C.w2_keccak256),
+		managed_keccak256_func_ptr:                                      funcPointer(C.w2_managedKeccak256),
+		ripemd160_func_ptr:                                              funcPointer(C.w2_ripemd160),
+		managed_ripemd160_func_ptr:                                      funcPointer(C.w2_managedRipemd160),
+		verify_bls_func_ptr:                                             funcPointer(C.w2_verifyBLS),
+		managed_verify_bls_func_ptr:                                     funcPointer(C.w2_managedVerifyBLS),
+		verify_ed25519_func_ptr:                                         funcPointer(C.w2_verifyEd25519),
+		managed_verify_ed25519_func_ptr:                                 funcPointer(C.w2_managedVerifyEd25519),
+		verify_custom_secp256k1_func_ptr:                                funcPointer(C.w2_verifyCustomSecp256k1),
+		managed_verify_custom_secp256k1_func_ptr:                        funcPointer(C.w2_managedVerifyCustomSecp256k1),
+		verify_secp256k1_func_ptr:                                       funcPointer(C.w2_verifySecp256k1),
+		managed_verify_secp256k1_func_ptr:                               funcPointer(C.w2_managedVerifySecp256k1),
+		encode_secp256k1_der_signature_func_ptr:                         funcPointer(C.w2_encodeSecp256k1DerSignature),
+		managed_encode_secp256k1_der_signature_func_ptr:                 funcPointer(C.w2_managedEncodeSecp256k1DerSignature),
+		add_ec_func_ptr:                                                 funcPointer(C.w2_addEC),
+		double_ec_func_ptr:                                              funcPointer(C.w2_doubleEC),
+		is_on_curve_ec_func_ptr:                                         funcPointer(C.w2_isOnCurveEC),
+		scalar_base_mult_ec_func_ptr:                                    funcPointer(C.w2_scalarBaseMultEC),
+		managed_scalar_base_mult_ec_func_ptr:                            funcPointer(C.w2_managedScalarBaseMultEC),
+		scalar_mult_ec_func_ptr:                                         funcPointer(C.w2_scalarMultEC),
+		managed_scalar_mult_ec_func_ptr:                                 funcPointer(C.w2_managedScalarMultEC),
+		marshal_ec_func_ptr:                                             funcPointer(C.w2_marshalEC),
+		managed_marshal_ec_func_ptr:                                     funcPointer(C.w2_managedMarshalEC),
+		marshal_compressed_ec_func_ptr:                                  funcPointer(C.w2_marshalCompressedEC),
+		managed_marshal_compressed_ec_func_ptr:                          funcPointer(C.w2_managedMarshalCompressedEC),
+		unmarshal_ec_func_ptr:                                           funcPointer(C.w2_unmarshalEC),
+		managed_unmarshal_ec_func_ptr:                                   funcPointer(C.w2_managedUnmarshalEC),
+		unmarshal_compressed_ec_func_ptr:                                funcPointer(C.w2_unmarshalCompressedEC),
+		managed_unmarshal_compressed_ec_func_ptr:                        funcPointer(C.w2_managedUnmarshalCompressedEC),
+		generate_key_ec_func_ptr:                                        funcPointer(C.w2_generateKeyEC),
+		managed_generate_key_ec_func_ptr:                                funcPointer(C.w2_managedGenerateKeyEC),
+		create_ec_func_ptr:                                              funcPointer(C.w2_createEC),
+		managed_create_ec_func_ptr:                                      funcPointer(C.w2_managedCreateEC),
+		get_curve_length_ec_func_ptr:                                    funcPointer(C.w2_getCurveLengthEC),
+		get_priv_key_byte_length_ec_func_ptr:                            funcPointer(C.w2_getPrivKeyByteLengthEC),
+		elliptic_curve_get_values_func_ptr:                              funcPointer(C.w2_ellipticCurveGetValues),
+		managed_verify_secp256r1_func_ptr:                               funcPointer(C.w2_managedVerifySecp256r1),
+		managed_verify_blssignature_share_func_ptr:                      funcPointer(C.w2_managedVerifyBLSSignatureShare),
+		managed_verify_blsaggregated_signature_func_ptr:                 funcPointer(C.w2_managedVerifyBLSAggregatedSignature),
 	}
 }
 
@@ -1305,6 +1387,36 @@ func w2_managedTransferValueExecute(context unsafe.Pointer, dstHandle int32, val
 	return vmHooks.ManagedTransferValueExecute(dstHandle, valueHandle, gasLimit, functionHandle, argumentsHandle)
 }
 
+//export w2_managedCreateContractWithErrorReturn
+func w2_managedCreateContractWithErrorReturn(context unsafe.Pointer, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedCreateContractWithErrorReturn(gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+}
+
+//export w2_managedDeployFromSourceContractWithErrorReturn
+func w2_managedDeployFromSourceContractWithErrorReturn(context unsafe.Pointer, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultAddressHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedDeployFromSourceContractWithErrorReturn(gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultAddressHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+}
+
+//export w2_managedUpgradeContractWithErrorReturn
+func w2_managedUpgradeContractWithErrorReturn(context unsafe.Pointer, destHandle int32, gas int64, valueHandle int32, codeHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedUpgradeContractWithErrorReturn(destHandle, gas, valueHandle, codeHandle, codeMetadataHandle, argumentsHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+}
+
+//export w2_managedUpgradeFromSourceContractWithErrorReturn
+func w2_managedUpgradeFromSourceContractWithErrorReturn(context unsafe.Pointer, destHandle int32, gas int64, valueHandle int32, addressHandle int32, codeMetadataHandle int32, argumentsHandle int32, resultHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedUpgradeFromSourceContractWithErrorReturn(destHandle, gas, valueHandle, addressHandle, codeMetadataHandle, argumentsHandle, resultHandle, errorCodeHandle, errorMessageHandle)
+}
+
+//export w2_managedTransferValueExecuteWithErrorReturn
+func w2_managedTransferValueExecuteWithErrorReturn(context unsafe.Pointer, dstHandle int32, valueHandle int32, gasLimit int64, functionHandle int32, argumentsHandle int32, errorCodeHandle int32, errorMessageHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedTransferValueExecuteWithErrorReturn(dstHandle, valueHandle, gasLimit, functionHandle, argumentsHandle, errorCodeHandle, errorMessageHandle)
+}
+
 //export w2_managedIsESDTFrozen
 func w2_managedIsESDTFrozen(context unsafe.Pointer, addressHandle int32, tokenIDHandle int32, nonce int64) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -1731,6 +1843,12 @@ func w2_bigIntToString(context unsafe.Pointer, bigIntHandle int32, destinationHa
 	vmHooks.BigIntToString(bigIntHandle, destinationHandle)
 }
 
+//export w2_bigIntSetRandomInRange
+func w2_bigIntSetRandomInRange(context unsafe.Pointer, destinationHandle int32, minHandle int32, maxHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.BigIntSetRandomInRange(destinationHandle, minHandle, maxHandle)
+}
+
 //export w2_mBufferNew
 func w2_mBufferNew(context unsafe.Pointer) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -1875,6 +1993,18 @@ func w2_mBufferStorageLoadFromAddress(context unsafe.Pointer, addressHandle int3
 	vmHooks.MBufferStorageLoadFromAddress(addressHandle, keyHandle, destinationHandle)
 }
 
+//export w2_mBufferStorageLoadBatch
+func w2_mBufferStorageLoadBatch(context unsafe.Pointer, keysHandle int32, destinationHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MBufferStorageLoadBatch(keysHandle, destinationHandle)
+}
+
+//export w2_mBufferStorageLoadBatchFromAddress
+func w2_mBufferStorageLoadBatchFromAddress(context unsafe.Pointer, addressHandle int32, keysHandle int32, destinationHandle int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.MBufferStorageLoadBatchFromAddress(addressHandle, keysHandle, destinationHandle)
+}
+
 //export w2_mBufferGetArgument
 func w2_mBufferGetArgument(context unsafe.Pointer, id int32, destinationHandle int32) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -1923,6 +2053,198 @@ func w2_managedMapContains(context unsafe.Pointer, mMapHandle int32, keyHandle i
 	return vmHooks.ManagedMapContains(mMapHandle, keyHandle)
 }
 
+//export w2_mDecimalFromBigInt
+func w2_mDecimalFromBigInt(context unsafe.Pointer, destinationHandle int32, bigIntHandle int32, scale int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalFromBigInt(destinationHandle, bigIntHandle, scale)
+}
+
+//export w2_mDecimalToBigInt
+func w2_mDecimalToBigInt(context unsafe.Pointer, destinationHandle int32, decimalHandle int32, roundingMode int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalToBigInt(destinationHandle, decimalHandle, roundingMode)
+}
+
+//export w2_mDecimalGetMantissa
+func w2_mDecimalGetMantissa(context unsafe.Pointer, destinationHandle int32, decimalHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalGetMantissa(destinationHandle, decimalHandle)
+}
+
+//export w2_mDecimalGetScale
+func w2_mDecimalGetScale(context unsafe.Pointer, decimalHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalGetScale(decimalHandle)
+}
+
+//export w2_mDecimalFromManagedBuffer
+func w2_mDecimalFromManagedBuffer(context unsafe.Pointer, destinationHandle int32, mBufferHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalFromManagedBuffer(destinationHandle, mBufferHandle)
+}
+
+//export w2_mDecimalToManagedBuffer
+func w2_mDecimalToManagedBuffer(context unsafe.Pointer, decimalHandle int32, destinationHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalToManagedBuffer(decimalHandle, destinationHandle)
+}
+
+//export w2_mDecimalAdd
+func w2_mDecimalAdd(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalAdd(destinationHandle, op1Handle, op2Handle)
+}
+
+//export w2_mDecimalSub
+func w2_mDecimalSub(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalSub(destinationHandle, op1Handle, op2Handle)
+}
+
+//export w2_mDecimalMul
+func w2_mDecimalMul(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32, roundingMode int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalMul(destinationHandle, op1Handle, op2Handle, roundingMode)
+}
+
+//export w2_mDecimalDiv
+func w2_mDecimalDiv(context unsafe.Pointer, destinationHandle int32, op1Handle int32, op2Handle int32, roundingMode int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalDiv(destinationHandle, op1Handle, op2Handle, roundingMode)
+}
+
+//export w2_mDecimalRescale
+func w2_mDecimalRescale(context unsafe.Pointer, destinationHandle int32, opHandle int32, scale int32, roundingMode int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalRescale(destinationHandle, opHandle, scale, roundingMode)
+}
+
+//export w2_mDecimalCmp
+func w2_mDecimalCmp(context unsafe.Pointer, op1Handle int32, op2Handle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalCmp(op1Handle, op2Handle)
+}
+
+//export w2_mDecimalLn
+func w2_mDecimalLn(context unsafe.Pointer, destinationHandle int32, opHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalLn(destinationHandle, opHandle)
+}
+
+//export w2_mDecimalExp
+func w2_mDecimalExp(context unsafe.Pointer, destinationHandle int32, opHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MDecimalExp(destinationHandle, opHandle)
+}
+
+//export w2_mBufferTransientStorageStore
+func w2_mBufferTransientStorageStore(context unsafe.Pointer, keyHandle int32, sourceHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MBufferTransientStorageStore(keyHandle, sourceHandle)
+}
+
+//export w2_mBufferTransientStorageLoad
+func w2_mBufferTransientStorageLoad(context unsafe.Pointer, keyHandle int32, destinationHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.MBufferTransientStorageLoad(keyHandle, destinationHandle)
+}
+
+//export w2_protectContractAgainstReentrancy
+func w2_protectContractAgainstReentrancy(context unsafe.Pointer) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ProtectContractAgainstReentrancy()
+}
+
+//export w2_managedProtectEndpointAgainstReentrancy
+func w2_managedProtectEndpointAgainstReentrancy(context unsafe.Pointer, endpointHandle int32) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.ManagedProtectEndpointAgainstReentrancy(endpointHandle)
+}
+
+//export w2_ethGetCallDataSize
+func w2_ethGetCallDataSize(context unsafe.Pointer) int32 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.EthGetCallDataSize()
+}
+
+//export w2_ethCallDataCopy
+func w2_ethCallDataCopy(context unsafe.Pointer, resultOffset int32, dataOffset int32, length int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthCallDataCopy(executor.MemPtr(resultOffset), dataOffset, length)
+}
+
+//export w2_ethStorageStore
+func w2_ethStorageStore(context unsafe.Pointer, pathOffset int32, valueOffset int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthStorageStore(executor.MemPtr(pathOffset), executor.MemPtr(valueOffset))
+}
+
+//export w2_ethStorageLoad
+func w2_ethStorageLoad(context unsafe.Pointer, pathOffset int32, resultOffset int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthStorageLoad(executor.MemPtr(pathOffset), executor.MemPtr(resultOffset))
+}
+
+//export w2_ethGetCallValue
+func w2_ethGetCallValue(context unsafe.Pointer, resultOffset int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthGetCallValue(executor.MemPtr(resultOffset))
+}
+
+//export w2_ethGetCaller
+func w2_ethGetCaller(context unsafe.Pointer, resultOffset int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthGetCaller(executor.MemPtr(resultOffset))
+}
+
+//export w2_ethGetAddress
+func w2_ethGetAddress(context unsafe.Pointer, resultOffset int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthGetAddress(executor.MemPtr(resultOffset))
+}
+
+//export w2_ethGetTxOrigin
+func w2_ethGetTxOrigin(context unsafe.Pointer, resultOffset int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthGetTxOrigin(executor.MemPtr(resultOffset))
+}
+
+//export w2_ethGetGasLeft
+func w2_ethGetGasLeft(context unsafe.Pointer) int64 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.EthGetGasLeft()
+}
+
+//export w2_ethGetBlockNumber
+func w2_ethGetBlockNumber(context unsafe.Pointer) int64 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.EthGetBlockNumber()
+}
+
+//export w2_ethGetBlockTimestamp
+func w2_ethGetBlockTimestamp(context unsafe.Pointer) int64 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.EthGetBlockTimestamp()
+}
+
+//export w2_ethLog
+func w2_ethLog(context unsafe.Pointer, dataOffset int32, dataLength int32, numberOfTopics int32, topic1Offset int32, topic2Offset int32, topic3Offset int32, topic4Offset int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthLog(executor.MemPtr(dataOffset), dataLength, numberOfTopics, executor.MemPtr(topic1Offset), executor.MemPtr(topic2Offset), executor.MemPtr(topic3Offset), executor.MemPtr(topic4Offset))
+}
+
+//export w2_ethFinish
+func w2_ethFinish(context unsafe.Pointer, dataOffset int32, dataLength int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthFinish(executor.MemPtr(dataOffset), dataLength)
+}
+
+//export w2_ethRevert
+func w2_ethRevert(context unsafe.Pointer, dataOffset int32, dataLength int32) {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	vmHooks.EthRevert(executor.MemPtr(dataOffset), dataLength)
+}
+
 //export w2_smallIntGetUnsignedArgument
 func w2_smallIntGetUnsignedArgument(context unsafe.Pointer, id int32) int64 {
 	vmHooks := getVMHooksFromContextRawPtr(context)
@@ -1995,6 +2317,12 @@ func w2_int64storageLoad(context unsafe.Pointer, keyOffset int32, keyLength int3
 	return vmHooks.Int64storageLoad(executor.MemPtr(keyOffset), keyLength)
 }
 
+//export w2_smallIntGetRandomInRange
+func w2_smallIntGetRandomInRange(context unsafe.Pointer, min int64, max int64) int64 {
+	vmHooks := getVMHooksFromContextRawPtr(context)
+	return vmHooks.SmallIntGetRandomInRange(min, max)
+}
+
 //export w2_sha256
 func w2_sha256(context unsafe.Pointer, dataOffset int32, length int32, resultOffset int32) int32 {
 	vmHooks := getVMHooksFromContextRawPtr(context)